@@ -5,6 +5,7 @@ import Login from './pages/Login'
 import Dashboard from './pages/Dashboard'
 import IncidentList from './pages/IncidentList'
 import IncidentDetail from './pages/IncidentDetail'
+import Search from './pages/Search'
 import Layout from './components/Layout/Layout'
 import { getCurrentUser } from './api/auth'
 import client from './apollo'
@@ -56,6 +57,7 @@ function App() {
               <Route index element={<Dashboard />} />
               <Route path="incidents" element={<IncidentList />} />
               <Route path="incidents/:id" element={<IncidentDetail />} />
+              <Route path="search" element={<Search />} />
               <Route path="*" element={<Navigate to="/" />} />
             </Route>
           ) : (
@@ -6,6 +6,7 @@ export interface User {
   email: string
   slack_user_id: string
   avatar_url?: string
+  role: 'admin' | 'responder' | 'viewer'
 }
 
 /**
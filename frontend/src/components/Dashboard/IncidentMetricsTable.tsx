@@ -0,0 +1,146 @@
+import React from 'react';
+import {
+  DurationStats,
+  IncidentMetrics,
+  IncidentMetricsGroup,
+  IncidentMetricsGroupBy,
+} from '../../types/dashboard';
+
+interface IncidentMetricsTableProps {
+  data?: IncidentMetrics;
+  loading?: boolean;
+  error?: Error;
+  groupBy: IncidentMetricsGroupBy;
+  months: number;
+  onGroupByChange: (groupBy: IncidentMetricsGroupBy) => void;
+  onMonthsChange: (months: number) => void;
+}
+
+const GROUP_BY_OPTIONS: { value: IncidentMetricsGroupBy; label: string }[] = [
+  { value: 'month', label: 'Month' },
+  { value: 'severity', label: 'Severity' },
+  { value: 'category', label: 'Category' },
+  { value: 'asset', label: 'Asset' },
+];
+
+const PERIOD_OPTIONS = [3, 6, 12];
+
+// formatDuration renders seconds as the two largest units, e.g. "2h 15m"
+export const formatDuration = (seconds: number | null | undefined): string => {
+  if (seconds == null) return '—';
+  const minutes = Math.round(seconds / 60);
+  if (minutes < 1) return '< 1m';
+  const days = Math.floor(minutes / 1440);
+  const hours = Math.floor((minutes % 1440) / 60);
+  const mins = minutes % 60;
+  if (days > 0) return `${days}d ${hours}h`;
+  if (hours > 0) return `${hours}h ${mins}m`;
+  return `${mins}m`;
+};
+
+const StatsCell: React.FC<{ stats: DurationStats }> = ({ stats }) => (
+  <td className="px-3 py-2 text-right whitespace-nowrap">
+    <div className="text-gray-900">{formatDuration(stats.meanSeconds)}</div>
+    {stats.count > 0 && (
+      <div className="text-xs text-gray-500">median {formatDuration(stats.medianSeconds)}</div>
+    )}
+  </td>
+);
+
+const GroupRow: React.FC<{ group: IncidentMetricsGroup; total?: boolean }> = ({ group, total }) => (
+  <tr className={total ? 'bg-gray-50 font-medium' : 'border-t border-gray-100'}>
+    <td className="px-3 py-2 text-left text-gray-900">{group.label}</td>
+    <td className="px-3 py-2 text-right">{group.incidentCount}</td>
+    <td className="px-3 py-2 text-right">{group.closedCount}</td>
+    <StatsCell stats={group.timeToAcknowledge} />
+    <StatsCell stats={group.timeToClose} />
+    <StatsCell stats={group.timeInTriage} />
+    <StatsCell stats={group.timeInHandling} />
+    <StatsCell stats={group.timeInMonitoring} />
+  </tr>
+);
+
+export const IncidentMetricsTable: React.FC<IncidentMetricsTableProps> = ({
+  data,
+  loading,
+  error,
+  groupBy,
+  months,
+  onGroupByChange,
+  onMonthsChange,
+}) => {
+  const title = 'Response Times';
+
+  return (
+    <div className="bg-white rounded-lg shadow-sm p-6">
+      <div className="flex items-center justify-between mb-6">
+        <h2 className="text-base font-semibold text-gray-900">{title}</h2>
+        <div className="flex items-center gap-2">
+          <select
+            value={groupBy}
+            onChange={(e) => onGroupByChange(e.target.value as IncidentMetricsGroupBy)}
+            className="px-2 py-1 text-sm border border-gray-300 rounded-md"
+          >
+            {GROUP_BY_OPTIONS.map((option) => (
+              <option key={option.value} value={option.value}>
+                By {option.label.toLowerCase()}
+              </option>
+            ))}
+          </select>
+          <select
+            value={months}
+            onChange={(e) => onMonthsChange(Number(e.target.value))}
+            className="px-2 py-1 text-sm border border-gray-300 rounded-md"
+          >
+            {PERIOD_OPTIONS.map((option) => (
+              <option key={option} value={option}>
+                Last {option} months
+              </option>
+            ))}
+          </select>
+        </div>
+      </div>
+
+      {loading ? (
+        <div className="flex items-center justify-center py-8">
+          <div className="animate-spin rounded-full h-8 w-8 border-b-2 border-blue-600"></div>
+        </div>
+      ) : error ? (
+        <div className="text-red-600">
+          <p className="font-medium">Error loading metrics</p>
+          <p className="text-sm mt-1">{error.message}</p>
+        </div>
+      ) : !data || data.total.incidentCount === 0 ? (
+        <p className="text-gray-500 text-center py-8">No incident data available</p>
+      ) : (
+        <div className="overflow-x-auto">
+          <table className="min-w-full text-sm">
+            <thead>
+              <tr className="text-xs uppercase tracking-wide text-gray-500">
+                <th className="px-3 py-2 text-left">
+                  {GROUP_BY_OPTIONS.find((option) => option.value === groupBy)?.label}
+                </th>
+                <th className="px-3 py-2 text-right">Incidents</th>
+                <th className="px-3 py-2 text-right">Closed</th>
+                <th className="px-3 py-2 text-right">MTTA</th>
+                <th className="px-3 py-2 text-right">MTTR</th>
+                <th className="px-3 py-2 text-right">Triage</th>
+                <th className="px-3 py-2 text-right">Handling</th>
+                <th className="px-3 py-2 text-right">Monitoring</th>
+              </tr>
+            </thead>
+            <tbody>
+              {data.groups.map((group) => (
+                <GroupRow key={group.key || 'none'} group={group} />
+              ))}
+              <GroupRow group={data.total} total />
+            </tbody>
+          </table>
+          <p className="mt-3 text-xs text-gray-500">
+            Means with medians below, excluding test incidents. MTTA is the time until an incident leaves triage, MTTR the time until it is closed.
+          </p>
+        </div>
+      )}
+    </div>
+  );
+};
@@ -0,0 +1,161 @@
+import React, { useState } from 'react';
+import { Link } from 'react-router-dom';
+import { useMutation } from '@apollo/client/react';
+import {
+  LINK_PARENT_INCIDENT,
+  UNLINK_PARENT_INCIDENT,
+  MERGE_INCIDENT,
+} from '../../graphql/mutations';
+import { RelatedIncident, IncidentStatus } from '../../types/incident';
+import StatusBadge from '../IncidentList/StatusBadge';
+import { Button } from '../ui/Button';
+
+interface RelatedIncidentsProps {
+  incidentId: string;
+  status: IncidentStatus;
+  parent?: RelatedIncident | null;
+  children: RelatedIncident[];
+  duplicateOf?: RelatedIncident | null;
+  duplicates: RelatedIncident[];
+  onChanged?: () => void;
+}
+
+const RelatedIncidentLink: React.FC<{ incident: RelatedIncident }> = ({ incident }) => (
+  <Link to={`/incidents/${incident.id}`} className="flex items-center gap-2 text-sm hover:underline">
+    <StatusBadge status={incident.status} size="sm" />
+    <span className="truncate">
+      #{incident.id} {incident.title}
+    </span>
+  </Link>
+);
+
+export const RelatedIncidents: React.FC<RelatedIncidentsProps> = ({
+  incidentId,
+  status,
+  parent,
+  children,
+  duplicateOf,
+  duplicates,
+  onChanged,
+}) => {
+  const [parentId, setParentId] = useState('');
+  const [primaryId, setPrimaryId] = useState('');
+
+  const options = { onCompleted: () => onChanged?.() };
+  const [linkParent, linkState] = useMutation(LINK_PARENT_INCIDENT, {
+    onCompleted: () => {
+      setParentId('');
+      onChanged?.();
+    },
+  });
+  const [unlinkParent, unlinkState] = useMutation(UNLINK_PARENT_INCIDENT, options);
+  const [mergeIncident, mergeState] = useMutation(MERGE_INCIDENT, {
+    onCompleted: () => {
+      setPrimaryId('');
+      onChanged?.();
+    },
+  });
+
+  const error = linkState.error || unlinkState.error || mergeState.error;
+  // Merged duplicates are closed and point to their primary incident instead
+  const editable = !duplicateOf && status !== IncidentStatus.CLOSED;
+
+  const handleMerge = (e: React.FormEvent) => {
+    e.preventDefault();
+    const target = primaryId.trim().replace(/^#/, '');
+    if (!target) return;
+    if (!window.confirm(`Merge this incident into #${target}? Its tasks are moved and it is closed as a duplicate.`)) {
+      return;
+    }
+    mergeIncident({ variables: { duplicateId: incidentId, primaryId: target } });
+  };
+
+  const handleLink = (e: React.FormEvent) => {
+    e.preventDefault();
+    const target = parentId.trim().replace(/^#/, '');
+    if (!target) return;
+    linkParent({ variables: { incidentId, parentId: target } });
+  };
+
+  return (
+    <div className="space-y-3">
+      {duplicateOf && (
+        <div>
+          <div className="text-xs text-slate-500 mb-1">Duplicate of</div>
+          <RelatedIncidentLink incident={duplicateOf} />
+        </div>
+      )}
+
+      {parent && (
+        <div>
+          <div className="flex items-center justify-between text-xs text-slate-500 mb-1">
+            <span>Part of major incident</span>
+            <button
+              type="button"
+              className="text-blue-600 hover:underline disabled:opacity-50"
+              disabled={unlinkState.loading}
+              onClick={() => unlinkParent({ variables: { incidentId } })}
+            >
+              Unlink
+            </button>
+          </div>
+          <RelatedIncidentLink incident={parent} />
+        </div>
+      )}
+
+      {children.length > 0 && (
+        <div>
+          <div className="text-xs text-slate-500 mb-1">Child incidents</div>
+          <div className="space-y-1">
+            {children.map((child) => (
+              <RelatedIncidentLink key={child.id} incident={child} />
+            ))}
+          </div>
+        </div>
+      )}
+
+      {duplicates.length > 0 && (
+        <div>
+          <div className="text-xs text-slate-500 mb-1">Merged duplicates</div>
+          <div className="space-y-1">
+            {duplicates.map((duplicate) => (
+              <RelatedIncidentLink key={duplicate.id} incident={duplicate} />
+            ))}
+          </div>
+        </div>
+      )}
+
+      {editable && !parent && children.length === 0 && (
+        <form onSubmit={handleLink} className="flex gap-2">
+          <input
+            value={parentId}
+            onChange={(e) => setParentId(e.target.value)}
+            className="flex-1 rounded-md border border-slate-300 px-2 py-1 text-sm focus:border-blue-500 focus:outline-none"
+            placeholder="Major incident #"
+          />
+          <Button type="submit" size="sm" variant="outline" disabled={linkState.loading || !parentId.trim()}>
+            Link
+          </Button>
+        </form>
+      )}
+
+      {editable && (
+        <form onSubmit={handleMerge} className="flex gap-2">
+          <input
+            value={primaryId}
+            onChange={(e) => setPrimaryId(e.target.value)}
+            className="flex-1 rounded-md border border-slate-300 px-2 py-1 text-sm focus:border-blue-500 focus:outline-none"
+            placeholder="Duplicate of #"
+          />
+          <Button type="submit" size="sm" variant="outline" disabled={mergeState.loading || !primaryId.trim()}>
+            Merge
+          </Button>
+        </form>
+      )}
+
+      {error && <p className="text-sm text-red-600">{error.message}</p>}
+    </div>
+  );
+};
+
+export default RelatedIncidents;
@@ -0,0 +1,83 @@
+import React, { useState } from 'react';
+import { useMutation } from '@apollo/client/react';
+import { formatDistanceToNow } from 'date-fns';
+import { POST_STAKEHOLDER_UPDATE } from '../../graphql/mutations';
+import { StakeholderUpdate } from '../../types/incident';
+import StatusBadge from '../IncidentList/StatusBadge';
+import { Button } from '../ui/Button';
+
+interface StakeholderUpdatesProps {
+  incidentId: string;
+  updates: StakeholderUpdate[];
+  onPosted?: () => void;
+}
+
+export const StakeholderUpdates: React.FC<StakeholderUpdatesProps> = ({
+  incidentId,
+  updates,
+  onPosted,
+}) => {
+  const [text, setText] = useState('');
+
+  const [postUpdate, { loading, error }] = useMutation(POST_STAKEHOLDER_UPDATE, {
+    onCompleted: () => {
+      setText('');
+      onPosted?.();
+    },
+  });
+
+  // Newest first
+  const sortedUpdates = [...updates].sort(
+    (a, b) => new Date(b.postedAt).getTime() - new Date(a.postedAt).getTime()
+  );
+
+  const handleSubmit = (e: React.FormEvent) => {
+    e.preventDefault();
+    if (!text.trim()) return;
+    postUpdate({ variables: { incidentId, text: text.trim() } });
+  };
+
+  return (
+    <div>
+      <h3 className="text-lg font-semibold mb-4">Stakeholder Updates</h3>
+
+      <form onSubmit={handleSubmit} className="space-y-2 mb-4">
+        <textarea
+          value={text}
+          onChange={(e) => setText(e.target.value)}
+          rows={3}
+          maxLength={3000}
+          className="w-full rounded-md border border-slate-300 px-3 py-2 text-sm focus:border-blue-500 focus:outline-none"
+          placeholder="What is the impact, what is being done and when is the next update?"
+        />
+        <div className="flex items-center justify-between">
+          {error ? <p className="text-sm text-red-600">{error.message}</p> : <span />}
+          <Button type="submit" size="sm" disabled={loading || !text.trim()}>
+            {loading ? 'Posting...' : 'Post update'}
+          </Button>
+        </div>
+      </form>
+
+      {sortedUpdates.length === 0 ? (
+        <p className="text-gray-500 text-center py-4">No stakeholder updates yet</p>
+      ) : (
+        <div className="space-y-4">
+          {sortedUpdates.map((update) => (
+            <div key={update.id} className="border-l-2 border-slate-200 pl-3">
+              <div className="flex items-center gap-2 mb-1">
+                <StatusBadge status={update.status} size="sm" />
+                <span className="text-sm text-gray-500">
+                  {update.postedByUser?.name || update.postedBy} ·{' '}
+                  {formatDistanceToNow(new Date(update.postedAt), { addSuffix: true })}
+                </span>
+              </div>
+              <p className="text-sm text-slate-700 whitespace-pre-wrap">{update.text}</p>
+            </div>
+          ))}
+        </div>
+      )}
+    </div>
+  );
+};
+
+export default StakeholderUpdates;
@@ -1,7 +1,7 @@
 import React, { useState } from 'react';
 import { useMutation } from '@apollo/client/react';
 import { IncidentStatus, getStatusConfig } from '../../types/incident';
-import { UPDATE_INCIDENT_STATUS } from '../../graphql/mutations';
+import { CLOSE_INCIDENT, UPDATE_INCIDENT_STATUS } from '../../graphql/mutations';
 import { GET_INCIDENT } from '../../graphql/queries';
 import StatusBadge from '../IncidentList/StatusBadge';
 import { Button } from '../ui/Button';
@@ -23,7 +23,7 @@ export const StatusChangeModal: React.FC<StatusChangeModalProps> = ({
   const [selectedStatus, setSelectedStatus] = useState<IncidentStatus | null>(null);
   const [note, setNote] = useState('');
 
-  const [updateIncidentStatus, { loading }] = useMutation(UPDATE_INCIDENT_STATUS, {
+  const mutationOptions = {
     refetchQueries: [
       { query: GET_INCIDENT, variables: { id: incidentId } }
     ],
@@ -33,11 +33,19 @@ export const StatusChangeModal: React.FC<StatusChangeModalProps> = ({
       }
       onClose();
     },
-    onError: (error) => {
+    onError: (error: Error) => {
       console.error('Failed to update status:', error);
       // You might want to show a toast notification here
     }
-  });
+  };
+  const [updateIncidentStatus, { loading: updating }] = useMutation(UPDATE_INCIDENT_STATUS, mutationOptions);
+  // Closing goes through closeIncident, which requires a resolution summary
+  const [closeIncident, { loading: closing }] = useMutation(CLOSE_INCIDENT, mutationOptions);
+  const loading = updating || closing;
+  const isClosing = selectedStatus === IncidentStatus.CLOSED;
+  // Leaving closed reopens the incident, which requires a reason
+  const isReopening = currentStatus === IncidentStatus.CLOSED;
+  const noteRequired = isClosing || isReopening;
 
   const statusOptions = [
     IncidentStatus.TRIAGE,
@@ -50,6 +58,15 @@ export const StatusChangeModal: React.FC<StatusChangeModalProps> = ({
     if (!selectedStatus) return;
 
     try {
+      if (isClosing) {
+        await closeIncident({
+          variables: {
+            id: incidentId,
+            resolution: note.trim()
+          }
+        });
+        return;
+      }
       await updateIncidentStatus({
         variables: {
           incidentId,
@@ -68,7 +85,7 @@ export const StatusChangeModal: React.FC<StatusChangeModalProps> = ({
       <div className="bg-white rounded-lg shadow-xl w-full max-w-md mx-4">
         {/* Header */}
         <div className="flex items-center justify-between p-4 border-b">
-          <h2 className="text-lg font-semibold">Change Status</h2>
+          <h2 className="text-lg font-semibold">{isReopening ? 'Reopen Incident' : 'Change Status'}</h2>
           <button
             onClick={onClose}
             className="text-gray-400 hover:text-gray-600 transition-colors"
@@ -122,13 +139,19 @@ export const StatusChangeModal: React.FC<StatusChangeModalProps> = ({
           {/* Note */}
           <div className="mb-4">
             <label htmlFor="status-note" className="block text-sm font-medium text-gray-700 mb-2">
-              Note (optional)
+              {isClosing ? 'Resolution summary (required)' : isReopening ? 'Reason for reopening (required)' : 'Note (optional)'}
             </label>
             <textarea
               id="status-note"
               value={note}
               onChange={(e) => setNote(e.target.value)}
-              placeholder="Add a note about this status change..."
+              placeholder={
+                isClosing
+                  ? 'Summarize how the incident was resolved...'
+                  : isReopening
+                  ? 'Why does this incident need to be reopened?'
+                  : 'Add a note about this status change...'
+              }
               className="w-full px-3 py-2 border border-gray-300 rounded-md focus:outline-none focus:ring-2 focus:ring-blue-500 focus:border-blue-500 resize-none"
               rows={3}
             />
@@ -148,10 +171,10 @@ export const StatusChangeModal: React.FC<StatusChangeModalProps> = ({
           </Button>
           <Button
             onClick={handleConfirmChange}
-            disabled={loading || !selectedStatus || selectedStatus === currentStatus}
+            disabled={loading || !selectedStatus || selectedStatus === currentStatus || (noteRequired && !note.trim())}
             className="flex-1"
           >
-            {loading ? 'Updating...' : 'Confirm Change'}
+            {loading ? 'Updating...' : isReopening ? 'Reopen' : 'Confirm Change'}
           </Button>
         </div>
       </div>
@@ -77,6 +77,16 @@ export const StatusSection: React.FC<StatusSectionProps> = ({
                   <div className="flex-1 min-w-0">
                     <div className="flex items-center gap-2 mb-1">
                       <StatusBadge status={history.status} size="sm" />
+                      {history.reopened && (
+                        <span className="text-xs font-medium text-orange-700 bg-orange-50 border border-orange-200 rounded px-1.5 py-0.5">
+                          Reopened
+                        </span>
+                      )}
+                      {history.sourceIncidentId && (
+                        <span className="text-xs font-medium text-gray-700 bg-gray-50 border border-gray-200 rounded px-1.5 py-0.5">
+                          From #{history.sourceIncidentId}
+                        </span>
+                      )}
                       <span className="text-sm text-gray-500">
                         {formatDistanceToNow(new Date(history.changedAt), { addSuffix: true })}
                       </span>
@@ -0,0 +1,253 @@
+import React, { useState } from 'react';
+import { useMutation, useQuery } from '@apollo/client/react';
+import { CREATE_INCIDENT } from '../../graphql/mutations';
+import { GET_CATEGORIES, GET_SEVERITIES, GET_SLACK_CHANNELS } from '../../graphql/queries';
+import { Button } from '../ui/Button';
+import { getSeverityStyle } from '../../types/incident';
+import { X } from 'lucide-react';
+
+interface Category {
+  id: string;
+  name: string;
+  description: string;
+}
+
+interface Severity {
+  id: string;
+  name: string;
+  level: number;
+}
+
+interface SlackChannel {
+  id: string;
+  name: string;
+}
+
+interface DeclareIncidentModalProps {
+  onClose: () => void;
+  onCreated: (incidentId: string) => void;
+}
+
+export const DeclareIncidentModal: React.FC<DeclareIncidentModalProps> = ({
+  onClose,
+  onCreated
+}) => {
+  const [title, setTitle] = useState('');
+  const [description, setDescription] = useState('');
+  const [categoryId, setCategoryId] = useState('');
+  const [severityId, setSeverityId] = useState('');
+  const [originChannelId, setOriginChannelId] = useState('');
+  const [isPrivate, setIsPrivate] = useState(false);
+  const [isTest, setIsTest] = useState(false);
+  const [error, setError] = useState<string | null>(null);
+
+  const { data: categoriesData } = useQuery<{ categories: Category[] }>(GET_CATEGORIES);
+  const { data: severitiesData } = useQuery<{ severities: Severity[] }>(GET_SEVERITIES);
+  const { data: channelsData, loading: channelsLoading } = useQuery<{ slackChannels: SlackChannel[] }>(GET_SLACK_CHANNELS);
+
+  const categories = categoriesData?.categories || [];
+  const severities = severitiesData?.severities || [];
+  const channels = channelsData?.slackChannels || [];
+
+  const [createIncident, { loading }] = useMutation<{ createIncident: { id: string } }>(CREATE_INCIDENT, {
+    onCompleted: (data) => {
+      onCreated(data.createIncident.id);
+    },
+    onError: (err) => {
+      console.error('Failed to declare incident:', err);
+      setError(err.message);
+    }
+  });
+
+  const canSubmit = title.trim() !== '' && categoryId !== '' && originChannelId !== '';
+
+  const handleSubmit = async () => {
+    if (!canSubmit) return;
+    setError(null);
+
+    try {
+      await createIncident({
+        variables: {
+          input: {
+            title: title.trim(),
+            description: description.trim() || undefined,
+            categoryId,
+            severityId: severityId || undefined,
+            originChannelId,
+            private: isPrivate,
+            isTest
+          }
+        }
+      });
+    } catch (err) {
+      // Error is handled in the onError callback
+    }
+  };
+
+  return (
+    <div className="fixed inset-0 bg-black bg-opacity-50 flex items-center justify-center z-50">
+      <div className="bg-white rounded-lg shadow-xl w-full max-w-2xl mx-4">
+        {/* Header */}
+        <div className="flex items-center justify-between p-4 border-b">
+          <h2 className="text-lg font-semibold">Declare Incident</h2>
+          <button
+            onClick={onClose}
+            className="text-gray-400 hover:text-gray-600 transition-colors"
+          >
+            <X size={20} />
+          </button>
+        </div>
+
+        {/* Content */}
+        <div className="p-6 space-y-4">
+          {error && (
+            <div className="rounded-md bg-red-50 border border-red-200 p-3 text-sm text-red-700">
+              {error}
+            </div>
+          )}
+
+          {/* Title field */}
+          <div>
+            <label htmlFor="declare-title" className="block text-sm font-medium text-gray-700 mb-1">
+              Title
+            </label>
+            <input
+              id="declare-title"
+              type="text"
+              value={title}
+              onChange={(e) => setTitle(e.target.value)}
+              className="w-full px-3 py-2 border border-gray-300 rounded-md focus:outline-none focus:ring-2 focus:ring-blue-500 focus:border-transparent"
+              placeholder="Enter incident title"
+            />
+          </div>
+
+          {/* Description field */}
+          <div>
+            <label htmlFor="declare-description" className="block text-sm font-medium text-gray-700 mb-1">
+              Description (optional)
+            </label>
+            <textarea
+              id="declare-description"
+              value={description}
+              onChange={(e) => setDescription(e.target.value)}
+              rows={3}
+              className="w-full px-3 py-2 border border-gray-300 rounded-md focus:outline-none focus:ring-2 focus:ring-blue-500 focus:border-transparent resize-none"
+              placeholder="Enter incident description"
+            />
+          </div>
+
+          {/* Category field */}
+          <div>
+            <label htmlFor="declare-category" className="block text-sm font-medium text-gray-700 mb-1">
+              Category
+            </label>
+            <select
+              id="declare-category"
+              value={categoryId}
+              onChange={(e) => setCategoryId(e.target.value)}
+              className="w-full px-3 py-2 border border-gray-300 rounded-md focus:outline-none focus:ring-2 focus:ring-blue-500 focus:border-transparent"
+            >
+              <option value="">Select a category...</option>
+              {categories.map((category) => (
+                <option key={category.id} value={category.id}>
+                  {category.name}
+                </option>
+              ))}
+            </select>
+          </div>
+
+          {/* Severity field */}
+          {severities.length > 0 && (
+            <div>
+              <label htmlFor="declare-severity" className="block text-sm font-medium text-gray-700 mb-1">
+                Severity (optional)
+              </label>
+              <select
+                id="declare-severity"
+                value={severityId}
+                onChange={(e) => setSeverityId(e.target.value)}
+                className="w-full px-3 py-2 border border-gray-300 rounded-md focus:outline-none focus:ring-2 focus:ring-blue-500 focus:border-transparent"
+              >
+                <option value="">Not set</option>
+                {severities.map((severity) => {
+                  const style = getSeverityStyle(severity.level);
+                  return (
+                    <option key={severity.id} value={severity.id}>
+                      {style.icon} {severity.name}
+                    </option>
+                  );
+                })}
+              </select>
+            </div>
+          )}
+
+          {/* Origin channel field */}
+          <div>
+            <label htmlFor="declare-channel" className="block text-sm font-medium text-gray-700 mb-1">
+              Announce in channel
+            </label>
+            <select
+              id="declare-channel"
+              value={originChannelId}
+              onChange={(e) => setOriginChannelId(e.target.value)}
+              disabled={channelsLoading}
+              className="w-full px-3 py-2 border border-gray-300 rounded-md focus:outline-none focus:ring-2 focus:ring-blue-500 focus:border-transparent"
+            >
+              <option value="">{channelsLoading ? 'Loading channels...' : 'Select a channel...'}</option>
+              {channels.map((channel) => (
+                <option key={channel.id} value={channel.id}>
+                  #{channel.name}
+                </option>
+              ))}
+            </select>
+            <p className="mt-1 text-sm text-gray-500">
+              Public channels lycaon is a member of
+            </p>
+          </div>
+
+          {/* Flags */}
+          <div className="flex items-center gap-6">
+            <label className="flex items-center gap-2 text-sm text-gray-700">
+              <input
+                type="checkbox"
+                checked={isPrivate}
+                onChange={(e) => setIsPrivate(e.target.checked)}
+                className="h-4 w-4 text-blue-600 focus:ring-blue-500 border-gray-300 rounded"
+              />
+              Private incident
+            </label>
+            <label className="flex items-center gap-2 text-sm text-gray-700">
+              <input
+                type="checkbox"
+                checked={isTest}
+                onChange={(e) => setIsTest(e.target.checked)}
+                className="h-4 w-4 text-blue-600 focus:ring-blue-500 border-gray-300 rounded"
+              />
+              Test incident
+            </label>
+          </div>
+        </div>
+
+        {/* Footer */}
+        <div className="flex items-center justify-end gap-3 px-6 py-4 border-t bg-gray-50">
+          <Button
+            variant="ghost"
+            onClick={onClose}
+            disabled={loading}
+          >
+            Cancel
+          </Button>
+          <Button
+            variant="default"
+            onClick={handleSubmit}
+            disabled={loading || !canSubmit}
+          >
+            {loading ? 'Declaring...' : 'Declare'}
+          </Button>
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default DeclareIncidentModal;
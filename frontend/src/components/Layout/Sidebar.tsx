@@ -4,6 +4,7 @@ import { cn } from '../../lib/utils';
 import {
   LayoutDashboard,
   AlertCircle,
+  Search,
   ChevronLeft,
   Menu,
 } from 'lucide-react';
@@ -28,6 +29,11 @@ const Sidebar: React.FC<SidebarProps> = ({ isOpen, onToggle }) => {
       path: '/incidents',
       icon: <AlertCircle className="h-5 w-5" />,
     },
+    {
+      text: 'Search',
+      path: '/search',
+      icon: <Search className="h-5 w-5" />,
+    },
   ];
 
   const isActive = (path: string) => {
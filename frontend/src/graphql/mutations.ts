@@ -1,5 +1,35 @@
 import { gql } from '@apollo/client';
-import { INCIDENT_FIELDS, TASK_FIELDS } from './queries';
+import { INCIDENT_FIELDS, TASK_FIELDS, STAKEHOLDER_UPDATE_FIELDS } from './queries';
+
+// Mutation to declare an incident
+export const CREATE_INCIDENT = gql`
+  ${INCIDENT_FIELDS}
+  mutation CreateIncident($input: CreateIncidentInput!) {
+    createIncident(input: $input) {
+      ...IncidentFields
+    }
+  }
+`;
+
+// Mutation to close an incident with a resolution summary
+export const CLOSE_INCIDENT = gql`
+  ${INCIDENT_FIELDS}
+  mutation CloseIncident($id: ID!, $resolution: String!) {
+    closeIncident(id: $id, resolution: $resolution) {
+      ...IncidentFields
+    }
+  }
+`;
+
+// Mutation to post a stakeholder update of an incident
+export const POST_STAKEHOLDER_UPDATE = gql`
+  ${STAKEHOLDER_UPDATE_FIELDS}
+  mutation PostStakeholderUpdate($incidentId: ID!, $text: String!) {
+    postStakeholderUpdate(incidentId: $incidentId, text: $text) {
+      ...StakeholderUpdateFields
+    }
+  }
+`;
 
 // Mutation to update an incident
 export const UPDATE_INCIDENT = gql`
@@ -46,4 +76,37 @@ export const UPDATE_INCIDENT_STATUS = gql`
       ...IncidentFields
     }
   }
-`;
\ No newline at end of file
+`;
+// Mutation to ask the incident lead for access to a private incident
+export const REQUEST_INCIDENT_ACCESS = gql`
+  mutation RequestIncidentAccess($incidentId: ID!, $reason: String) {
+    requestIncidentAccess(incidentId: $incidentId, reason: $reason)
+  }
+`;
+
+// Mutation to link an incident as a child of a major incident
+export const LINK_PARENT_INCIDENT = gql`
+  mutation LinkParentIncident($incidentId: ID!, $parentId: ID!) {
+    linkParentIncident(incidentId: $incidentId, parentId: $parentId) {
+      id
+    }
+  }
+`;
+
+// Mutation to remove an incident from its major incident
+export const UNLINK_PARENT_INCIDENT = gql`
+  mutation UnlinkParentIncident($incidentId: ID!) {
+    unlinkParentIncident(incidentId: $incidentId) {
+      id
+    }
+  }
+`;
+
+// Mutation to merge a duplicate incident into a primary incident
+export const MERGE_INCIDENT = gql`
+  mutation MergeIncident($duplicateId: ID!, $primaryId: ID!) {
+    mergeIncident(duplicateId: $duplicateId, primaryId: $primaryId) {
+      id
+    }
+  }
+`;
@@ -24,6 +24,24 @@ export const STATUS_HISTORY_FIELDS = gql`
     }
     changedAt
     note
+    reopened
+    sourceIncidentId
+  }
+  ${USER_FIELDS}
+`;
+
+// Fragment for stakeholder update fields
+export const STAKEHOLDER_UPDATE_FIELDS = gql`
+  fragment StakeholderUpdateFields on StakeholderUpdate {
+    id
+    incidentId
+    text
+    status
+    postedBy
+    postedByUser {
+      ...UserFields
+    }
+    postedAt
   }
   ${USER_FIELDS}
 `;
@@ -60,6 +78,9 @@ export const INCIDENT_FIELDS = gql`
     private
     viewerCanAccess
     isTest
+    resolution
+    reopenCount
+    firstClosedAt
     statusHistories {
       ...StatusHistoryFields
     }
@@ -116,14 +137,44 @@ export const GET_INCIDENTS = gql`
 export const GET_INCIDENT = gql`
   ${INCIDENT_FIELDS}
   ${TASK_FIELDS}
+  ${STAKEHOLDER_UPDATE_FIELDS}
   query GetIncident($id: ID!) {
     incident(id: $id) {
       ...IncidentFields
       tasks {
         ...TaskFields
       }
+      stakeholderUpdates {
+        ...StakeholderUpdateFields
+      }
+      closedAt
+      channelArchived
+      transcript {
+        exportedAt
+        messageCount
+        markdown
+      }
+      parent {
+        ...RelatedIncidentFields
+      }
+      children {
+        ...RelatedIncidentFields
+      }
+      duplicateOf {
+        ...RelatedIncidentFields
+      }
+      duplicates {
+        ...RelatedIncidentFields
+      }
     }
   }
+
+  fragment RelatedIncidentFields on Incident {
+    id
+    title
+    status
+    private
+  }
 `;
 
 // Query to get tasks for an incident
@@ -166,6 +217,27 @@ export const GET_CHANNEL_MEMBERS = gql`
   }
 `;
 
+// Query to get all categories
+export const GET_CATEGORIES = gql`
+  query GetCategories {
+    categories {
+      id
+      name
+      description
+    }
+  }
+`;
+
+// Query to get the Slack channels an incident can be declared from
+export const GET_SLACK_CHANNELS = gql`
+  query GetSlackChannels {
+    slackChannels {
+      id
+      name
+    }
+  }
+`;
+
 // Query to get all severities
 export const GET_SEVERITIES = gql`
   query GetSeverities {
@@ -233,4 +305,85 @@ export const GET_INCIDENT_TREND_BY_SEVERITY = gql`
       }
     }
   }
-`;
\ No newline at end of file
+`;
+// Fragment for duration statistics of incident metrics
+export const DURATION_STATS_FIELDS = gql`
+  fragment DurationStatsFields on DurationStats {
+    count
+    meanSeconds
+    medianSeconds
+  }
+`;
+
+// Fragment for a group of incident metrics
+export const INCIDENT_METRICS_GROUP_FIELDS = gql`
+  fragment IncidentMetricsGroupFields on IncidentMetricsGroup {
+    key
+    label
+    incidentCount
+    closedCount
+    timeToAcknowledge {
+      ...DurationStatsFields
+    }
+    timeToClose {
+      ...DurationStatsFields
+    }
+    timeInTriage {
+      ...DurationStatsFields
+    }
+    timeInHandling {
+      ...DurationStatsFields
+    }
+    timeInMonitoring {
+      ...DurationStatsFields
+    }
+  }
+  ${DURATION_STATS_FIELDS}
+`;
+
+// Query to get response time metrics of incidents
+export const GET_INCIDENT_METRICS = gql`
+  query GetIncidentMetrics($from: Time!, $to: Time!, $groupBy: IncidentMetricsGroupBy!) {
+    incidentMetrics(from: $from, to: $to, groupBy: $groupBy) {
+      from
+      to
+      groupBy
+      total {
+        ...IncidentMetricsGroupFields
+      }
+      groups {
+        ...IncidentMetricsGroupFields
+      }
+    }
+  }
+  ${INCIDENT_METRICS_GROUP_FIELDS}
+`;
+
+// Query to search incidents, tasks, status changes and channel messages
+export const SEARCH = gql`
+  query Search($query: String!, $filter: SearchFilterInput, $first: Int) {
+    search(query: $query, filter: $filter, first: $first) {
+      totalCount
+      hits {
+        id
+        kind
+        incidentId
+        incident {
+          id
+          title
+        }
+        title
+        timestamp
+        permalink
+        highlights {
+          field
+          fragment
+          ranges {
+            start
+            end
+          }
+        }
+      }
+    }
+  }
+`;
@@ -0,0 +1,24 @@
+// Subscriptions are run by useLiveUpdates, which takes the query as plain text
+
+// Subscription to incidents being declared or changed
+export const INCIDENT_UPDATED = `
+  subscription IncidentUpdated {
+    incidentUpdated {
+      id
+      status
+    }
+  }
+`;
+
+// Subscription to everything happening during one incident
+export const TIMELINE_EVENT_ADDED = `
+  subscription TimelineEventAdded($incidentId: ID!) {
+    timelineEventAdded(incidentId: $incidentId) {
+      incidentId
+      kind
+      actorId
+      summary
+      timestamp
+    }
+  }
+`;
@@ -0,0 +1,100 @@
+import { useEffect, useRef } from 'react';
+
+// Minimal client of the graphql-transport-ws protocol served on /graphql.
+// It only needs to notify about changes, so payloads are passed through as is.
+
+const RECONNECT_MIN_MS = 1000;
+const RECONNECT_MAX_MS = 30000;
+
+interface Message {
+  id?: string;
+  type: string;
+  payload?: unknown;
+}
+
+const subscriptionURL = (): string => {
+  const protocol = window.location.protocol === 'https:' ? 'wss:' : 'ws:';
+  return `${protocol}//${window.location.host}/graphql`;
+};
+
+/**
+ * Runs a GraphQL subscription while the component is mounted and calls onData
+ * for every event. The connection is re-established with backoff when it drops.
+ */
+export function useLiveUpdates<T>(
+  query: string,
+  variables: Record<string, unknown>,
+  onData: (data: T) => void,
+  enabled = true
+): void {
+  const onDataRef = useRef(onData);
+  onDataRef.current = onData;
+
+  const variablesKey = JSON.stringify(variables);
+
+  useEffect(() => {
+    if (!enabled) {
+      return;
+    }
+
+    let socket: WebSocket | null = null;
+    let closed = false;
+    let retryDelay = RECONNECT_MIN_MS;
+    let retryTimer: ReturnType<typeof setTimeout> | undefined;
+
+    const connect = () => {
+      socket = new WebSocket(subscriptionURL(), 'graphql-transport-ws');
+
+      socket.onopen = () => {
+        socket?.send(JSON.stringify({ type: 'connection_init' }));
+      };
+
+      socket.onmessage = (event) => {
+        const message: Message = JSON.parse(event.data);
+        switch (message.type) {
+          case 'connection_ack':
+            retryDelay = RECONNECT_MIN_MS;
+            socket?.send(
+              JSON.stringify({
+                id: '1',
+                type: 'subscribe',
+                payload: { query, variables: JSON.parse(variablesKey) },
+              })
+            );
+            break;
+          case 'ping':
+            socket?.send(JSON.stringify({ type: 'pong' }));
+            break;
+          case 'next': {
+            const payload = message.payload as { data?: T };
+            if (payload?.data) {
+              onDataRef.current(payload.data);
+            }
+            break;
+          }
+          case 'error':
+            console.error('Subscription error:', message.payload);
+            break;
+        }
+      };
+
+      socket.onclose = () => {
+        if (closed) {
+          return;
+        }
+        retryTimer = setTimeout(connect, retryDelay);
+        retryDelay = Math.min(retryDelay * 2, RECONNECT_MAX_MS);
+      };
+    };
+
+    connect();
+
+    return () => {
+      closed = true;
+      if (retryTimer) {
+        clearTimeout(retryTimer);
+      }
+      socket?.close();
+    };
+  }, [query, variablesKey, enabled]);
+}
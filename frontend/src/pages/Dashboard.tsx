@@ -1,14 +1,18 @@
-import React from 'react';
+import React, { useMemo, useState } from 'react';
 import { useQuery } from '@apollo/client/react';
 import { OpenIncidentsList } from '../components/Dashboard/OpenIncidentsList';
 import { SeverityTrendChart } from '../components/Dashboard/SeverityTrendChart';
+import { IncidentMetricsTable } from '../components/Dashboard/IncidentMetricsTable';
 import {
   GET_RECENT_OPEN_INCIDENTS,
   GET_INCIDENT_TREND_BY_SEVERITY,
+  GET_INCIDENT_METRICS,
 } from '../graphql/queries';
 import {
   RecentOpenIncidentsData,
   IncidentTrendBySeverityData,
+  IncidentMetricsData,
+  IncidentMetricsGroupBy,
 } from '../types/dashboard';
 
 const Dashboard: React.FC = () => {
@@ -31,6 +35,24 @@ const Dashboard: React.FC = () => {
     variables: { weeks },
   });
 
+  const [metricsGroupBy, setMetricsGroupBy] = useState<IncidentMetricsGroupBy>('month');
+  const [metricsMonths, setMetricsMonths] = useState(6);
+  // The period covers whole months, up to the start of the next month
+  const metricsPeriod = useMemo(() => {
+    const now = new Date();
+    const to = new Date(Date.UTC(now.getUTCFullYear(), now.getUTCMonth() + 1, 1));
+    const from = new Date(Date.UTC(now.getUTCFullYear(), now.getUTCMonth() + 1 - metricsMonths, 1));
+    return { from: from.toISOString(), to: to.toISOString() };
+  }, [metricsMonths]);
+
+  const {
+    data: metricsData,
+    loading: metricsLoading,
+    error: metricsError,
+  } = useQuery<IncidentMetricsData>(GET_INCIDENT_METRICS, {
+    variables: { ...metricsPeriod, groupBy: metricsGroupBy },
+  });
+
   return (
     <div className="space-y-6">
       <SeverityTrendChart
@@ -40,6 +62,16 @@ const Dashboard: React.FC = () => {
         weeks={weeks}
       />
 
+      <IncidentMetricsTable
+        data={metricsData?.incidentMetrics}
+        loading={metricsLoading}
+        error={metricsError}
+        groupBy={metricsGroupBy}
+        months={metricsMonths}
+        onGroupByChange={setMetricsGroupBy}
+        onMonthsChange={setMetricsMonths}
+      />
+
       <OpenIncidentsList
         incidents={incidentsData?.recentOpenIncidents || []}
         loading={incidentsLoading}
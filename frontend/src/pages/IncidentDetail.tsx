@@ -1,11 +1,16 @@
 import React, { useState } from 'react';
 import { useParams, useNavigate } from 'react-router-dom';
-import { useQuery } from '@apollo/client/react';
+import { useQuery, useMutation } from '@apollo/client/react';
 import { format } from 'date-fns';
 import { GET_INCIDENT, GET_SEVERITIES, GET_ASSETS } from '../graphql/queries';
+import { REQUEST_INCIDENT_ACCESS } from '../graphql/mutations';
+import { TIMELINE_EVENT_ADDED } from '../graphql/subscriptions';
+import { useLiveUpdates } from '../hooks/useLiveUpdates';
 import { IncidentStatus, toIncidentStatus, Asset } from '../types/incident';
 import StatusSection from '../components/IncidentDetail/StatusSection';
 import TaskList from '../components/IncidentDetail/TaskList';
+import StakeholderUpdates from '../components/IncidentDetail/StakeholderUpdates';
+import RelatedIncidents from '../components/IncidentDetail/RelatedIncidents';
 import { EditIncidentModal } from '../components/IncidentDetail/EditIncidentModal';
 import { Button } from '../components/ui/Button';
 import SlackChannelLink from '../components/common/SlackChannelLink';
@@ -25,15 +30,27 @@ const IncidentDetail: React.FC = () => {
   const { id } = useParams<{ id: string }>();
   const navigate = useNavigate();
   const [showEditModal, setShowEditModal] = useState(false);
+  const [accessReason, setAccessReason] = useState('');
+  const [accessRequested, setAccessRequested] = useState(false);
 
-  const { loading, error, data } = useQuery<{ incident: any }>(GET_INCIDENT, {
+  const { loading, error, data, refetch } = useQuery<{ incident: any }>(GET_INCIDENT, {
     variables: { id },
     skip: !id,
   });
 
+  // Reload the incident whenever responders change it, e.g. from Slack
+  useLiveUpdates(TIMELINE_EVENT_ADDED, { incidentId: id }, () => refetch(), !!id);
+
   const { data: severitiesData } = useQuery<{ severities: Array<{ id: string; name: string; level: number }> }>(GET_SEVERITIES);
   const { data: assetsData } = useQuery<{ assets: Asset[] }>(GET_ASSETS);
 
+  const [requestAccess, { loading: requestingAccess, error: requestAccessError }] = useMutation(
+    REQUEST_INCIDENT_ACCESS,
+    {
+      onCompleted: () => setAccessRequested(true),
+    }
+  );
+
   if (loading) {
     return (
       <div className="flex items-center justify-center h-96">
@@ -101,7 +118,7 @@ const IncidentDetail: React.FC = () => {
             </h2>
             <p className="text-slate-600 mb-6">
               This is a private incident. You don't have access to view the full details.
-              Only members who have joined the incident Slack channel can see the complete information.
+              Only members of the incident Slack channel and users granted access can see the complete information.
             </p>
             <div className="w-full bg-slate-50 rounded-lg p-4 text-left space-y-2">
               <p className="text-sm text-slate-500">
@@ -114,6 +131,40 @@ const IncidentDetail: React.FC = () => {
                 <li>• Created: {format(new Date(incident.createdAt), 'MMM d, yyyy HH:mm')}</li>
               </ul>
             </div>
+            <div className="w-full mt-6 text-left">
+              {accessRequested ? (
+                <p className="text-sm text-green-700">
+                  Access requested. The incident lead has been asked in Slack to approve it.
+                </p>
+              ) : (
+                <div className="space-y-2">
+                  <label className="block text-sm font-medium text-slate-700" htmlFor="access-reason">
+                    Reason (optional)
+                  </label>
+                  <input
+                    id="access-reason"
+                    type="text"
+                    value={accessReason}
+                    onChange={(e) => setAccessReason(e.target.value)}
+                    className="w-full rounded-md border border-slate-300 px-3 py-2 text-sm focus:border-blue-500 focus:outline-none"
+                    placeholder="Why do you need access?"
+                  />
+                  <Button
+                    onClick={() =>
+                      requestAccess({
+                        variables: { incidentId: incident.id, reason: accessReason || null },
+                      })
+                    }
+                    disabled={requestingAccess}
+                  >
+                    {requestingAccess ? 'Requesting...' : 'Request access'}
+                  </Button>
+                  {requestAccessError && (
+                    <p className="text-sm text-red-600">{requestAccessError.message}</p>
+                  )}
+                </div>
+              )}
+            </div>
           </div>
         </div>
       ) : (
@@ -138,6 +189,28 @@ const IncidentDetail: React.FC = () => {
                 <p className="text-slate-600">
                   {incident.description || 'No description provided.'}
                 </p>
+                {incident.resolution && (
+                  <div className="mt-4 rounded-md bg-green-50 border border-green-200 p-3">
+                    <h3 className="text-sm font-medium text-green-800">Resolution</h3>
+                    <p className="mt-1 text-sm text-green-700 whitespace-pre-wrap">{incident.resolution}</p>
+                  </div>
+                )}
+                {incident.transcript && (
+                  <div className="mt-4 flex items-center justify-between rounded-md bg-slate-50 border p-3 text-sm text-slate-600">
+                    <span>
+                      Channel transcript of {incident.transcript.messageCount} messages saved{' '}
+                      {new Date(incident.transcript.exportedAt).toLocaleString()}
+                      {incident.channelArchived && ' (channel archived)'}
+                    </span>
+                    <a
+                      className="text-blue-600 hover:underline"
+                      href={`data:text/markdown;charset=utf-8,${encodeURIComponent(incident.transcript.markdown)}`}
+                      download={`incident-${incident.id}-transcript.md`}
+                    >
+                      Download
+                    </a>
+                  </div>
+                )}
               </div>
 
               {/* Tasks */}
@@ -148,6 +221,15 @@ const IncidentDetail: React.FC = () => {
                   tasks={incident.tasks || []}
                 />
               </div>
+
+              {/* Stakeholder Updates */}
+              <div className="mt-6 bg-white rounded-lg border p-6">
+                <StakeholderUpdates
+                  incidentId={incident.id}
+                  updates={incident.stakeholderUpdates || []}
+                  onPosted={() => refetch()}
+                />
+              </div>
             </div>
 
         {/* Right Column - Sidebar */}
@@ -293,6 +375,20 @@ const IncidentDetail: React.FC = () => {
             statusHistories={incident.statusHistories || []}
             className="mb-4"
           />
+
+          {/* Related Incidents */}
+          <div className="bg-white border border-slate-200 rounded-lg p-4 mb-4">
+            <h3 className="font-semibold mb-3">Related Incidents</h3>
+            <RelatedIncidents
+              incidentId={incident.id}
+              status={validStatus}
+              parent={incident.parent}
+              children={incident.children || []}
+              duplicateOf={incident.duplicateOf}
+              duplicates={incident.duplicates || []}
+              onChanged={() => refetch()}
+            />
+          </div>
         </div>
       </div>
 
@@ -1,8 +1,9 @@
-import React, { useState, useMemo, useEffect, useCallback } from 'react';
+import React, { useState, useMemo, useEffect, useCallback, useRef } from 'react';
 import { useQuery } from '@apollo/client/react';
 import { useNavigate } from 'react-router-dom';
 import { format } from 'date-fns';
 import { GET_INCIDENTS } from '../graphql/queries';
+import { INCIDENT_UPDATED } from '../graphql/subscriptions';
 import { Button } from '../components/ui/Button';
 import { IncidentStatus, StatusHistory, Task } from '../types/incident';
 import StatusBadge from '../components/IncidentList/StatusBadge';
@@ -10,7 +11,9 @@ import SeverityBadge from '../components/common/SeverityBadge';
 import TestBadge from '../components/common/TestBadge';
 import SlackChannelLink from '../components/common/SlackChannelLink';
 import { StatCard } from '../components/IncidentList/StatCard';
+import { DeclareIncidentModal } from '../components/IncidentList/DeclareIncidentModal';
 import { useIncidentStats } from '../hooks/useIncidentStats';
+import { useLiveUpdates } from '../hooks/useLiveUpdates';
 import {
   AlertCircle,
   RefreshCw,
@@ -89,6 +92,7 @@ const IncidentList: React.FC = () => {
   const navigate = useNavigate();
 
   // State management
+  const [showDeclareModal, setShowDeclareModal] = useState(false);
   const [searchInput, setSearchInput] = useState('');
   const [searchText, setSearchText] = useState('');
   const [statusFilter, setStatusFilter] = useState<Set<IncidentStatus>>(new Set());
@@ -117,6 +121,25 @@ const IncidentList: React.FC = () => {
     }
   );
 
+  // Reload the list when incidents change, coalescing bursts of updates
+  const refetchTimer = useRef<ReturnType<typeof setTimeout> | null>(null);
+  useLiveUpdates(INCIDENT_UPDATED, {}, () => {
+    if (refetchTimer.current) {
+      return;
+    }
+    refetchTimer.current = setTimeout(() => {
+      refetchTimer.current = null;
+      refetch();
+    }, 1000);
+  });
+  useEffect(() => {
+    return () => {
+      if (refetchTimer.current) {
+        clearTimeout(refetchTimer.current);
+      }
+    };
+  }, []);
+
   // Debounce search input
   useEffect(() => {
     const timer = setTimeout(() => {
@@ -360,7 +383,7 @@ const IncidentList: React.FC = () => {
             <RefreshCw className="h-4 w-4" />
             Refresh
           </Button>
-          <Button size="sm" className="gap-2">
+          <Button size="sm" className="gap-2" onClick={() => setShowDeclareModal(true)}>
             <Plus className="h-4 w-4" />
             New Incident
           </Button>
@@ -877,6 +900,16 @@ const IncidentList: React.FC = () => {
           </div>
         </div>
       )}
+
+      {showDeclareModal && (
+        <DeclareIncidentModal
+          onClose={() => setShowDeclareModal(false)}
+          onCreated={(id) => {
+            setShowDeclareModal(false);
+            navigate(`/incidents/${id}`);
+          }}
+        />
+      )}
     </div>
   );
 };
@@ -0,0 +1,151 @@
+import React, { useState } from 'react';
+import { useQuery } from '@apollo/client/react';
+import { Link } from 'react-router-dom';
+import { format } from 'date-fns';
+import { SEARCH } from '../graphql/queries';
+import { Button } from '../components/ui/Button';
+import { ExternalLink, Search as SearchIcon } from 'lucide-react';
+
+type SearchDocumentKind = 'incident' | 'task' | 'timeline' | 'message';
+
+interface SearchHighlight {
+  field: string;
+  fragment: string;
+  ranges: Array<{ start: number; end: number }>;
+}
+
+interface SearchHit {
+  id: string;
+  kind: SearchDocumentKind;
+  incidentId: string;
+  incident: { id: string; title: string } | null;
+  title: string;
+  timestamp: string;
+  permalink: string | null;
+  highlights: SearchHighlight[];
+}
+
+interface SearchData {
+  search: {
+    totalCount: number;
+    hits: SearchHit[];
+  };
+}
+
+const kindLabels: Record<SearchDocumentKind, string> = {
+  incident: 'Incident',
+  task: 'Task',
+  timeline: 'Status change',
+  message: 'Message',
+};
+
+// Ranges are in characters (code points), so the fragment is split by code point
+const Highlighted: React.FC<{ highlight: SearchHighlight }> = ({ highlight }) => {
+  const chars = Array.from(highlight.fragment);
+  const parts: React.ReactNode[] = [];
+  let pos = 0;
+  highlight.ranges.forEach((range, i) => {
+    parts.push(chars.slice(pos, range.start).join(''));
+    parts.push(
+      <mark key={i} className="bg-yellow-200 rounded px-0.5">
+        {chars.slice(range.start, range.end).join('')}
+      </mark>
+    );
+    pos = range.end;
+  });
+  parts.push(chars.slice(pos).join(''));
+  return <>{parts}</>;
+};
+
+const Search: React.FC = () => {
+  const [input, setInput] = useState('');
+  const [query, setQuery] = useState('');
+  const [kind, setKind] = useState<SearchDocumentKind | ''>('');
+
+  const { data, loading, error } = useQuery<SearchData>(SEARCH, {
+    variables: {
+      query,
+      filter: kind ? { kinds: [kind] } : undefined,
+      first: 50,
+    },
+    skip: query === '',
+  });
+
+  const onSubmit = (e: React.FormEvent) => {
+    e.preventDefault();
+    setQuery(input.trim());
+  };
+
+  return (
+    <div className="space-y-6">
+      <h1 className="text-2xl font-bold text-slate-900">Search</h1>
+
+      <form onSubmit={onSubmit} className="flex gap-2">
+        <div className="relative flex-1">
+          <SearchIcon className="absolute left-3 top-1/2 h-4 w-4 -translate-y-1/2 text-slate-400" />
+          <input
+            type="text"
+            value={input}
+            onChange={(e) => setInput(e.target.value)}
+            placeholder="Search incidents, tasks and channel messages"
+            className="w-full rounded-md border border-slate-300 py-2 pl-9 pr-3 text-sm focus:border-blue-500 focus:outline-none"
+          />
+        </div>
+        <select
+          value={kind}
+          onChange={(e) => setKind(e.target.value as SearchDocumentKind | '')}
+          className="rounded-md border border-slate-300 px-3 py-2 text-sm"
+        >
+          <option value="">All</option>
+          {(Object.keys(kindLabels) as SearchDocumentKind[]).map((k) => (
+            <option key={k} value={k}>
+              {kindLabels[k]}
+            </option>
+          ))}
+        </select>
+        <Button type="submit">Search</Button>
+      </form>
+
+      {loading && <div className="text-slate-500">Searching...</div>}
+      {error && <div className="text-red-600">Search failed: {error.message}</div>}
+
+      {data && (
+        <div className="space-y-3">
+          <div className="text-sm text-slate-500">{data.search.totalCount} results</div>
+          {data.search.hits.map((hit) => (
+            <div key={hit.id} className="rounded-lg border border-slate-200 bg-white p-4">
+              <div className="flex items-center justify-between gap-2 text-xs text-slate-500">
+                <span>
+                  <span className="mr-2 rounded bg-slate-100 px-2 py-0.5 font-medium text-slate-700">
+                    {kindLabels[hit.kind]}
+                  </span>
+                  <Link to={`/incidents/${hit.incidentId}`} className="text-blue-600 hover:underline">
+                    #{hit.incidentId} {hit.incident?.title}
+                  </Link>
+                </span>
+                <span className="flex items-center gap-2">
+                  {format(new Date(hit.timestamp), 'yyyy-MM-dd HH:mm')}
+                  {hit.permalink && (
+                    <a href={hit.permalink} target="_blank" rel="noopener noreferrer" title="Open in Slack">
+                      <ExternalLink className="h-3.5 w-3.5" />
+                    </a>
+                  )}
+                </span>
+              </div>
+              {hit.highlights.map((h) => (
+                <div
+                  key={h.field}
+                  className={h.field === 'title' ? 'mt-2 font-medium text-slate-900' : 'mt-1 text-sm text-slate-600'}
+                >
+                  <Highlighted highlight={h} />
+                </div>
+              ))}
+            </div>
+          ))}
+        </div>
+      )}
+    </div>
+  );
+};
+
+export default Search;
@@ -26,3 +26,35 @@ export interface RecentOpenIncidentsData {
 export interface IncidentTrendBySeverityData {
   incidentTrendBySeverity: WeeklySeverityCount[];
 }
+
+export type IncidentMetricsGroupBy = 'severity' | 'category' | 'asset' | 'month';
+
+export interface DurationStats {
+  count: number;
+  meanSeconds: number | null;
+  medianSeconds: number | null;
+}
+
+export interface IncidentMetricsGroup {
+  key: string;
+  label: string;
+  incidentCount: number;
+  closedCount: number;
+  timeToAcknowledge: DurationStats;
+  timeToClose: DurationStats;
+  timeInTriage: DurationStats;
+  timeInHandling: DurationStats;
+  timeInMonitoring: DurationStats;
+}
+
+export interface IncidentMetrics {
+  from: string;
+  to: string;
+  groupBy: IncidentMetricsGroupBy;
+  total: IncidentMetricsGroup;
+  groups: IncidentMetricsGroup[];
+}
+
+export interface IncidentMetricsData {
+  incidentMetrics: IncidentMetrics;
+}
@@ -14,6 +14,19 @@ export interface StatusHistory {
   changedBy: User;
   changedAt: string;
   note?: string;
+  reopened?: boolean;
+  sourceIncidentId?: string | null;
+}
+
+// Stakeholder update type
+export interface StakeholderUpdate {
+  id: string;
+  incidentId: string;
+  text: string;
+  status: IncidentStatus;
+  postedBy: string;
+  postedByUser?: User | null;
+  postedAt: string;
 }
 
 // User type
@@ -59,10 +72,36 @@ export interface Incident {
   createdAt: string;
   updatedAt: string;
   statusHistories: StatusHistory[];
+  stakeholderUpdates?: StakeholderUpdate[];
   tasks: Task[];
   private: boolean;
   viewerCanAccess: boolean;
   isTest: boolean;
+  resolution?: string | null;
+  closedAt?: string | null;
+  firstClosedAt?: string | null;
+  reopenCount?: number;
+  channelArchived?: boolean;
+  transcript?: Transcript | null;
+  parent?: RelatedIncident | null;
+  children?: RelatedIncident[];
+  duplicateOf?: RelatedIncident | null;
+  duplicates?: RelatedIncident[];
+}
+
+// Incident linked as a major incident, child or duplicate
+export interface RelatedIncident {
+  id: string;
+  title: string;
+  status: IncidentStatus;
+  private: boolean;
+}
+
+// Saved history of an incident channel
+export interface Transcript {
+  exportedAt: string;
+  messageCount: number;
+  markdown: string;
 }
 
 // Task types
@@ -33,6 +33,10 @@ func Run(ctx context.Context, args []string) error {
 		},
 		Commands: []*cli.Command{
 			cmdServe(),
+			cmdJob(),
+			cmdToken(),
+			cmdSession(),
+			cmdDev(),
 			ConfigInitCommand,
 		},
 	}
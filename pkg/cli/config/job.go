@@ -0,0 +1,53 @@
+package config
+
+import (
+	"log/slog"
+
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/service/job"
+	"github.com/urfave/cli/v3"
+)
+
+// Job holds background job queue configuration
+type Job struct {
+	Concurrency int
+	MaxAttempts int
+}
+
+// Flags returns CLI flags for job queue configuration
+func (j *Job) Flags() []cli.Flag {
+	return []cli.Flag{
+		&cli.IntFlag{
+			Name:        "job-concurrency",
+			Usage:       "Maximum number of background jobs processed concurrently",
+			Category:    "Job",
+			Value:       8,
+			Sources:     cli.EnvVars("LYCAON_JOB_CONCURRENCY"),
+			Destination: &j.Concurrency,
+		},
+		&cli.IntFlag{
+			Name:        "job-max-attempts",
+			Usage:       "Number of attempts before a background job is moved to the dead letter list",
+			Category:    "Job",
+			Value:       5,
+			Sources:     cli.EnvVars("LYCAON_JOB_MAX_ATTEMPTS"),
+			Destination: &j.MaxAttempts,
+		},
+	}
+}
+
+// Configure creates a job queue backed by the given repository
+func (j *Job) Configure(repo interfaces.Repository) *job.Queue {
+	return job.New(repo,
+		job.WithConcurrency(j.Concurrency),
+		job.WithMaxAttempts(j.MaxAttempts),
+	)
+}
+
+// LogValue returns structured log value
+func (j Job) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("concurrency", j.Concurrency),
+		slog.Int("max_attempts", j.MaxAttempts),
+	)
+}
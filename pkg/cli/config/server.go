@@ -8,6 +8,8 @@ import (
 type Server struct {
 	Addr        string
 	FrontendURL string
+	// MetricsToken, when set, is required as a Bearer token to read /metrics
+	MetricsToken string
 }
 
 // Flags returns CLI flags for Server configuration
@@ -27,5 +29,11 @@ func (s *Server) Flags() []cli.Flag {
 			Sources:     cli.EnvVars("LYCAON_FRONTEND_URL"),
 			Destination: &s.FrontendURL,
 		},
+		&cli.StringFlag{
+			Name:        "metrics-token",
+			Usage:       "Bearer token required to scrape /metrics (if not set, /metrics is public)",
+			Sources:     cli.EnvVars("LYCAON_METRICS_TOKEN"),
+			Destination: &s.MetricsToken,
+		},
 	}
 }
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/urfave/cli/v3"
+)
+
+// Session holds browser session configuration
+type Session struct {
+	TTL             time.Duration
+	MaxLifetime     time.Duration
+	CleanupInterval time.Duration
+}
+
+// Flags returns CLI flags for session configuration
+func (s *Session) Flags() []cli.Flag {
+	return []cli.Flag{
+		&cli.DurationFlag{
+			Name:        "session-ttl",
+			Usage:       "How long a session stays valid without activity",
+			Category:    "Session",
+			Value:       24 * time.Hour,
+			Sources:     cli.EnvVars("LYCAON_SESSION_TTL"),
+			Destination: &s.TTL,
+		},
+		&cli.DurationFlag{
+			Name:        "session-max-lifetime",
+			Usage:       "Maximum lifetime of a session regardless of activity",
+			Category:    "Session",
+			Value:       30 * 24 * time.Hour,
+			Sources:     cli.EnvVars("LYCAON_SESSION_MAX_LIFETIME"),
+			Destination: &s.MaxLifetime,
+		},
+		&cli.DurationFlag{
+			Name:        "session-cleanup-interval",
+			Usage:       "Interval for deleting expired sessions",
+			Category:    "Session",
+			Value:       time.Hour,
+			Sources:     cli.EnvVars("LYCAON_SESSION_CLEANUP_INTERVAL"),
+			Destination: &s.CleanupInterval,
+		},
+	}
+}
+
+// LogValue returns structured log value
+func (s Session) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Duration("ttl", s.TTL),
+		slog.Duration("max_lifetime", s.MaxLifetime),
+		slog.Duration("cleanup_interval", s.CleanupInterval),
+	)
+}
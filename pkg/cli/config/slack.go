@@ -7,17 +7,22 @@ import (
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
 	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/slack-go/slack"
 	"github.com/urfave/cli/v3"
 )
 
 // Slack holds Slack configuration
 type Slack struct {
-	ClientID      string
-	ClientSecret  string
-	SigningSecret string
-	OAuthToken    string
-	AppID         string // API App ID to filter events from correct app
-	ChannelPrefix string // Channel name prefix for incidents
+	ClientID       string
+	ClientSecret   string
+	SigningSecret  string
+	OAuthToken     string
+	AppID          string // API App ID to filter events from correct app
+	ChannelPrefix  string // Channel name prefix for incidents
+	AppToken       string // App-level token (xapp-...) for Socket Mode
+	SocketMode     bool   // Receive events over Socket Mode instead of HTTP webhooks
+	APIURL         string // Slack Web API base URL override (e.g. a local fake server)
+	RenameChannels bool   // Rename incident channels when their title changes
 }
 
 // Flags returns CLI flags for Slack configuration
@@ -59,15 +64,53 @@ func (s *Slack) Flags() []cli.Flag {
 			Sources:     cli.EnvVars("LYCAON_SLACK_CHANNEL_PREFIX"),
 			Destination: &s.ChannelPrefix,
 		},
+		&cli.BoolFlag{
+			Name:        "slack-rename-channels",
+			Usage:       "Rename incident channels and update their purpose when the incident title changes",
+			Category:    "Slack",
+			Value:       true,
+			Sources:     cli.EnvVars("LYCAON_SLACK_RENAME_CHANNELS"),
+			Destination: &s.RenameChannels,
+		},
+		&cli.StringFlag{
+			Name:        "slack-app-token",
+			Usage:       "Slack app-level token (xapp-...) for Socket Mode",
+			Category:    "Slack",
+			Sources:     cli.EnvVars("LYCAON_SLACK_APP_TOKEN"),
+			Destination: &s.AppToken,
+		},
+		&cli.BoolFlag{
+			Name:        "slack-socket-mode",
+			Usage:       "Receive Slack events and interactions over Socket Mode instead of HTTP webhooks",
+			Category:    "Slack",
+			Sources:     cli.EnvVars("LYCAON_SLACK_SOCKET_MODE"),
+			Destination: &s.SocketMode,
+		},
+		&cli.StringFlag{
+			Name:        "slack-api-url",
+			Usage:       "Override Slack Web API base URL (for development against a fake Slack server)",
+			Category:    "Slack",
+			Sources:     cli.EnvVars("LYCAON_SLACK_API_URL"),
+			Destination: &s.APIURL,
+		},
 	}
 }
 
+// SlackOptions returns options for slack-go clients derived from the configuration
+func (s *Slack) SlackOptions() []slack.Option {
+	var opts []slack.Option
+	if s.APIURL != "" {
+		opts = append(opts, slack.OptionAPIURL(s.APIURL))
+	}
+	return opts
+}
+
 // Configure creates and returns a Slack client interface
 func (s *Slack) Configure(ctx context.Context) (interfaces.SlackClient, error) {
 	if !s.IsConfigured() {
 		return nil, goerr.New("Slack client configuration is required. Please provide LYCAON_SLACK_OAUTH_TOKEN")
 	}
-	return slackSvc.New(s.OAuthToken), nil
+	return slackSvc.New(s.OAuthToken, slackSvc.WithSlackOptions(s.SlackOptions()...)), nil
 }
 
 // ConfigureOptional creates a Slack client if configured, returns nil if not
@@ -96,6 +139,11 @@ func (s *Slack) IsFullyConfigured() bool {
 	return s.SigningSecret != "" && s.OAuthToken != ""
 }
 
+// IsSocketModeConfigured checks if Socket Mode is enabled and has the required tokens
+func (s *Slack) IsSocketModeConfigured() bool {
+	return s.SocketMode && s.AppToken != "" && s.OAuthToken != ""
+}
+
 // IsOAuthConfigured checks if OAuth is configured
 func (s *Slack) IsOAuthConfigured() bool {
 	return s.ClientID != "" && s.ClientSecret != ""
@@ -108,6 +156,10 @@ func (s Slack) LogValue() slog.Value {
 		slog.Bool("has_client_secret", s.ClientSecret != ""),
 		slog.Bool("has_signing_secret", s.SigningSecret != ""),
 		slog.Bool("has_oauth_token", s.OAuthToken != ""),
+		slog.Bool("has_app_token", s.AppToken != ""),
+		slog.Bool("socket_mode", s.SocketMode),
+		slog.Bool("rename_channels", s.RenameChannels),
+		slog.String("api_url", s.APIURL),
 	)
 }
 
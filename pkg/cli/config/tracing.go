@@ -0,0 +1,123 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/utils/tracing"
+	"github.com/urfave/cli/v3"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+)
+
+// Trace exporters
+const (
+	TraceExporterNone   = "none"
+	TraceExporterOTLP   = "otlp"
+	TraceExporterStdout = "stdout"
+)
+
+// Tracing holds OpenTelemetry tracing configuration
+type Tracing struct {
+	Exporter     string
+	OTLPEndpoint string
+	SampleRatio  float64
+}
+
+// Flags returns CLI flags for tracing configuration
+func (t *Tracing) Flags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:        "trace-exporter",
+			Usage:       "Trace exporter (none, otlp, stdout)",
+			Category:    "Tracing",
+			Value:       TraceExporterNone,
+			Sources:     cli.EnvVars("LYCAON_TRACE_EXPORTER"),
+			Destination: &t.Exporter,
+		},
+		&cli.StringFlag{
+			Name:        "trace-otlp-endpoint",
+			Usage:       "OTLP/HTTP endpoint URL for traces (defaults to OTEL_EXPORTER_OTLP_ENDPOINT or http://localhost:4318)",
+			Category:    "Tracing",
+			Sources:     cli.EnvVars("LYCAON_TRACE_OTLP_ENDPOINT"),
+			Destination: &t.OTLPEndpoint,
+		},
+		&cli.Float64Flag{
+			Name:        "trace-sample-ratio",
+			Usage:       "Ratio of new traces to sample (0.0-1.0); traces started upstream follow the caller's decision",
+			Category:    "Tracing",
+			Value:       1.0,
+			Sources:     cli.EnvVars("LYCAON_TRACE_SAMPLE_RATIO"),
+			Destination: &t.SampleRatio,
+		},
+	}
+}
+
+// LogValue returns structured log value
+func (t Tracing) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("exporter", t.Exporter),
+		slog.String("otlp_endpoint", t.OTLPEndpoint),
+		slog.Float64("sample_ratio", t.SampleRatio),
+	)
+}
+
+// Configure installs the global tracer provider and propagator. The returned
+// function flushes pending spans and must be called before exiting.
+func (t *Tracing) Configure(ctx context.Context) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(tracing.Propagator())
+
+	var exporter sdktrace.SpanExporter
+	switch t.Exporter {
+	case "", TraceExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case TraceExporterOTLP:
+		var opts []otlptracehttp.Option
+		if t.OTLPEndpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpointURL(t.OTLPEndpoint))
+		}
+		exp, err := otlptracehttp.New(ctx, opts...)
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to create OTLP trace exporter", goerr.V("endpoint", t.OTLPEndpoint))
+		}
+		exporter = exp
+	case TraceExporterStdout:
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to create stdout trace exporter")
+		}
+		exporter = exp
+	default:
+		return nil, goerr.New("unknown trace exporter", goerr.V("exporter", t.Exporter))
+	}
+
+	if t.SampleRatio < 0 || t.SampleRatio > 1 {
+		return nil, goerr.New("trace sample ratio must be between 0 and 1", goerr.V("ratio", t.SampleRatio))
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName("lycaon"),
+		semconv.ServiceVersion(types.Version),
+	))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to create trace resource")
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(t.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	ctxlog.From(ctx).Info("Tracing enabled", "exporter", t.Exporter, "sampleRatio", t.SampleRatio)
+	return provider.Shutdown, nil
+}
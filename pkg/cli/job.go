@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/cli/config"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/urfave/cli/v3"
+)
+
+func cmdJob() *cli.Command {
+	var (
+		firestoreCfg config.Firestore
+		jobCfg       config.Job
+	)
+
+	flags := joinFlags(
+		firestoreCfg.Flags(),
+		jobCfg.Flags(),
+	)
+
+	return &cli.Command{
+		Name:  "job",
+		Usage: "Background job management commands",
+		Commands: []*cli.Command{
+			{
+				Name:  "dead-letters",
+				Usage: "List jobs that exhausted their retry attempts",
+				Flags: joinFlags(flags, []cli.Flag{
+					&cli.IntFlag{
+						Name:  "limit",
+						Usage: "Maximum number of jobs to list",
+						Value: 50,
+					},
+				}),
+				Action: func(ctx context.Context, c *cli.Command) error {
+					repo, err := firestoreCfg.Configure(ctx)
+					if err != nil {
+						return err
+					}
+					defer repo.Close()
+
+					jobs, err := jobCfg.Configure(repo).ListDeadLetters(ctx, int(c.Int("limit")))
+					if err != nil {
+						return err
+					}
+
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+					_, _ = fmt.Fprintln(w, "ID\tKIND\tATTEMPTS\tUPDATED\tLAST ERROR")
+					for _, job := range jobs {
+						_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n",
+							job.ID, job.Kind, job.Attempts, job.UpdatedAt.Format(time.RFC3339), job.LastError)
+					}
+					return w.Flush()
+				},
+			},
+			{
+				Name:      "retry",
+				Usage:     "Move a dead-lettered job back to the queue",
+				ArgsUsage: "<job-id>",
+				Flags:     flags,
+				Action: func(ctx context.Context, c *cli.Command) error {
+					jobID := c.Args().First()
+					if jobID == "" {
+						return goerr.New("job ID is required")
+					}
+
+					repo, err := firestoreCfg.Configure(ctx)
+					if err != nil {
+						return err
+					}
+					defer repo.Close()
+
+					if err := jobCfg.Configure(repo).Retry(ctx, types.JobID(jobID)); err != nil {
+						return err
+					}
+
+					fmt.Printf("Job %s requeued\n", jobID)
+					return nil
+				},
+			},
+		},
+	}
+}
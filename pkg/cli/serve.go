@@ -14,8 +14,12 @@ import (
 	"github.com/secmon-lab/lycaon/pkg/cli/config"
 	controller "github.com/secmon-lab/lycaon/pkg/controller/http"
 	slackCtrl "github.com/secmon-lab/lycaon/pkg/controller/slack"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	"github.com/secmon-lab/lycaon/pkg/service/pubsub"
+	"github.com/secmon-lab/lycaon/pkg/service/search"
 	slackservice "github.com/secmon-lab/lycaon/pkg/service/slack"
 	"github.com/secmon-lab/lycaon/pkg/usecase"
+	"github.com/secmon-lab/lycaon/pkg/utils/metrics"
 	"github.com/urfave/cli/v3"
 )
 
@@ -25,6 +29,9 @@ func cmdServe() *cli.Command {
 		slackCfg     config.Slack
 		firestoreCfg config.Firestore
 		geminiCfg    config.Gemini
+		jobCfg       config.Job
+		sessionCfg   config.Session
+		tracingCfg   config.Tracing
 	)
 
 	// Add config file flag
@@ -41,6 +48,9 @@ func cmdServe() *cli.Command {
 		slackCfg.Flags(),
 		firestoreCfg.Flags(),
 		geminiCfg.Flags(),
+		jobCfg.Flags(),
+		sessionCfg.Flags(),
+		tracingCfg.Flags(),
 	)
 
 	return &cli.Command{
@@ -49,157 +59,274 @@ func cmdServe() *cli.Command {
 		Usage:   "Start HTTP server",
 		Flags:   flags,
 		Action: func(ctx context.Context, c *cli.Command) error {
-			// Get logger from root command metadata
-			logger := ctxlog.From(ctx)
+			return runServe(ctx, c.String("config"), serverCfg, slackCfg, firestoreCfg, geminiCfg, jobCfg, sessionCfg, tracingCfg)
+		},
+	}
+}
 
-			// Load unified configuration (categories + severities)
-			configPath := c.String("config")
-			if configPath == "" {
-				return goerr.New("configuration file path is required (use --config flag or LYCAON_CONFIG environment variable)")
-			}
+// runServe wires up all components and runs the HTTP server until interrupted.
+// It is shared by the serve and dev commands.
+func runServe(ctx context.Context, configPath string, serverCfg config.Server, slackCfg config.Slack, firestoreCfg config.Firestore, geminiCfg config.Gemini, jobCfg config.Job, sessionCfg config.Session, tracingCfg config.Tracing) error {
+	// Get logger from root command metadata
+	logger := ctxlog.From(ctx)
 
-			appConfig, err := config.LoadConfigFromFile(configPath)
-			if err != nil {
-				return goerr.Wrap(err, "failed to load configuration file")
-			}
+	// Load unified configuration (categories + severities)
+	if configPath == "" {
+		return goerr.New("configuration file path is required (use --config flag or LYCAON_CONFIG environment variable)")
+	}
 
-			logger.Info("Starting lycaon server",
-				slog.String("addr", serverCfg.Addr),
-				slog.String("config", configPath),
-				slog.Int("categories", len(appConfig.Categories)),
-				slog.Int("severities", len(appConfig.Severities)),
-				slog.String("channel_prefix", slackCfg.ChannelPrefix),
-				slog.Any("slack", slackCfg),
-				slog.Any("firestore", firestoreCfg),
-				slog.Any("gemini", geminiCfg),
-			)
-
-			// Create repository using config
-			repo, err := firestoreCfg.Configure(ctx)
-			if err != nil {
-				return err
-			}
-			defer repo.Close()
+	appConfig, err := config.LoadConfigFromFile(configPath)
+	if err != nil {
+		return goerr.Wrap(err, "failed to load configuration file")
+	}
 
-			// Create gollem LLM client using Gemini configuration
-			gollemClient := geminiCfg.ConfigureOptional(ctx)
-			if gollemClient == nil {
-				return goerr.New("LLM client configuration is required. Please configure Gemini settings")
-			}
-			if closer, ok := gollemClient.(interface{ Close() error }); ok && closer != nil {
-				defer closer.Close()
-			}
+	logger.Info("Starting lycaon server",
+		slog.String("addr", serverCfg.Addr),
+		slog.String("config", configPath),
+		slog.Int("categories", len(appConfig.Categories)),
+		slog.Int("severities", len(appConfig.Severities)),
+		slog.Bool("rbac", appConfig.Roles != nil),
+		slog.Bool("reminders", appConfig.Reminders != nil),
+		slog.Bool("archive", appConfig.Archive != nil),
+		slog.String("channel_prefix", slackCfg.ChannelPrefix),
+		slog.Any("slack", slackCfg),
+		slog.Any("firestore", firestoreCfg),
+		slog.Any("gemini", geminiCfg),
+		slog.Any("job", jobCfg),
+		slog.Any("session", sessionCfg),
+		slog.Any("tracing", tracingCfg),
+	)
 
-			// Create Slack client using config
-			slackClient, err := slackCfg.Configure(ctx)
-			if err != nil {
-				return err
-			}
+	shutdownTracing, err := tracingCfg.Configure(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Flush spans buffered by the batch exporter
+		flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(flushCtx); err != nil {
+			logger.Warn("Failed to flush traces", slog.Any("error", err))
+		}
+	}()
 
-			// Debug log Slack configuration
-			logger.Debug("Slack configuration loaded",
-				"has_signing_secret", slackCfg.SigningSecret != "",
-				"signing_secret_length", len(slackCfg.SigningSecret),
-				"has_oauth_token", slackCfg.OAuthToken != "",
-			)
-
-			// Create slack service
-			slackSvc := slackservice.NewUIService(slackClient, appConfig)
-
-			// Create use cases
-			authUC := usecase.NewAuth(ctx, repo, &slackCfg)
-			messageUC, err := usecase.NewSlackMessage(ctx, repo, gollemClient, slackClient, slackSvc, appConfig)
-			if err != nil {
-				return goerr.Wrap(err, "failed to create message use case")
-			}
-			inviteUC := usecase.NewInvite(slackClient)
+	// Create repository using config
+	baseRepo, err := firestoreCfg.Configure(ctx)
+	if err != nil {
+		return err
+	}
+	defer baseRepo.Close()
+	repo := repository.NewTracing(baseRepo)
 
-			// Create incident configuration with optional settings
-			var incidentOpts []usecase.IncidentOption
-			if slackCfg.ChannelPrefix != "" {
-				incidentOpts = append(incidentOpts, usecase.WithChannelPrefix(slackCfg.ChannelPrefix))
-			}
-			if serverCfg.FrontendURL != "" {
-				incidentOpts = append(incidentOpts, usecase.WithFrontendURL(serverCfg.FrontendURL))
-			}
-			incidentConfig := usecase.NewIncidentConfig(incidentOpts...)
-
-			incidentUC := usecase.NewIncident(repo, slackClient, slackSvc, appConfig, inviteUC, incidentConfig)
-			taskUC := usecase.NewTaskUseCase(repo, slackClient)
-			statusUC := usecase.NewStatusUseCase(repo, slackSvc, appConfig)
-			slackInteractionUC := usecase.NewSlackInteraction(incidentUC, taskUC, statusUC, authUC, slackClient, slackSvc, appConfig.GetSeveritiesConfig())
-
-			// Create configuration
-			config := controller.NewConfig(
-				serverCfg.Addr,
-				&slackCfg,
-				appConfig,
-				serverCfg.FrontendURL,
-			)
-
-			// Create use cases structure
-			useCases := controller.NewUseCases(
-				authUC,
-				messageUC,
-				incidentUC,
-				taskUC,
-				slackInteractionUC,
-			)
-
-			// Create handlers
-			slackHandler := slackCtrl.NewHandler(ctx, &slackCfg, repo, messageUC, incidentUC, taskUC, slackInteractionUC, slackClient, appConfig)
-			authHandler := controller.NewAuthHandler(ctx, &slackCfg, authUC, serverCfg.FrontendURL)
-
-			// Create GraphQL handler
-			var graphqlHandler http.Handler
-			if repo != nil && incidentUC != nil && taskUC != nil {
-				graphqlHandler = controller.CreateGraphQLHandler(repo, slackClient, useCases, appConfig)
-			}
+	// Create gollem LLM client using Gemini configuration
+	gollemClient := geminiCfg.ConfigureOptional(ctx)
+	if gollemClient == nil {
+		return goerr.New("LLM client configuration is required. Please configure Gemini settings")
+	}
+	if closer, ok := gollemClient.(interface{ Close() error }); ok && closer != nil {
+		defer closer.Close()
+	}
 
-			// Create controllers
-			controllers := controller.NewController(slackHandler, authHandler, graphqlHandler)
-
-			// Create HTTP server
-			server, err := controller.NewServer(
-				ctx,
-				config,
-				useCases,
-				controllers,
-				repo,
-			)
-			if err != nil {
-				return goerr.Wrap(err, "failed to create HTTP server")
-			}
+	// Create Slack client using config
+	slackClient, err := slackCfg.Configure(ctx)
+	if err != nil {
+		return err
+	}
 
-			// Start server in goroutine
-			go func() {
-				logger.Info("HTTP server starting", slog.String("addr", serverCfg.Addr))
-				if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-					logger.Error("HTTP server error", slog.Any("error", err))
-				}
-			}()
-
-			// Wait for interrupt signal
-			sigChan := make(chan os.Signal, 1)
-			signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
-
-			select {
-			case <-ctx.Done():
-				logger.Info("Context cancelled, shutting down...")
-			case sig := <-sigChan:
-				logger.Info("Signal received, shutting down...", slog.Any("signal", sig))
-			}
+	// Debug log Slack configuration
+	logger.Debug("Slack configuration loaded",
+		"has_signing_secret", slackCfg.SigningSecret != "",
+		"signing_secret_length", len(slackCfg.SigningSecret),
+		"has_oauth_token", slackCfg.OAuthToken != "",
+	)
+
+	// Create slack service
+	slackSvc := slackservice.NewUIService(slackClient, appConfig)
+
+	// Create use cases
+	authUC := usecase.NewAuth(ctx, repo, &slackCfg,
+		usecase.WithSessionTTL(sessionCfg.TTL),
+		usecase.WithSessionMaxLifetime(sessionCfg.MaxLifetime),
+	)
+	inviteUC := usecase.NewInvite(slackClient)
+
+	// Create incident configuration with optional settings
+	var incidentOpts []usecase.IncidentOption
+	if slackCfg.ChannelPrefix != "" {
+		incidentOpts = append(incidentOpts, usecase.WithChannelPrefix(slackCfg.ChannelPrefix))
+	}
+	if serverCfg.FrontendURL != "" {
+		incidentOpts = append(incidentOpts, usecase.WithFrontendURL(serverCfg.FrontendURL))
+	}
+	// Changes are published to GraphQL subscribers and the search index of this process.
+	// The index is backfilled from the repository and reads other replicas' changes from it periodically.
+	events := pubsub.New()
+	incidentOpts = append(incidentOpts, usecase.WithIncidentEvents(events))
+	incidentOpts = append(incidentOpts, usecase.WithChannelRename(slackCfg.RenameChannels))
+	searchIndex := search.New()
+	go searchIndex.Run(ctx, repo, events)
+
+	messageUC, err := usecase.NewSlackMessage(ctx, repo, gollemClient, slackClient, slackSvc, appConfig, usecase.WithMessageEvents(events))
+	if err != nil {
+		return goerr.Wrap(err, "failed to create message use case")
+	}
+	incidentConfig := usecase.NewIncidentConfig(incidentOpts...)
+
+	incidentUC := usecase.NewIncident(repo, slackClient, slackSvc, appConfig, inviteUC, incidentConfig)
+	taskUC := usecase.NewTaskUseCase(repo, slackClient, usecase.WithTaskEvents(events))
+	statusUC := usecase.NewStatusUseCase(repo, slackSvc, appConfig, usecase.WithStatusEvents(events))
+	authzUC := usecase.NewAuthorization(appConfig.Roles, slackClient)
+	updateUC := usecase.NewStakeholderUpdate(repo, slackSvc, appConfig, usecase.WithStakeholderUpdateEvents(events))
+	relationUC := usecase.NewIncidentRelation(repo, slackSvc, statusUC, usecase.WithIncidentRelationEvents(events))
+	interactionOpts := []usecase.SlackInteractionOption{
+		usecase.WithAuthorization(authzUC),
+		usecase.WithStakeholderUpdates(updateUC),
+	}
+	var reminderUC *usecase.Reminder
+	if appConfig.Reminders != nil || appConfig.StakeholderUpdates != nil {
+		reminderUC = usecase.NewReminder(repo, slackSvc, appConfig)
+		interactionOpts = append(interactionOpts, usecase.WithReminders(reminderUC))
+	}
+	var archiveUC *usecase.Archive
+	if appConfig.Archive != nil {
+		archiveUC = usecase.NewArchive(repo, slackClient, slackSvc, appConfig)
+	}
+	slackInteractionUC := usecase.NewSlackInteraction(incidentUC, taskUC, statusUC, authUC, slackClient, slackSvc, appConfig.GetSeveritiesConfig(), interactionOpts...)
+
+	// Create configuration
+	config := controller.NewConfig(
+		serverCfg.Addr,
+		&slackCfg,
+		appConfig,
+		serverCfg.FrontendURL,
+		controller.WithMetricsToken(serverCfg.MetricsToken),
+	)
+
+	// Create use cases structure
+	useCases := controller.NewUseCases(
+		authUC,
+		messageUC,
+		incidentUC,
+		taskUC,
+		slackInteractionUC,
+		authzUC,
+		controller.WithStatusUseCase(statusUC),
+		controller.WithStakeholderUpdates(updateUC),
+		controller.WithIncidentRelations(relationUC),
+		controller.WithEvents(events),
+		controller.WithSearch(searchIndex),
+		controller.WithReadiness(usecase.NewReadiness(repo, slackClient, gollemClient, appConfig)),
+	)
+
+	// Create persistent job queue for Slack event processing
+	jobQueue := jobCfg.Configure(repo)
+
+	// Create handlers
+	slackHandler := slackCtrl.NewHandler(ctx, &slackCfg, repo, messageUC, incidentUC, taskUC, slackInteractionUC, slackClient, appConfig, jobQueue, slackCtrl.WithStatusUseCase(statusUC))
+	authHandler := controller.NewAuthHandler(ctx, &slackCfg, authUC, authzUC, serverCfg.FrontendURL)
+
+	// Create GraphQL handler
+	var graphqlHandler http.Handler
+	if repo != nil && incidentUC != nil && taskUC != nil {
+		graphqlHandler = controller.CreateGraphQLHandler(repo, slackClient, useCases, appConfig)
+	}
+
+	// Create controllers
+	controllers := controller.NewController(slackHandler, authHandler, graphqlHandler)
+
+	// Create HTTP server
+	server, err := controller.NewServer(
+		ctx,
+		config,
+		useCases,
+		controllers,
+		repo,
+	)
+	if err != nil {
+		return goerr.Wrap(err, "failed to create HTTP server")
+	}
+
+	// Expose metrics collected at scrape time
+	domainMetrics := usecase.NewDomainMetrics(repo, usecase.DefaultDomainMetricsTTL)
+	if err := domainMetrics.Register(metrics.Default); err != nil {
+		return goerr.Wrap(err, "failed to register domain metrics")
+	}
+	if err := jobQueue.RegisterMetrics(metrics.Default); err != nil {
+		return goerr.Wrap(err, "failed to register job metrics")
+	}
+	if err := slackHandler.RegisterMetrics(metrics.Default); err != nil {
+		return goerr.Wrap(err, "failed to register Slack handler metrics")
+	}
+
+	// Start job workers after all job kinds are registered
+	jobQueue.Start(ctx)
 
-			// Graceful shutdown
-			shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-			defer cancel()
+	// Background workers stop when the server shuts down
+	runCtx, stopRunner := context.WithCancel(ctx)
+	defer stopRunner()
 
-			if err := server.Shutdown(shutdownCtx); err != nil {
-				return goerr.Wrap(err, "failed to shutdown server gracefully")
+	// Periodically delete expired sessions
+	if sessionCfg.CleanupInterval > 0 {
+		go authUC.RunSessionCleanup(runCtx, sessionCfg.CleanupInterval)
+	}
+
+	// Periodically remind stale incidents and prompt for stakeholder updates
+	if reminderUC != nil {
+		go reminderUC.Run(runCtx)
+	}
+
+	// Periodically export transcripts of closed incidents and archive their channels
+	if archiveUC != nil {
+		go archiveUC.Run(runCtx)
+	}
+
+	// Start Socket Mode runner if enabled
+	if slackCfg.SocketMode {
+		if !slackCfg.IsSocketModeConfigured() {
+			return goerr.New("Socket Mode requires LYCAON_SLACK_APP_TOKEN and LYCAON_SLACK_OAUTH_TOKEN")
+		}
+
+		runner := slackCtrl.NewSocketModeRunner(ctx, slackHandler, slackCfg.AppToken, slackCfg.OAuthToken, slackCfg.SlackOptions()...)
+		go func() {
+			if err := runner.Run(runCtx); err != nil {
+				logger.Error("Slack Socket Mode runner error", slog.Any("error", err))
 			}
+		}()
+	}
 
-			logger.Info("Server shutdown complete")
-			return nil
-		},
+	// Start server in goroutine
+	go func() {
+		logger.Info("HTTP server starting", slog.String("addr", serverCfg.Addr))
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error("HTTP server error", slog.Any("error", err))
+		}
+	}()
+
+	// Wait for interrupt signal
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+
+	select {
+	case <-ctx.Done():
+		logger.Info("Context cancelled, shutting down...")
+	case sig := <-sigChan:
+		logger.Info("Signal received, shutting down...", slog.Any("signal", sig))
+	}
+
+	// Graceful shutdown
+	stopRunner()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return goerr.Wrap(err, "failed to shutdown server gracefully")
+	}
+
+	// Drain running jobs; unfinished jobs stay persisted and resume on next start
+	if err := jobQueue.Shutdown(shutdownCtx); err != nil {
+		return goerr.Wrap(err, "failed to drain job queue")
 	}
+
+	logger.Info("Server shutdown complete")
+	return nil
 }
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/secmon-lab/lycaon/pkg/cli/config"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+	"github.com/urfave/cli/v3"
+)
+
+func cmdSession() *cli.Command {
+	var firestoreCfg config.Firestore
+
+	flags := firestoreCfg.Flags()
+	userFlag := &cli.StringFlag{
+		Name:     "user",
+		Usage:    "Slack user ID",
+		Required: true,
+	}
+
+	configureAuth := func(ctx context.Context) (*usecase.Auth, func() error, error) {
+		repo, err := firestoreCfg.Configure(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		return usecase.NewAuth(ctx, repo, &config.SlackConfig{}), repo.Close, nil
+	}
+
+	return &cli.Command{
+		Name:   "session",
+		Usage:  "Browser session management commands",
+		Before: withCLIAuditActor,
+		Commands: []*cli.Command{
+			{
+				Name:  "list",
+				Usage: "List active sessions of a user",
+				Flags: joinFlags(flags, []cli.Flag{userFlag}),
+				Action: func(ctx context.Context, c *cli.Command) error {
+					authUC, closeFn, err := configureAuth(ctx)
+					if err != nil {
+						return err
+					}
+					defer func() { _ = closeFn() }()
+
+					sessions, err := authUC.ListSessions(ctx, types.UserID(c.String("user")))
+					if err != nil {
+						return err
+					}
+
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+					_, _ = fmt.Fprintln(w, "ID\tCREATED\tLAST SEEN\tEXPIRES\tIP\tUSER AGENT")
+					for _, session := range sessions {
+						_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+							session.ID,
+							session.CreatedAt.Format(time.RFC3339),
+							session.LastSeenAt.Format(time.RFC3339),
+							session.ExpiresAt.Format(time.RFC3339),
+							session.IPAddress,
+							session.UserAgent,
+						)
+					}
+					return w.Flush()
+				},
+			},
+			{
+				Name:  "revoke-all",
+				Usage: "Sign out every session of a user",
+				Flags: joinFlags(flags, []cli.Flag{userFlag}),
+				Action: func(ctx context.Context, c *cli.Command) error {
+					authUC, closeFn, err := configureAuth(ctx)
+					if err != nil {
+						return err
+					}
+					defer func() { _ = closeFn() }()
+
+					revoked, err := authUC.RevokeAllSessions(ctx, types.UserID(c.String("user")))
+					if err != nil {
+						return err
+					}
+
+					fmt.Printf("%d session(s) of %s revoked\n", revoked, c.String("user"))
+					return nil
+				},
+			},
+			{
+				Name:  "cleanup",
+				Usage: "Delete expired sessions",
+				Flags: flags,
+				Action: func(ctx context.Context, c *cli.Command) error {
+					authUC, closeFn, err := configureAuth(ctx)
+					if err != nil {
+						return err
+					}
+					defer func() { _ = closeFn() }()
+
+					deleted, err := authUC.CleanupExpiredSessions(ctx)
+					if err != nil {
+						return err
+					}
+
+					fmt.Printf("%d expired session(s) deleted\n", deleted)
+					return nil
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/cli/config"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+	"github.com/urfave/cli/v3"
+)
+
+func cmdToken() *cli.Command {
+	var firestoreCfg config.Firestore
+
+	flags := firestoreCfg.Flags()
+
+	configureAuth := func(ctx context.Context) (*usecase.Auth, func() error, error) {
+		repo, err := firestoreCfg.Configure(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		return usecase.NewAuth(ctx, repo, &config.SlackConfig{}), repo.Close, nil
+	}
+
+	return &cli.Command{
+		Name:   "token",
+		Usage:  "API token management commands",
+		Before: withCLIAuditActor,
+		Commands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "Create an API token and print it once",
+				Flags: joinFlags(flags, []cli.Flag{
+					&cli.StringFlag{
+						Name:     "name",
+						Usage:    "Token name describing its purpose",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:  "kind",
+						Usage: "Token kind (personal, service)",
+						Value: types.APITokenKindService.String(),
+					},
+					&cli.StringFlag{
+						Name:  "owner",
+						Usage: "Slack user ID owning a personal token",
+					},
+					&cli.StringSliceFlag{
+						Name:  "scope",
+						Usage: "Token scope (read, write); can be repeated",
+						Value: []string{types.APITokenScopeRead.String()},
+					},
+					&cli.DurationFlag{
+						Name:  "expires-in",
+						Usage: "Token lifetime, 0 for a token that never expires",
+						Value: 90 * 24 * time.Hour,
+					},
+				}),
+				Action: func(ctx context.Context, c *cli.Command) error {
+					scopes := make([]types.APITokenScope, 0, len(c.StringSlice("scope")))
+					for _, scope := range c.StringSlice("scope") {
+						scopes = append(scopes, types.APITokenScope(scope))
+					}
+
+					authUC, closeFn, err := configureAuth(ctx)
+					if err != nil {
+						return err
+					}
+					defer func() { _ = closeFn() }()
+
+					token, plain, err := authUC.CreateAPIToken(ctx, interfaces.CreateAPITokenRequest{
+						Name:      c.String("name"),
+						Kind:      types.APITokenKind(c.String("kind")),
+						OwnerID:   types.SlackUserID(c.String("owner")),
+						Scopes:    scopes,
+						TTL:       c.Duration("expires-in"),
+						CreatedBy: "cli",
+					})
+					if err != nil {
+						return err
+					}
+
+					fmt.Printf("Token %s (%s) created. Store it now, it cannot be shown again:\n", token.ID, token.Name)
+					fmt.Println(plain)
+					return nil
+				},
+			},
+			{
+				Name:  "list",
+				Usage: "List API tokens",
+				Flags: joinFlags(flags, []cli.Flag{
+					&cli.StringFlag{
+						Name:  "owner",
+						Usage: "Only list tokens owned by this Slack user ID",
+					},
+				}),
+				Action: func(ctx context.Context, c *cli.Command) error {
+					authUC, closeFn, err := configureAuth(ctx)
+					if err != nil {
+						return err
+					}
+					defer func() { _ = closeFn() }()
+
+					tokens, err := authUC.ListAPITokens(ctx, types.SlackUserID(c.String("owner")))
+					if err != nil {
+						return err
+					}
+
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+					_, _ = fmt.Fprintln(w, "ID\tNAME\tKIND\tOWNER\tSCOPES\tEXPIRES\tSTATUS")
+					for _, token := range tokens {
+						scopes := make([]string, len(token.Scopes))
+						for i, scope := range token.Scopes {
+							scopes[i] = scope.String()
+						}
+
+						expires := "never"
+						if !token.ExpiresAt.IsZero() {
+							expires = token.ExpiresAt.Format(time.RFC3339)
+						}
+
+						status := "active"
+						switch {
+						case token.IsRevoked():
+							status = "revoked"
+						case token.IsExpired():
+							status = "expired"
+						}
+
+						_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+							token.ID, token.Name, token.Kind, token.OwnerID, strings.Join(scopes, ","), expires, status)
+					}
+					return w.Flush()
+				},
+			},
+			{
+				Name:      "revoke",
+				Usage:     "Revoke an API token",
+				ArgsUsage: "<token-id>",
+				Flags:     flags,
+				Action: func(ctx context.Context, c *cli.Command) error {
+					tokenID := c.Args().First()
+					if tokenID == "" {
+						return goerr.New("token ID is required")
+					}
+
+					authUC, closeFn, err := configureAuth(ctx)
+					if err != nil {
+						return err
+					}
+					defer func() { _ = closeFn() }()
+
+					if _, err := authUC.RevokeAPIToken(ctx, types.APITokenID(tokenID)); err != nil {
+						return err
+					}
+
+					fmt.Printf("Token %s revoked\n", tokenID)
+					return nil
+				},
+			},
+		},
+	}
+}
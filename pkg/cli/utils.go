@@ -1,6 +1,13 @@
 package cli
 
-import "github.com/urfave/cli/v3"
+import (
+	"context"
+	"os/user"
+
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/urfave/cli/v3"
+)
 
 // joinFlags combines multiple flag slices into one
 func joinFlags(flags ...[]cli.Flag) []cli.Flag {
@@ -10,3 +17,15 @@ func joinFlags(flags ...[]cli.Flag) []cli.Flag {
 	}
 	return result
 }
+
+// withCLIAuditActor attributes audit entries recorded by a command to the local OS user
+func withCLIAuditActor(ctx context.Context, _ *cli.Command) (context.Context, error) {
+	actorID := "cli"
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		actorID = "cli:" + u.Username
+	}
+	return model.WithAuditActor(ctx, model.AuditActor{
+		ID:     actorID,
+		Source: types.AuditSourceCLI,
+	}), nil
+}
@@ -0,0 +1,24 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/service/audit"
+)
+
+// WithAuditActor attributes audit entries recorded while executing an operation
+// to the authenticated user or token, tagged with the HTTP request ID.
+func WithAuditActor(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	actor := model.AuditActor{
+		Source:    types.AuditSourceGraphQL,
+		RequestID: middleware.GetReqID(ctx),
+	}
+	if authCtx, ok := model.GetAuthContext(ctx); ok && authCtx != nil {
+		actor.ID = audit.ActorIDFromAuthContext(authCtx)
+	}
+	return next(model.WithAuditActor(ctx, actor))
+}
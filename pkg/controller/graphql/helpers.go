@@ -2,14 +2,20 @@ package graphql
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"slices"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	graphql1 "github.com/secmon-lab/lycaon/pkg/domain/model/graphql"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/slack-go/slack"
 )
 
 // convertToGroupedIncidents converts incidents map to grouped incidents slice
@@ -65,6 +71,72 @@ func getSlackUserIDFromContext(ctx context.Context) (types.SlackUserID, bool) {
 	return types.SlackUserID(authCtx.SlackUserID), true
 }
 
+// authorizeIncidentUpdate checks that the authenticated user may modify the incident
+func (r *Resolver) authorizeIncidentUpdate(ctx context.Context, incidentID types.IncidentID) error {
+	// Service tokens are not tied to a Slack user; their write scope is checked per operation
+	if authCtx, ok := model.GetAuthContext(ctx); ok && authCtx.IsServiceToken() {
+		if authCtx.ServiceTokenRole() != types.RoleAdmin {
+			return goerr.Wrap(model.ErrPermissionDenied, "service token lacks write scope")
+		}
+		return nil
+	}
+
+	// An unauthenticated caller gets the default role; routes are guarded by RequireAuth anyway
+	slackUserID, _ := getSlackUserIDFromContext(ctx)
+
+	incident, err := r.repo.GetIncident(ctx, incidentID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to get incident", goerr.V("incidentID", incidentID))
+	}
+
+	return r.authzUC.AuthorizeIncidentUpdate(ctx, slackUserID, incident)
+}
+
+// actorSlackUserID returns the Slack user acting through a session or personal
+// API token, or "system" for callers without one such as service tokens
+func (r *Resolver) actorSlackUserID(ctx context.Context) types.SlackUserID {
+	if authCtx, ok := model.GetAuthContext(ctx); ok && authCtx != nil {
+		if authCtx.SessionID != "" {
+			user, err := r.authUC.GetUserFromSession(ctx, authCtx.SessionID)
+			if err == nil && user != nil {
+				return types.SlackUserID(user.ID)
+			}
+		} else if authCtx.IsAPIToken() && authCtx.SlackUserID != "" {
+			return types.SlackUserID(authCtx.SlackUserID)
+		}
+	}
+	return types.SlackUserID("system")
+}
+
+// listSlackChannels lists the public channels the bot is a member of by name.
+// Private channels are left out so that their names are not disclosed.
+func (r *Resolver) listSlackChannels(ctx context.Context) ([]*graphql1.SlackChannel, error) {
+	channels := []*graphql1.SlackChannel{}
+	params := &slack.GetConversationsForUserParameters{
+		Types:           []string{"public_channel"},
+		ExcludeArchived: true,
+		Limit:           200,
+	}
+	for {
+		page, cursor, err := r.slackSvc.GetConversationsForUserContext(ctx, params)
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to list Slack channels")
+		}
+		for _, ch := range page {
+			channels = append(channels, &graphql1.SlackChannel{ID: ch.ID, Name: ch.Name})
+		}
+		if cursor == "" {
+			break
+		}
+		params.Cursor = cursor
+	}
+
+	slices.SortFunc(channels, func(a, b *graphql1.SlackChannel) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return channels, nil
+}
+
 // filterIncidentForUser filters incident information based on user access
 func filterIncidentForUser(ctx context.Context, incident *model.Incident, incidentUC interfaces.Incident, slackUserID types.SlackUserID) *model.Incident {
 	if incident == nil {
@@ -85,3 +157,249 @@ func filterIncidentsForUser(ctx context.Context, incidents []*model.Incident, in
 	}
 	return filtered
 }
+
+const (
+	defaultAPITokenExpiresInDays = 90
+	maxAPITokenExpiresInDays     = 365
+	maxAccessGrantExpiresInHours = 365 * 24
+)
+
+// requireBrowserSession returns the Slack user of a browser session. Credentials
+// (API tokens and sessions) can only be managed from a session so that a leaked
+// token cannot mint new tokens or sign users out.
+func (r *Resolver) requireBrowserSession(ctx context.Context) (types.SlackUserID, error) {
+	authCtx, ok := model.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.SlackUserID == "" {
+		return "", goerr.Wrap(model.ErrPermissionDenied, "authentication required to manage credentials")
+	}
+	if authCtx.IsAPIToken() {
+		return "", goerr.Wrap(model.ErrPermissionDenied, "API tokens cannot manage credentials",
+			goerr.V("tokenID", authCtx.APITokenID))
+	}
+	return types.SlackUserID(authCtx.SlackUserID), nil
+}
+
+// requireAdmin returns ErrPermissionDenied unless the user has the admin role
+func (r *Resolver) requireAdmin(ctx context.Context, userID types.SlackUserID) error {
+	role, err := r.authzUC.GetRole(ctx, userID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to get user role", goerr.V("userID", userID))
+	}
+	if role != types.RoleAdmin {
+		return goerr.Wrap(model.ErrPermissionDenied, "admin role required",
+			goerr.V("userID", userID), goerr.V("role", role))
+	}
+	return nil
+}
+
+// optionalTime returns nil for a zero time so that unset timestamps are null in GraphQL
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// optionalString returns nil for an empty string so that unset values are null in GraphQL
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+const (
+	defaultAuditLogLimit = 100
+	maxAuditLogLimit     = 1000
+)
+
+// Page size of the search query when first is omitted, and its upper bound
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// toSearchFilter converts the search filter input and page size into search options
+func toSearchFilter(filter *graphql1.SearchFilterInput, first *int) (model.SearchFilter, int, error) {
+	var result model.SearchFilter
+	limit := defaultSearchLimit
+	if first != nil {
+		if *first < 1 || *first > maxSearchLimit {
+			return result, 0, goerr.New("first must be between 1 and 100", goerr.V("first", *first))
+		}
+		limit = *first
+	}
+	if filter == nil {
+		return result, limit, nil
+	}
+
+	for _, kind := range filter.Kinds {
+		if !kind.IsValid() {
+			return result, 0, goerr.New("invalid search document kind", goerr.V("kind", kind))
+		}
+	}
+	result.Kinds = filter.Kinds
+	if filter.IncidentID != nil {
+		id, err := strconv.Atoi(*filter.IncidentID)
+		if err != nil {
+			return result, 0, goerr.Wrap(err, "invalid incident ID", goerr.V("incidentID", *filter.IncidentID))
+		}
+		result.IncidentID = types.IncidentID(id)
+	}
+	if filter.Since != nil {
+		result.Since = *filter.Since
+	}
+	if filter.Until != nil {
+		result.Until = *filter.Until
+	}
+	return result, limit, nil
+}
+
+// toAuditFilter converts the auditLog query arguments into a repository filter
+func toAuditFilter(filter *graphql1.AuditLogFilter, limit *int) (model.AuditFilter, error) {
+	result := model.AuditFilter{Limit: defaultAuditLogLimit}
+	if limit != nil {
+		if *limit < 1 || *limit > maxAuditLogLimit {
+			return result, goerr.New("limit must be between 1 and 1000", goerr.V("limit", *limit))
+		}
+		result.Limit = *limit
+	}
+	if filter == nil {
+		return result, nil
+	}
+
+	if filter.IncidentID != nil {
+		id, err := strconv.Atoi(*filter.IncidentID)
+		if err != nil {
+			return result, goerr.Wrap(err, "invalid incident ID", goerr.V("incidentID", *filter.IncidentID))
+		}
+		result.IncidentID = types.IncidentID(id)
+	}
+	if filter.ActorID != nil {
+		result.ActorID = *filter.ActorID
+	}
+	if filter.Action != nil {
+		result.Action = types.AuditAction(*filter.Action)
+	}
+	if filter.Source != nil {
+		result.Source = *filter.Source
+	}
+	if filter.Since != nil {
+		result.Since = *filter.Since
+	}
+	if filter.Until != nil {
+		result.Until = *filter.Until
+	}
+	return result, nil
+}
+
+// toIncidentFilter converts the incidents query filter into a repository filter.
+// It returns false if the filter cannot match any incident, e.g. when no
+// configured severity is within the level range.
+func toIncidentFilter(filter *graphql1.IncidentFilterInput, severities []model.Severity) (model.IncidentFilter, bool) {
+	var result model.IncidentFilter
+	if filter == nil {
+		return result, true
+	}
+
+	result.Statuses = filter.Statuses
+	if filter.SeverityLevelMin != nil || filter.SeverityLevelMax != nil {
+		for _, severity := range severities {
+			if filter.SeverityLevelMin != nil && severity.Level < *filter.SeverityLevelMin {
+				continue
+			}
+			if filter.SeverityLevelMax != nil && severity.Level > *filter.SeverityLevelMax {
+				continue
+			}
+			result.SeverityIDs = append(result.SeverityIDs, types.SeverityID(severity.ID))
+		}
+		if len(result.SeverityIDs) == 0 {
+			return result, false
+		}
+	}
+
+	if filter.CategoryID != nil {
+		result.CategoryID = *filter.CategoryID
+	}
+	if filter.AssetID != nil {
+		result.AssetID = types.AssetID(*filter.AssetID)
+	}
+	if filter.Lead != nil {
+		result.Lead = types.SlackUserID(*filter.Lead)
+	}
+	if filter.CreatedBy != nil {
+		result.CreatedBy = types.SlackUserID(*filter.CreatedBy)
+	}
+	result.IsTest = filter.IsTest
+	result.Private = filter.Private
+	if filter.CreatedAfter != nil {
+		result.CreatedAfter = *filter.CreatedAfter
+	}
+	if filter.CreatedBefore != nil {
+		result.CreatedBefore = *filter.CreatedBefore
+	}
+	if filter.Text != nil {
+		result.Text = strings.TrimSpace(*filter.Text)
+	}
+	if filter.ParentID != nil {
+		parentID, err := parseIncidentID(*filter.ParentID)
+		if err != nil {
+			return result, false
+		}
+		result.ParentID = parentID
+	}
+	return result, true
+}
+
+// parseIncidentID parses an incident ID argument
+func parseIncidentID(id string) (types.IncidentID, error) {
+	incidentID, err := strconv.Atoi(id)
+	if err != nil {
+		return 0, goerr.Wrap(err, "invalid incident ID", goerr.V("id", id))
+	}
+	return types.IncidentID(incidentID), nil
+}
+
+// relatedIncident returns the incident id related to another incident as seen by
+// the viewer, or nil if there is none or it no longer exists
+func (r *Resolver) relatedIncident(ctx context.Context, id types.IncidentID) (*model.Incident, error) {
+	if id == 0 {
+		return nil, nil
+	}
+	incident, err := r.repo.GetIncident(ctx, id)
+	if err != nil {
+		if errors.Is(err, model.ErrIncidentNotFound) {
+			return nil, nil
+		}
+		return nil, goerr.Wrap(err, "failed to get related incident", goerr.V("incidentID", id))
+	}
+	return r.incidentsForViewer(ctx, []*model.Incident{incident})[0], nil
+}
+
+// incidentsForViewer hides the details of private incidents the viewer cannot access.
+// Viewers without a Slack user, such as service tokens, cannot access any.
+func (r *Resolver) incidentsForViewer(ctx context.Context, incidents []*model.Incident) []*model.Incident {
+	slackUserID, _ := getSlackUserIDFromContext(ctx)
+	return filterIncidentsForUser(ctx, incidents, r.incidentUC, slackUserID)
+}
+
+// filtersRedactedFields checks if the filter uses fields hidden from users without
+// access to a private incident. Matching on them would reveal the hidden values.
+func filtersRedactedFields(filter model.IncidentFilter) bool {
+	return filter.AssetID != "" || filter.Lead != "" || filter.CreatedBy != "" ||
+		filter.IsTest != nil || filter.Text != ""
+}
+
+// toIncidentSort converts the incidents query sort into a repository sort order
+func toIncidentSort(order *graphql1.IncidentSortInput) (types.IncidentSort, error) {
+	if order == nil {
+		return types.IncidentSort{}, nil
+	}
+	if !order.Field.IsValid() {
+		return types.IncidentSort{}, goerr.New("invalid sort field", goerr.V("field", order.Field))
+	}
+	return types.IncidentSort{
+		Field:     order.Field,
+		Ascending: order.Direction == graphql1.SortDirectionAsc,
+	}, nil
+}
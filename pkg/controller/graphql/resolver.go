@@ -3,6 +3,9 @@ package graphql
 import (
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/service/audit"
+	"github.com/secmon-lab/lycaon/pkg/service/pubsub"
+	"github.com/secmon-lab/lycaon/pkg/service/search"
 	slackservice "github.com/secmon-lab/lycaon/pkg/service/slack"
 	"github.com/secmon-lab/lycaon/pkg/usecase"
 )
@@ -18,9 +21,15 @@ type Resolver struct {
 	incidentUC  interfaces.Incident
 	taskUC      interfaces.Task
 	authUC      interfaces.Auth
+	authzUC     interfaces.Authorization
 	statusUC    *usecase.StatusUseCase
+	updateUC    interfaces.StakeholderUpdate
+	relationUC  interfaces.IncidentRelation
 	modelConfig *model.Config
 	userUC      *usecase.UserUseCase
+	audit       *audit.Recorder
+	events      *pubsub.Broker
+	search      *search.Index
 }
 
 // UseCases contains all usecase interfaces
@@ -28,19 +37,57 @@ type UseCases struct {
 	IncidentUC interfaces.Incident
 	TaskUC     interfaces.Task
 	AuthUC     interfaces.Auth
+	// AuthzUC enforces roles on mutations. When nil, it is built from modelConfig.Roles.
+	AuthzUC interfaces.Authorization
+	// StatusUC changes incident statuses. When nil, one publishing to Events is built.
+	StatusUC *usecase.StatusUseCase
+	// UpdateUC posts stakeholder updates. When nil, one publishing to Events is built.
+	UpdateUC interfaces.StakeholderUpdate
+	// RelationUC links and merges incidents. When nil, one publishing to Events is built.
+	RelationUC interfaces.IncidentRelation
+	// Events feeds subscriptions. When nil, subscriptions receive no events.
+	Events *pubsub.Broker
+	// Search serves the search query. When nil, searches return no hits.
+	Search *search.Index
 }
 
 // NewResolver creates a new resolver instance
 func NewResolver(repo interfaces.Repository, slackSvc interfaces.SlackClient, uc *UseCases, modelConfig *model.Config) *Resolver {
 	slackUIService := slackservice.NewUIService(slackSvc, modelConfig)
+	authzUC := uc.AuthzUC
+	if authzUC == nil {
+		var roles *model.RolesConfig
+		if modelConfig != nil {
+			roles = modelConfig.Roles
+		}
+		authzUC = usecase.NewAuthorization(roles, slackSvc)
+	}
+	statusUC := uc.StatusUC
+	if statusUC == nil {
+		statusUC = usecase.NewStatusUseCase(repo, slackUIService, modelConfig, usecase.WithStatusEvents(uc.Events))
+	}
+	updateUC := uc.UpdateUC
+	if updateUC == nil {
+		updateUC = usecase.NewStakeholderUpdate(repo, slackUIService, modelConfig, usecase.WithStakeholderUpdateEvents(uc.Events))
+	}
+	relationUC := uc.RelationUC
+	if relationUC == nil {
+		relationUC = usecase.NewIncidentRelation(repo, slackUIService, statusUC, usecase.WithIncidentRelationEvents(uc.Events))
+	}
 	return &Resolver{
 		repo:        repo,
 		slackSvc:    slackSvc,
 		incidentUC:  uc.IncidentUC,
 		taskUC:      uc.TaskUC,
 		authUC:      uc.AuthUC,
-		statusUC:    usecase.NewStatusUseCase(repo, slackUIService, modelConfig),
+		authzUC:     authzUC,
+		statusUC:    statusUC,
+		updateUC:    updateUC,
+		relationUC:  relationUC,
 		modelConfig: modelConfig,
 		userUC:      usecase.NewUserUseCase(repo, slackSvc),
+		audit:       audit.New(repo),
+		events:      uc.Events,
+		search:      uc.Search,
 	}
 }
@@ -2,7 +2,9 @@ package graphql_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -10,8 +12,11 @@ import (
 	"github.com/secmon-lab/lycaon/pkg/controller/graphql"
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces/mocks"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	graphql1 "github.com/secmon-lab/lycaon/pkg/domain/model/graphql"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
 	"github.com/secmon-lab/lycaon/pkg/repository"
+	"github.com/secmon-lab/lycaon/pkg/service/pubsub"
+	"github.com/secmon-lab/lycaon/pkg/service/search"
 	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
 	"github.com/secmon-lab/lycaon/pkg/usecase"
 	"github.com/slack-go/slack"
@@ -157,7 +162,7 @@ func TestIncidentResolverPrivateFiltering(t *testing.T) {
 
 		// Get incidents list
 		first := 10
-		result, err := resolver.Query().Incidents(nonMemberCtx, &first, nil)
+		result, err := resolver.Query().Incidents(nonMemberCtx, &first, nil, nil, nil)
 		gt.NoError(t, err)
 		gt.V(t, result).NotNil()
 		gt.Equal(t, len(result.Edges), 2)
@@ -191,7 +196,7 @@ func TestIncidentResolverPrivateFiltering(t *testing.T) {
 
 		// Get incidents list
 		first := 10
-		result, err := resolver.Query().Incidents(memberCtx, &first, nil)
+		result, err := resolver.Query().Incidents(memberCtx, &first, nil, nil, nil)
 		gt.NoError(t, err)
 		gt.V(t, result).NotNil()
 		gt.Equal(t, len(result.Edges), 2)
@@ -211,18 +216,73 @@ func TestIncidentResolverPrivateFiltering(t *testing.T) {
 		gt.Equal(t, privateInc.Description, "This is private and sensitive")
 	})
 
-	t.Run("No auth context returns incidents as-is", func(t *testing.T) {
-		// No auth context (backward compatibility)
-		noAuthCtx := context.Background()
+	t.Run("Searching hidden fields skips inaccessible private incidents", func(t *testing.T) {
+		nonMemberCtx := model.WithAuthContext(ctx, &model.AuthContext{
+			SlackUserID: "U-NON-MEMBER",
+		})
 
-		// Get incident
-		incident, err := resolver.Query().Incident(noAuthCtx, fmt.Sprintf("%d", privateIncidentID))
+		first := 10
+		text := "sensitive"
+		result, err := resolver.Query().Incidents(nonMemberCtx, &first, nil, &graphql1.IncidentFilterInput{Text: &text}, nil)
 		gt.NoError(t, err)
-		gt.V(t, incident).NotNil()
+		gt.Equal(t, len(result.Edges), 0)
 
-		// Should return as-is (no filtering)
-		gt.Equal(t, incident.Title, "Private Incident Real Title")
-		gt.Equal(t, incident.Description, "This is private and sensitive")
+		memberCtx := model.WithAuthContext(ctx, &model.AuthContext{
+			SlackUserID: "U-MEMBER1",
+		})
+		result, err = resolver.Query().Incidents(memberCtx, &first, nil, &graphql1.IncidentFilterInput{Text: &text}, nil)
+		gt.NoError(t, err)
+		gt.Equal(t, len(result.Edges), 1)
+		gt.Equal(t, result.Edges[0].Node.ID, privateIncidentID)
+	})
+
+	t.Run("Incidents list filters by severity level range", func(t *testing.T) {
+		first := 10
+		minLevel, maxLevel := 50, 100
+		result, err := resolver.Query().Incidents(ctx, &first, nil, &graphql1.IncidentFilterInput{SeverityLevelMin: &minLevel, SeverityLevelMax: &maxLevel}, nil)
+		gt.NoError(t, err)
+		gt.Equal(t, len(result.Edges), 2)
+
+		// No severity has a level in range, so nothing can match
+		minLevel = 90
+		result, err = resolver.Query().Incidents(ctx, &first, nil, &graphql1.IncidentFilterInput{SeverityLevelMin: &minLevel}, nil)
+		gt.NoError(t, err)
+		gt.Equal(t, len(result.Edges), 0)
+		gt.Equal(t, result.TotalCount, 0)
+	})
+
+	t.Run("Callers without a Slack user see private incidents redacted", func(t *testing.T) {
+		serviceTokenCtx := model.WithAuthContext(ctx, &model.AuthContext{
+			APITokenID:   "tok-service",
+			APITokenKind: types.APITokenKindService,
+			Scopes:       []types.APITokenScope{types.APITokenScopeRead},
+		})
+
+		for _, callerCtx := range []context.Context{context.Background(), serviceTokenCtx} {
+			incident, err := resolver.Query().Incident(callerCtx, fmt.Sprintf("%d", privateIncidentID))
+			gt.NoError(t, err)
+			gt.V(t, incident).NotNil()
+			gt.Equal(t, incident.Title, "Private Incident")
+			gt.Equal(t, incident.Description, "")
+
+			canAccess, err := resolver.Incident().ViewerCanAccess(callerCtx, incident)
+			gt.NoError(t, err)
+			gt.False(t, canAccess)
+		}
+
+		first := 10
+		result, err := resolver.Query().Incidents(serviceTokenCtx, &first, nil, nil, nil)
+		gt.NoError(t, err)
+		for _, edge := range result.Edges {
+			if edge.Node.ID == privateIncidentID {
+				gt.Equal(t, edge.Node.Title, "Private Incident")
+			}
+		}
+
+		text := "sensitive"
+		result, err = resolver.Query().Incidents(serviceTokenCtx, &first, nil, &graphql1.IncidentFilterInput{Text: &text}, nil)
+		gt.NoError(t, err)
+		gt.Equal(t, len(result.Edges), 0)
 	})
 }
 
@@ -378,4 +438,588 @@ func TestTaskResolverPrivateFiltering(t *testing.T) {
 		gt.Error(t, err)
 		gt.V(t, task).Nil()
 	})
+
+	t.Run("Service token cannot access tasks of private incident", func(t *testing.T) {
+		serviceTokenCtx := model.WithAuthContext(ctx, &model.AuthContext{
+			APITokenID:   "tok-service",
+			APITokenKind: types.APITokenKindService,
+			Scopes:       []types.APITokenScope{types.APITokenScopeRead},
+		})
+
+		tasks, err := resolver.Query().Tasks(serviceTokenCtx, fmt.Sprintf("%d", privateIncidentID))
+		gt.NoError(t, err)
+		gt.Equal(t, 0, len(tasks))
+
+		task, err := resolver.Query().Task(serviceTokenCtx, string(privateTaskID))
+		gt.Error(t, err)
+		gt.V(t, task).Nil()
+
+		tasks, err = resolver.Query().Tasks(serviceTokenCtx, fmt.Sprintf("%d", publicIncidentID))
+		gt.NoError(t, err)
+		gt.Equal(t, 1, len(tasks))
+	})
+}
+
+func TestMutationResolverAuthorization(t *testing.T) {
+	repo := repository.NewMemory()
+	mockSlack := &mocks.SlackClientMock{
+		GetUsersInConversationContextFunc: func(ctx context.Context, params *slack.GetUsersInConversationParameters) ([]string, string, error) {
+			return []string{"U-LEAD"}, "", nil
+		},
+		PostMessageFunc: func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+			return channelID, "1234.5678", nil
+		},
+	}
+	config := &model.Config{
+		Categories: []model.Category{
+			{ID: "unknown", Name: "Unknown"},
+		},
+		Roles: &model.RolesConfig{
+			Bindings: []model.RoleBinding{
+				{Role: types.RoleAdmin, Users: []string{"U-ADMIN"}},
+				{Role: types.RoleResponder, Users: []string{"U-LEAD", "U-OUTSIDER"}},
+			},
+		},
+	}
+	taskUC := usecase.NewTaskUseCase(repo, mockSlack)
+	incidentUC := usecase.NewIncident(repo, mockSlack, slackSvc.NewUIService(mockSlack, config), config, nil, usecase.NewIncidentConfig())
+	resolver := graphql.NewResolver(repo, mockSlack, &graphql.UseCases{IncidentUC: incidentUC, TaskUC: taskUC}, config)
+
+	ctx := context.Background()
+	incidentID := types.IncidentID(time.Now().UnixNano())
+	gt.NoError(t, repo.PutIncident(ctx, &model.Incident{
+		ID:        incidentID,
+		Title:     "Database outage",
+		ChannelID: "C-INCIDENT",
+		Status:    types.IncidentStatusHandling,
+		CreatedBy: "U-LEAD",
+	}))
+	task, err := model.NewTask(incidentID, "Check replicas", "U-LEAD")
+	gt.NoError(t, err).Required()
+	gt.NoError(t, repo.CreateTask(ctx, task))
+
+	asUser := func(userID string) context.Context {
+		return model.WithAuthContext(ctx, &model.AuthContext{SlackUserID: userID})
+	}
+	title := "Renamed"
+	input := graphql1.UpdateIncidentInput{Title: &title}
+
+	t.Run("viewer cannot update incident", func(t *testing.T) {
+		_, err := resolver.Mutation().UpdateIncident(asUser("U-VIEWER"), fmt.Sprintf("%d", incidentID), input)
+		gt.True(t, errors.Is(err, model.ErrPermissionDenied))
+	})
+
+	t.Run("responder outside incident cannot delete task", func(t *testing.T) {
+		_, err := resolver.Mutation().DeleteTask(asUser("U-OUTSIDER"), task.ID.String())
+		gt.True(t, errors.Is(err, model.ErrPermissionDenied))
+	})
+
+	t.Run("responder in incident can update incident", func(t *testing.T) {
+		incident, err := resolver.Mutation().UpdateIncident(asUser("U-LEAD"), fmt.Sprintf("%d", incidentID), input)
+		gt.NoError(t, err)
+		gt.Equal(t, "Renamed", incident.Title)
+	})
+
+	t.Run("admin can delete task", func(t *testing.T) {
+		ok, err := resolver.Mutation().DeleteTask(asUser("U-ADMIN"), task.ID.String())
+		gt.NoError(t, err)
+		gt.True(t, ok)
+	})
+
+	t.Run("incident cannot be declared without a Slack user", func(t *testing.T) {
+		_, err := resolver.Mutation().CreateIncident(ctx, graphql1.CreateIncidentInput{
+			Title:           "Database outage",
+			CategoryID:      "unknown",
+			OriginChannelID: "C-GENERAL",
+		})
+		gt.True(t, errors.Is(err, model.ErrPermissionDenied))
+	})
+
+	t.Run("viewer cannot close incident", func(t *testing.T) {
+		_, err := resolver.Mutation().CloseIncident(asUser("U-VIEWER"), fmt.Sprintf("%d", incidentID), "Fixed")
+		gt.True(t, errors.Is(err, model.ErrPermissionDenied))
+	})
+}
+
+func TestUpdateIncidentMutationRenamesChannel(t *testing.T) {
+	repo := repository.NewMemory()
+	mockSlack := &mocks.SlackClientMock{
+		PostMessageFunc: func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+			return channelID, "1234.5678", nil
+		},
+		RenameConversationContextFunc: func(ctx context.Context, channelID, name string) (*slack.Channel, error) {
+			return &slack.Channel{}, nil
+		},
+		SetPurposeOfConversationContextFunc: func(ctx context.Context, channelID, purpose string) (*slack.Channel, error) {
+			return &slack.Channel{}, nil
+		},
+	}
+	config := &model.Config{
+		Roles: &model.RolesConfig{
+			Bindings: []model.RoleBinding{
+				{Role: types.RoleResponder, Users: []string{"U-LEAD"}},
+			},
+		},
+	}
+	incidentUC := usecase.NewIncident(repo, mockSlack, slackSvc.NewUIService(mockSlack, config), config, nil,
+		usecase.NewIncidentConfig(usecase.WithChannelRename(true)))
+	resolver := graphql.NewResolver(repo, mockSlack, &graphql.UseCases{IncidentUC: incidentUC}, config)
+
+	ctx := context.Background()
+	incidentID := types.IncidentID(time.Now().UnixNano())
+	gt.NoError(t, repo.PutIncident(ctx, &model.Incident{
+		ID:              incidentID,
+		Title:           "Database outage",
+		ChannelID:       "C-INCIDENT",
+		ChannelName:     types.ChannelName(fmt.Sprintf("inc-%d-database-outage", incidentID)),
+		Status:          types.IncidentStatusHandling,
+		CreatedBy:       "U-LEAD",
+		JoinedMemberIDs: []types.SlackUserID{"U-LEAD"},
+	}))
+
+	title := "Replica lag"
+	ctx = model.WithAuthContext(ctx, &model.AuthContext{SlackUserID: "U-LEAD"})
+	incident, err := resolver.Mutation().UpdateIncident(ctx, fmt.Sprintf("%d", incidentID), graphql1.UpdateIncidentInput{Title: &title})
+	gt.NoError(t, err).Required()
+
+	expected := types.ChannelName(fmt.Sprintf("inc-%d-replica-lag", incidentID))
+	gt.A(t, mockSlack.RenameConversationContextCalls()).Length(1).At(0, func(t testing.TB, v struct {
+		Ctx       context.Context
+		ChannelID string
+		Name      string
+	}) {
+		gt.Equal(t, v.ChannelID, "C-INCIDENT")
+		gt.Equal(t, v.Name, expected.String())
+	})
+	gt.A(t, mockSlack.SetPurposeOfConversationContextCalls()).Length(1).At(0, func(t testing.TB, v struct {
+		Ctx       context.Context
+		ChannelID string
+		Purpose   string
+	}) {
+		gt.Equal(t, v.Purpose, "Replica lag")
+	})
+	gt.Equal(t, incident.ChannelName, expected)
+
+	stored, err := repo.GetIncident(ctx, incidentID)
+	gt.NoError(t, err).Required()
+	gt.Equal(t, stored.Title, "Replica lag")
+	gt.Equal(t, stored.ChannelName, expected)
+}
+
+func TestUpdateIncidentMutationBroadcastsSeverity(t *testing.T) {
+	repo := repository.NewMemory()
+	var mu sync.Mutex
+	var posted []string
+	mockSlack := &mocks.SlackClientMock{
+		PostMessageFunc: func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			posted = append(posted, channelID)
+			return channelID, "1234.5678", nil
+		},
+	}
+	config := &model.Config{
+		Severities: []model.Severity{
+			{ID: "critical", Name: "Critical", Level: 90},
+			{ID: "low", Name: "Low", Level: 20},
+		},
+		Notifications: &model.NotificationsConfig{
+			BroadcastChannels: map[string][]types.ChannelID{
+				"critical": {"C-EXEC"},
+			},
+		},
+		Roles: &model.RolesConfig{
+			Bindings: []model.RoleBinding{
+				{Role: types.RoleResponder, Users: []string{"U-LEAD"}},
+			},
+		},
+	}
+	incidentUC := usecase.NewIncident(repo, mockSlack, slackSvc.NewUIService(mockSlack, config), config, nil, usecase.NewIncidentConfig())
+	resolver := graphql.NewResolver(repo, mockSlack, &graphql.UseCases{IncidentUC: incidentUC}, config)
+
+	ctx := context.Background()
+	incidentID := types.IncidentID(time.Now().UnixNano())
+	gt.NoError(t, repo.PutIncident(ctx, &model.Incident{
+		ID:              incidentID,
+		Title:           "Database outage",
+		ChannelID:       "C-INCIDENT",
+		SeverityID:      "low",
+		Status:          types.IncidentStatusHandling,
+		CreatedBy:       "U-LEAD",
+		JoinedMemberIDs: []types.SlackUserID{"U-LEAD"},
+	}))
+
+	severityID := "critical"
+	ctx = model.WithAuthContext(ctx, &model.AuthContext{SlackUserID: "U-LEAD"})
+	_, err := resolver.Mutation().UpdateIncident(ctx, fmt.Sprintf("%d", incidentID), graphql1.UpdateIncidentInput{SeverityID: &severityID})
+	gt.NoError(t, err).Required()
+
+	mu.Lock()
+	defer mu.Unlock()
+	gt.A(t, posted).Has("C-EXEC")
+}
+
+func TestAuditLogResolver(t *testing.T) {
+	repo := repository.NewMemory()
+	mockSlack := &mocks.SlackClientMock{
+		GetUsersInConversationContextFunc: func(ctx context.Context, params *slack.GetUsersInConversationParameters) ([]string, string, error) {
+			return []string{"U-LEAD"}, "", nil
+		},
+		PostMessageFunc: func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+			return channelID, "1234.5678", nil
+		},
+	}
+	config := &model.Config{
+		Roles: &model.RolesConfig{
+			Bindings: []model.RoleBinding{
+				{Role: types.RoleAdmin, Users: []string{"U-ADMIN"}},
+				{Role: types.RoleResponder, Users: []string{"U-LEAD"}},
+			},
+		},
+	}
+	taskUC := usecase.NewTaskUseCase(repo, mockSlack)
+	incidentUC := usecase.NewIncident(repo, mockSlack, slackSvc.NewUIService(mockSlack, config), config, nil, usecase.NewIncidentConfig())
+	resolver := graphql.NewResolver(repo, mockSlack, &graphql.UseCases{IncidentUC: incidentUC, TaskUC: taskUC}, config)
+
+	ctx := context.Background()
+	incidentID := types.IncidentID(time.Now().UnixNano())
+	gt.NoError(t, repo.PutIncident(ctx, &model.Incident{
+		ID:        incidentID,
+		Title:     "Database outage",
+		ChannelID: "C-INCIDENT",
+		Status:    types.IncidentStatusHandling,
+		CreatedBy: "U-LEAD",
+	}))
+	task, err := model.NewTask(incidentID, "Check replicas", "U-LEAD")
+	gt.NoError(t, err).Required()
+	gt.NoError(t, repo.CreateTask(ctx, task))
+
+	asUser := func(userID string) context.Context {
+		return model.WithAuthContext(ctx, &model.AuthContext{SlackUserID: userID})
+	}
+
+	title := "Replica lag"
+	_, err = resolver.Mutation().UpdateIncident(asUser("U-LEAD"), fmt.Sprintf("%d", incidentID), graphql1.UpdateIncidentInput{Title: &title})
+	gt.NoError(t, err).Required()
+	taskTitle := "Check replica lag"
+	_, err = resolver.Mutation().UpdateTask(asUser("U-LEAD"), task.ID.String(), graphql1.UpdateTaskInput{Title: &taskTitle})
+	gt.NoError(t, err).Required()
+	_, err = resolver.Mutation().DeleteTask(asUser("U-ADMIN"), task.ID.String())
+	gt.NoError(t, err).Required()
+
+	t.Run("non-admin cannot read the audit log", func(t *testing.T) {
+		_, err := resolver.Query().AuditLog(asUser("U-LEAD"), nil, nil)
+		gt.True(t, errors.Is(err, model.ErrPermissionDenied))
+	})
+
+	t.Run("service token cannot read the audit log", func(t *testing.T) {
+		tokenCtx := model.WithAuthContext(ctx, &model.AuthContext{
+			APITokenID:   "token-1",
+			APITokenKind: types.APITokenKindService,
+			Scopes:       []types.APITokenScope{types.APITokenScopeRead, types.APITokenScopeWrite},
+		})
+		_, err := resolver.Query().AuditLog(tokenCtx, nil, nil)
+		gt.True(t, errors.Is(err, model.ErrPermissionDenied))
+	})
+
+	t.Run("admin reads entries newest first", func(t *testing.T) {
+		incidentFilter := fmt.Sprintf("%d", incidentID)
+		entries, err := resolver.Query().AuditLog(asUser("U-ADMIN"), &graphql1.AuditLogFilter{IncidentID: &incidentFilter}, nil)
+		gt.NoError(t, err).Required()
+		gt.A(t, entries).Length(3).Required()
+
+		gt.Equal(t, entries[0].Action, types.AuditActionTaskDelete)
+		gt.Equal(t, entries[0].ActorID, "U-ADMIN")
+		gt.Equal(t, entries[1].Action, types.AuditActionTaskUpdate)
+		gt.Equal(t, entries[1].ActorID, "U-LEAD")
+		gt.Equal(t, entries[2].Action, types.AuditActionIncidentUpdate)
+		gt.Equal(t, entries[2].ActorID, "U-LEAD")
+		gt.A(t, entries[2].Changes).Length(1).At(0, func(t testing.TB, v model.AuditChange) {
+			gt.Equal(t, v.Field, "Title")
+			gt.Equal(t, v.Before, `"Database outage"`)
+			gt.Equal(t, v.After, `"Replica lag"`)
+		})
+	})
+
+	t.Run("admin filters by action", func(t *testing.T) {
+		action := types.AuditActionIncidentUpdate.String()
+		entries, err := resolver.Query().AuditLog(asUser("U-ADMIN"), &graphql1.AuditLogFilter{Action: &action}, nil)
+		gt.NoError(t, err)
+		gt.A(t, entries).Length(1)
+	})
+
+	t.Run("rejects limit out of range", func(t *testing.T) {
+		limit := 5000
+		_, err := resolver.Query().AuditLog(asUser("U-ADMIN"), nil, &limit)
+		gt.Error(t, err)
+	})
+}
+
+func TestIncidentAccessGrantResolvers(t *testing.T) {
+	repo := repository.NewMemory()
+	mockSlack := &mocks.SlackClientMock{
+		PostMessageFunc: func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+			return channelID, "1234.5678", nil
+		},
+		GetUsersInConversationContextFunc: func(ctx context.Context, params *slack.GetUsersInConversationParameters) ([]string, string, error) {
+			return []string{"U-LEAD"}, "", nil
+		},
+	}
+	config := &model.Config{
+		Roles: &model.RolesConfig{
+			Bindings: []model.RoleBinding{
+				{Role: types.RoleResponder, Users: []string{"U-LEAD"}},
+				{Role: types.RoleAdmin, Users: []string{"U-ADMIN"}},
+			},
+		},
+	}
+	incidentUC := usecase.NewIncident(repo, mockSlack, slackSvc.NewUIService(mockSlack, config), config, nil, usecase.NewIncidentConfig())
+	resolver := graphql.NewResolver(repo, mockSlack, &graphql.UseCases{IncidentUC: incidentUC}, config)
+
+	ctx := context.Background()
+	incidentID := types.IncidentID(time.Now().UnixNano())
+	gt.NoError(t, repo.PutIncident(ctx, &model.Incident{
+		ID:              incidentID,
+		Title:           "Credential leak",
+		ChannelID:       "C-PRIVATE",
+		CreatedBy:       "U-LEAD",
+		Private:         true,
+		JoinedMemberIDs: []types.SlackUserID{"U-LEAD"},
+	}))
+	id := fmt.Sprintf("%d", incidentID)
+
+	asUser := func(userID string) context.Context {
+		return model.WithAuthContext(ctx, &model.AuthContext{SlackUserID: userID})
+	}
+
+	t.Run("outsider can request access", func(t *testing.T) {
+		ok, err := resolver.Mutation().RequestIncidentAccess(asUser("U-ANALYST"), id, nil)
+		gt.NoError(t, err)
+		gt.True(t, ok)
+	})
+
+	t.Run("service token cannot request access", func(t *testing.T) {
+		tokenCtx := model.WithAuthContext(ctx, &model.AuthContext{
+			APITokenID:   "token-1",
+			APITokenKind: types.APITokenKindService,
+			Scopes:       []types.APITokenScope{types.APITokenScopeWrite},
+		})
+		_, err := resolver.Mutation().RequestIncidentAccess(tokenCtx, id, nil)
+		gt.True(t, errors.Is(err, model.ErrPermissionDenied))
+	})
+
+	t.Run("viewer cannot grant access", func(t *testing.T) {
+		userID := "U-ANALYST"
+		_, err := resolver.Mutation().GrantIncidentAccess(asUser("U-ANALYST"), id, graphql1.GrantIncidentAccessInput{UserID: &userID})
+		gt.True(t, errors.Is(err, model.ErrPermissionDenied))
+	})
+
+	t.Run("admin without access to the incident cannot grant access", func(t *testing.T) {
+		userID := "U-ADMIN"
+		_, err := resolver.Mutation().GrantIncidentAccess(asUser("U-ADMIN"), id, graphql1.GrantIncidentAccessInput{UserID: &userID})
+		gt.True(t, errors.Is(err, model.ErrPermissionDenied))
+
+		incident, err := resolver.Query().Incident(asUser("U-ADMIN"), id)
+		gt.NoError(t, err)
+		gt.Equal(t, incident.Title, "Private Incident")
+	})
+
+	t.Run("lead grants time-boxed access", func(t *testing.T) {
+		userID := "U-ANALYST"
+		hours := 8
+		grant, err := resolver.Mutation().GrantIncidentAccess(asUser("U-LEAD"), id, graphql1.GrantIncidentAccessInput{
+			UserID:         &userID,
+			ExpiresInHours: &hours,
+		})
+		gt.NoError(t, err).Required()
+		gt.True(t, time.Until(grant.ExpiresAt) <= 8*time.Hour)
+
+		incident, err := resolver.Query().Incident(asUser("U-ANALYST"), id)
+		gt.NoError(t, err)
+		gt.Equal(t, incident.Title, "Credential leak")
+
+		ok, err := resolver.Mutation().RevokeIncidentAccess(asUser("U-LEAD"), id, grant.ID.String())
+		gt.NoError(t, err)
+		gt.True(t, ok)
+
+		incident, err = resolver.Query().Incident(asUser("U-ANALYST"), id)
+		gt.NoError(t, err)
+		gt.Equal(t, incident.Title, "Private Incident")
+	})
+
+	t.Run("rejects grant lifetime out of range", func(t *testing.T) {
+		userID := "U-ANALYST"
+		hours := 10000
+		_, err := resolver.Mutation().GrantIncidentAccess(asUser("U-LEAD"), id, graphql1.GrantIncidentAccessInput{
+			UserID:         &userID,
+			ExpiresInHours: &hours,
+		})
+		gt.Error(t, err)
+	})
+}
+
+func TestSubscriptionResolvers(t *testing.T) {
+	repo := repository.NewMemory()
+	mockSlack := &mocks.SlackClientMock{
+		PostMessageFunc: func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+			return channelID, "1234.5678", nil
+		},
+	}
+	config := &model.Config{
+		Roles: &model.RolesConfig{
+			Bindings: []model.RoleBinding{
+				{Role: types.RoleResponder, Users: []string{"U-LEAD"}},
+			},
+		},
+	}
+	events := pubsub.New()
+	incidentUC := usecase.NewIncident(repo, mockSlack, slackSvc.NewUIService(mockSlack, config), config, nil,
+		usecase.NewIncidentConfig(usecase.WithIncidentEvents(events)))
+	resolver := graphql.NewResolver(repo, mockSlack, &graphql.UseCases{IncidentUC: incidentUC, Events: events}, config)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	incidentID := types.IncidentID(time.Now().UnixNano())
+	gt.NoError(t, repo.PutIncident(ctx, &model.Incident{
+		ID:              incidentID,
+		Title:           "Credential leak",
+		ChannelID:       "C-PRIVATE",
+		CreatedBy:       "U-LEAD",
+		Private:         true,
+		JoinedMemberIDs: []types.SlackUserID{"U-LEAD"},
+	}))
+	id := incidentID.String()
+
+	asUser := func(userID string) context.Context {
+		return model.WithAuthContext(ctx, &model.AuthContext{SlackUserID: userID})
+	}
+
+	outsiderIncidents, err := resolver.Subscription().IncidentUpdated(asUser("U-OUTSIDER"), nil)
+	gt.NoError(t, err).Required()
+	serviceTokenCtx := model.WithAuthContext(ctx, &model.AuthContext{
+		APITokenID:   "tok-service",
+		APITokenKind: types.APITokenKindService,
+		Scopes:       []types.APITokenScope{types.APITokenScopeRead},
+	})
+	serviceIncidents, err := resolver.Subscription().IncidentUpdated(serviceTokenCtx, nil)
+	gt.NoError(t, err).Required()
+	serviceTimeline, err := resolver.Subscription().TimelineEventAdded(serviceTokenCtx, &id)
+	gt.NoError(t, err).Required()
+	outsiderTimeline, err := resolver.Subscription().TimelineEventAdded(asUser("U-OUTSIDER"), &id)
+	gt.NoError(t, err).Required()
+	memberTimeline, err := resolver.Subscription().TimelineEventAdded(asUser("U-LEAD"), &id)
+	gt.NoError(t, err).Required()
+
+	title := "Credential leak in CI"
+	_, err = resolver.Mutation().UpdateIncident(asUser("U-LEAD"), id, graphql1.UpdateIncidentInput{Title: &title})
+	gt.NoError(t, err).Required()
+
+	select {
+	case incident := <-outsiderIncidents:
+		gt.Equal(t, incident.ID, incidentID)
+		gt.Equal(t, incident.Title, "Private Incident")
+	case <-time.After(time.Second):
+		t.Fatal("incident update not received")
+	}
+
+	select {
+	case ev := <-memberTimeline:
+		gt.Equal(t, ev.Kind, types.TimelineEventIncidentUpdated)
+		gt.Equal(t, ev.ActorID, "U-LEAD")
+	case <-time.After(time.Second):
+		t.Fatal("timeline event not received")
+	}
+
+	select {
+	case incident := <-serviceIncidents:
+		gt.Equal(t, incident.ID, incidentID)
+		gt.Equal(t, incident.Title, "Private Incident")
+	case <-time.After(time.Second):
+		t.Fatal("incident update not received by service token")
+	}
+
+	select {
+	case ev := <-outsiderTimeline:
+		t.Fatalf("timeline of a private incident leaked: %s", ev.Summary)
+	case ev := <-serviceTimeline:
+		t.Fatalf("timeline of a private incident leaked to a service token: %s", ev.Summary)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	t.Run("rejects invalid incident ID", func(t *testing.T) {
+		invalid := "not-a-number"
+		_, err := resolver.Subscription().TaskUpdated(asUser("U-LEAD"), &invalid)
+		gt.Error(t, err)
+	})
+}
+
+func TestSearchResolver(t *testing.T) {
+	repo := repository.NewMemory()
+	mockSlack := &mocks.SlackClientMock{}
+	config := &model.Config{}
+	incidentUC := usecase.NewIncident(repo, mockSlack, slackSvc.NewUIService(mockSlack, config), config, nil, usecase.NewIncidentConfig())
+	index := search.New()
+	resolver := graphql.NewResolver(repo, mockSlack, &graphql.UseCases{IncidentUC: incidentUC, Search: index}, config)
+
+	ctx := context.Background()
+	baseID := time.Now().UnixNano()
+	public := &model.Incident{ID: types.IncidentID(baseID), Title: "Payment API timeout", ChannelID: "C-PUBLIC"}
+	private := &model.Incident{
+		ID:              types.IncidentID(baseID + 1),
+		Title:           "Payment card data exposure",
+		ChannelID:       "C-PRIVATE",
+		Private:         true,
+		JoinedMemberIDs: []types.SlackUserID{"U-MEMBER"},
+	}
+	for _, incident := range []*model.Incident{public, private} {
+		gt.NoError(t, repo.PutIncident(ctx, incident))
+		index.Put(search.IncidentDocument(incident))
+	}
+	index.Put(search.MessageDocument(&model.Message{ID: "msg-1", ChannelID: "C-PRIVATE", Text: "Payment logs contain card numbers", EventTS: "1700000000.000100"}))
+
+	asUser := func(userID string) context.Context {
+		return model.WithAuthContext(ctx, &model.AuthContext{SlackUserID: userID})
+	}
+
+	t.Run("hides private incidents from outsiders", func(t *testing.T) {
+		result, err := resolver.Query().Search(asUser("U-OUTSIDER"), "payment", nil, nil)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, result.TotalCount, 1)
+		gt.Equal(t, result.Hits[0].IncidentID, public.ID)
+	})
+
+	t.Run("hides private incidents from service tokens", func(t *testing.T) {
+		serviceTokenCtx := model.WithAuthContext(ctx, &model.AuthContext{
+			APITokenID:   "tok-service",
+			APITokenKind: types.APITokenKindService,
+			Scopes:       []types.APITokenScope{types.APITokenScopeRead},
+		})
+		result, err := resolver.Query().Search(serviceTokenCtx, "payment", nil, nil)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, result.TotalCount, 1)
+		gt.Equal(t, result.Hits[0].IncidentID, public.ID)
+	})
+
+	t.Run("returns private hits to members", func(t *testing.T) {
+		kinds := []types.SearchDocumentKind{types.SearchKindMessage}
+		result, err := resolver.Query().Search(asUser("U-MEMBER"), "payment", &graphql1.SearchFilterInput{Kinds: kinds}, nil)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, result.TotalCount, 1)
+
+		hit := result.Hits[0]
+		incident, err := resolver.SearchHit().Incident(asUser("U-MEMBER"), hit)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, incident.Title, "Payment card data exposure")
+
+		permalink, err := resolver.SearchHit().Permalink(ctx, hit)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, *permalink, "https://slack.com/archives/C-PRIVATE/p1700000000000100")
+	})
+
+	t.Run("rejects invalid page size", func(t *testing.T) {
+		first := 0
+		_, err := resolver.Query().Search(ctx, "payment", nil, &first)
+		gt.Error(t, err)
+	})
 }
@@ -6,23 +6,138 @@ package graphql
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
 
 	goerr "github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	graphql1 "github.com/secmon-lab/lycaon/pkg/domain/model/graphql"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
 	"github.com/secmon-lab/lycaon/pkg/repository"
+	"github.com/secmon-lab/lycaon/pkg/service/audit"
+	"github.com/secmon-lab/lycaon/pkg/service/pubsub"
 	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
 )
 
+// ID is the resolver for the id field.
+func (r *aPITokenResolver) ID(ctx context.Context, obj *model.APIToken) (string, error) {
+	return string(obj.ID), nil
+}
+
+// OwnerID is the resolver for the ownerId field.
+func (r *aPITokenResolver) OwnerID(ctx context.Context, obj *model.APIToken) (*string, error) {
+	if obj.OwnerID == "" {
+		return nil, nil
+	}
+	ownerID := string(obj.OwnerID)
+	return &ownerID, nil
+}
+
+// CreatedBy is the resolver for the createdBy field.
+func (r *aPITokenResolver) CreatedBy(ctx context.Context, obj *model.APIToken) (*string, error) {
+	if obj.CreatedBy == "" {
+		return nil, nil
+	}
+	createdBy := string(obj.CreatedBy)
+	return &createdBy, nil
+}
+
+// ExpiresAt is the resolver for the expiresAt field.
+func (r *aPITokenResolver) ExpiresAt(ctx context.Context, obj *model.APIToken) (*time.Time, error) {
+	return optionalTime(obj.ExpiresAt), nil
+}
+
+// LastUsedAt is the resolver for the lastUsedAt field.
+func (r *aPITokenResolver) LastUsedAt(ctx context.Context, obj *model.APIToken) (*time.Time, error) {
+	return optionalTime(obj.LastUsedAt), nil
+}
+
+// RevokedAt is the resolver for the revokedAt field.
+func (r *aPITokenResolver) RevokedAt(ctx context.Context, obj *model.APIToken) (*time.Time, error) {
+	return optionalTime(obj.RevokedAt), nil
+}
+
+// ID is the resolver for the id field.
+func (r *accessGrantResolver) ID(ctx context.Context, obj *model.AccessGrant) (string, error) {
+	return obj.ID.String(), nil
+}
+
+// UserID is the resolver for the userId field.
+func (r *accessGrantResolver) UserID(ctx context.Context, obj *model.AccessGrant) (*string, error) {
+	return optionalString(obj.UserID.String()), nil
+}
+
+// GroupID is the resolver for the groupId field.
+func (r *accessGrantResolver) GroupID(ctx context.Context, obj *model.AccessGrant) (*string, error) {
+	return optionalString(obj.GroupID), nil
+}
+
+// Reason is the resolver for the reason field.
+func (r *accessGrantResolver) Reason(ctx context.Context, obj *model.AccessGrant) (*string, error) {
+	return optionalString(obj.Reason), nil
+}
+
+// GrantedBy is the resolver for the grantedBy field.
+func (r *accessGrantResolver) GrantedBy(ctx context.Context, obj *model.AccessGrant) (*string, error) {
+	return optionalString(obj.GrantedBy.String()), nil
+}
+
+// ExpiresAt is the resolver for the expiresAt field.
+func (r *accessGrantResolver) ExpiresAt(ctx context.Context, obj *model.AccessGrant) (*time.Time, error) {
+	return optionalTime(obj.ExpiresAt), nil
+}
+
 // ID is the resolver for the id field.
 func (r *assetResolver) ID(ctx context.Context, obj *model.Asset) (string, error) {
 	return string(obj.ID), nil
 }
 
+// Before is the resolver for the before field.
+func (r *auditChangeResolver) Before(ctx context.Context, obj *model.AuditChange) (*string, error) {
+	return optionalString(obj.Before), nil
+}
+
+// After is the resolver for the after field.
+func (r *auditChangeResolver) After(ctx context.Context, obj *model.AuditChange) (*string, error) {
+	return optionalString(obj.After), nil
+}
+
+// ID is the resolver for the id field.
+func (r *auditEntryResolver) ID(ctx context.Context, obj *model.AuditEntry) (string, error) {
+	return string(obj.ID), nil
+}
+
+// Action is the resolver for the action field.
+func (r *auditEntryResolver) Action(ctx context.Context, obj *model.AuditEntry) (string, error) {
+	return obj.Action.String(), nil
+}
+
+// IncidentID is the resolver for the incidentId field.
+func (r *auditEntryResolver) IncidentID(ctx context.Context, obj *model.AuditEntry) (*string, error) {
+	if obj.IncidentID == 0 {
+		return nil, nil
+	}
+	id := obj.IncidentID.String()
+	return &id, nil
+}
+
+// Changes is the resolver for the changes field.
+func (r *auditEntryResolver) Changes(ctx context.Context, obj *model.AuditEntry) ([]*model.AuditChange, error) {
+	changes := make([]*model.AuditChange, len(obj.Changes))
+	for i := range obj.Changes {
+		changes[i] = &obj.Changes[i]
+	}
+	return changes, nil
+}
+
+// RequestID is the resolver for the requestId field.
+func (r *auditEntryResolver) RequestID(ctx context.Context, obj *model.AuditEntry) (*string, error) {
+	return optionalString(obj.RequestID), nil
+}
+
 // ID is the resolver for the id field.
 func (r *incidentResolver) ID(ctx context.Context, obj *model.Incident) (string, error) {
 	return fmt.Sprintf("%d", obj.ID), nil
@@ -221,99 +336,311 @@ func (r *incidentResolver) ViewerCanAccess(ctx context.Context, obj *model.Incid
 		return true, nil
 	}
 
-	// Get the viewer's Slack user ID from context
-	slackUserID, hasAuth := getSlackUserIDFromContext(ctx)
-	if !hasAuth {
-		// No auth context means backward compatibility mode - allow access
-		return true, nil
+	// Members of the incident channel and users with an access grant can access it.
+	// Callers without a Slack user, such as service tokens, cannot.
+	slackUserID, _ := getSlackUserIDFromContext(ctx)
+	return r.incidentUC.CanUserAccessIncident(ctx, obj, slackUserID), nil
+}
+
+// AccessGrants is the resolver for the accessGrants field.
+func (r *incidentResolver) AccessGrants(ctx context.Context, obj *model.Incident) ([]*model.AccessGrant, error) {
+	grants := obj.ActiveAccessGrants(time.Now())
+	result := make([]*model.AccessGrant, len(grants))
+	for i := range grants {
+		result[i] = &grants[i]
 	}
+	return result, nil
+}
 
-	// Check if the viewer is a member of the incident
-	for _, memberID := range obj.JoinedMemberIDs {
-		if memberID == slackUserID {
-			return true, nil
+// Durations is the resolver for the durations field.
+func (r *incidentResolver) Durations(ctx context.Context, obj *model.Incident) (*model.IncidentDurations, error) {
+	histories, err := r.repo.GetStatusHistories(ctx, obj.ID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get status histories")
+	}
+	return model.NewIncidentDurations(obj, histories, time.Now()), nil
+}
+
+// StakeholderUpdates is the resolver for the stakeholderUpdates field.
+func (r *incidentResolver) StakeholderUpdates(ctx context.Context, obj *model.Incident) ([]*model.StakeholderUpdate, error) {
+	// Updates of private incidents are only shown to viewers who can access them
+	canAccess, err := r.ViewerCanAccess(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	if !canAccess {
+		return []*model.StakeholderUpdate{}, nil
+	}
+	return r.updateUC.ListStakeholderUpdates(ctx, obj.ID)
+}
+
+// ClosedAt is the resolver for the closedAt field.
+func (r *incidentResolver) ClosedAt(ctx context.Context, obj *model.Incident) (*time.Time, error) {
+	if obj.ClosedAt.IsZero() {
+		return nil, nil
+	}
+	return &obj.ClosedAt, nil
+}
+
+// FirstClosedAt is the resolver for the firstClosedAt field.
+func (r *incidentResolver) FirstClosedAt(ctx context.Context, obj *model.Incident) (*time.Time, error) {
+	if obj.FirstClosedAt.IsZero() {
+		return nil, nil
+	}
+	return &obj.FirstClosedAt, nil
+}
+
+// Transcript is the resolver for the transcript field.
+func (r *incidentResolver) Transcript(ctx context.Context, obj *model.Incident) (*model.Transcript, error) {
+	canAccess, err := r.ViewerCanAccess(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	if !canAccess || obj.TranscriptExportedAt.IsZero() {
+		return nil, nil
+	}
+
+	transcript, err := r.repo.GetTranscript(ctx, obj.ID)
+	if err != nil {
+		if errors.Is(err, model.ErrTranscriptNotFound) {
+			return nil, nil
 		}
+		return nil, err
 	}
+	return transcript, nil
+}
 
-	// Viewer is not a member of this private incident
-	return false, nil
+// Parent is the resolver for the parent field.
+func (r *incidentResolver) Parent(ctx context.Context, obj *model.Incident) (*model.Incident, error) {
+	return r.relatedIncident(ctx, obj.ParentID)
 }
 
-// UpdateIncident is the resolver for the updateIncident field.
-func (r *mutationResolver) UpdateIncident(ctx context.Context, id string, input graphql1.UpdateIncidentInput) (*model.Incident, error) {
-	// Parse incident ID
+// Children is the resolver for the children field.
+func (r *incidentResolver) Children(ctx context.Context, obj *model.Incident) ([]*model.Incident, error) {
+	children, err := r.relationUC.ListChildIncidents(ctx, obj.ID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list child incidents", goerr.V("incidentID", obj.ID))
+	}
+	return r.incidentsForViewer(ctx, children), nil
+}
+
+// DuplicateOf is the resolver for the duplicateOf field.
+func (r *incidentResolver) DuplicateOf(ctx context.Context, obj *model.Incident) (*model.Incident, error) {
+	return r.relatedIncident(ctx, obj.DuplicateOf)
+}
+
+// Duplicates is the resolver for the duplicates field.
+func (r *incidentResolver) Duplicates(ctx context.Context, obj *model.Incident) ([]*model.Incident, error) {
+	duplicates, err := r.relationUC.ListDuplicateIncidents(ctx, obj.ID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list duplicate incidents", goerr.V("incidentID", obj.ID))
+	}
+	return r.incidentsForViewer(ctx, duplicates), nil
+}
+
+// CreateIncident is the resolver for the createIncident field.
+func (r *mutationResolver) CreateIncident(ctx context.Context, input graphql1.CreateIncidentInput) (*model.Incident, error) {
+	// The creator is invited to the incident channel, so a Slack user is required
+	userID, ok := getSlackUserIDFromContext(ctx)
+	if !ok {
+		return nil, goerr.Wrap(model.ErrPermissionDenied, "a Slack user is required to declare incidents")
+	}
+	if err := r.authzUC.AuthorizeIncidentCreation(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	req := &model.CreateIncidentRequest{
+		Title:           input.Title,
+		CategoryID:      input.CategoryID,
+		OriginChannelID: input.OriginChannelID,
+		CreatedBy:       userID.String(),
+	}
+	if input.Description != nil {
+		req.Description = *input.Description
+	}
+	if input.SeverityID != nil {
+		req.SeverityID = *input.SeverityID
+	}
+	for _, id := range input.AssetIds {
+		req.AssetIDs = append(req.AssetIDs, types.AssetID(id))
+	}
+	if input.Private != nil {
+		req.Private = *input.Private
+	}
+	if input.IsTest != nil {
+		req.IsTest = *input.IsTest
+	}
+
+	incident, err := r.incidentUC.DeclareIncident(ctx, req)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to declare incident")
+	}
+	return incident, nil
+}
+
+// CloseIncident is the resolver for the closeIncident field.
+func (r *mutationResolver) CloseIncident(ctx context.Context, id string, resolution string) (*model.Incident, error) {
 	incidentIDInt, err := strconv.Atoi(id)
 	if err != nil {
 		return nil, goerr.Wrap(err, "invalid incident ID")
 	}
 	incidentID := types.IncidentID(incidentIDInt)
 
-	// Check if repository is Firestore for atomic update
-	if firestoreRepo, ok := r.repo.(*repository.Firestore); ok {
-		// Use atomic transaction for Firestore
-		var updatedIncident *model.Incident
-		err := firestoreRepo.UpdateIncidentAtomic(ctx, incidentID, func(incident *model.Incident) error {
-			// Update fields if provided
-			if input.Title != nil {
-				incident.Title = *input.Title
-			}
-			if input.Description != nil {
-				incident.Description = *input.Description
-			}
-			if input.Lead != nil {
-				incident.Lead = types.SlackUserID(*input.Lead)
-			}
-			if input.SeverityID != nil {
-				incident.SeverityID = types.SeverityID(*input.SeverityID)
-			}
-			if input.AssetIds != nil {
-				assetIDs := make([]types.AssetID, len(input.AssetIds))
-				for i, id := range input.AssetIds {
-					assetIDs[i] = types.AssetID(id)
-				}
-				incident.AssetIDs = assetIDs
-			}
-			updatedIncident = incident
-			return nil
-		})
-		if err != nil {
-			return nil, goerr.Wrap(err, "failed to update incident", goerr.V("incidentID", incidentID))
+	if err := r.authorizeIncidentUpdate(ctx, incidentID); err != nil {
+		return nil, err
+	}
+
+	incident, err := r.statusUC.CloseIncident(ctx, incidentID, r.actorSlackUserID(ctx), resolution)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to close incident")
+	}
+	return incident, nil
+}
+
+// ReopenIncident is the resolver for the reopenIncident field.
+func (r *mutationResolver) ReopenIncident(ctx context.Context, id string, reason string) (*model.Incident, error) {
+	incidentIDInt, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, goerr.Wrap(err, "invalid incident ID")
+	}
+	incidentID := types.IncidentID(incidentIDInt)
+
+	if err := r.authorizeIncidentUpdate(ctx, incidentID); err != nil {
+		return nil, err
+	}
+
+	incident, err := r.statusUC.ReopenIncident(ctx, incidentID, r.actorSlackUserID(ctx), reason)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to reopen incident")
+	}
+	return incident, nil
+}
+
+// LinkParentIncident is the resolver for the linkParentIncident field.
+func (r *mutationResolver) LinkParentIncident(ctx context.Context, incidentID string, parentID string) (*model.Incident, error) {
+	childID, err := parseIncidentID(incidentID)
+	if err != nil {
+		return nil, err
+	}
+	parent, err := parseIncidentID(parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Linking changes both incidents
+	for _, id := range []types.IncidentID{childID, parent} {
+		if err := r.authorizeIncidentUpdate(ctx, id); err != nil {
+			return nil, err
 		}
-		return updatedIncident, nil
 	}
 
-	// Fallback to non-atomic update for other repositories (e.g., Memory for testing)
-	incident, err := r.repo.GetIncident(ctx, incidentID)
+	incident, err := r.relationUC.LinkParent(ctx, childID, parent, r.actorSlackUserID(ctx))
 	if err != nil {
-		return nil, goerr.Wrap(err, "failed to get incident", goerr.V("incidentID", incidentID))
+		return nil, goerr.Wrap(err, "failed to link parent incident")
 	}
+	return incident, nil
+}
 
-	// Update fields if provided
-	if input.Title != nil {
-		incident.Title = *input.Title
+// UnlinkParentIncident is the resolver for the unlinkParentIncident field.
+func (r *mutationResolver) UnlinkParentIncident(ctx context.Context, incidentID string) (*model.Incident, error) {
+	childID, err := parseIncidentID(incidentID)
+	if err != nil {
+		return nil, err
 	}
-	if input.Description != nil {
-		incident.Description = *input.Description
+	if err := r.authorizeIncidentUpdate(ctx, childID); err != nil {
+		return nil, err
+	}
+
+	incident, err := r.relationUC.UnlinkParent(ctx, childID, r.actorSlackUserID(ctx))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to unlink parent incident")
+	}
+	return incident, nil
+}
+
+// MergeIncident is the resolver for the mergeIncident field.
+func (r *mutationResolver) MergeIncident(ctx context.Context, duplicateID string, primaryID string) (*model.Incident, error) {
+	duplicate, err := parseIncidentID(duplicateID)
+	if err != nil {
+		return nil, err
+	}
+	primary, err := parseIncidentID(primaryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Merging moves tasks out of the duplicate into the primary incident
+	for _, id := range []types.IncidentID{duplicate, primary} {
+		if err := r.authorizeIncidentUpdate(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	incident, err := r.relationUC.MergeIncident(ctx, duplicate, primary, r.actorSlackUserID(ctx))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to merge incident")
+	}
+	return incident, nil
+}
+
+// PostStakeholderUpdate is the resolver for the postStakeholderUpdate field.
+func (r *mutationResolver) PostStakeholderUpdate(ctx context.Context, incidentID string, text string) (*model.StakeholderUpdate, error) {
+	incidentIDInt, err := strconv.Atoi(incidentID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "invalid incident ID")
+	}
+	id := types.IncidentID(incidentIDInt)
+
+	if err := r.authorizeIncidentUpdate(ctx, id); err != nil {
+		return nil, err
+	}
+
+	update, err := r.updateUC.PostStakeholderUpdate(ctx, id, text, r.actorSlackUserID(ctx))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to post stakeholder update")
+	}
+	return update, nil
+}
+
+// UpdateIncident is the resolver for the updateIncident field.
+func (r *mutationResolver) UpdateIncident(ctx context.Context, id string, input graphql1.UpdateIncidentInput) (*model.Incident, error) {
+	// Parse incident ID
+	incidentIDInt, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, goerr.Wrap(err, "invalid incident ID")
+	}
+	incidentID := types.IncidentID(incidentIDInt)
+
+	if err := r.authorizeIncidentUpdate(ctx, incidentID); err != nil {
+		return nil, err
+	}
+
+	req := model.UpdateIncidentRequest{
+		Title:       input.Title,
+		Description: input.Description,
 	}
 	if input.Lead != nil {
-		incident.Lead = types.SlackUserID(*input.Lead)
+		lead := types.SlackUserID(*input.Lead)
+		req.Lead = &lead
 	}
 	if input.SeverityID != nil {
-		incident.SeverityID = types.SeverityID(*input.SeverityID)
+		severityID := types.SeverityID(*input.SeverityID)
+		req.SeverityID = &severityID
 	}
 	if input.AssetIds != nil {
 		assetIDs := make([]types.AssetID, len(input.AssetIds))
 		for i, id := range input.AssetIds {
 			assetIDs[i] = types.AssetID(id)
 		}
-		incident.AssetIDs = assetIDs
+		req.AssetIDs = &assetIDs
 	}
 
-	// Save updated incident
-	if err := r.repo.PutIncident(ctx, incident); err != nil {
+	// Service tokens have no Slack user; the audit log takes the actor from the request
+	updatedBy, _ := getSlackUserIDFromContext(ctx)
+	incident, err := r.incidentUC.UpdateIncident(ctx, incidentID, req, updatedBy)
+	if err != nil {
 		return nil, goerr.Wrap(err, "failed to update incident", goerr.V("incidentID", incidentID))
 	}
-
 	return incident, nil
 }
 
@@ -326,21 +653,11 @@ func (r *mutationResolver) UpdateIncidentStatus(ctx context.Context, incidentID
 	}
 	id := types.IncidentID(incidentIDInt)
 
-	// Get the authenticated user from context
-	var userID types.SlackUserID
-	if authCtx, ok := model.GetAuthContext(ctx); ok && authCtx != nil {
-		if authCtx.SessionID != "" {
-			user, err := r.authUC.GetUserFromSession(ctx, authCtx.SessionID)
-			if err == nil && user != nil {
-				userID = types.SlackUserID(user.ID)
-			}
-		}
+	if err := r.authorizeIncidentUpdate(ctx, id); err != nil {
+		return nil, err
 	}
 
-	// Fall back to "system" if no user found
-	if userID == "" {
-		userID = types.SlackUserID("system")
-	}
+	userID := r.actorSlackUserID(ctx)
 
 	// Prepare note string
 	noteStr := ""
@@ -366,28 +683,12 @@ func (r *mutationResolver) CreateTask(ctx context.Context, input graphql1.Create
 	}
 	incidentID := types.IncidentID(incidentIDInt)
 
-	// Validate incident exists
-	_, err = r.repo.GetIncident(ctx, incidentID)
-	if err != nil {
-		return nil, goerr.Wrap(err, "incident not found", goerr.V("incidentID", incidentID))
-	}
-
-	// Get the authenticated user from context
-	var slackUserID types.SlackUserID
-	if authCtx, ok := model.GetAuthContext(ctx); ok && authCtx != nil {
-		// Get user from session
-		if authCtx.SessionID != "" {
-			user, err := r.authUC.GetUserFromSession(ctx, authCtx.SessionID)
-			if err == nil && user != nil {
-				slackUserID = types.SlackUserID(user.ID)
-			}
-		}
+	// Validate incident exists and the user may modify it
+	if err := r.authorizeIncidentUpdate(ctx, incidentID); err != nil {
+		return nil, err
 	}
 
-	// Fall back to "system" if no user found
-	if slackUserID == "" {
-		slackUserID = types.SlackUserID("system")
-	}
+	slackUserID := r.actorSlackUserID(ctx)
 
 	// Create task using TaskUC
 	task, err := r.taskUC.CreateTask(ctx, incidentID, input.Title, slackUserID, types.ChannelID(""), "")
@@ -395,6 +696,8 @@ func (r *mutationResolver) CreateTask(ctx context.Context, input graphql1.Create
 		return nil, goerr.Wrap(err, "failed to create task")
 	}
 
+	created := *task
+
 	// Set description if provided
 	if input.Description != nil {
 		task.UpdateDescription(*input.Description)
@@ -410,6 +713,9 @@ func (r *mutationResolver) CreateTask(ctx context.Context, input graphql1.Create
 		if err := r.repo.UpdateTask(ctx, task); err != nil {
 			return nil, goerr.Wrap(err, "failed to update task")
 		}
+		r.audit.Record(ctx, types.AuditActionTaskUpdate, audit.TaskTarget(task), slackUserID, &created, task)
+		// The creation is already on the timeline, so only the task itself is republished
+		r.events.Publish(ctx, pubsub.Event{Kind: pubsub.KindTaskUpdated, IncidentID: task.IncidentID, Task: task})
 	}
 
 	return task, nil
@@ -427,11 +733,18 @@ func (r *mutationResolver) UpdateTask(ctx context.Context, id string, input grap
 	}
 	incidentID := task.IncidentID
 
+	if err := r.authorizeIncidentUpdate(ctx, incidentID); err != nil {
+		return nil, err
+	}
+
 	// Check if repository is Firestore for atomic update
 	if firestoreRepo, ok := r.repo.(*repository.Firestore); ok {
 		// Use atomic transaction for Firestore
-		var updatedTask *model.Task
+		var before, updatedTask *model.Task
 		err := firestoreRepo.UpdateTaskAtomic(ctx, incidentID, taskID, func(task *model.Task) error {
+			snapshot := *task
+			before = &snapshot
+
 			// Update fields if provided
 			if input.Title != nil {
 				if err := task.UpdateTitle(*input.Title); err != nil {
@@ -460,10 +773,15 @@ func (r *mutationResolver) UpdateTask(ctx context.Context, id string, input grap
 		if err != nil {
 			return nil, goerr.Wrap(err, "failed to update task", goerr.V("taskID", taskID))
 		}
+		actor := r.actorSlackUserID(ctx)
+		r.audit.Record(ctx, types.AuditActionTaskUpdate, audit.TaskTarget(updatedTask), actor, before, updatedTask)
+		r.events.PublishTask(ctx, types.TimelineEventTaskUpdated, updatedTask, actor.String())
 		return updatedTask, nil
 	}
 
 	// Fallback to non-atomic update for other repositories (e.g., Memory for testing)
+	before := *task
+
 	// Update fields if provided
 	if input.Title != nil {
 		if err := task.UpdateTitle(*input.Title); err != nil {
@@ -491,6 +809,9 @@ func (r *mutationResolver) UpdateTask(ctx context.Context, id string, input grap
 	if err := r.repo.UpdateTask(ctx, task); err != nil {
 		return nil, goerr.Wrap(err, "failed to save updated task", goerr.V("taskID", taskID))
 	}
+	actor := r.actorSlackUserID(ctx)
+	r.audit.Record(ctx, types.AuditActionTaskUpdate, audit.TaskTarget(task), actor, &before, task)
+	r.events.PublishTask(ctx, types.TimelineEventTaskUpdated, task, actor.String())
 
 	return task, nil
 }
@@ -506,16 +827,215 @@ func (r *mutationResolver) DeleteTask(ctx context.Context, id string) (bool, err
 		return false, goerr.Wrap(err, "failed to get task", goerr.V("taskID", taskID))
 	}
 
+	if err := r.authorizeIncidentUpdate(ctx, task.IncidentID); err != nil {
+		return false, err
+	}
+
 	// Delete task using both IncidentID and TaskID for efficient deletion
 	if err := r.repo.DeleteTask(ctx, task.IncidentID, taskID); err != nil {
 		return false, goerr.Wrap(err, "failed to delete task", goerr.V("taskID", taskID))
 	}
+	actor := r.actorSlackUserID(ctx)
+	r.audit.Record(ctx, types.AuditActionTaskDelete, audit.TaskTarget(task), actor, task, nil)
+	r.events.PublishTask(ctx, types.TimelineEventTaskDeleted, task, actor.String())
 
 	return true, nil
 }
 
+// CreateAPIToken is the resolver for the createAPIToken field.
+func (r *mutationResolver) CreateAPIToken(ctx context.Context, input graphql1.CreateAPITokenInput) (*graphql1.CreatedAPIToken, error) {
+	userID, err := r.requireBrowserSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := types.APITokenKindPersonal
+	if input.Kind != nil {
+		kind = *input.Kind
+	}
+
+	req := interfaces.CreateAPITokenRequest{
+		Name:      input.Name,
+		Kind:      kind,
+		Scopes:    input.Scopes,
+		CreatedBy: userID,
+	}
+
+	switch kind {
+	case types.APITokenKindPersonal:
+		// Personal tokens always act as the caller
+		req.OwnerID = userID
+	case types.APITokenKindService:
+		if err := r.requireAdmin(ctx, userID); err != nil {
+			return nil, goerr.Wrap(err, "only admins can create service tokens")
+		}
+	}
+
+	expiresInDays := defaultAPITokenExpiresInDays
+	if input.ExpiresInDays != nil {
+		expiresInDays = *input.ExpiresInDays
+	}
+	if expiresInDays < 1 || expiresInDays > maxAPITokenExpiresInDays {
+		return nil, goerr.New("expiresInDays must be between 1 and 365", goerr.V("expiresInDays", expiresInDays))
+	}
+	req.TTL = time.Duration(expiresInDays) * 24 * time.Hour
+
+	token, plain, err := r.authUC.CreateAPIToken(ctx, req)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to create API token")
+	}
+
+	return &graphql1.CreatedAPIToken{
+		APIToken: token,
+		Token:    plain,
+	}, nil
+}
+
+// RevokeAPIToken is the resolver for the revokeAPIToken field.
+func (r *mutationResolver) RevokeAPIToken(ctx context.Context, id string) (*model.APIToken, error) {
+	userID, err := r.requireBrowserSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID := types.APITokenID(id)
+	token, err := r.authUC.GetAPIToken(ctx, tokenID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get API token", goerr.V("tokenID", tokenID))
+	}
+
+	// Users may revoke their own tokens; anything else needs admin
+	if token.OwnerID != userID {
+		if err := r.requireAdmin(ctx, userID); err != nil {
+			return nil, goerr.Wrap(err, "only admins can revoke other users' tokens", goerr.V("tokenID", tokenID))
+		}
+	}
+
+	revoked, err := r.authUC.RevokeAPIToken(ctx, tokenID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to revoke API token", goerr.V("tokenID", tokenID))
+	}
+	return revoked, nil
+}
+
+// RevokeSession is the resolver for the revokeSession field.
+func (r *mutationResolver) RevokeSession(ctx context.Context, id string) (bool, error) {
+	userID, err := r.requireBrowserSession(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.authUC.RevokeSession(ctx, types.UserID(userID), types.SessionID(id)); err != nil {
+		return false, goerr.Wrap(err, "failed to revoke session", goerr.V("sessionID", id))
+	}
+	return true, nil
+}
+
+// RevokeAllSessions is the resolver for the revokeAllSessions field.
+func (r *mutationResolver) RevokeAllSessions(ctx context.Context, userID string) (int, error) {
+	callerID, err := r.requireBrowserSession(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	targetID := types.SlackUserID(userID)
+	if targetID != callerID {
+		if err := r.requireAdmin(ctx, callerID); err != nil {
+			return 0, goerr.Wrap(err, "only admins can revoke other users' sessions", goerr.V("userID", targetID))
+		}
+	}
+
+	revoked, err := r.authUC.RevokeAllSessions(ctx, types.UserID(targetID))
+	if err != nil {
+		return 0, goerr.Wrap(err, "failed to revoke sessions", goerr.V("userID", targetID))
+	}
+	return revoked, nil
+}
+
+// GrantIncidentAccess is the resolver for the grantIncidentAccess field.
+func (r *mutationResolver) GrantIncidentAccess(ctx context.Context, incidentID string, input graphql1.GrantIncidentAccessInput) (*model.AccessGrant, error) {
+	incidentIDInt, err := strconv.Atoi(incidentID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "invalid incident ID")
+	}
+	id := types.IncidentID(incidentIDInt)
+
+	if err := r.authorizeIncidentUpdate(ctx, id); err != nil {
+		return nil, err
+	}
+
+	req := interfaces.GrantIncidentAccessRequest{}
+	if input.UserID != nil {
+		req.UserID = types.SlackUserID(*input.UserID)
+	}
+	if input.GroupID != nil {
+		req.GroupID = *input.GroupID
+	}
+	if input.Reason != nil {
+		req.Reason = *input.Reason
+	}
+	if input.ExpiresInHours != nil {
+		hours := *input.ExpiresInHours
+		if hours < 1 || hours > maxAccessGrantExpiresInHours {
+			return nil, goerr.New("expiresInHours must be between 1 and 8760", goerr.V("expiresInHours", hours))
+		}
+		req.TTL = time.Duration(hours) * time.Hour
+	}
+	if slackUserID, ok := getSlackUserIDFromContext(ctx); ok {
+		req.GrantedBy = slackUserID
+	}
+
+	grant, err := r.incidentUC.GrantIncidentAccess(ctx, id, req)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to grant incident access", goerr.V("incidentID", id))
+	}
+	return grant, nil
+}
+
+// RevokeIncidentAccess is the resolver for the revokeIncidentAccess field.
+func (r *mutationResolver) RevokeIncidentAccess(ctx context.Context, incidentID string, grantID string) (bool, error) {
+	incidentIDInt, err := strconv.Atoi(incidentID)
+	if err != nil {
+		return false, goerr.Wrap(err, "invalid incident ID")
+	}
+	id := types.IncidentID(incidentIDInt)
+
+	if err := r.authorizeIncidentUpdate(ctx, id); err != nil {
+		return false, err
+	}
+
+	if err := r.incidentUC.RevokeIncidentAccess(ctx, id, types.AccessGrantID(grantID)); err != nil {
+		return false, goerr.Wrap(err, "failed to revoke incident access", goerr.V("incidentID", id))
+	}
+	return true, nil
+}
+
+// RequestIncidentAccess is the resolver for the requestIncidentAccess field.
+func (r *mutationResolver) RequestIncidentAccess(ctx context.Context, incidentID string, reason *string) (bool, error) {
+	incidentIDInt, err := strconv.Atoi(incidentID)
+	if err != nil {
+		return false, goerr.Wrap(err, "invalid incident ID")
+	}
+
+	// Only Slack users can be granted access, so service tokens cannot request it
+	slackUserID, ok := getSlackUserIDFromContext(ctx)
+	if !ok {
+		return false, goerr.Wrap(model.ErrPermissionDenied, "a Slack user is required to request access")
+	}
+
+	reasonStr := ""
+	if reason != nil {
+		reasonStr = *reason
+	}
+
+	if err := r.incidentUC.RequestIncidentAccess(ctx, types.IncidentID(incidentIDInt), slackUserID, reasonStr); err != nil {
+		return false, goerr.Wrap(err, "failed to request incident access", goerr.V("incidentID", incidentIDInt))
+	}
+	return true, nil
+}
+
 // Incidents is the resolver for the incidents field.
-func (r *queryResolver) Incidents(ctx context.Context, first *int, after *string) (*graphql1.IncidentConnection, error) {
+func (r *queryResolver) Incidents(ctx context.Context, first *int, after *string, filter *graphql1.IncidentFilterInput, sort *graphql1.IncidentSortInput) (*graphql1.IncidentConnection, error) {
 	// Build pagination options
 	opts := types.PaginationOptions{
 		Limit: 20, // Default limit
@@ -540,17 +1060,39 @@ func (r *queryResolver) Incidents(ctx context.Context, first *int, after *string
 		opts.After = &id
 	}
 
+	incidentSort, err := toIncidentSort(sort)
+	if err != nil {
+		return nil, err
+	}
+	opts.Sort = incidentSort
+
+	var severities []model.Severity
+	if r.modelConfig != nil {
+		severities = r.modelConfig.Severities
+	}
+	incidentFilter, matchable := toIncidentFilter(filter, severities)
+	if !matchable {
+		return &graphql1.IncidentConnection{
+			Edges:    []*graphql1.IncidentEdge{},
+			PageInfo: &graphql1.PageInfo{HasPreviousPage: opts.After != nil},
+		}, nil
+	}
+
+	slackUserID, _ := getSlackUserIDFromContext(ctx)
+	if filtersRedactedFields(incidentFilter) {
+		incidentFilter.Include = func(incident *model.Incident) bool {
+			return r.incidentUC.CanUserAccessIncident(ctx, incident, slackUserID)
+		}
+	}
+
 	// Get paginated incidents from repository
-	incidents, pageResult, err := r.repo.ListIncidentsPaginated(ctx, opts)
+	incidents, pageResult, err := r.repo.ListIncidentsPaginated(ctx, incidentFilter, opts)
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to list incidents")
 	}
 
 	// Apply filtering based on user access
-	slackUserID, ok := getSlackUserIDFromContext(ctx)
-	if ok {
-		incidents = filterIncidentsForUser(ctx, incidents, r.incidentUC, slackUserID)
-	}
+	incidents = filterIncidentsForUser(ctx, incidents, r.incidentUC, slackUserID)
 
 	// Create edges
 	edges := make([]*graphql1.IncidentEdge, len(incidents))
@@ -594,13 +1136,9 @@ func (r *queryResolver) Incident(ctx context.Context, id string) (*model.Inciden
 		return nil, err
 	}
 
-	// Apply filtering based on user access
-	slackUserID, ok := getSlackUserIDFromContext(ctx)
-	if !ok {
-		// If no user context, return as-is (for backward compatibility)
-		return incident, nil
-	}
-	return filterIncidentForUser(ctx, incident, r.incidentUC, slackUserID), nil
+	// Apply filtering based on user access; callers without a Slack user see private incidents redacted
+	slackUserID, _ := getSlackUserIDFromContext(ctx)
+	return r.incidentUC.ViewIncident(ctx, incident, slackUserID), nil
 }
 
 // IncidentStatusHistory is the resolver for the incidentStatusHistory field.
@@ -640,13 +1178,10 @@ func (r *queryResolver) Tasks(ctx context.Context, incidentID string) ([]*model.
 		return nil, goerr.Wrap(err, "failed to get incident")
 	}
 
-	// Check if user has access to this incident
-	slackUserID, hasAuth := getSlackUserIDFromContext(ctx)
-	if hasAuth && incident.Private {
-		// Use CanUserAccessIncident to check access
-		if !r.incidentUC.CanUserAccessIncident(ctx, incident, slackUserID) {
-			return []*model.Task{}, nil // Return empty list for non-members
-		}
+	// Check if user has access to this incident; callers without a Slack user have none
+	slackUserID, _ := getSlackUserIDFromContext(ctx)
+	if !r.incidentUC.CanUserAccessIncident(ctx, incident, slackUserID) {
+		return []*model.Task{}, nil // Return empty list for non-members
 	}
 
 	return r.repo.ListTasksByIncident(ctx, types.IncidentID(id))
@@ -665,13 +1200,10 @@ func (r *queryResolver) Task(ctx context.Context, id string) (*model.Task, error
 		return nil, goerr.Wrap(err, "failed to get incident for task")
 	}
 
-	// Check if user has access to this incident
-	slackUserID, hasAuth := getSlackUserIDFromContext(ctx)
-	if hasAuth && incident.Private {
-		// Use CanUserAccessIncident to check access
-		if !r.incidentUC.CanUserAccessIncident(ctx, incident, slackUserID) {
-			return nil, goerr.New("access denied: task belongs to private incident")
-		}
+	// Check if user has access to this incident; callers without a Slack user have none
+	slackUserID, _ := getSlackUserIDFromContext(ctx)
+	if !r.incidentUC.CanUserAccessIncident(ctx, incident, slackUserID) {
+		return nil, goerr.New("access denied: task belongs to private incident")
 	}
 
 	return task, nil
@@ -682,6 +1214,19 @@ func (r *queryResolver) ChannelMembers(ctx context.Context, channelID string) ([
 	return r.authUC.GetChannelMembers(ctx, channelID)
 }
 
+// Categories is the resolver for the categories field.
+func (r *queryResolver) Categories(ctx context.Context) ([]*model.Category, error) {
+	if r.modelConfig == nil || len(r.modelConfig.Categories) == 0 {
+		return []*model.Category{}, nil
+	}
+
+	result := make([]*model.Category, len(r.modelConfig.Categories))
+	for i := range r.modelConfig.Categories {
+		result[i] = &r.modelConfig.Categories[i]
+	}
+	return result, nil
+}
+
 // Severities is the resolver for the severities field.
 func (r *queryResolver) Severities(ctx context.Context) ([]*model.Severity, error) {
 	if r.modelConfig.GetSeveritiesConfig() == nil {
@@ -720,13 +1265,10 @@ func (r *queryResolver) RecentOpenIncidents(ctx context.Context, days *int) ([]*
 		return nil, goerr.Wrap(err, "failed to get recent open incidents")
 	}
 
-	// Apply filtering based on user access
-	slackUserID, ok := getSlackUserIDFromContext(ctx)
-	if ok {
-		// Filter incidents in each date group
-		for date, incidents := range incidentsMap {
-			incidentsMap[date] = filterIncidentsForUser(ctx, incidents, r.incidentUC, slackUserID)
-		}
+	// Apply filtering based on user access to the incidents in each date group
+	slackUserID, _ := getSlackUserIDFromContext(ctx)
+	for date, incidents := range incidentsMap {
+		incidentsMap[date] = filterIncidentsForUser(ctx, incidents, r.incidentUC, slackUserID)
 	}
 
 	// Convert map to sorted slice (by date descending)
@@ -748,6 +1290,202 @@ func (r *queryResolver) IncidentTrendBySeverity(ctx context.Context, weeks *int)
 	return trend, nil
 }
 
+// IncidentMetrics is the resolver for the incidentMetrics field.
+func (r *queryResolver) IncidentMetrics(ctx context.Context, from time.Time, to time.Time, groupBy types.IncidentMetricsGroupBy) (*model.IncidentMetrics, error) {
+	metrics, err := r.incidentUC.GetIncidentMetrics(ctx, from, to, groupBy)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get incident metrics")
+	}
+	return metrics, nil
+}
+
+// APITokens is the resolver for the apiTokens field.
+func (r *queryResolver) APITokens(ctx context.Context) ([]*model.APIToken, error) {
+	userID, err := r.requireBrowserSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Admins see every token, others only their own
+	ownerID := userID
+	if r.requireAdmin(ctx, userID) == nil {
+		ownerID = ""
+	}
+
+	tokens, err := r.authUC.ListAPITokens(ctx, ownerID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list API tokens")
+	}
+	return tokens, nil
+}
+
+// Sessions is the resolver for the sessions field.
+func (r *queryResolver) Sessions(ctx context.Context, userID *string) ([]*model.Session, error) {
+	callerID, err := r.requireBrowserSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	targetID := callerID
+	if userID != nil && types.SlackUserID(*userID) != callerID {
+		targetID = types.SlackUserID(*userID)
+		if err := r.requireAdmin(ctx, callerID); err != nil {
+			return nil, goerr.Wrap(err, "only admins can list other users' sessions", goerr.V("userID", targetID))
+		}
+	}
+
+	sessions, err := r.authUC.ListSessions(ctx, types.UserID(targetID))
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list sessions", goerr.V("userID", targetID))
+	}
+	return sessions, nil
+}
+
+// AuditLog is the resolver for the auditLog field.
+func (r *queryResolver) AuditLog(ctx context.Context, filter *graphql1.AuditLogFilter, limit *int) ([]*model.AuditEntry, error) {
+	userID, ok := getSlackUserIDFromContext(ctx)
+	if !ok {
+		return nil, goerr.Wrap(model.ErrPermissionDenied, "authentication required to read the audit log")
+	}
+	if err := r.requireAdmin(ctx, userID); err != nil {
+		return nil, goerr.Wrap(err, "only admins can read the audit log")
+	}
+
+	auditFilter, err := toAuditFilter(filter, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := r.repo.ListAuditEntries(ctx, auditFilter)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list audit entries")
+	}
+	return entries, nil
+}
+
+// SlackChannels is the resolver for the slackChannels field.
+func (r *queryResolver) SlackChannels(ctx context.Context) ([]*graphql1.SlackChannel, error) {
+	if _, ok := getSlackUserIDFromContext(ctx); !ok {
+		return nil, goerr.Wrap(model.ErrPermissionDenied, "a Slack user is required to declare incidents")
+	}
+
+	return r.listSlackChannels(ctx)
+}
+
+// Search is the resolver for the search field.
+func (r *queryResolver) Search(ctx context.Context, query string, filter *graphql1.SearchFilterInput, first *int) (*graphql1.SearchResult, error) {
+	searchFilter, limit, err := toSearchFilter(filter, first)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &graphql1.SearchResult{Hits: []*model.SearchHit{}}
+	if r.search == nil {
+		return result, nil
+	}
+
+	// Hits of private incidents are only returned to users who can access them,
+	// never to callers without a Slack user
+	slackUserID, _ := getSlackUserIDFromContext(ctx)
+	searchFilter.Include = func(ids []types.IncidentID) map[types.IncidentID]bool {
+		incidents, err := r.repo.GetIncidents(ctx, ids)
+		if err != nil {
+			apperr.Handle(ctx, goerr.Wrap(err, "failed to get incidents of search hits", goerr.V("count", len(ids))))
+			return nil
+		}
+		included := make(map[types.IncidentID]bool, len(incidents))
+		for _, incident := range incidents {
+			included[incident.ID] = r.incidentUC.CanUserAccessIncident(ctx, incident, slackUserID)
+		}
+		return included
+	}
+
+	hits, total := r.search.Search(query, searchFilter, limit)
+	for i := range hits {
+		result.Hits = append(result.Hits, &hits[i])
+	}
+	result.TotalCount = total
+	return result, nil
+}
+
+// IncidentID is the resolver for the incidentId field.
+func (r *searchHitResolver) IncidentID(ctx context.Context, obj *model.SearchHit) (string, error) {
+	return obj.IncidentID.String(), nil
+}
+
+// Incident is the resolver for the incident field.
+func (r *searchHitResolver) Incident(ctx context.Context, obj *model.SearchHit) (*model.Incident, error) {
+	incident, err := r.repo.GetIncident(ctx, obj.IncidentID)
+	if err != nil {
+		if errors.Is(err, model.ErrIncidentNotFound) {
+			return nil, nil
+		}
+		return nil, goerr.Wrap(err, "failed to get incident of search hit", goerr.V("incidentID", obj.IncidentID))
+	}
+
+	slackUserID, _ := getSlackUserIDFromContext(ctx)
+	return filterIncidentForUser(ctx, incident, r.incidentUC, slackUserID), nil
+}
+
+// Permalink is the resolver for the permalink field.
+func (r *searchHitResolver) Permalink(ctx context.Context, obj *model.SearchHit) (*string, error) {
+	permalink := obj.Permalink()
+	if permalink == "" {
+		return nil, nil
+	}
+	return &permalink, nil
+}
+
+// ID is the resolver for the id field.
+func (r *sessionResolver) ID(ctx context.Context, obj *model.Session) (string, error) {
+	return obj.ID.String(), nil
+}
+
+// UserID is the resolver for the userId field.
+func (r *sessionResolver) UserID(ctx context.Context, obj *model.Session) (string, error) {
+	return obj.UserID.String(), nil
+}
+
+// Current is the resolver for the current field.
+func (r *sessionResolver) Current(ctx context.Context, obj *model.Session) (bool, error) {
+	authCtx, ok := model.GetAuthContext(ctx)
+	return ok && authCtx.SessionID == obj.ID.String(), nil
+}
+
+// ID is the resolver for the id field.
+func (r *stakeholderUpdateResolver) ID(ctx context.Context, obj *model.StakeholderUpdate) (string, error) {
+	return string(obj.ID), nil
+}
+
+// IncidentID is the resolver for the incidentId field.
+func (r *stakeholderUpdateResolver) IncidentID(ctx context.Context, obj *model.StakeholderUpdate) (string, error) {
+	return fmt.Sprintf("%d", obj.IncidentID), nil
+}
+
+// PostedBy is the resolver for the postedBy field.
+func (r *stakeholderUpdateResolver) PostedBy(ctx context.Context, obj *model.StakeholderUpdate) (string, error) {
+	return string(obj.PostedBy), nil
+}
+
+// PostedByUser is the resolver for the postedByUser field.
+func (r *stakeholderUpdateResolver) PostedByUser(ctx context.Context, obj *model.StakeholderUpdate) (*model.User, error) {
+	if r.userUC == nil || obj.PostedBy == "" {
+		return nil, nil
+	}
+
+	user, err := r.userUC.GetOrFetchUser(ctx, obj.PostedBy)
+	if err != nil {
+		// Don't fail the query for a user lookup; fall back to the bare user ID
+		apperr.Handle(ctx, err)
+		return &model.User{
+			ID:   types.UserID(obj.PostedBy),
+			Name: string(obj.PostedBy),
+		}, nil
+	}
+
+	return user, nil
+}
+
 // ID is the resolver for the id field.
 func (r *statusHistoryResolver) ID(ctx context.Context, obj *model.StatusHistory) (string, error) {
 	return string(obj.ID), nil
@@ -780,6 +1518,41 @@ func (r *statusHistoryResolver) ChangedBy(ctx context.Context, obj *model.Status
 	return user, nil
 }
 
+// SourceIncidentID is the resolver for the sourceIncidentId field.
+func (r *statusHistoryResolver) SourceIncidentID(ctx context.Context, obj *model.StatusHistory) (*string, error) {
+	if obj.SourceIncidentID == 0 {
+		return nil, nil
+	}
+	id := obj.SourceIncidentID.String()
+	return &id, nil
+}
+
+// IncidentUpdated is the resolver for the incidentUpdated field.
+func (r *subscriptionResolver) IncidentUpdated(ctx context.Context, incidentID *string) (<-chan *model.Incident, error) {
+	return subscribe(ctx, r.Resolver, pubsub.KindIncidentUpdated, incidentID, r.subscribedIncident)
+}
+
+// TaskUpdated is the resolver for the taskUpdated field.
+func (r *subscriptionResolver) TaskUpdated(ctx context.Context, incidentID *string) (<-chan *model.Task, error) {
+	return subscribe(ctx, r.Resolver, pubsub.KindTaskUpdated, incidentID, func(ctx context.Context, ev pubsub.Event) (*model.Task, bool) {
+		return ev.Task, ev.Task != nil && r.subscriberCanAccess(ctx, ev)
+	})
+}
+
+// StatusChanged is the resolver for the statusChanged field.
+func (r *subscriptionResolver) StatusChanged(ctx context.Context, incidentID *string) (<-chan *model.StatusHistory, error) {
+	return subscribe(ctx, r.Resolver, pubsub.KindStatusChanged, incidentID, func(ctx context.Context, ev pubsub.Event) (*model.StatusHistory, bool) {
+		return ev.Status, ev.Status != nil && r.subscriberCanAccess(ctx, ev)
+	})
+}
+
+// TimelineEventAdded is the resolver for the timelineEventAdded field.
+func (r *subscriptionResolver) TimelineEventAdded(ctx context.Context, incidentID *string) (<-chan *model.TimelineEvent, error) {
+	return subscribe(ctx, r.Resolver, pubsub.KindTimelineEventAdded, incidentID, func(ctx context.Context, ev pubsub.Event) (*model.TimelineEvent, bool) {
+		return ev.Timeline, ev.Timeline != nil && r.subscriberCanAccess(ctx, ev)
+	})
+}
+
 // ID is the resolver for the id field.
 func (r *taskResolver) ID(ctx context.Context, obj *model.Task) (string, error) {
 	return string(obj.ID), nil
@@ -831,6 +1604,30 @@ func (r *taskResolver) ChannelID(ctx context.Context, obj *model.Task) (string,
 	return string(obj.ChannelID), nil
 }
 
+// IncidentID is the resolver for the incidentId field.
+func (r *timelineEventResolver) IncidentID(ctx context.Context, obj *model.TimelineEvent) (string, error) {
+	return obj.IncidentID.String(), nil
+}
+
+// ActorID is the resolver for the actorId field.
+func (r *timelineEventResolver) ActorID(ctx context.Context, obj *model.TimelineEvent) (*string, error) {
+	return optionalString(obj.ActorID), nil
+}
+
+// MessageCount is the resolver for the messageCount field.
+func (r *transcriptResolver) MessageCount(ctx context.Context, obj *model.Transcript) (int, error) {
+	return len(obj.Messages), nil
+}
+
+// Markdown is the resolver for the markdown field.
+func (r *transcriptResolver) Markdown(ctx context.Context, obj *model.Transcript) (string, error) {
+	incident, err := r.repo.GetIncident(ctx, obj.IncidentID)
+	if err != nil {
+		return "", err
+	}
+	return obj.Markdown(incident), nil
+}
+
 // ID is the resolver for the id field.
 func (r *userResolver) ID(ctx context.Context, obj *model.User) (string, error) {
 	return string(obj.ID), nil
@@ -888,9 +1685,21 @@ func (r *weeklySeverityCountResolver) SeverityCounts(ctx context.Context, obj *m
 	return severityCounts, nil
 }
 
+// APIToken returns APITokenResolver implementation.
+func (r *Resolver) APIToken() APITokenResolver { return &aPITokenResolver{r} }
+
+// AccessGrant returns AccessGrantResolver implementation.
+func (r *Resolver) AccessGrant() AccessGrantResolver { return &accessGrantResolver{r} }
+
 // Asset returns AssetResolver implementation.
 func (r *Resolver) Asset() AssetResolver { return &assetResolver{r} }
 
+// AuditChange returns AuditChangeResolver implementation.
+func (r *Resolver) AuditChange() AuditChangeResolver { return &auditChangeResolver{r} }
+
+// AuditEntry returns AuditEntryResolver implementation.
+func (r *Resolver) AuditEntry() AuditEntryResolver { return &auditEntryResolver{r} }
+
 // Incident returns IncidentResolver implementation.
 func (r *Resolver) Incident() IncidentResolver { return &incidentResolver{r} }
 
@@ -900,12 +1709,32 @@ func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// SearchHit returns SearchHitResolver implementation.
+func (r *Resolver) SearchHit() SearchHitResolver { return &searchHitResolver{r} }
+
+// Session returns SessionResolver implementation.
+func (r *Resolver) Session() SessionResolver { return &sessionResolver{r} }
+
+// StakeholderUpdate returns StakeholderUpdateResolver implementation.
+func (r *Resolver) StakeholderUpdate() StakeholderUpdateResolver {
+	return &stakeholderUpdateResolver{r}
+}
+
 // StatusHistory returns StatusHistoryResolver implementation.
 func (r *Resolver) StatusHistory() StatusHistoryResolver { return &statusHistoryResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 // Task returns TaskResolver implementation.
 func (r *Resolver) Task() TaskResolver { return &taskResolver{r} }
 
+// TimelineEvent returns TimelineEventResolver implementation.
+func (r *Resolver) TimelineEvent() TimelineEventResolver { return &timelineEventResolver{r} }
+
+// Transcript returns TranscriptResolver implementation.
+func (r *Resolver) Transcript() TranscriptResolver { return &transcriptResolver{r} }
+
 // User returns UserResolver implementation.
 func (r *Resolver) User() UserResolver { return &userResolver{r} }
 
@@ -914,11 +1743,21 @@ func (r *Resolver) WeeklySeverityCount() WeeklySeverityCountResolver {
 	return &weeklySeverityCountResolver{r}
 }
 
+type aPITokenResolver struct{ *Resolver }
+type accessGrantResolver struct{ *Resolver }
 type assetResolver struct{ *Resolver }
+type auditChangeResolver struct{ *Resolver }
+type auditEntryResolver struct{ *Resolver }
 type incidentResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type searchHitResolver struct{ *Resolver }
+type sessionResolver struct{ *Resolver }
+type stakeholderUpdateResolver struct{ *Resolver }
 type statusHistoryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
 type taskResolver struct{ *Resolver }
+type timelineEventResolver struct{ *Resolver }
+type transcriptResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
 type weeklySeverityCountResolver struct{ *Resolver }
@@ -0,0 +1,39 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// RequireTokenScope rejects operations that the request's API token is not scoped
+// for: mutations need the write scope, everything else needs read. Browser sessions
+// are not scoped and always pass.
+func RequireTokenScope(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	authCtx, ok := model.GetAuthContext(ctx)
+	if !ok || !authCtx.IsAPIToken() {
+		return next(ctx)
+	}
+
+	scope := types.APITokenScopeRead
+	if op := graphql.GetOperationContext(ctx).Operation; op != nil && op.Operation == ast.Mutation {
+		scope = types.APITokenScopeWrite
+	}
+
+	if !authCtx.HasScope(scope) {
+		return graphql.OneShot(&graphql.Response{
+			Errors: gqlerror.List{{
+				Message: "API token requires " + scope.String() + " scope",
+				Extensions: map[string]any{
+					"code": "FORBIDDEN",
+				},
+			}},
+		})
+	}
+
+	return next(ctx)
+}
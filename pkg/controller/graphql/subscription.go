@@ -0,0 +1,90 @@
+package graphql
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/service/pubsub"
+	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
+)
+
+// subscribe relays events of kind to the subscriber until ctx is done. incidentID
+// optionally narrows the events down to one incident. payload converts an event
+// into what is sent and decides whether the subscriber may see it.
+func subscribe[T any](ctx context.Context, r *Resolver, kind pubsub.Kind, incidentID *string, payload func(ctx context.Context, ev pubsub.Event) (T, bool)) (<-chan T, error) {
+	var filterID types.IncidentID
+	if incidentID != nil && *incidentID != "" {
+		id, err := strconv.ParseInt(*incidentID, 10, 64)
+		if err != nil {
+			return nil, goerr.Wrap(err, "invalid incident ID", goerr.V("incidentID", *incidentID))
+		}
+		filterID = types.IncidentID(id)
+	}
+
+	out := make(chan T, 1)
+	if r.events == nil {
+		go func() {
+			<-ctx.Done()
+			close(out)
+		}()
+		return out, nil
+	}
+
+	events := r.events.Subscribe(ctx, func(ev pubsub.Event) bool {
+		return ev.Kind == kind && (filterID == 0 || ev.IncidentID == filterID)
+	})
+
+	go func() {
+		defer close(out)
+		for ev := range events {
+			v, ok := payload(ctx, ev)
+			if !ok {
+				continue
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// subscriberCanAccess checks if the subscriber may see full details of the incident
+// the event belongs to. As with queries, callers without a Slack user (service
+// tokens) cannot see private incidents.
+func (r *Resolver) subscriberCanAccess(ctx context.Context, ev pubsub.Event) bool {
+	slackUserID, _ := getSlackUserIDFromContext(ctx)
+	if ev.Incident != nil && !ev.Incident.Private {
+		return true
+	}
+
+	incident := ev.Incident
+	if incident == nil {
+		var err error
+		incident, err = r.repo.GetIncident(ctx, ev.IncidentID)
+		if err != nil {
+			apperr.Handle(ctx, goerr.Wrap(err, "failed to get incident of subscription event",
+				goerr.V("incidentID", ev.IncidentID), goerr.V("kind", ev.Kind)))
+			return false
+		}
+	}
+
+	return r.incidentUC.CanUserAccessIncident(ctx, incident, slackUserID)
+}
+
+// subscribedIncident returns the incident of ev, redacted for subscribers without access
+func (r *Resolver) subscribedIncident(ctx context.Context, ev pubsub.Event) (*model.Incident, bool) {
+	if ev.Incident == nil {
+		return nil, false
+	}
+	if !r.subscriberCanAccess(ctx, ev) {
+		return ev.Incident.Redacted(), true
+	}
+	return ev.Incident, true
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
+	"net"
 	"net/http"
 	"net/url"
 
@@ -12,20 +13,25 @@ import (
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/lycaon/pkg/cli/config"
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
 )
 
 // AuthHandler handles authentication endpoints
 type AuthHandler struct {
 	slackConfig *config.SlackConfig
 	authUC      interfaces.Auth
+	authzUC     interfaces.Authorization
 	frontendURL string
 }
 
 // NewAuthHandler creates a new auth handler
-func NewAuthHandler(ctx context.Context, slackConfig *config.SlackConfig, authUC interfaces.Auth, frontendURL string) *AuthHandler {
+// authzUC may be nil when access control is disabled; every user is then reported as admin.
+func NewAuthHandler(ctx context.Context, slackConfig *config.SlackConfig, authUC interfaces.Auth, authzUC interfaces.Authorization, frontendURL string) *AuthHandler {
 	return &AuthHandler{
 		slackConfig: slackConfig,
 		authUC:      authUC,
+		authzUC:     authzUC,
 		frontendURL: frontendURL,
 	}
 }
@@ -180,6 +186,7 @@ func (h *AuthHandler) HandleCallback(w http.ResponseWriter, r *http.Request) {
 		user.ID.String(),
 		user.Name,
 		user.Email,
+		sessionClientFromRequest(r),
 	)
 	if err != nil {
 		logger.Error("Failed to create session", "error", err)
@@ -188,23 +195,7 @@ func (h *AuthHandler) HandleCallback(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Set session cookies
-	http.SetCookie(w, &http.Cookie{
-		Name:     "session_id",
-		Value:    session.ID.String(),
-		Path:     "/",
-		HttpOnly: true,
-		SameSite: http.SameSiteLaxMode,
-		Expires:  session.ExpiresAt,
-	})
-
-	http.SetCookie(w, &http.Cookie{
-		Name:     "session_secret",
-		Value:    session.Secret.String(),
-		Path:     "/",
-		HttpOnly: true,
-		SameSite: http.SameSiteLaxMode,
-		Expires:  session.ExpiresAt,
-	})
+	setSessionCookies(w, session)
 
 	logger.Info("User authenticated successfully",
 		"userID", user.ID,
@@ -254,6 +245,14 @@ func (h *AuthHandler) HandleLogout(w http.ResponseWriter, r *http.Request) {
 
 // HandleUserMe returns current user information
 func (h *AuthHandler) HandleUserMe(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// API token requests have no session; report the token's principal instead
+	if authCtx, ok := model.GetAuthContext(ctx); ok && authCtx.IsAPIToken() {
+		h.writeAPITokenPrincipal(w, r, authCtx)
+		return
+	}
+
 	// Get session ID from cookie
 	sessionIDCookie, err := r.Cookie("session_id")
 	if err != nil {
@@ -262,21 +261,112 @@ func (h *AuthHandler) HandleUserMe(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Get user from session
-	user, err := h.authUC.GetUserFromSession(r.Context(), sessionIDCookie.Value)
+	user, err := h.authUC.GetUserFromSession(ctx, sessionIDCookie.Value)
 	if err != nil {
 		writeError(w, goerr.Wrap(err, "failed to get user"), http.StatusInternalServerError)
 		return
 	}
 
+	role, err := h.getRole(ctx, types.SlackUserID(user.ID))
+	if err != nil {
+		writeError(w, goerr.Wrap(err, "failed to get user role"), http.StatusInternalServerError)
+		return
+	}
+
+	writeUserMe(w, r, userMeResponse{User: user, Role: role})
+}
+
+// writeAPITokenPrincipal responds to /api/user/me for API token requests
+func (h *AuthHandler) writeAPITokenPrincipal(w http.ResponseWriter, r *http.Request, authCtx *model.AuthContext) {
+	resp := userMeResponse{
+		User: &model.User{ID: types.UserID(authCtx.SlackUserID)},
+		APIToken: &apiTokenPrincipal{
+			ID:     authCtx.APITokenID,
+			Kind:   authCtx.APITokenKind,
+			Scopes: authCtx.Scopes,
+		},
+	}
+
+	if authCtx.IsServiceToken() {
+		resp.Role = authCtx.ServiceTokenRole()
+	} else {
+		role, err := h.getRole(r.Context(), types.SlackUserID(authCtx.SlackUserID))
+		if err != nil {
+			writeError(w, goerr.Wrap(err, "failed to get user role"), http.StatusInternalServerError)
+			return
+		}
+		resp.Role = role
+	}
+
+	writeUserMe(w, r, resp)
+}
+
+// getRole returns the user's role, or admin when access control is disabled
+func (h *AuthHandler) getRole(ctx context.Context, userID types.SlackUserID) (types.Role, error) {
+	if h.authzUC == nil {
+		return types.RoleAdmin, nil
+	}
+	return h.authzUC.GetRole(ctx, userID)
+}
+
+func writeUserMe(w http.ResponseWriter, r *http.Request, resp userMeResponse) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(user); err != nil {
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
 		ctxlog.From(r.Context()).Error("Failed to encode user response", "error", err)
 	}
 }
 
+// userMeResponse is the user returned by /api/user/me with the user's role
+type userMeResponse struct {
+	*model.User
+	Role     types.Role         `json:"role"`
+	APIToken *apiTokenPrincipal `json:"api_token,omitempty"`
+}
+
+// apiTokenPrincipal describes the API token a request was authenticated with
+type apiTokenPrincipal struct {
+	ID     string                `json:"id"`
+	Kind   types.APITokenKind    `json:"kind"`
+	Scopes []types.APITokenScope `json:"scopes"`
+}
+
 // getRedirectURI constructs the redirect URI
 func (h *AuthHandler) getRedirectURI(r *http.Request) string {
 	baseURL := GetFrontendURL(r, h.frontendURL)
 	return baseURL + "/api/auth/callback"
 }
+
+// setSessionCookies sets the session cookies to expire together with the session
+func setSessionCookies(w http.ResponseWriter, session *model.Session) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     "session_id",
+		Value:    session.ID.String(),
+		Path:     "/",
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+		Expires:  session.ExpiresAt,
+	})
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "session_secret",
+		Value:    session.Secret.String(),
+		Path:     "/",
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+		Expires:  session.ExpiresAt,
+	})
+}
+
+// sessionClientFromRequest extracts the client address and user agent. RemoteAddr
+// is already rewritten from proxy headers by the RealIP middleware.
+func sessionClientFromRequest(r *http.Request) model.SessionClient {
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	return model.SessionClient{
+		IPAddress: ip,
+		UserAgent: r.UserAgent(),
+	}
+}
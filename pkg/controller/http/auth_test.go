@@ -13,6 +13,8 @@ import (
 	"github.com/m-mizutani/gt"
 	"github.com/secmon-lab/lycaon/pkg/cli/config"
 	controller "github.com/secmon-lab/lycaon/pkg/controller/http"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
 	"github.com/secmon-lab/lycaon/pkg/repository"
 	"github.com/secmon-lab/lycaon/pkg/usecase"
 )
@@ -27,7 +29,7 @@ func TestAuthHandlerLoginNotConfigured(t *testing.T) {
 	repo := repository.NewMemory()
 	authUC := usecase.NewAuth(ctx, repo, slackConfig)
 
-	handler := controller.NewAuthHandler(ctx, slackConfig, authUC, "")
+	handler := controller.NewAuthHandler(ctx, slackConfig, authUC, nil, "")
 
 	// Create request
 	req := httptest.NewRequest(http.MethodGet, "/api/auth/login", nil)
@@ -53,7 +55,7 @@ func TestAuthHandlerLoginConfigured(t *testing.T) {
 	repo := repository.NewMemory()
 	authUC := usecase.NewAuth(ctx, repo, slackConfig)
 
-	handler := controller.NewAuthHandler(ctx, slackConfig, authUC, "")
+	handler := controller.NewAuthHandler(ctx, slackConfig, authUC, nil, "")
 
 	// Create request
 	req := httptest.NewRequest(http.MethodGet, "/api/auth/login", nil)
@@ -81,10 +83,10 @@ func TestAuthHandlerLogout(t *testing.T) {
 	authUC := usecase.NewAuth(ctx, repo, slackConfig)
 
 	// Create session first
-	session, err := authUC.CreateSession(ctx, "U123", "Test User", "test@example.com")
+	session, err := authUC.CreateSession(ctx, "U123", "Test User", "test@example.com", model.SessionClient{})
 	gt.NoError(t, err).Required()
 
-	handler := controller.NewAuthHandler(ctx, slackConfig, authUC, "")
+	handler := controller.NewAuthHandler(ctx, slackConfig, authUC, nil, "")
 
 	// Create request with session cookie
 	req := httptest.NewRequest(http.MethodPost, "/api/auth/logout", nil)
@@ -132,10 +134,10 @@ func TestAuthHandlerUserMe(t *testing.T) {
 	authUC := usecase.NewAuth(ctx, repo, slackConfig)
 
 	// Create session
-	session, err := authUC.CreateSession(ctx, "U123", "Test User", "test@example.com")
+	session, err := authUC.CreateSession(ctx, "U123", "Test User", "test@example.com", model.SessionClient{})
 	gt.NoError(t, err).Required()
 
-	handler := controller.NewAuthHandler(ctx, slackConfig, authUC, "")
+	handler := controller.NewAuthHandler(ctx, slackConfig, authUC, nil, "")
 
 	t.Run("With valid session", func(t *testing.T) {
 		// Create request with session cookie
@@ -154,6 +156,28 @@ func TestAuthHandlerUserMe(t *testing.T) {
 		gt.True(t, strings.Contains(w.Body.String(), "U123"))
 		gt.True(t, strings.Contains(w.Body.String(), "Test User"))
 		gt.True(t, strings.Contains(w.Body.String(), "test@example.com"))
+		gt.True(t, strings.Contains(w.Body.String(), `"role":"admin"`))
+	})
+
+	t.Run("With role bindings", func(t *testing.T) {
+		authzUC := usecase.NewAuthorization(&model.RolesConfig{
+			Bindings: []model.RoleBinding{
+				{Role: types.RoleResponder, Users: []string{"U123"}},
+			},
+		}, nil)
+		handler := controller.NewAuthHandler(ctx, slackConfig, authUC, authzUC, "")
+
+		req := httptest.NewRequest(http.MethodGet, "/api/user/me", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  "session_id",
+			Value: session.ID.String(),
+		})
+		w := httptest.NewRecorder()
+
+		handler.HandleUserMe(w, req)
+
+		gt.Equal(t, http.StatusOK, w.Code)
+		gt.True(t, strings.Contains(w.Body.String(), `"role":"responder"`))
 	})
 
 	t.Run("Without session", func(t *testing.T) {
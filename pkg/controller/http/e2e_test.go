@@ -0,0 +1,274 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gollem"
+	"github.com/m-mizutani/gollem/mock"
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/cli/config"
+	controller "github.com/secmon-lab/lycaon/pkg/controller/http"
+	slackCtrl "github.com/secmon-lab/lycaon/pkg/controller/slack"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	"github.com/secmon-lab/lycaon/pkg/service/job"
+	slackservice "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/service/slack/slackfake"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+)
+
+const e2eSigningSecret = "e2e-signing-secret"
+
+type e2eEnv struct {
+	fake       *slackfake.Server
+	hook       *slackfake.Hook
+	repo       interfaces.Repository
+	incidentUC interfaces.Incident
+	taskUC     interfaces.Task
+}
+
+// setupE2E runs the full HTTP server against a fake Slack workspace
+func setupE2E(t *testing.T) *e2eEnv {
+	t.Helper()
+	ctx := context.Background()
+
+	fake := slackfake.New(
+		slackfake.WithUser(slackfake.User{ID: "U0ALICE", Name: "alice", RealName: "Alice"}),
+	)
+	fakeSrv := httptest.NewServer(fake)
+	t.Cleanup(fakeSrv.Close)
+
+	slackCfg := &config.Slack{
+		SigningSecret: e2eSigningSecret,
+		OAuthToken:    "xoxb-e2e",
+		ChannelPrefix: "inc",
+		APIURL:        fakeSrv.URL + "/api/",
+	}
+	slackClient, err := slackCfg.Configure(ctx)
+	gt.NoError(t, err).Required()
+
+	// LLM is unavailable: incident commands fall back to the title typed by the user
+	llm := &mock.LLMClientMock{
+		NewSessionFunc: func(ctx context.Context, options ...gollem.SessionOption) (gollem.Session, error) {
+			return nil, errors.New("LLM is not available in e2e tests")
+		},
+	}
+
+	repo := repository.NewMemory()
+	appConfig := testConfig()
+	uiSvc := slackservice.NewUIService(slackClient, appConfig)
+	authUC := usecase.NewAuth(ctx, repo, slackCfg)
+	messageUC, err := usecase.NewSlackMessage(ctx, repo, llm, slackClient, uiSvc, appConfig)
+	gt.NoError(t, err).Required()
+	inviteUC := usecase.NewInvite(slackClient)
+	incidentUC := usecase.NewIncident(repo, slackClient, uiSvc, appConfig, inviteUC, usecase.NewIncidentConfig(usecase.WithChannelPrefix("inc")))
+	taskUC := usecase.NewTaskUseCase(repo, slackClient)
+	statusUC := usecase.NewStatusUseCase(repo, uiSvc, appConfig)
+	interactionUC := usecase.NewSlackInteraction(incidentUC, taskUC, statusUC, authUC, slackClient, uiSvc, appConfig.GetSeveritiesConfig())
+	useCases := controller.NewUseCases(authUC, messageUC, incidentUC, taskUC, interactionUC, nil)
+
+	jobs := job.New(repo, job.WithPollInterval(10*time.Millisecond))
+	slackHandler := slackCtrl.NewHandler(ctx, slackCfg, repo, messageUC, incidentUC, taskUC, interactionUC, slackClient, appConfig, jobs)
+	authHandler := controller.NewAuthHandler(ctx, slackCfg, authUC, nil, "")
+	controllers := controller.NewController(slackHandler, authHandler, nil)
+
+	server, err := controller.NewServer(ctx, controller.NewConfig(":0", slackCfg, appConfig, ""), useCases, controllers, repo)
+	gt.NoError(t, err).Required()
+	lycaonSrv := httptest.NewServer(server.Server.Handler)
+	t.Cleanup(lycaonSrv.Close)
+
+	jobs.Start(ctx)
+	t.Cleanup(func() { gt.NoError(t, jobs.Shutdown(ctx)) })
+
+	hook := slackfake.NewHook(lycaonSrv.URL, e2eSigningSecret)
+	fake.SetHook(hook)
+
+	return &e2eEnv{
+		fake:       fake,
+		hook:       hook,
+		repo:       repo,
+		incidentUC: incidentUC,
+		taskUC:     taskUC,
+	}
+}
+
+// waitForAction polls the channel until a bot message has a button with actionID
+func (e *e2eEnv) waitForAction(t *testing.T, channelID, actionID string) string {
+	t.Helper()
+	var value string
+	waitUntil(t, func() bool {
+		for _, msg := range e.fake.Messages(channelID) {
+			if v, ok := findActionValue(msg.Blocks, actionID); ok {
+				value = v
+				return true
+			}
+		}
+		return false
+	})
+	return value
+}
+
+func (e *e2eEnv) interact(t *testing.T, payload map[string]any) {
+	t.Helper()
+	raw, err := json.Marshal(payload)
+	gt.NoError(t, err).Required()
+	gt.NoError(t, e.hook.SendInteraction(context.Background(), raw)).Required()
+}
+
+func waitUntil(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatal("condition not met within timeout")
+}
+
+// findActionValue searches Block Kit JSON for an element with the action ID
+func findActionValue(blocks json.RawMessage, actionID string) (string, bool) {
+	if len(blocks) == 0 {
+		return "", false
+	}
+	var tree any
+	if err := json.Unmarshal(blocks, &tree); err != nil {
+		return "", false
+	}
+
+	var walk func(node any) (string, bool)
+	walk = func(node any) (string, bool) {
+		switch n := node.(type) {
+		case map[string]any:
+			if n["action_id"] == actionID {
+				v, _ := n["value"].(string)
+				return v, true
+			}
+			for _, child := range n {
+				if v, ok := walk(child); ok {
+					return v, true
+				}
+			}
+		case []any:
+			for _, child := range n {
+				if v, ok := walk(child); ok {
+					return v, true
+				}
+			}
+		}
+		return "", false
+	}
+	return walk(tree)
+}
+
+func TestE2EIncidentLifecycle(t *testing.T) {
+	ctx := context.Background()
+	env := setupE2E(t)
+	bot := "<@" + slackfake.DefaultBotUserID + ">"
+
+	// Declare: mention the bot with an incident command
+	_, err := env.fake.Say(ctx, slackfake.DefaultChannelID, "U0ALICE", bot+" inc Database outage", "")
+	gt.NoError(t, err).Required()
+	requestID := env.waitForAction(t, slackfake.DefaultChannelID, "create_incident")
+	gt.NotEqual(t, "", requestID)
+
+	// Create channel: press the Declare button
+	env.interact(t, map[string]any{
+		"type":       "block_actions",
+		"trigger_id": "trigger-declare",
+		"user":       map[string]any{"id": "U0ALICE"},
+		"team":       map[string]any{"id": slackfake.DefaultTeamID},
+		"channel":    map[string]any{"id": slackfake.DefaultChannelID},
+		"actions": []map[string]any{
+			{"action_id": "create_incident", "block_id": "incident_creation", "type": "button", "value": requestID},
+		},
+	})
+
+	var incidentChannel slackfake.Channel
+	waitUntil(t, func() bool {
+		for _, ch := range env.fake.State().Channels {
+			if strings.HasPrefix(ch.Name, "inc-") {
+				incidentChannel = ch
+				return true
+			}
+		}
+		return false
+	})
+	gt.True(t, strings.Contains(incidentChannel.Name, "database-outage"))
+
+	incident, err := env.incidentUC.GetIncidentByChannelID(ctx, types.ChannelID(incidentChannel.ID))
+	gt.NoError(t, err).Required()
+	gt.Equal(t, "Database outage", incident.Title)
+
+	// Tasks: create a task from the incident channel
+	_, err = env.fake.Say(ctx, incidentChannel.ID, "U0ALICE", bot+" t Check replication lag", "")
+	gt.NoError(t, err).Required()
+	waitUntil(t, func() bool {
+		tasks, err := env.taskUC.ListTasks(ctx, incident.ID)
+		return err == nil && len(tasks) == 1 && tasks[0].Title == "Check replication lag"
+	})
+
+	// Close: open the status modal and submit "closed"
+	_, err = env.fake.Say(ctx, incidentChannel.ID, "U0ALICE", bot+" s", "")
+	gt.NoError(t, err).Required()
+	incidentIDValue := env.waitForAction(t, incidentChannel.ID, "edit_incident_status")
+
+	env.interact(t, map[string]any{
+		"type":       "block_actions",
+		"trigger_id": "trigger-status",
+		"user":       map[string]any{"id": "U0ALICE"},
+		"team":       map[string]any{"id": slackfake.DefaultTeamID},
+		"channel":    map[string]any{"id": incidentChannel.ID},
+		"actions": []map[string]any{
+			{"action_id": "edit_incident_status", "block_id": "status_actions", "type": "button", "value": incidentIDValue},
+		},
+	})
+
+	var privateMetadata string
+	waitUntil(t, func() bool {
+		for _, v := range env.fake.Views() {
+			var view struct {
+				CallbackID      string `json:"callback_id"`
+				PrivateMetadata string `json:"private_metadata"`
+			}
+			if json.Unmarshal(v.View, &view) == nil && view.CallbackID == "status_change_modal" {
+				privateMetadata = view.PrivateMetadata
+				return true
+			}
+		}
+		return false
+	})
+
+	env.interact(t, map[string]any{
+		"type": "view_submission",
+		"user": map[string]any{"id": "U0ALICE"},
+		"team": map[string]any{"id": slackfake.DefaultTeamID},
+		"view": map[string]any{
+			"id":               "V0STATUS",
+			"type":             "modal",
+			"callback_id":      "status_change_modal",
+			"private_metadata": privateMetadata,
+			"state": map[string]any{"values": map[string]any{
+				"status_block": map[string]any{
+					"status_select": map[string]any{
+						"type":            "static_select",
+						"selected_option": map[string]any{"value": "closed"},
+					},
+				},
+			}},
+		},
+	})
+
+	waitUntil(t, func() bool {
+		got, err := env.repo.GetIncident(ctx, incident.ID)
+		return err == nil && got.Status == types.IncidentStatusClosed
+	})
+}
@@ -2,15 +2,30 @@ package http
 
 import (
 	"context"
+	"crypto/subtle"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/m-mizutani/ctxlog"
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/utils/async"
+	"github.com/secmon-lab/lycaon/pkg/utils/metrics"
+	"github.com/secmon-lab/lycaon/pkg/utils/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var httpRequestDuration = metrics.NewHistogramVec("lycaon_http_request_duration_seconds",
+	"HTTP request latency by method, route pattern and status code.", metrics.DefaultBuckets, "method", "route", "code")
+
 // Middleware provides common HTTP middleware
 type Middleware struct {
 	authUC interfaces.Auth
@@ -41,9 +56,16 @@ func (m *Middleware) CORS(next http.Handler) http.Handler {
 	})
 }
 
-// RequireAuth middleware checks session authentication (chi compatible)
+// RequireAuth middleware checks session or API token authentication (chi compatible).
+// Requests with an "Authorization: Bearer" header are authenticated by API token,
+// all others by the session cookies set on Slack login.
 func (m *Middleware) RequireAuth(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if authz := r.Header.Get("Authorization"); authz != "" {
+			m.authenticateAPIToken(w, r, authz, next)
+			return
+		}
+
 		// Get session ID and secret from cookies
 		sessionIDCookie, err := r.Cookie("session_id")
 		if err != nil {
@@ -58,7 +80,7 @@ func (m *Middleware) RequireAuth(next http.Handler) http.Handler {
 		}
 
 		// Validate session
-		session, err := m.authUC.ValidateSession(r.Context(), sessionIDCookie.Value, sessionSecretCookie.Value)
+		session, err := m.authUC.ValidateSession(r.Context(), sessionIDCookie.Value, sessionSecretCookie.Value, sessionClientFromRequest(r))
 		if err != nil {
 			logger := ctxlog.From(r.Context())
 			logger.Debug("Session validation failed",
@@ -69,6 +91,9 @@ func (m *Middleware) RequireAuth(next http.Handler) http.Handler {
 			return
 		}
 
+		// Refresh cookies since the session expiry slides on activity
+		setSessionCookies(w, session)
+
 		// Update auth context with user info
 		authCtx := model.GetOrCreateAuthContext(r.Context())
 		authCtx.UserID = session.UserID.String()
@@ -87,6 +112,39 @@ func (m *Middleware) RequireAuth(next http.Handler) http.Handler {
 	})
 }
 
+// authenticateAPIToken validates a Bearer API token and serves the request as its principal
+func (m *Middleware) authenticateAPIToken(w http.ResponseWriter, r *http.Request, header string, next http.Handler) {
+	scheme, plain, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || plain == "" {
+		http.Error(w, "Unauthorized: unsupported authorization scheme", http.StatusUnauthorized)
+		return
+	}
+
+	token, err := m.authUC.ValidateAPIToken(r.Context(), strings.TrimSpace(plain))
+	if err != nil {
+		ctxlog.From(r.Context()).Debug("API token validation failed", "error", err)
+		http.Error(w, "Unauthorized: invalid API token", http.StatusUnauthorized)
+		return
+	}
+
+	// Personal tokens act as their owner; service tokens have no Slack user
+	authCtx := model.GetOrCreateAuthContext(r.Context())
+	authCtx.UserID = token.OwnerID.String()
+	authCtx.SlackUserID = token.OwnerID.String()
+	authCtx.APITokenID = token.ID.String()
+	authCtx.APITokenKind = token.Kind
+	authCtx.Scopes = token.Scopes
+	r = r.WithContext(model.WithAuthContext(r.Context(), authCtx))
+
+	ctxlog.From(r.Context()).Debug("Authenticated request with API token",
+		"tokenID", token.ID,
+		"kind", token.Kind,
+		"ownerID", token.OwnerID,
+	)
+
+	next.ServeHTTP(w, r)
+}
+
 // LoggingMiddleware creates a chi-compatible logging middleware
 func LoggingMiddleware(ctx context.Context) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -94,6 +152,11 @@ func LoggingMiddleware(ctx context.Context) func(next http.Handler) http.Handler
 			// Embed logger from the initial context into request context
 			r = r.WithContext(ctxlog.With(r.Context(), ctxlog.From(ctx)))
 
+			// Correlate logs with the trace of the request
+			if traceID, ok := async.GetTraceID(r.Context()); ok {
+				r = r.WithContext(ctxlog.With(r.Context(), ctxlog.From(r.Context()).With("trace_id", traceID)))
+			}
+
 			logger := ctxlog.From(r.Context())
 			start := time.Now()
 
@@ -132,3 +195,83 @@ func AuthContextMiddleware() func(next http.Handler) http.Handler {
 		})
 	}
 }
+
+// TracingMiddleware starts a server span for each request, continuing the
+// trace of the caller if the request carries W3C trace context. The span is
+// named after the route pattern once the request has been routed.
+func TracingMiddleware() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+			ctx, span := tracing.Start(ctx, "HTTP "+r.Method,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					attribute.String("http.request.method", r.Method),
+					attribute.String("url.path", r.URL.Path),
+				),
+			)
+			defer span.End()
+			if traceID := tracing.TraceID(ctx); traceID != "" {
+				ctx = async.WithTraceID(ctx, traceID)
+			}
+
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r.WithContext(ctx))
+
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				span.SetName(r.Method + " " + rctx.RoutePattern())
+				span.SetAttributes(attribute.String("http.route", rctx.RoutePattern()))
+			}
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			span.SetAttributes(attribute.Int("http.response.status_code", status))
+			if status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(status))
+			}
+		})
+	}
+}
+
+// MetricsMiddleware records request latency by route pattern. Patterns rather
+// than paths are used as labels to keep their cardinality bounded.
+func MetricsMiddleware() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			next.ServeHTTP(ww, r)
+
+			route := "unmatched"
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				route = rctx.RoutePattern()
+			}
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			httpRequestDuration.Observe(time.Since(start).Seconds(), r.Method, route, strconv.Itoa(status))
+		})
+	}
+}
+
+// RequireBearerToken rejects requests without "Authorization: Bearer <token>".
+// An empty token allows all requests.
+func RequireBearerToken(token string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if token == "" {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scheme, plain, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+			if !ok || !strings.EqualFold(scheme, "Bearer") ||
+				subtle.ConstantTimeCompare([]byte(strings.TrimSpace(plain)), []byte(token)) != 1 {
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
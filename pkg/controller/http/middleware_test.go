@@ -12,16 +12,25 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/m-mizutani/ctxlog"
 	"github.com/m-mizutani/gt"
 	"github.com/secmon-lab/lycaon/pkg/cli/config"
 	controller "github.com/secmon-lab/lycaon/pkg/controller/http"
 	slackCtrl "github.com/secmon-lab/lycaon/pkg/controller/slack"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
 	"github.com/secmon-lab/lycaon/pkg/repository"
+	"github.com/secmon-lab/lycaon/pkg/service/job"
 	slackservice "github.com/secmon-lab/lycaon/pkg/service/slack"
 	"github.com/secmon-lab/lycaon/pkg/usecase"
+	"github.com/secmon-lab/lycaon/pkg/utils/async"
+	"github.com/secmon-lab/lycaon/pkg/utils/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 // TestHTTPAccessControlPrivateIncidents tests that the HTTP layer properly enforces
@@ -49,11 +58,11 @@ func TestHTTPAccessControlPrivateIncidents(t *testing.T) {
 	httpConfig := controller.NewConfig(":8080", slackConfig, testConfig(), "")
 
 	// Create use cases structure
-	useCases := controller.NewUseCases(authUC, messageUC, incidentUC, taskUC, slackInteractionUC)
+	useCases := controller.NewUseCases(authUC, messageUC, incidentUC, taskUC, slackInteractionUC, nil)
 
 	// Create handlers
-	slackHandler := slackCtrl.NewHandler(ctx, slackConfig, repo, useCases.SlackMessage(), useCases.Incident(), useCases.Task(), useCases.SlackInteraction(), mockSlack, testConfig())
-	authHandler := controller.NewAuthHandler(ctx, slackConfig, useCases.Auth(), "")
+	slackHandler := slackCtrl.NewHandler(ctx, slackConfig, repo, useCases.SlackMessage(), useCases.Incident(), useCases.Task(), useCases.SlackInteraction(), mockSlack, testConfig(), job.New(repo))
+	authHandler := controller.NewAuthHandler(ctx, slackConfig, useCases.Auth(), nil, "")
 
 	// Create GraphQL handler
 	graphqlHandler := controller.CreateGraphQLHandler(repo, mockSlack, useCases, testConfig())
@@ -369,3 +378,256 @@ func TestHTTPAccessControlPrivateIncidents(t *testing.T) {
 		gt.Equal(t, true, privateInc["private"])
 	})
 }
+
+// newAuthTestServer builds a server backed by memory repository for authentication tests
+func newAuthTestServer(t *testing.T) (context.Context, *controller.Server, interfaces.Repository, *usecase.Auth) {
+	ctx := ctxlog.With(context.Background(), slog.New(slog.NewTextHandler(os.Stdout, nil)))
+
+	slackConfig := &config.SlackConfig{}
+	repo := repository.NewMemory()
+	authUC := usecase.NewAuth(ctx, repo, slackConfig)
+	mockLLM, mockSlack := createMockClients()
+	slackSvc := slackservice.NewUIService(mockSlack, testConfig())
+	messageUC, err := usecase.NewSlackMessage(ctx, repo, mockLLM, mockSlack, slackSvc, testConfig())
+	gt.NoError(t, err).Required()
+	incidentUC := usecase.NewIncident(repo, mockSlack, slackSvc, testConfig(), nil, usecase.NewIncidentConfig())
+	taskUC := usecase.NewTaskUseCase(repo, mockSlack)
+	statusUC := usecase.NewStatusUseCase(repo, slackSvc, testConfig())
+	slackInteractionUC := usecase.NewSlackInteraction(incidentUC, taskUC, statusUC, authUC, mockSlack, slackSvc, nil)
+
+	useCases := controller.NewUseCases(authUC, messageUC, incidentUC, taskUC, slackInteractionUC, nil)
+	slackHandler := slackCtrl.NewHandler(ctx, slackConfig, repo, useCases.SlackMessage(), useCases.Incident(), useCases.Task(), useCases.SlackInteraction(), mockSlack, testConfig(), job.New(repo))
+	authHandler := controller.NewAuthHandler(ctx, slackConfig, useCases.Auth(), nil, "")
+	graphqlHandler := controller.CreateGraphQLHandler(repo, mockSlack, useCases, testConfig())
+	server, err := controller.NewServer(ctx, controller.NewConfig(":8080", slackConfig, testConfig(), ""), useCases,
+		controller.NewController(slackHandler, authHandler, graphqlHandler), repo)
+	gt.NoError(t, err).Required()
+
+	return ctx, server, repo, authUC
+}
+
+func TestAPITokenAuthentication(t *testing.T) {
+	ctx, server, repo, authUC := newAuthTestServer(t)
+
+	incidentID := types.IncidentID(time.Now().UnixNano())
+	gt.NoError(t, repo.PutIncident(ctx, &model.Incident{
+		ID:        incidentID,
+		Title:     "Token Incident",
+		ChannelID: types.ChannelID(fmt.Sprintf("C-TOKEN-%d", incidentID)),
+		Status:    types.IncidentStatusTriage,
+		CreatedBy: "U-CREATOR",
+	}))
+
+	createToken := func(t *testing.T, kind types.APITokenKind, ownerID types.SlackUserID, scopes ...types.APITokenScope) string {
+		_, plain, err := authUC.CreateAPIToken(ctx, interfaces.CreateAPITokenRequest{
+			Name:    "automation",
+			Kind:    kind,
+			OwnerID: ownerID,
+			Scopes:  scopes,
+		})
+		gt.NoError(t, err).Required()
+		return plain
+	}
+
+	postGraphQL := func(t *testing.T, bearer, query string) (int, map[string]any) {
+		body, err := json.Marshal(map[string]any{"query": query})
+		gt.NoError(t, err).Required()
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+		req.Header.Set("Content-Type", "application/json")
+		if bearer != "" {
+			req.Header.Set("Authorization", "Bearer "+bearer)
+		}
+		w := httptest.NewRecorder()
+		server.Server.Handler.ServeHTTP(w, req)
+
+		var resp map[string]any
+		if w.Code == http.StatusOK {
+			gt.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		}
+		return w.Code, resp
+	}
+
+	readQuery := fmt.Sprintf(`{ incident(id: "%d") { title } }`, incidentID)
+	updateMutation := fmt.Sprintf(`mutation { updateIncident(id: "%d", input: {description: "updated by automation"}) { description } }`, incidentID)
+
+	t.Run("read token can query", func(t *testing.T) {
+		code, resp := postGraphQL(t, createToken(t, types.APITokenKindService, "", types.APITokenScopeRead), readQuery)
+		gt.Equal(t, http.StatusOK, code)
+		gt.V(t, resp["errors"]).Nil()
+		incident := resp["data"].(map[string]any)["incident"].(map[string]any)
+		gt.Equal(t, "Token Incident", incident["title"])
+	})
+
+	t.Run("read token cannot mutate", func(t *testing.T) {
+		code, resp := postGraphQL(t, createToken(t, types.APITokenKindService, "", types.APITokenScopeRead), updateMutation)
+		gt.Equal(t, http.StatusOK, code)
+		gt.V(t, resp["errors"]).NotNil()
+		gt.S(t, fmt.Sprint(resp["errors"])).Contains("write scope")
+	})
+
+	t.Run("write service token can mutate", func(t *testing.T) {
+		code, resp := postGraphQL(t, createToken(t, types.APITokenKindService, "", types.APITokenScopeWrite), updateMutation)
+		gt.Equal(t, http.StatusOK, code)
+		gt.V(t, resp["errors"]).Nil()
+
+		incident, err := repo.GetIncident(ctx, incidentID)
+		gt.NoError(t, err)
+		gt.Equal(t, "updated by automation", incident.Description)
+	})
+
+	t.Run("tokens cannot create tokens", func(t *testing.T) {
+		code, resp := postGraphQL(t, createToken(t, types.APITokenKindPersonal, "U-OWNER", types.APITokenScopeWrite),
+			`mutation { createAPIToken(input: {name: "escalate", scopes: [write]}) { token } }`)
+		gt.Equal(t, http.StatusOK, code)
+		gt.V(t, resp["errors"]).NotNil()
+	})
+
+	t.Run("revoked token is rejected", func(t *testing.T) {
+		plain := createToken(t, types.APITokenKindService, "", types.APITokenScopeRead)
+		tokenID, _, err := model.ParseAPIToken(plain)
+		gt.NoError(t, err).Required()
+		_, err = authUC.RevokeAPIToken(ctx, tokenID)
+		gt.NoError(t, err).Required()
+
+		code, _ := postGraphQL(t, plain, readQuery)
+		gt.Equal(t, http.StatusUnauthorized, code)
+	})
+
+	t.Run("invalid token is rejected", func(t *testing.T) {
+		code, _ := postGraphQL(t, "lyc_unknown_secret", readQuery)
+		gt.Equal(t, http.StatusUnauthorized, code)
+	})
+
+	t.Run("session can create personal token", func(t *testing.T) {
+		session, err := authUC.CreateSession(ctx, "U-SESSION", "Session User", "session@example.com", model.SessionClient{})
+		gt.NoError(t, err).Required()
+
+		body, err := json.Marshal(map[string]any{
+			"query": `mutation { createAPIToken(input: {name: "laptop", scopes: [read]}) { token apiToken { kind ownerId } } }`,
+		})
+		gt.NoError(t, err).Required()
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+		req.Header.Set("Content-Type", "application/json")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: session.ID.String()})
+		req.AddCookie(&http.Cookie{Name: "session_secret", Value: session.Secret.String()})
+		w := httptest.NewRecorder()
+		server.Server.Handler.ServeHTTP(w, req)
+		gt.Equal(t, http.StatusOK, w.Code)
+
+		var resp map[string]any
+		gt.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		gt.V(t, resp["errors"]).Nil()
+		created := resp["data"].(map[string]any)["createAPIToken"].(map[string]any)
+		apiToken := created["apiToken"].(map[string]any)
+		gt.Equal(t, "personal", apiToken["kind"])
+		gt.Equal(t, "U-SESSION", apiToken["ownerId"])
+
+		// The returned token authenticates as the session user
+		code, resp := postGraphQL(t, created["token"].(string), readQuery)
+		gt.Equal(t, http.StatusOK, code)
+		gt.V(t, resp["errors"]).Nil()
+	})
+}
+
+func TestSessionManagement(t *testing.T) {
+	ctx, server, _, authUC := newAuthTestServer(t)
+
+	current, err := authUC.CreateSession(ctx, "U-SESSIONS", "Session User", "session@example.com", model.SessionClient{})
+	gt.NoError(t, err).Required()
+	stolen, err := authUC.CreateSession(ctx, "U-SESSIONS", "Session User", "session@example.com",
+		model.SessionClient{IPAddress: "203.0.113.9", UserAgent: "stolen-agent"})
+	gt.NoError(t, err).Required()
+
+	postGraphQL := func(t *testing.T, session *model.Session, query string) (*httptest.ResponseRecorder, map[string]any) {
+		body, err := json.Marshal(map[string]any{"query": query})
+		gt.NoError(t, err).Required()
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("User-Agent", "test-browser")
+		req.AddCookie(&http.Cookie{Name: "session_id", Value: session.ID.String()})
+		req.AddCookie(&http.Cookie{Name: "session_secret", Value: session.Secret.String()})
+		w := httptest.NewRecorder()
+		server.Server.Handler.ServeHTTP(w, req)
+
+		var resp map[string]any
+		if w.Code == http.StatusOK {
+			gt.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		}
+		return w, resp
+	}
+
+	t.Run("lists own sessions and refreshes cookies", func(t *testing.T) {
+		w, resp := postGraphQL(t, current, `{ sessions { id current ipAddress userAgent } }`)
+		gt.Equal(t, http.StatusOK, w.Code)
+		gt.V(t, resp["errors"]).Nil()
+		gt.S(t, w.Header().Get("Set-Cookie")).Contains("session_id=" + current.ID.String())
+
+		sessions := resp["data"].(map[string]any)["sessions"].([]any)
+		gt.A(t, sessions).Length(2)
+		for _, v := range sessions {
+			session := v.(map[string]any)
+			gt.Equal(t, session["current"], any(session["id"] == current.ID.String()))
+			if session["id"] == stolen.ID.String() {
+				gt.Equal(t, "203.0.113.9", session["ipAddress"])
+				gt.Equal(t, "stolen-agent", session["userAgent"])
+			}
+		}
+	})
+
+	t.Run("unknown session cannot be revoked", func(t *testing.T) {
+		_, resp := postGraphQL(t, current, `mutation { revokeSession(id: "unknown") }`)
+		gt.V(t, resp["errors"]).NotNil()
+	})
+
+	t.Run("revokes another session remotely", func(t *testing.T) {
+		_, resp := postGraphQL(t, current, fmt.Sprintf(`mutation { revokeSession(id: "%s") }`, stolen.ID))
+		gt.V(t, resp["errors"]).Nil()
+
+		w, _ := postGraphQL(t, stolen, `{ sessions { id } }`)
+		gt.Equal(t, http.StatusUnauthorized, w.Code)
+
+		w, _ = postGraphQL(t, current, `{ sessions { id } }`)
+		gt.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("revokes all own sessions", func(t *testing.T) {
+		_, resp := postGraphQL(t, current, `mutation { revokeAllSessions(userId: "U-SESSIONS") }`)
+		gt.V(t, resp["errors"]).Nil()
+		gt.Equal(t, any(float64(1)), resp["data"].(map[string]any)["revokeAllSessions"])
+
+		w, _ := postGraphQL(t, current, `{ sessions { id } }`)
+		gt.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestTracingMiddleware(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(tracing.Propagator())
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+
+	var handlerTraceID string
+	router := chi.NewRouter()
+	router.Use(controller.TracingMiddleware())
+	router.Get("/incidents/{id}", func(w http.ResponseWriter, r *http.Request) {
+		handlerTraceID, _ = async.GetTraceID(r.Context())
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/incidents/42", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := recorder.Ended()
+	gt.A(t, spans).Length(1).Required()
+	span := spans[0]
+	gt.Equal(t, "GET /incidents/{id}", span.Name())
+	gt.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+	gt.Equal(t, "00f067aa0ba902b7", span.Parent().SpanID().String())
+	gt.Equal(t, codes.Error, span.Status().Code)
+	gt.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", handlerTraceID)
+}
@@ -19,6 +19,10 @@ import (
 	slackCtrl "github.com/secmon-lab/lycaon/pkg/controller/slack"
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/service/pubsub"
+	"github.com/secmon-lab/lycaon/pkg/service/search"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+	"github.com/secmon-lab/lycaon/pkg/utils/metrics"
 )
 
 //go:embed static/fallback.html
@@ -30,6 +34,18 @@ type Config struct {
 	modelConfig *model.Config
 	addr        string
 	frontendURL string
+	// metricsToken protects /metrics when set
+	metricsToken string
+}
+
+// ConfigOption configures optional server settings
+type ConfigOption func(*Config)
+
+// WithMetricsToken requires token as a Bearer token to read /metrics
+func WithMetricsToken(token string) ConfigOption {
+	return func(c *Config) {
+		c.metricsToken = token
+	}
 }
 
 // NewConfig creates a new Config instance
@@ -38,13 +54,18 @@ func NewConfig(
 	slackConfig *config.SlackConfig,
 	modelConfig *model.Config,
 	frontendURL string,
+	opts ...ConfigOption,
 ) *Config {
-	return &Config{
+	c := &Config{
 		slackConfig: slackConfig,
 		modelConfig: modelConfig,
 		addr:        addr,
 		frontendURL: frontendURL,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // UseCases holds use case dependencies for the HTTP server
@@ -54,6 +75,58 @@ type UseCases struct {
 	incident         interfaces.Incident
 	task             interfaces.Task
 	slackInteraction interfaces.SlackInteraction
+	authorization    interfaces.Authorization
+	status           *usecase.StatusUseCase
+	update           interfaces.StakeholderUpdate
+	relation         interfaces.IncidentRelation
+	events           *pubsub.Broker
+	search           *search.Index
+	readiness        *usecase.Readiness
+}
+
+// UseCasesOption configures optional use case dependencies
+type UseCasesOption func(*UseCases)
+
+// WithStatusUseCase shares a status use case with the GraphQL handler
+func WithStatusUseCase(statusUC *usecase.StatusUseCase) UseCasesOption {
+	return func(u *UseCases) {
+		u.status = statusUC
+	}
+}
+
+// WithStakeholderUpdates shares a stakeholder update use case with the GraphQL handler
+func WithStakeholderUpdates(updateUC interfaces.StakeholderUpdate) UseCasesOption {
+	return func(u *UseCases) {
+		u.update = updateUC
+	}
+}
+
+// WithIncidentRelations shares an incident relation use case with the GraphQL handler
+func WithIncidentRelations(relationUC interfaces.IncidentRelation) UseCasesOption {
+	return func(u *UseCases) {
+		u.relation = relationUC
+	}
+}
+
+// WithEvents sets the broker feeding GraphQL subscriptions
+func WithEvents(events *pubsub.Broker) UseCasesOption {
+	return func(u *UseCases) {
+		u.events = events
+	}
+}
+
+// WithSearch sets the index serving the GraphQL search query
+func WithSearch(index *search.Index) UseCasesOption {
+	return func(u *UseCases) {
+		u.search = index
+	}
+}
+
+// WithReadiness sets the dependency checks served on /ready
+func WithReadiness(readiness *usecase.Readiness) UseCasesOption {
+	return func(u *UseCases) {
+		u.readiness = readiness
+	}
 }
 
 // NewUseCases creates a new UseCases instance
@@ -63,14 +136,21 @@ func NewUseCases(
 	incidentUC interfaces.Incident,
 	taskUC interfaces.Task,
 	slackInteractionUC interfaces.SlackInteraction,
+	authzUC interfaces.Authorization,
+	opts ...UseCasesOption,
 ) *UseCases {
-	return &UseCases{
+	u := &UseCases{
 		auth:             authUC,
 		slackMessage:     messageUC,
 		incident:         incidentUC,
 		task:             taskUC,
 		slackInteraction: slackInteractionUC,
+		authorization:    authzUC,
+	}
+	for _, opt := range opts {
+		opt(u)
 	}
+	return u
 }
 
 // Controllers holds controller dependencies for the HTTP server
@@ -112,12 +192,18 @@ func NewServer(
 	// Apply global middleware
 	router.Use(middleware.RequestID)
 	router.Use(middleware.RealIP)
+	router.Use(TracingMiddleware())
+	router.Use(MetricsMiddleware())
 	router.Use(LoggingMiddleware(ctx))
 	router.Use(AuthContextMiddleware())
 	router.Use(middleware.Recoverer)
 
-	// Health check
+	// Liveness and readiness checks
 	router.Get("/health", handleHealth)
+	router.Get("/ready", handleReady(useCases.readiness))
+
+	// Prometheus metrics
+	router.With(RequireBearerToken(config.metricsToken)).Handle("/metrics", metrics.Default.Handler())
 
 	// API routes
 	router.Route("/api", func(r chi.Router) {
@@ -201,6 +287,60 @@ func handleHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// readyResponse is the body of /ready
+type readyResponse struct {
+	Status string                     `json:"status"`
+	Checks map[string]dependencyCheck `json:"checks"`
+}
+
+type dependencyCheck struct {
+	Status    string    `json:"status"`
+	LatencyMS int64     `json:"latency_ms"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// handleReady reports whether the server's dependencies are usable. Unlike
+// /health, it fails with 503 when e.g. the Slack token has been revoked, so
+// that load balancers stop routing traffic to the instance. The endpoint is
+// unauthenticated, so failure reasons are logged instead of returned.
+func handleReady(readiness *usecase.Readiness) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp := readyResponse{Status: "ready", Checks: map[string]dependencyCheck{}}
+		code := http.StatusOK
+		if readiness != nil {
+			report := readiness.Check(r.Context())
+			for _, dep := range report.Dependencies {
+				check := dependencyCheck{
+					Status:    "ok",
+					LatencyMS: dep.Latency.Milliseconds(),
+					CheckedAt: dep.CheckedAt,
+				}
+				switch {
+				case dep.Skipped:
+					check.Status = "skipped"
+				case !dep.OK:
+					check.Status = "error"
+					ctxlog.From(r.Context()).Warn("Dependency is not ready",
+						"dependency", dep.Name,
+						"error", dep.Error,
+					)
+				}
+				resp.Checks[dep.Name] = check
+			}
+			if !report.Ready {
+				resp.Status = "not_ready"
+				code = http.StatusServiceUnavailable
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			ctxlog.From(r.Context()).Error("Failed to encode readiness response", "error", err)
+		}
+	}
+}
+
 // handleFallbackHome handles the root path when frontend is not available
 func handleFallbackHome(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
@@ -217,12 +357,20 @@ func CreateGraphQLHandler(repo interfaces.Repository, slackClient interfaces.Sla
 		IncidentUC: useCases.incident,
 		TaskUC:     useCases.task,
 		AuthUC:     useCases.auth,
+		AuthzUC:    useCases.authorization,
+		StatusUC:   useCases.status,
+		UpdateUC:   useCases.update,
+		RelationUC: useCases.relation,
+		Events:     useCases.events,
+		Search:     useCases.search,
 	}
 
 	resolver := graphql.NewResolver(repo, slackClient, gqlUseCases, modelConfig)
 	srv := handler.NewDefaultServer(
 		graphql.NewExecutableSchema(graphql.Config{Resolvers: resolver}),
 	)
+	srv.AroundOperations(graphql.RequireTokenScope)
+	srv.AroundOperations(graphql.WithAuditActor)
 
 	// TODO: Add DataLoader middleware here when implemented
 	return srv
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
@@ -25,6 +26,7 @@ import (
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
 	"github.com/secmon-lab/lycaon/pkg/repository"
+	"github.com/secmon-lab/lycaon/pkg/service/job"
 	slackservice "github.com/secmon-lab/lycaon/pkg/service/slack"
 	"github.com/secmon-lab/lycaon/pkg/usecase"
 	slackgo "github.com/slack-go/slack"
@@ -86,6 +88,9 @@ func createMockClients() (gollem.LLMClient, *mocks.SlackClientMock) {
 				},
 			}, nil
 		},
+		PostMessageFunc: func(ctx context.Context, channelID string, options ...slackgo.MsgOption) (string, string, error) {
+			return channelID, "1234.5678", nil
+		},
 	}
 }
 
@@ -112,11 +117,11 @@ func TestServerHealthCheck(t *testing.T) {
 	config := controller.NewConfig(":8080", slackConfig, testConfig(), "")
 
 	// Create use cases structure
-	useCases := controller.NewUseCases(authUC, messageUC, incidentUC, taskUC, slackInteractionUC)
+	useCases := controller.NewUseCases(authUC, messageUC, incidentUC, taskUC, slackInteractionUC, nil)
 
 	// Create handlers
-	slackHandler := slackCtrl.NewHandler(ctx, slackConfig, repo, useCases.SlackMessage(), useCases.Incident(), useCases.Task(), useCases.SlackInteraction(), mockSlack, testConfig())
-	authHandler := controller.NewAuthHandler(ctx, slackConfig, useCases.Auth(), "")
+	slackHandler := slackCtrl.NewHandler(ctx, slackConfig, repo, useCases.SlackMessage(), useCases.Incident(), useCases.Task(), useCases.SlackInteraction(), mockSlack, testConfig(), job.New(repo))
+	authHandler := controller.NewAuthHandler(ctx, slackConfig, useCases.Auth(), nil, "")
 
 	// Create GraphQL handler
 	var graphqlHandler http.Handler
@@ -143,6 +148,115 @@ func TestServerHealthCheck(t *testing.T) {
 	gt.True(t, strings.Contains(w.Body.String(), "lycaon"))
 }
 
+func TestServerMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	slackConfig := &config.SlackConfig{}
+	repo := repository.NewMemory()
+	authUC := usecase.NewAuth(ctx, repo, slackConfig)
+	mockLLM, mockSlack := createMockClients()
+	slackSvc := slackservice.NewUIService(mockSlack, testConfig())
+	messageUC, err := usecase.NewSlackMessage(ctx, repo, mockLLM, mockSlack, slackSvc, testConfig())
+	gt.NoError(t, err).Required()
+	incidentUC := usecase.NewIncident(repo, nil, slackSvc, testConfig(), nil, usecase.NewIncidentConfig())
+	taskUC := usecase.NewTaskUseCase(repo, mockSlack)
+	statusUC := usecase.NewStatusUseCase(repo, slackSvc, testConfig())
+	slackInteractionUC := usecase.NewSlackInteraction(incidentUC, taskUC, statusUC, authUC, mockSlack, slackSvc, nil)
+
+	config := controller.NewConfig(":8080", slackConfig, testConfig(), "", controller.WithMetricsToken("metrics-secret"))
+	useCases := controller.NewUseCases(authUC, messageUC, incidentUC, taskUC, slackInteractionUC, nil)
+	slackHandler := slackCtrl.NewHandler(ctx, slackConfig, repo, useCases.SlackMessage(), useCases.Incident(), useCases.Task(), useCases.SlackInteraction(), mockSlack, testConfig(), job.New(repo))
+	authHandler := controller.NewAuthHandler(ctx, slackConfig, useCases.Auth(), nil, "")
+	controllers := controller.NewController(slackHandler, authHandler, nil)
+
+	server, err := controller.NewServer(ctx, config, useCases, controllers, repo)
+	gt.NoError(t, err).Required()
+
+	server.Server.Handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	t.Run("token is required", func(t *testing.T) {
+		for _, header := range []string{"", "Bearer wrong", "Basic metrics-secret"} {
+			req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+			if header != "" {
+				req.Header.Set("Authorization", header)
+			}
+			w := httptest.NewRecorder()
+			server.Server.Handler.ServeHTTP(w, req)
+			gt.Equal(t, http.StatusUnauthorized, w.Code)
+		}
+	})
+
+	t.Run("requests are recorded by route", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		req.Header.Set("Authorization", "Bearer metrics-secret")
+		w := httptest.NewRecorder()
+		server.Server.Handler.ServeHTTP(w, req)
+
+		gt.Equal(t, http.StatusOK, w.Code)
+		gt.S(t, w.Body.String()).Contains(`lycaon_http_request_duration_seconds_count{method="GET",route="/health",code="200"}`)
+		gt.S(t, w.Body.String()).Contains(`lycaon_http_request_duration_seconds_count{method="GET",route="/metrics",code="401"}`)
+	})
+}
+
+func TestServerReadiness(t *testing.T) {
+	ctx := context.Background()
+
+	slackConfig := &config.SlackConfig{}
+	repo := repository.NewMemory()
+	authUC := usecase.NewAuth(ctx, repo, slackConfig)
+	mockLLM, mockSlack := createMockClients()
+	slackSvc := slackservice.NewUIService(mockSlack, testConfig())
+	messageUC, err := usecase.NewSlackMessage(ctx, repo, mockLLM, mockSlack, slackSvc, testConfig())
+	gt.NoError(t, err).Required()
+	incidentUC := usecase.NewIncident(repo, nil, slackSvc, testConfig(), nil, usecase.NewIncidentConfig())
+	taskUC := usecase.NewTaskUseCase(repo, mockSlack)
+	statusUC := usecase.NewStatusUseCase(repo, slackSvc, testConfig())
+	slackInteractionUC := usecase.NewSlackInteraction(incidentUC, taskUC, statusUC, authUC, mockSlack, slackSvc, nil)
+
+	revokedSlack := &mocks.SlackClientMock{
+		AuthTestContextFunc: func(ctx context.Context) (*slackgo.AuthTestResponse, error) {
+			return nil, errors.New("token_revoked")
+		},
+	}
+	readiness := usecase.NewReadiness(repo, revokedSlack, nil, testConfig())
+
+	useCases := controller.NewUseCases(authUC, messageUC, incidentUC, taskUC, slackInteractionUC, nil, controller.WithReadiness(readiness))
+	slackHandler := slackCtrl.NewHandler(ctx, slackConfig, repo, useCases.SlackMessage(), useCases.Incident(), useCases.Task(), useCases.SlackInteraction(), mockSlack, testConfig(), job.New(repo))
+	authHandler := controller.NewAuthHandler(ctx, slackConfig, useCases.Auth(), nil, "")
+	controllers := controller.NewController(slackHandler, authHandler, nil)
+
+	server, err := controller.NewServer(ctx, controller.NewConfig(":8080", slackConfig, testConfig(), ""), useCases, controllers, repo)
+	gt.NoError(t, err).Required()
+
+	t.Run("readiness reports each dependency", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		server.Server.Handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ready", nil))
+		gt.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+		var resp struct {
+			Status string                    `json:"status"`
+			Checks map[string]map[string]any `json:"checks"`
+		}
+		gt.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp)).Required()
+		gt.Equal(t, "not_ready", resp.Status)
+		gt.Equal(t, "ok", resp.Checks["repository"]["status"])
+		gt.Equal(t, "ok", resp.Checks["config"]["status"])
+		gt.Equal(t, "error", resp.Checks["slack"]["status"])
+		// Failure reasons are only logged, as the endpoint is unauthenticated
+		_, hasError := resp.Checks["slack"]["error"]
+		gt.False(t, hasError)
+		gt.S(t, w.Body.String()).NotContains("token_revoked")
+		// Without an LLM client the check is skipped rather than failed
+		gt.Equal(t, "skipped", resp.Checks["llm"]["status"])
+	})
+
+	t.Run("liveness does not depend on dependencies", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		server.Server.Handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+		gt.Equal(t, http.StatusOK, w.Code)
+	})
+}
+
 func TestServerFallbackHome(t *testing.T) {
 	// Setup
 	ctx := context.Background()
@@ -166,11 +280,11 @@ func TestServerFallbackHome(t *testing.T) {
 	config := controller.NewConfig(":8080", slackConfig, testConfig(), "")
 
 	// Create use cases structure
-	useCases := controller.NewUseCases(authUC, messageUC, incidentUC, taskUC, slackInteractionUC)
+	useCases := controller.NewUseCases(authUC, messageUC, incidentUC, taskUC, slackInteractionUC, nil)
 
 	// Create handlers
-	slackHandler := slackCtrl.NewHandler(ctx, slackConfig, repo, useCases.SlackMessage(), useCases.Incident(), useCases.Task(), useCases.SlackInteraction(), mockSlack, testConfig())
-	authHandler := controller.NewAuthHandler(ctx, slackConfig, useCases.Auth(), "")
+	slackHandler := slackCtrl.NewHandler(ctx, slackConfig, repo, useCases.SlackMessage(), useCases.Incident(), useCases.Task(), useCases.SlackInteraction(), mockSlack, testConfig(), job.New(repo))
+	authHandler := controller.NewAuthHandler(ctx, slackConfig, useCases.Auth(), nil, "")
 
 	// Create GraphQL handler
 	var graphqlHandler http.Handler
@@ -1078,7 +1192,7 @@ func TestGraphQL_Firestore_Integration(t *testing.T) {
 			Limit: 10,
 		}
 
-		incidents, result, err := repo.ListIncidentsPaginated(ctx, opts)
+		incidents, result, err := repo.ListIncidentsPaginated(ctx, model.IncidentFilter{}, opts)
 
 		if err != nil {
 			t.Logf("Firestore ListIncidentsPaginated failed with error: %v", err)
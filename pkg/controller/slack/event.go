@@ -12,7 +12,6 @@ import (
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
 	slackblocks "github.com/secmon-lab/lycaon/pkg/service/slack"
 	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
-	"github.com/secmon-lab/lycaon/pkg/utils/async"
 	"github.com/slack-go/slack"
 	"github.com/slack-go/slack/slackevents"
 )
@@ -57,6 +56,8 @@ func (h *EventHandler) HandleEvent(ctx context.Context, event *slackevents.Event
 		"innerEvent", event.InnerEvent.Type,
 	)
 
+	ctx = model.WithAuditActor(ctx, eventAuditActor(event))
+
 	// Handle different event types
 	switch ev := event.InnerEvent.Data.(type) {
 	case *slackevents.MessageEvent:
@@ -79,8 +80,28 @@ func (h *EventHandler) HandleEvent(ctx context.Context, event *slackevents.Event
 	}
 }
 
+// eventAuditActor attributes audit entries to the user who triggered the event
+func eventAuditActor(event *slackevents.EventsAPIEvent) model.AuditActor {
+	actor := model.AuditActor{Source: types.AuditSourceSlack}
+	if cb, ok := event.Data.(*slackevents.EventsAPICallbackEvent); ok {
+		actor.RequestID = cb.EventID
+	}
+
+	switch ev := event.InnerEvent.Data.(type) {
+	case *slackevents.MessageEvent:
+		actor.ID = ev.User
+	case *slackevents.AppMentionEvent:
+		actor.ID = ev.User
+	case *slackevents.MemberJoinedChannelEvent:
+		actor.ID = ev.User
+	case *slackevents.MemberLeftChannelEvent:
+		actor.ID = ev.User
+	}
+	return actor
+}
+
 // handleMessageEvent handles message events
-// Controller responsibility: Basic validation, then async dispatch for processing
+// Controller responsibility: Basic validation, then message processing
 func (h *EventHandler) handleMessageEvent(ctx context.Context, event *slackevents.MessageEvent) error {
 	logger := ctxlog.From(ctx)
 
@@ -112,26 +133,19 @@ func (h *EventHandler) handleMessageEvent(ctx context.Context, event *slackevent
 		"ts", event.TimeStamp,
 	)
 
-	// Dispatch message processing asynchronously to return 200 immediately
-	backgroundCtx := async.NewBackgroundContext(ctx)
-	async.Dispatch(backgroundCtx, func(asyncCtx context.Context) error {
-		if err := h.messageUC.ProcessMessage(asyncCtx, event); err != nil {
-			// Use apperr.Handle to properly log the error
-			apperr.Handle(asyncCtx, err)
-		}
-		return nil
-	})
+	// Already running in a job worker; errors are returned so the job is retried
+	if err := h.messageUC.ProcessMessage(ctx, event); err != nil {
+		return goerr.Wrap(err, "failed to process message",
+			goerr.V("channel", event.Channel),
+			goerr.V("ts", event.TimeStamp),
+		)
+	}
 
-	// Return immediately to send 200 response to Slack
-	logger.Debug("Message dispatched for async processing",
-		"user", event.User,
-		"channel", event.Channel,
-	)
 	return nil
 }
 
 // handleAppMentionEvent handles app mention events
-// Controller responsibility: Basic validation, then async dispatch for all processing
+// Controller responsibility: Basic validation, then processing (already running in a job worker)
 func (h *EventHandler) handleAppMentionEvent(ctx context.Context, event *slackevents.AppMentionEvent) error {
 	logger := ctxlog.From(ctx)
 
@@ -142,24 +156,15 @@ func (h *EventHandler) handleAppMentionEvent(ctx context.Context, event *slackev
 		"ts", event.TimeStamp,
 	)
 
-	// Dispatch all app mention processing asynchronously to return 200 immediately
-	backgroundCtx := async.NewBackgroundContext(ctx)
-	async.Dispatch(backgroundCtx, func(asyncCtx context.Context) error {
-		h.processAppMentionAsync(asyncCtx, event)
-		return nil
-	})
-
-	// Return immediately to send 200 response to Slack
-	logger.Debug("App mention dispatched for async processing",
-		"user", event.User,
-		"channel", event.Channel,
-	)
+	// Errors are reported to the user in-channel, so the job is not retried
+	// to avoid posting duplicate prompts
+	h.processAppMention(ctx, event)
 	return nil
 }
 
-// processAppMentionAsync processes app mention events asynchronously
+// processAppMention processes app mention events
 // UseCase orchestration: Handle message saving, task commands, and incident creation
-func (h *EventHandler) processAppMentionAsync(ctx context.Context, event *slackevents.AppMentionEvent) {
+func (h *EventHandler) processAppMention(ctx context.Context, event *slackevents.AppMentionEvent) {
 	logger := ctxlog.From(ctx)
 
 	// Convert AppMentionEvent to MessageEvent for processing
@@ -390,7 +395,7 @@ func (h *EventHandler) sendStatusErrorMessage(ctx context.Context, channel, thre
 }
 
 // handleMemberJoinedChannel handles member_joined_channel events
-// Controller responsibility: Parse event, dispatch async processing
+// Controller responsibility: Parse event, sync incident membership
 func (h *EventHandler) handleMemberJoinedChannel(ctx context.Context, event *slackevents.MemberJoinedChannelEvent) error {
 	logger := ctxlog.From(ctx)
 
@@ -413,23 +418,19 @@ func (h *EventHandler) handleMemberJoinedChannel(ctx context.Context, event *sla
 		return nil
 	}
 
-	// Create background context for async processing
-	backgroundCtx := async.NewBackgroundContext(ctx)
-
 	// Extract event user ID
 	eventUserID := types.SlackUserID(event.User)
 
-	// Dispatch member sync asynchronously with event details
-	async.Dispatch(backgroundCtx, func(asyncCtx context.Context) error {
-		return h.incidentUC.SyncIncidentMemberWithEvent(asyncCtx, incident.ID, incident.ChannelID, eventUserID, true)
-	})
+	// Sync member with event details (already running in a job worker)
+	if err := h.incidentUC.SyncIncidentMemberWithEvent(ctx, incident.ID, incident.ChannelID, eventUserID, true); err != nil {
+		return goerr.Wrap(err, "failed to sync incident member", goerr.V("incidentID", incident.ID))
+	}
 
-	// Return immediately to send 200 response to Slack
 	return nil
 }
 
 // handleMemberLeftChannel handles member_left_channel events
-// Controller responsibility: Parse event, dispatch async processing
+// Controller responsibility: Parse event, sync incident membership
 func (h *EventHandler) handleMemberLeftChannel(ctx context.Context, event *slackevents.MemberLeftChannelEvent) error {
 	logger := ctxlog.From(ctx)
 
@@ -452,17 +453,13 @@ func (h *EventHandler) handleMemberLeftChannel(ctx context.Context, event *slack
 		return nil
 	}
 
-	// Create background context for async processing
-	backgroundCtx := async.NewBackgroundContext(ctx)
-
 	// Extract event user ID
 	eventUserID := types.SlackUserID(event.User)
 
-	// Dispatch member sync asynchronously with event details
-	async.Dispatch(backgroundCtx, func(asyncCtx context.Context) error {
-		return h.incidentUC.SyncIncidentMemberWithEvent(asyncCtx, incident.ID, incident.ChannelID, eventUserID, false)
-	})
+	// Sync member with event details (already running in a job worker)
+	if err := h.incidentUC.SyncIncidentMemberWithEvent(ctx, incident.ID, incident.ChannelID, eventUserID, false); err != nil {
+		return goerr.Wrap(err, "failed to sync incident member", goerr.V("incidentID", incident.ID))
+	}
 
-	// Return immediately to send 200 response to Slack
 	return nil
 }
@@ -0,0 +1,12 @@
+package slack
+
+import (
+	"context"
+
+	"github.com/slack-go/slack/socketmode"
+)
+
+// HandleSocketEvent exposes handleSocketEvent for testing
+func (r *SocketModeRunner) HandleSocketEvent(ctx context.Context, evt socketmode.Event) {
+	r.handleSocketEvent(ctx, evt)
+}
@@ -18,12 +18,19 @@ import (
 	"github.com/secmon-lab/lycaon/pkg/cli/config"
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/service/job"
 	slackservice "github.com/secmon-lab/lycaon/pkg/service/slack"
 	"github.com/secmon-lab/lycaon/pkg/usecase"
-	"github.com/secmon-lab/lycaon/pkg/utils/async"
 	"github.com/slack-go/slack/slackevents"
 )
 
+const (
+	// JobKindSlackEvent is the job kind for Events API callbacks (payload: raw event_callback JSON)
+	JobKindSlackEvent = "slack_event"
+	// JobKindSlackInteraction is the job kind for interactions (payload: raw interaction JSON)
+	JobKindSlackInteraction = "slack_interaction"
+)
+
 // Handler handles Slack webhook endpoints
 type Handler struct {
 	slackConfig        *config.SlackConfig
@@ -32,20 +39,57 @@ type Handler struct {
 	taskUC             interfaces.Task
 	eventHandler       *EventHandler
 	interactionHandler *InteractionHandler
+	dedup              *usecase.EventDedup
+	jobs               *job.Queue
+}
+
+// HandlerOption configures optional Handler dependencies
+type HandlerOption func(*handlerOptions)
+
+type handlerOptions struct {
+	statusUC *usecase.StatusUseCase
+}
+
+// WithStatusUseCase shares a status use case, e.g. one publishing status changes
+func WithStatusUseCase(statusUC *usecase.StatusUseCase) HandlerOption {
+	return func(o *handlerOptions) {
+		o.statusUC = statusUC
+	}
 }
 
 // NewHandler creates a new Slack handler
-func NewHandler(ctx context.Context, slackConfig *config.SlackConfig, repo interfaces.Repository, messageUC interfaces.SlackMessage, incidentUC interfaces.Incident, taskUC interfaces.Task, slackInteractionUC interfaces.SlackInteraction, slackClient interfaces.SlackClient, modelConfig *model.Config) *Handler {
-	slackUIService := slackservice.NewUIService(slackClient, modelConfig)
-	statusUC := usecase.NewStatusUseCase(repo, slackUIService, modelConfig)
-	return &Handler{
+func NewHandler(ctx context.Context, slackConfig *config.SlackConfig, repo interfaces.Repository, messageUC interfaces.SlackMessage, incidentUC interfaces.Incident, taskUC interfaces.Task, slackInteractionUC interfaces.SlackInteraction, slackClient interfaces.SlackClient, modelConfig *model.Config, jobs *job.Queue, opts ...HandlerOption) *Handler {
+	var options handlerOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	statusUC := options.statusUC
+	if statusUC == nil {
+		slackUIService := slackservice.NewUIService(slackClient, modelConfig)
+		statusUC = usecase.NewStatusUseCase(repo, slackUIService, modelConfig)
+	}
+	h := &Handler{
 		slackConfig:        slackConfig,
 		messageUC:          messageUC,
 		incidentUC:         incidentUC,
 		taskUC:             taskUC,
 		eventHandler:       NewEventHandler(ctx, messageUC, taskUC, incidentUC, statusUC, slackClient),
 		interactionHandler: NewInteractionHandler(ctx, slackInteractionUC),
+		dedup:              usecase.NewEventDedup(repo, usecase.DefaultEventDedupTTL),
+		jobs:               jobs,
 	}
+
+	jobs.Register(JobKindSlackEvent, h.runEventJob)
+	// Trigger IDs expire within seconds, so a failed interaction is dead-lettered immediately
+	jobs.Register(JobKindSlackInteraction, h.runInteractionJob, job.WithKindMaxAttempts(1))
+
+	return h
+}
+
+// DedupStats returns counters of duplicate deliveries dropped by the handler
+func (h *Handler) DedupStats() usecase.DedupStats {
+	return h.dedup.Stats()
 }
 
 // HandleEvent handles a single Slack event
@@ -113,16 +157,16 @@ func (h *Handler) HandleEvent(w http.ResponseWriter, r *http.Request) {
 			"type", eventsAPIEvent.Type,
 			"team_id", eventsAPIEvent.TeamID,
 			"api_app_id", eventsAPIEvent.APIAppID,
+			"retry_num", r.Header.Get("X-Slack-Retry-Num"),
+			"retry_reason", r.Header.Get("X-Slack-Retry-Reason"),
 		)
 
-		// Acknowledge receipt immediately
+		// Acknowledge only once the event is persisted, so that Slack retries otherwise
+		if err := h.dispatchEvent(r.Context(), &eventsAPIEvent, body); err != nil {
+			h.writeError(w, r.Context(), err, http.StatusInternalServerError)
+			return
+		}
 		w.WriteHeader(http.StatusOK)
-
-		// Process event asynchronously with preserved context
-		backgroundCtx := async.NewBackgroundContext(r.Context())
-		async.Dispatch(backgroundCtx, func(ctx context.Context) error {
-			return h.eventHandler.HandleEvent(ctx, &eventsAPIEvent)
-		})
 		return
 	}
 
@@ -169,14 +213,68 @@ func (h *Handler) HandleInteraction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Acknowledge receipt immediately
+	// Acknowledge only once the interaction is persisted, so that Slack retries otherwise
+	if err := h.dispatchInteraction(r.Context(), []byte(payload)); err != nil {
+		h.writeError(w, r.Context(), err, http.StatusInternalServerError)
+		return
+	}
 	w.WriteHeader(http.StatusOK)
+}
+
+// dispatchEvent persists a callback event as a job for asynchronous processing.
+// Shared by the HTTP webhook and Socket Mode transports. Duplicate deliveries are dropped.
+// An error means the event was not persisted and must not be acknowledged; the dedup
+// record is released then, so that Slack's retry is not dropped as a duplicate.
+func (h *Handler) dispatchEvent(ctx context.Context, event *slackevents.EventsAPIEvent, raw []byte) error {
+	var eventID string
+	if cb, ok := event.Data.(*slackevents.EventsAPICallbackEvent); ok && cb != nil {
+		eventID = cb.EventID
+		if h.dedup.IsDuplicateEvent(ctx, eventID) {
+			return nil
+		}
+	}
+
+	if _, err := h.jobs.Enqueue(ctx, JobKindSlackEvent, raw); err != nil {
+		h.dedup.ReleaseEvent(ctx, eventID)
+		return goerr.Wrap(err, "failed to enqueue Slack event")
+	}
+	return nil
+}
+
+// dispatchInteraction persists an interaction payload as a job for asynchronous processing.
+// Shared by the HTTP webhook and Socket Mode transports. Duplicate deliveries are dropped.
+// An error means the interaction was not persisted and must not be acknowledged; the
+// dedup record is released then, as for events.
+func (h *Handler) dispatchInteraction(ctx context.Context, payload []byte) error {
+	var envelope struct {
+		TriggerID string `json:"trigger_id"`
+	}
+	if err := json.Unmarshal(payload, &envelope); err == nil {
+		if h.dedup.IsDuplicateInteraction(ctx, envelope.TriggerID) {
+			return nil
+		}
+	}
+
+	if _, err := h.jobs.Enqueue(ctx, JobKindSlackInteraction, payload); err != nil {
+		h.dedup.ReleaseInteraction(ctx, envelope.TriggerID)
+		return goerr.Wrap(err, "failed to enqueue Slack interaction")
+	}
+	return nil
+}
+
+// runEventJob processes a persisted Slack event
+func (h *Handler) runEventJob(ctx context.Context, payload []byte) error {
+	eventsAPIEvent, err := slackevents.ParseEvent(payload, slackevents.OptionNoVerifyToken())
+	if err != nil {
+		return goerr.Wrap(err, "failed to parse persisted event")
+	}
+	observeEventLag(&eventsAPIEvent)
+	return h.eventHandler.HandleEvent(ctx, &eventsAPIEvent)
+}
 
-	// Process interaction asynchronously with preserved context
-	backgroundCtx := async.NewBackgroundContext(r.Context())
-	async.Dispatch(backgroundCtx, func(ctx context.Context) error {
-		return h.interactionHandler.HandleInteraction(ctx, []byte(payload))
-	})
+// runInteractionJob processes a persisted Slack interaction
+func (h *Handler) runInteractionJob(ctx context.Context, payload []byte) error {
+	return h.interactionHandler.HandleInteraction(ctx, payload)
 }
 
 // verifySlackSignature verifies the Slack request signature
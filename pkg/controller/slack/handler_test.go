@@ -7,10 +7,12 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"strconv"
 	"testing"
@@ -22,9 +24,12 @@ import (
 	"github.com/m-mizutani/gt"
 	"github.com/secmon-lab/lycaon/pkg/cli/config"
 	"github.com/secmon-lab/lycaon/pkg/controller/slack"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces/mocks"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
 	"github.com/secmon-lab/lycaon/pkg/repository"
+	"github.com/secmon-lab/lycaon/pkg/service/job"
 	slackservice "github.com/secmon-lab/lycaon/pkg/service/slack"
 	"github.com/secmon-lab/lycaon/pkg/usecase"
 	slackgo "github.com/slack-go/slack"
@@ -104,7 +109,7 @@ func TestSlackHandlerChallenge(t *testing.T) {
 
 	authUC := usecase.NewAuth(ctx, repo, slackConfig)
 	slackInteractionUC := usecase.NewSlackInteraction(incidentUC, taskUC, statusUC, authUC, mockSlack, slackSvc, nil)
-	handler := slack.NewHandler(ctx, slackConfig, repo, messageUC, incidentUC, taskUC, slackInteractionUC, mockSlack, testConfig())
+	handler := slack.NewHandler(ctx, slackConfig, repo, messageUC, incidentUC, taskUC, slackInteractionUC, mockSlack, testConfig(), job.New(repo))
 
 	// Create challenge request with type field
 	challenge := map[string]any{
@@ -156,7 +161,7 @@ func TestSlackHandlerInvalidSignature(t *testing.T) {
 
 	authUC := usecase.NewAuth(ctx, repo, slackConfig)
 	slackInteractionUC := usecase.NewSlackInteraction(incidentUC, taskUC, statusUC, authUC, mockSlack, slackSvc, nil)
-	handler := slack.NewHandler(ctx, slackConfig, repo, messageUC, incidentUC, taskUC, slackInteractionUC, mockSlack, testConfig())
+	handler := slack.NewHandler(ctx, slackConfig, repo, messageUC, incidentUC, taskUC, slackInteractionUC, mockSlack, testConfig(), job.New(repo))
 
 	// Create request with invalid signature
 	body := []byte(`{"type":"event_callback","event":{"type":"message","text":"test"}}`)
@@ -193,7 +198,7 @@ func TestSlackHandlerNotConfigured(t *testing.T) {
 
 	authUC := usecase.NewAuth(ctx, repo, slackConfig)
 	slackInteractionUC := usecase.NewSlackInteraction(incidentUC, taskUC, statusUC, authUC, mockSlack, slackSvc, nil)
-	handler := slack.NewHandler(ctx, slackConfig, repo, messageUC, incidentUC, taskUC, slackInteractionUC, mockSlack, testConfig())
+	handler := slack.NewHandler(ctx, slackConfig, repo, messageUC, incidentUC, taskUC, slackInteractionUC, mockSlack, testConfig(), job.New(repo))
 
 	// Create request with valid JSON body
 	body := []byte(`{"type":"event_callback","event":{"type":"message","text":"test"}}`)
@@ -207,6 +212,88 @@ func TestSlackHandlerNotConfigured(t *testing.T) {
 	gt.Equal(t, http.StatusServiceUnavailable, w.Code)
 }
 
+// failingJobRepository is a repository that cannot persist jobs while fail is set
+type failingJobRepository struct {
+	interfaces.Repository
+	fail bool
+}
+
+func (r *failingJobRepository) PutJob(ctx context.Context, job *model.Job) error {
+	if r.fail {
+		return errors.New("unavailable")
+	}
+	return r.Repository.PutJob(ctx, job)
+}
+
+func TestSlackHandlerEnqueueFailure(t *testing.T) {
+	ctx := ctxlog.With(context.Background(), slog.New(slog.NewTextHandler(os.Stdout, nil)))
+
+	slackConfig := &config.SlackConfig{
+		SigningSecret: "test-secret",
+		OAuthToken:    "test-token",
+	}
+	repo := &failingJobRepository{Repository: repository.NewMemory()}
+	mockLLM, mockSlack := createMockClientsForController()
+	slackSvc := slackservice.NewUIService(mockSlack, testConfig())
+	messageUC, err := usecase.NewSlackMessage(ctx, repo, mockLLM, mockSlack, slackSvc, testConfig())
+	gt.NoError(t, err).Required()
+	incidentUC := usecase.NewIncident(repo, nil, slackSvc, testConfig(), nil, usecase.NewIncidentConfig())
+	taskUC := usecase.NewTaskUseCase(repo, mockSlack)
+	statusUC := usecase.NewStatusUseCase(repo, slackSvc, testConfig())
+	authUC := usecase.NewAuth(ctx, repo, slackConfig)
+	slackInteractionUC := usecase.NewSlackInteraction(incidentUC, taskUC, statusUC, authUC, mockSlack, slackSvc, nil)
+	handler := slack.NewHandler(ctx, slackConfig, repo, messageUC, incidentUC, taskUC, slackInteractionUC, mockSlack, testConfig(), job.New(repo))
+
+	pendingJobs := func(t *testing.T) int {
+		jobs, err := repo.ListJobsByStatus(ctx, types.JobStatusPending, 10)
+		gt.NoError(t, err).Required()
+		return len(jobs)
+	}
+
+	signed := func(target, contentType string, body []byte) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set("X-Slack-Request-Timestamp", timestamp)
+		req.Header.Set("X-Slack-Signature", generateSlackSignature(slackConfig.SigningSecret, timestamp, body))
+		return req
+	}
+
+	t.Run("event is not acknowledged and its retry is enqueued", func(t *testing.T) {
+		body := []byte(`{"type":"event_callback","event_id":"Ev-FAIL","event":{"type":"message","text":"test"}}`)
+		before := pendingJobs(t)
+
+		repo.fail = true
+		w := httptest.NewRecorder()
+		handler.HandleEvent(w, signed("/hooks/slack/events", "application/json", body))
+		gt.Equal(t, http.StatusInternalServerError, w.Code)
+
+		repo.fail = false
+		w = httptest.NewRecorder()
+		handler.HandleEvent(w, signed("/hooks/slack/events", "application/json", body))
+		gt.Equal(t, http.StatusOK, w.Code)
+		gt.Equal(t, before+1, pendingJobs(t))
+		gt.Equal(t, uint64(0), handler.DedupStats().DroppedEvents)
+	})
+
+	t.Run("interaction is not acknowledged and its retry is enqueued", func(t *testing.T) {
+		body := []byte("payload=" + url.QueryEscape(`{"type":"block_actions","trigger_id":"T-FAIL"}`))
+		before := pendingJobs(t)
+
+		repo.fail = true
+		w := httptest.NewRecorder()
+		handler.HandleInteraction(w, signed("/hooks/slack/interaction", "application/x-www-form-urlencoded", body))
+		gt.Equal(t, http.StatusInternalServerError, w.Code)
+
+		repo.fail = false
+		w = httptest.NewRecorder()
+		handler.HandleInteraction(w, signed("/hooks/slack/interaction", "application/x-www-form-urlencoded", body))
+		gt.Equal(t, http.StatusOK, w.Code)
+		gt.Equal(t, before+1, pendingJobs(t))
+		gt.Equal(t, uint64(0), handler.DedupStats().DroppedInteractions)
+	})
+}
+
 // generateSlackSignature generates a valid Slack signature for testing
 func generateSlackSignature(secret, timestamp string, body []byte) string {
 	baseString := fmt.Sprintf("v0:%s:%s", timestamp, string(body))
@@ -7,14 +7,14 @@ import (
 	"github.com/m-mizutani/ctxlog"
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
-	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
-	"github.com/secmon-lab/lycaon/pkg/utils/async"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
 	"github.com/slack-go/slack"
 )
 
 // InteractionHandler handles Slack interactions
 // Responsibility: Parse Slack messages, extract necessary information,
-// prepare data for usecase, and control async dispatch
+// prepare data for usecase, and invoke it
 type InteractionHandler struct {
 	slackUC interfaces.SlackInteraction
 }
@@ -27,7 +27,7 @@ func NewInteractionHandler(ctx context.Context, slackUC interfaces.SlackInteract
 }
 
 // HandleInteraction handles a Slack interaction
-// Controller responsibility: Parse request, extract data, prepare usecase call, handle async dispatch
+// Controller responsibility: Parse request, extract data, prepare and run usecase call
 func (h *InteractionHandler) HandleInteraction(ctx context.Context, payload []byte) error {
 	logger := ctxlog.From(ctx)
 
@@ -43,6 +43,12 @@ func (h *InteractionHandler) HandleInteraction(ctx context.Context, payload []by
 		"team", interaction.Team.ID,
 	)
 
+	ctx = model.WithAuditActor(ctx, model.AuditActor{
+		ID:        interaction.User.ID,
+		Source:    types.AuditSourceSlack,
+		RequestID: interaction.TriggerID,
+	})
+
 	// Prepare interaction data for usecase
 	interactionData := &interfaces.SlackInteractionData{
 		Type:       string(interaction.Type),
@@ -53,16 +59,15 @@ func (h *InteractionHandler) HandleInteraction(ctx context.Context, payload []by
 		RawPayload: payload,
 	}
 
-	// All usecase calls must be async dispatched to return 200 immediately
 	switch interaction.Type {
 	case slack.InteractionTypeBlockActions:
-		// Validate critical fields before async dispatch
+		// Validate critical fields before usecase processing
 		for _, action := range interaction.ActionCallback.BlockActions {
 			if action.ActionID == "create_incident" && action.Value == "" {
 				return goerr.New("empty request ID")
 			}
 		}
-		return h.handleAsync(ctx, interactionData, h.slackUC.HandleBlockActions)
+		return h.handleUseCase(ctx, interactionData, h.slackUC.HandleBlockActions)
 
 	case slack.InteractionTypeViewSubmission:
 		// Validate critical fields for incident creation modal
@@ -81,10 +86,10 @@ func (h *InteractionHandler) HandleInteraction(ctx context.Context, payload []by
 				return goerr.New("incident title is required")
 			}
 		}
-		return h.handleAsync(ctx, interactionData, h.slackUC.HandleViewSubmission)
+		return h.handleUseCase(ctx, interactionData, h.slackUC.HandleViewSubmission)
 
 	case slack.InteractionTypeShortcut:
-		return h.handleAsync(ctx, interactionData, h.slackUC.HandleShortcut)
+		return h.handleUseCase(ctx, interactionData, h.slackUC.HandleShortcut)
 
 	case slack.InteractionTypeViewClosed:
 		// No processing needed for view closed
@@ -97,31 +102,18 @@ func (h *InteractionHandler) HandleInteraction(ctx context.Context, payload []by
 	}
 }
 
-// handleAsync handles all usecase calls asynchronously to return 200 immediately
-// Controller responsibility: Dispatch usecase processing and return immediate response
-func (h *InteractionHandler) handleAsync(ctx context.Context, data *interfaces.SlackInteractionData, usecaseHandler func(context.Context, *interfaces.SlackInteractionData) error) error {
-	logger := ctxlog.From(ctx)
-
-	// Create background context for async processing
-	backgroundCtx := async.NewBackgroundContext(ctx)
-
-	// Dispatch usecase processing asynchronously
-	async.Dispatch(backgroundCtx, func(asyncCtx context.Context) error {
-		if err := usecaseHandler(asyncCtx, data); err != nil {
-			// Use apperr.Handle to properly log the error
-			apperr.Handle(asyncCtx, err)
-			return nil
-		}
-
-		logger.Debug("UseCase processing completed",
-			"interactionType", data.Type,
-			"user", data.UserID,
+// handleUseCase runs the usecase for an interaction
+// Controller responsibility: Invoke usecase processing; this already runs in a job worker,
+// so the HTTP/Socket Mode acknowledgement is not blocked
+func (h *InteractionHandler) handleUseCase(ctx context.Context, data *interfaces.SlackInteractionData, usecaseHandler func(context.Context, *interfaces.SlackInteractionData) error) error {
+	if err := usecaseHandler(ctx, data); err != nil {
+		return goerr.Wrap(err, "failed to handle interaction",
+			goerr.V("interactionType", data.Type),
+			goerr.V("user", data.UserID),
 		)
-		return nil
-	})
+	}
 
-	// Return immediately to send 200 response to Slack
-	logger.Debug("Interaction dispatched for async processing",
+	ctxlog.From(ctx).Debug("UseCase processing completed",
 		"interactionType", data.Type,
 		"user", data.UserID,
 	)
@@ -71,7 +71,7 @@ func TestInteractionHandlerHandleInteraction(t *testing.T) {
 		var createdIncident *model.Incident
 		created := make(chan bool, 1)
 		incidentMock := &mocks.IncidentMock{
-			HandleCreateIncidentActionAsyncFunc: func(ctx context.Context, requestID, userID, channelID string) {
+			HandleCreateIncidentActionFunc: func(ctx context.Context, requestID, userID, channelID string) {
 				createdIncident = &model.Incident{
 					ID:                1,
 					ChannelID:         types.ChannelID("C-INC-001"),
@@ -176,7 +176,7 @@ func TestInteractionHandlerHandleInteraction(t *testing.T) {
 	t.Run("Handle incident creation failure", func(t *testing.T) {
 		failed := make(chan bool, 1)
 		incidentMock := &mocks.IncidentMock{
-			HandleCreateIncidentActionAsyncFunc: func(ctx context.Context, requestID, userID, channelID string) {
+			HandleCreateIncidentActionFunc: func(ctx context.Context, requestID, userID, channelID string) {
 				failed <- true
 				// In real implementation, this would handle the error internally
 			},
@@ -285,7 +285,7 @@ func TestInteractionHandlerHandleInteraction(t *testing.T) {
 
 	t.Run("Handle view submission", func(t *testing.T) {
 		incidentMock := &mocks.IncidentMock{
-			HandleCreateIncidentWithDetailsFunc: func(ctx context.Context, requestID, title, description, categoryID, severityID, userID string) (*model.Incident, error) {
+			HandleCreateIncidentWithDetailsAndAssetsFunc: func(ctx context.Context, requestID, title, description, categoryID, severityID string, assetIDs []types.AssetID, isPrivate bool, isTest bool, userID string) (*model.Incident, error) {
 				return &model.Incident{
 					ID:    types.IncidentID(1),
 					Title: title,
@@ -340,6 +340,7 @@ func TestInteractionHandlerHandleInteraction(t *testing.T) {
 		// Should handle view submission without error
 		err = handler.HandleInteraction(ctx, payload)
 		gt.NoError(t, err).Required()
+		gt.A(t, incidentMock.HandleCreateIncidentWithDetailsAndAssetsCalls()).Length(1)
 	})
 
 	t.Run("Handle view submission with missing fields", func(t *testing.T) {
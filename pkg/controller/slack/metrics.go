@@ -0,0 +1,37 @@
+package slack
+
+import (
+	"context"
+	"time"
+
+	"github.com/secmon-lab/lycaon/pkg/utils/metrics"
+	"github.com/slack-go/slack/slackevents"
+)
+
+var eventLag = metrics.NewHistogramVec("lycaon_slack_event_lag_seconds",
+	"Time from a Slack event occurring to lycaon starting to process it, by event type.",
+	[]float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 300, 900}, "type")
+
+// RegisterMetrics exposes the number of duplicate Slack deliveries dropped by h to reg
+func (h *Handler) RegisterMetrics(reg *metrics.Registry) error {
+	return reg.Register(metrics.NewCounterFunc("lycaon_slack_duplicate_deliveries_total",
+		"Duplicate Slack deliveries dropped, by kind (event or interaction).", []string{"kind"},
+		func(_ context.Context) ([]metrics.Sample, error) {
+			stats := h.DedupStats()
+			return []metrics.Sample{
+				{LabelValues: []string{"event"}, Value: float64(stats.DroppedEvents)},
+				{LabelValues: []string{"interaction"}, Value: float64(stats.DroppedInteractions)},
+			}, nil
+		}))
+}
+
+// observeEventLag records the lag of an Events API callback. Slack only
+// reports event_time in whole seconds, so sub-second lags are approximate.
+func observeEventLag(event *slackevents.EventsAPIEvent) {
+	callback, ok := event.Data.(*slackevents.EventsAPICallbackEvent)
+	if !ok || callback.EventTime == 0 {
+		return
+	}
+	lag := time.Since(time.Unix(int64(callback.EventTime), 0))
+	eventLag.Observe(max(0, lag.Seconds()), event.InnerEvent.Type)
+}
@@ -0,0 +1,139 @@
+package slack
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+	"github.com/slack-go/slack/socketmode"
+)
+
+// SocketModeRunner receives Slack events and interactions over a Socket Mode
+// WebSocket connection and dispatches them into the same EventHandler and
+// InteractionHandler used by the HTTP webhook endpoints.
+// This allows running lycaon in environments without inbound HTTP access from Slack.
+type SocketModeRunner struct {
+	handler *Handler
+	client  *socketmode.Client
+}
+
+// NewSocketModeRunner creates a new Socket Mode runner.
+// appToken is the app-level token (xapp-...) with connections:write scope,
+// botToken is the bot OAuth token used for Web API calls.
+func NewSocketModeRunner(ctx context.Context, handler *Handler, appToken, botToken string, slackOpts ...slack.Option) *SocketModeRunner {
+	opts := append([]slack.Option{slack.OptionAppLevelToken(appToken)}, slackOpts...)
+	api := slack.New(botToken, opts...)
+
+	// Route socketmode library logs into our structured logger at debug level
+	logger := ctxlog.From(ctx)
+	client := socketmode.New(api,
+		socketmode.OptionLog(slog.NewLogLogger(logger.Handler(), slog.LevelDebug)),
+	)
+
+	return &SocketModeRunner{
+		handler: handler,
+		client:  client,
+	}
+}
+
+// Run connects to Slack and processes incoming events until ctx is cancelled
+func (r *SocketModeRunner) Run(ctx context.Context) error {
+	logger := ctxlog.From(ctx)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- r.client.RunContext(ctx)
+	}()
+
+	logger.Info("Slack Socket Mode runner started")
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Slack Socket Mode runner stopped")
+			return nil
+
+		case err := <-errCh:
+			if ctx.Err() != nil {
+				return nil
+			}
+			return goerr.Wrap(err, "socket mode connection terminated")
+
+		case evt, ok := <-r.client.Events:
+			if !ok {
+				return nil
+			}
+			r.handleSocketEvent(ctx, evt)
+		}
+	}
+}
+
+// handleSocketEvent acknowledges and dispatches a single Socket Mode event
+func (r *SocketModeRunner) handleSocketEvent(ctx context.Context, evt socketmode.Event) {
+	logger := ctxlog.From(ctx)
+
+	switch evt.Type {
+	case socketmode.EventTypeConnecting:
+		logger.Info("Connecting to Slack with Socket Mode")
+
+	case socketmode.EventTypeConnected:
+		logger.Info("Connected to Slack with Socket Mode")
+
+	case socketmode.EventTypeConnectionError:
+		logger.Warn("Socket Mode connection failed, retrying", "data", evt.Data)
+
+	case socketmode.EventTypeInvalidAuth:
+		logger.Error("Socket Mode authentication failed, check the app-level token")
+
+	case socketmode.EventTypeEventsAPI:
+		eventsAPIEvent, ok := evt.Data.(slackevents.EventsAPIEvent)
+		if !ok || evt.Request == nil {
+			logger.Warn("Unexpected Socket Mode events_api payload", "data", evt.Data)
+			return
+		}
+		if eventsAPIEvent.Type != slackevents.CallbackEvent {
+			r.ack(evt)
+			logger.Warn("Unknown Slack event type", "type", eventsAPIEvent.Type)
+			return
+		}
+
+		logger.Debug("Processing Slack event",
+			"transport", "socket_mode",
+			"type", eventsAPIEvent.Type,
+			"team_id", eventsAPIEvent.TeamID,
+			"api_app_id", eventsAPIEvent.APIAppID,
+		)
+		// Leave the envelope unacknowledged if the event was not persisted, so that Slack redelivers it
+		if err := r.handler.dispatchEvent(ctx, &eventsAPIEvent, evt.Request.Payload); err != nil {
+			apperr.Handle(ctx, err)
+			return
+		}
+		r.ack(evt)
+
+	case socketmode.EventTypeInteractive:
+		if evt.Request == nil || len(evt.Request.Payload) == 0 {
+			logger.Warn("Socket Mode interaction without payload")
+			return
+		}
+		if err := r.handler.dispatchInteraction(ctx, evt.Request.Payload); err != nil {
+			apperr.Handle(ctx, err)
+			return
+		}
+		r.ack(evt)
+
+	default:
+		logger.Debug("Ignoring Socket Mode event", "type", evt.Type)
+	}
+}
+
+// ack acknowledges the envelope so that Slack does not retry delivery
+func (r *SocketModeRunner) ack(evt socketmode.Event) {
+	if evt.Request == nil {
+		return
+	}
+	r.client.Ack(*evt.Request)
+}
@@ -0,0 +1,169 @@
+package slack_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/cli/config"
+	"github.com/secmon-lab/lycaon/pkg/controller/slack"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces/mocks"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	"github.com/secmon-lab/lycaon/pkg/service/job"
+	slackservice "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+	slackgo "github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+	"github.com/slack-go/slack/socketmode"
+)
+
+func setupSocketModeRunner(t *testing.T, ctx context.Context, incidentMock *mocks.IncidentMock) *slack.SocketModeRunner {
+	slackConfig := &config.SlackConfig{
+		OAuthToken: "test-token",
+		AppToken:   "xapp-test",
+		SocketMode: true,
+	}
+	repo := repository.NewMemory()
+	mockLLM, mockSlack := createMockClientsForController()
+	slackSvc := slackservice.NewUIService(mockSlack, testConfig())
+	messageUC, err := usecase.NewSlackMessage(ctx, repo, mockLLM, mockSlack, slackSvc, testConfig())
+	gt.NoError(t, err).Required()
+	taskUC := usecase.NewTaskUseCase(repo, mockSlack)
+	statusUC := usecase.NewStatusUseCase(repo, slackSvc, testConfig())
+	slackInteractionUC := usecase.NewSlackInteraction(incidentMock, taskUC, statusUC, &mocks.AuthMock{}, mockSlack, slackSvc, nil)
+	jobs := job.New(repo, job.WithPollInterval(10*time.Millisecond))
+	handler := slack.NewHandler(ctx, slackConfig, repo, messageUC, incidentMock, taskUC, slackInteractionUC, mockSlack, testConfig(), jobs)
+	jobs.Start(ctx)
+	t.Cleanup(func() {
+		gt.NoError(t, jobs.Shutdown(context.Background()))
+	})
+
+	return slack.NewSocketModeRunner(ctx, handler, slackConfig.AppToken, slackConfig.OAuthToken)
+}
+
+func TestSocketModeRunnerHandleSocketEvent(t *testing.T) {
+	ctx := ctxlog.With(context.Background(), slog.New(slog.NewTextHandler(os.Stdout, nil)))
+
+	t.Run("events_api envelope is dispatched to event handler", func(t *testing.T) {
+		lookedUp := make(chan types.ChannelID, 1)
+		incidentMock := &mocks.IncidentMock{
+			GetIncidentByChannelIDFunc: func(ctx context.Context, channelID types.ChannelID) (*model.Incident, error) {
+				lookedUp <- channelID
+				return &model.Incident{ID: 1, ChannelID: channelID, Private: false}, nil
+			},
+		}
+		runner := setupSocketModeRunner(t, ctx, incidentMock)
+
+		body := []byte(`{"type":"event_callback","team_id":"T12345","event":{"type":"member_joined_channel","user":"U12345","channel":"C-INC-001"}}`)
+		eventsAPIEvent, err := slackevents.ParseEvent(body, slackevents.OptionNoVerifyToken())
+		gt.NoError(t, err).Required()
+
+		runner.HandleSocketEvent(ctx, socketmode.Event{
+			Type:    socketmode.EventTypeEventsAPI,
+			Data:    eventsAPIEvent,
+			Request: &socketmode.Request{Type: "events_api", EnvelopeID: "env-1", Payload: body},
+		})
+
+		select {
+		case channelID := <-lookedUp:
+			gt.Equal(t, types.ChannelID("C-INC-001"), channelID)
+		case <-time.After(time.Second):
+			t.Fatal("event was not dispatched within timeout")
+		}
+	})
+
+	t.Run("interactive envelope is dispatched to interaction handler", func(t *testing.T) {
+		created := make(chan string, 1)
+		incidentMock := &mocks.IncidentMock{
+			HandleCreateIncidentActionFunc: func(ctx context.Context, requestID, userID, channelID string) {
+				created <- requestID
+			},
+		}
+		runner := setupSocketModeRunner(t, ctx, incidentMock)
+
+		interaction := slackgo.InteractionCallback{
+			Type: slackgo.InteractionTypeBlockActions,
+			User: slackgo.User{ID: "U12345"},
+			Team: slackgo.Team{ID: "T12345"},
+			Channel: slackgo.Channel{
+				GroupConversation: slackgo.GroupConversation{
+					Conversation: slackgo.Conversation{ID: "C12345"},
+				},
+			},
+			ActionCallback: slackgo.ActionCallbacks{
+				BlockActions: []*slackgo.BlockAction{
+					{ActionID: "create_incident", Value: "req-socket-1", Type: slackgo.ActionType("button")},
+				},
+			},
+		}
+		payload, err := json.Marshal(interaction)
+		gt.NoError(t, err).Required()
+
+		runner.HandleSocketEvent(ctx, socketmode.Event{
+			Type:    socketmode.EventTypeInteractive,
+			Data:    interaction,
+			Request: &socketmode.Request{Type: "interactive", EnvelopeID: "env-2", Payload: payload},
+		})
+
+		select {
+		case requestID := <-created:
+			gt.Equal(t, "req-socket-1", requestID)
+		case <-time.After(time.Second):
+			t.Fatal("interaction was not dispatched within timeout")
+		}
+	})
+
+	t.Run("redelivered event is dropped", func(t *testing.T) {
+		lookedUp := make(chan types.ChannelID, 2)
+		incidentMock := &mocks.IncidentMock{
+			GetIncidentByChannelIDFunc: func(ctx context.Context, channelID types.ChannelID) (*model.Incident, error) {
+				lookedUp <- channelID
+				return &model.Incident{ID: 1, ChannelID: channelID, Private: false}, nil
+			},
+		}
+		runner := setupSocketModeRunner(t, ctx, incidentMock)
+
+		body := []byte(`{"type":"event_callback","team_id":"T12345","event_id":"Ev-dup-1","event":{"type":"member_joined_channel","user":"U12345","channel":"C-INC-001"}}`)
+		eventsAPIEvent, err := slackevents.ParseEvent(body, slackevents.OptionNoVerifyToken())
+		gt.NoError(t, err).Required()
+
+		for i := range 2 {
+			runner.HandleSocketEvent(ctx, socketmode.Event{
+				Type:    socketmode.EventTypeEventsAPI,
+				Data:    eventsAPIEvent,
+				Request: &socketmode.Request{Type: "events_api", EnvelopeID: fmt.Sprintf("env-%d", i), Payload: body},
+			})
+		}
+
+		select {
+		case <-lookedUp:
+		case <-time.After(time.Second):
+			t.Fatal("event was not dispatched within timeout")
+		}
+		select {
+		case <-lookedUp:
+			t.Fatal("duplicate event was dispatched")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("interactive envelope without payload is ignored", func(t *testing.T) {
+		incidentMock := &mocks.IncidentMock{
+			HandleCreateIncidentActionFunc: func(ctx context.Context, requestID, userID, channelID string) {
+				t.Error("unexpected dispatch")
+			},
+		}
+		runner := setupSocketModeRunner(t, ctx, incidentMock)
+
+		runner.HandleSocketEvent(ctx, socketmode.Event{Type: socketmode.EventTypeInteractive})
+		time.Sleep(50 * time.Millisecond)
+	})
+}
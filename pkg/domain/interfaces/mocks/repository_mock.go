@@ -22,24 +22,45 @@ var _ interfaces.Repository = &RepositoryMock{}
 //
 //		// make and configure a mocked interfaces.Repository
 //		mockedRepository := &RepositoryMock{
+//			AddStakeholderUpdateFunc: func(ctx context.Context, update *model.StakeholderUpdate) error {
+//				panic("mock out the AddStakeholderUpdate method")
+//			},
 //			AddStatusHistoryFunc: func(ctx context.Context, history *model.StatusHistory) error {
 //				panic("mock out the AddStatusHistory method")
 //			},
+//			ClaimJobsFunc: func(ctx context.Context, owner string, limit int, lease time.Duration) ([]*model.Job, error) {
+//				panic("mock out the ClaimJobs method")
+//			},
 //			CloseFunc: func() error {
 //				panic("mock out the Close method")
 //			},
+//			CompleteJobFunc: func(ctx context.Context, id types.JobID, owner string, leaseToken string) error {
+//				panic("mock out the CompleteJob method")
+//			},
 //			CreateTaskFunc: func(ctx context.Context, task *model.Task) error {
 //				panic("mock out the CreateTask method")
 //			},
+//			DeleteExpiredSessionsFunc: func(ctx context.Context, now time.Time) (int, error) {
+//				panic("mock out the DeleteExpiredSessions method")
+//			},
 //			DeleteIncidentRequestFunc: func(ctx context.Context, id types.IncidentRequestID) error {
 //				panic("mock out the DeleteIncidentRequest method")
 //			},
+//			DeleteJobFunc: func(ctx context.Context, id types.JobID) error {
+//				panic("mock out the DeleteJob method")
+//			},
 //			DeleteSessionFunc: func(ctx context.Context, id types.SessionID) error {
 //				panic("mock out the DeleteSession method")
 //			},
 //			DeleteTaskFunc: func(ctx context.Context, incidentID types.IncidentID, taskID types.TaskID) error {
 //				panic("mock out the DeleteTask method")
 //			},
+//			FailJobFunc: func(ctx context.Context, job *model.Job, owner string, leaseToken string) error {
+//				panic("mock out the FailJob method")
+//			},
+//			GetAPITokenFunc: func(ctx context.Context, id types.APITokenID) (*model.APIToken, error) {
+//				panic("mock out the GetAPIToken method")
+//			},
 //			GetIncidentFunc: func(ctx context.Context, id types.IncidentID) (*model.Incident, error) {
 //				panic("mock out the GetIncident method")
 //			},
@@ -49,12 +70,21 @@ var _ interfaces.Repository = &RepositoryMock{}
 //			GetIncidentRequestFunc: func(ctx context.Context, id types.IncidentRequestID) (*model.IncidentRequest, error) {
 //				panic("mock out the GetIncidentRequest method")
 //			},
+//			GetIncidentsFunc: func(ctx context.Context, ids []types.IncidentID) ([]*model.Incident, error) {
+//				panic("mock out the GetIncidents method")
+//			},
+//			GetJobFunc: func(ctx context.Context, id types.JobID) (*model.Job, error) {
+//				panic("mock out the GetJob method")
+//			},
 //			GetMessageFunc: func(ctx context.Context, id types.MessageID) (*model.Message, error) {
 //				panic("mock out the GetMessage method")
 //			},
 //			GetNextIncidentNumberFunc: func(ctx context.Context) (types.IncidentID, error) {
 //				panic("mock out the GetNextIncidentNumber method")
 //			},
+//			GetReminderFunc: func(ctx context.Context, id string) (*model.Reminder, error) {
+//				panic("mock out the GetReminder method")
+//			},
 //			GetSessionFunc: func(ctx context.Context, id types.SessionID) (*model.Session, error) {
 //				panic("mock out the GetSession method")
 //			},
@@ -67,30 +97,84 @@ var _ interfaces.Repository = &RepositoryMock{}
 //			GetTaskByIncidentFunc: func(ctx context.Context, incidentID types.IncidentID, taskID types.TaskID) (*model.Task, error) {
 //				panic("mock out the GetTaskByIncident method")
 //			},
+//			GetTranscriptFunc: func(ctx context.Context, incidentID types.IncidentID) (*model.Transcript, error) {
+//				panic("mock out the GetTranscript method")
+//			},
 //			GetUserFunc: func(ctx context.Context, id types.UserID) (*model.User, error) {
 //				panic("mock out the GetUser method")
 //			},
 //			GetUserBySlackIDFunc: func(ctx context.Context, slackUserID types.SlackUserID) (*model.User, error) {
 //				panic("mock out the GetUserBySlackID method")
 //			},
+//			ListAPITokensFunc: func(ctx context.Context) ([]*model.APIToken, error) {
+//				panic("mock out the ListAPITokens method")
+//			},
+//			ListAuditEntriesFunc: func(ctx context.Context, filter model.AuditFilter) ([]*model.AuditEntry, error) {
+//				panic("mock out the ListAuditEntries method")
+//			},
 //			ListIncidentsFunc: func(ctx context.Context) ([]*model.Incident, error) {
 //				panic("mock out the ListIncidents method")
 //			},
-//			ListIncidentsPaginatedFunc: func(ctx context.Context, opts types.PaginationOptions) ([]*model.Incident, *types.PaginationResult, error) {
+//			ListIncidentsPaginatedFunc: func(ctx context.Context, filter model.IncidentFilter, opts types.PaginationOptions) ([]*model.Incident, *types.PaginationResult, error) {
 //				panic("mock out the ListIncidentsPaginated method")
 //			},
 //			ListIncidentsSinceFunc: func(ctx context.Context, since time.Time) ([]*model.Incident, error) {
 //				panic("mock out the ListIncidentsSince method")
 //			},
+//			ListIncidentsUpdatedSinceFunc: func(ctx context.Context, since time.Time) ([]*model.Incident, error) {
+//				panic("mock out the ListIncidentsUpdatedSince method")
+//			},
+//			ListJobsByStatusFunc: func(ctx context.Context, status types.JobStatus, limit int) ([]*model.Job, error) {
+//				panic("mock out the ListJobsByStatus method")
+//			},
 //			ListMessagesFunc: func(ctx context.Context, channelID types.ChannelID, limit int) ([]*model.Message, error) {
 //				panic("mock out the ListMessages method")
 //			},
+//			ListMessagesSinceFunc: func(ctx context.Context, since time.Time) ([]*model.Message, error) {
+//				panic("mock out the ListMessagesSince method")
+//			},
+//			ListSessionsByUserFunc: func(ctx context.Context, userID types.UserID) ([]*model.Session, error) {
+//				panic("mock out the ListSessionsByUser method")
+//			},
+//			ListStakeholderUpdatesFunc: func(ctx context.Context, incidentID types.IncidentID) ([]*model.StakeholderUpdate, error) {
+//				panic("mock out the ListStakeholderUpdates method")
+//			},
+//			ListStakeholderUpdatesSinceFunc: func(ctx context.Context, since time.Time) ([]*model.StakeholderUpdate, error) {
+//				panic("mock out the ListStakeholderUpdatesSince method")
+//			},
+//			ListStatusHistoriesSinceFunc: func(ctx context.Context, since time.Time) ([]*model.StatusHistory, error) {
+//				panic("mock out the ListStatusHistoriesSince method")
+//			},
 //			ListTasksByIncidentFunc: func(ctx context.Context, incidentID types.IncidentID) ([]*model.Task, error) {
 //				panic("mock out the ListTasksByIncident method")
 //			},
+//			ListTasksUpdatedSinceFunc: func(ctx context.Context, since time.Time) ([]*model.Task, error) {
+//				panic("mock out the ListTasksUpdatedSince method")
+//			},
+//			MarkEventProcessedFunc: func(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+//				panic("mock out the MarkEventProcessed method")
+//			},
+//			PutAPITokenFunc: func(ctx context.Context, token *model.APIToken) error {
+//				panic("mock out the PutAPIToken method")
+//			},
+//			PutAuditEntryFunc: func(ctx context.Context, entry *model.AuditEntry) error {
+//				panic("mock out the PutAuditEntry method")
+//			},
 //			PutIncidentFunc: func(ctx context.Context, incident *model.Incident) error {
 //				panic("mock out the PutIncident method")
 //			},
+//			PutJobFunc: func(ctx context.Context, job *model.Job) error {
+//				panic("mock out the PutJob method")
+//			},
+//			PutReminderFunc: func(ctx context.Context, reminder *model.Reminder) error {
+//				panic("mock out the PutReminder method")
+//			},
+//			PutTranscriptFunc: func(ctx context.Context, transcript *model.Transcript) error {
+//				panic("mock out the PutTranscript method")
+//			},
+//			RenewJobLeaseFunc: func(ctx context.Context, id types.JobID, owner string, leaseToken string, until time.Time) error {
+//				panic("mock out the RenewJobLease method")
+//			},
 //			SaveIncidentRequestFunc: func(ctx context.Context, request *model.IncidentRequest) error {
 //				panic("mock out the SaveIncidentRequest method")
 //			},
@@ -103,6 +187,18 @@ var _ interfaces.Repository = &RepositoryMock{}
 //			SaveUserFunc: func(ctx context.Context, user *model.User) error {
 //				panic("mock out the SaveUser method")
 //			},
+//			TouchSessionFunc: func(ctx context.Context, session *model.Session) error {
+//				panic("mock out the TouchSession method")
+//			},
+//			UnmarkEventProcessedFunc: func(ctx context.Context, key string) error {
+//				panic("mock out the UnmarkEventProcessed method")
+//			},
+//			UpdateAPITokenLastUsedFunc: func(ctx context.Context, id types.APITokenID, lastUsedAt time.Time) error {
+//				panic("mock out the UpdateAPITokenLastUsed method")
+//			},
+//			UpdateIncidentAtomicFunc: func(ctx context.Context, incidentID types.IncidentID, updateFn func(*model.Incident) error) error {
+//				panic("mock out the UpdateIncidentAtomic method")
+//			},
 //			UpdateIncidentStatusFunc: func(ctx context.Context, incidentID types.IncidentID, status types.IncidentStatus) error {
 //				panic("mock out the UpdateIncidentStatus method")
 //			},
@@ -116,24 +212,45 @@ var _ interfaces.Repository = &RepositoryMock{}
 //
 //	}
 type RepositoryMock struct {
+	// AddStakeholderUpdateFunc mocks the AddStakeholderUpdate method.
+	AddStakeholderUpdateFunc func(ctx context.Context, update *model.StakeholderUpdate) error
+
 	// AddStatusHistoryFunc mocks the AddStatusHistory method.
 	AddStatusHistoryFunc func(ctx context.Context, history *model.StatusHistory) error
 
+	// ClaimJobsFunc mocks the ClaimJobs method.
+	ClaimJobsFunc func(ctx context.Context, owner string, limit int, lease time.Duration) ([]*model.Job, error)
+
 	// CloseFunc mocks the Close method.
 	CloseFunc func() error
 
+	// CompleteJobFunc mocks the CompleteJob method.
+	CompleteJobFunc func(ctx context.Context, id types.JobID, owner string, leaseToken string) error
+
 	// CreateTaskFunc mocks the CreateTask method.
 	CreateTaskFunc func(ctx context.Context, task *model.Task) error
 
+	// DeleteExpiredSessionsFunc mocks the DeleteExpiredSessions method.
+	DeleteExpiredSessionsFunc func(ctx context.Context, now time.Time) (int, error)
+
 	// DeleteIncidentRequestFunc mocks the DeleteIncidentRequest method.
 	DeleteIncidentRequestFunc func(ctx context.Context, id types.IncidentRequestID) error
 
+	// DeleteJobFunc mocks the DeleteJob method.
+	DeleteJobFunc func(ctx context.Context, id types.JobID) error
+
 	// DeleteSessionFunc mocks the DeleteSession method.
 	DeleteSessionFunc func(ctx context.Context, id types.SessionID) error
 
 	// DeleteTaskFunc mocks the DeleteTask method.
 	DeleteTaskFunc func(ctx context.Context, incidentID types.IncidentID, taskID types.TaskID) error
 
+	// FailJobFunc mocks the FailJob method.
+	FailJobFunc func(ctx context.Context, job *model.Job, owner string, leaseToken string) error
+
+	// GetAPITokenFunc mocks the GetAPIToken method.
+	GetAPITokenFunc func(ctx context.Context, id types.APITokenID) (*model.APIToken, error)
+
 	// GetIncidentFunc mocks the GetIncident method.
 	GetIncidentFunc func(ctx context.Context, id types.IncidentID) (*model.Incident, error)
 
@@ -143,12 +260,21 @@ type RepositoryMock struct {
 	// GetIncidentRequestFunc mocks the GetIncidentRequest method.
 	GetIncidentRequestFunc func(ctx context.Context, id types.IncidentRequestID) (*model.IncidentRequest, error)
 
+	// GetIncidentsFunc mocks the GetIncidents method.
+	GetIncidentsFunc func(ctx context.Context, ids []types.IncidentID) ([]*model.Incident, error)
+
+	// GetJobFunc mocks the GetJob method.
+	GetJobFunc func(ctx context.Context, id types.JobID) (*model.Job, error)
+
 	// GetMessageFunc mocks the GetMessage method.
 	GetMessageFunc func(ctx context.Context, id types.MessageID) (*model.Message, error)
 
 	// GetNextIncidentNumberFunc mocks the GetNextIncidentNumber method.
 	GetNextIncidentNumberFunc func(ctx context.Context) (types.IncidentID, error)
 
+	// GetReminderFunc mocks the GetReminder method.
+	GetReminderFunc func(ctx context.Context, id string) (*model.Reminder, error)
+
 	// GetSessionFunc mocks the GetSession method.
 	GetSessionFunc func(ctx context.Context, id types.SessionID) (*model.Session, error)
 
@@ -161,30 +287,84 @@ type RepositoryMock struct {
 	// GetTaskByIncidentFunc mocks the GetTaskByIncident method.
 	GetTaskByIncidentFunc func(ctx context.Context, incidentID types.IncidentID, taskID types.TaskID) (*model.Task, error)
 
+	// GetTranscriptFunc mocks the GetTranscript method.
+	GetTranscriptFunc func(ctx context.Context, incidentID types.IncidentID) (*model.Transcript, error)
+
 	// GetUserFunc mocks the GetUser method.
 	GetUserFunc func(ctx context.Context, id types.UserID) (*model.User, error)
 
 	// GetUserBySlackIDFunc mocks the GetUserBySlackID method.
 	GetUserBySlackIDFunc func(ctx context.Context, slackUserID types.SlackUserID) (*model.User, error)
 
+	// ListAPITokensFunc mocks the ListAPITokens method.
+	ListAPITokensFunc func(ctx context.Context) ([]*model.APIToken, error)
+
+	// ListAuditEntriesFunc mocks the ListAuditEntries method.
+	ListAuditEntriesFunc func(ctx context.Context, filter model.AuditFilter) ([]*model.AuditEntry, error)
+
 	// ListIncidentsFunc mocks the ListIncidents method.
 	ListIncidentsFunc func(ctx context.Context) ([]*model.Incident, error)
 
 	// ListIncidentsPaginatedFunc mocks the ListIncidentsPaginated method.
-	ListIncidentsPaginatedFunc func(ctx context.Context, opts types.PaginationOptions) ([]*model.Incident, *types.PaginationResult, error)
+	ListIncidentsPaginatedFunc func(ctx context.Context, filter model.IncidentFilter, opts types.PaginationOptions) ([]*model.Incident, *types.PaginationResult, error)
 
 	// ListIncidentsSinceFunc mocks the ListIncidentsSince method.
 	ListIncidentsSinceFunc func(ctx context.Context, since time.Time) ([]*model.Incident, error)
 
+	// ListIncidentsUpdatedSinceFunc mocks the ListIncidentsUpdatedSince method.
+	ListIncidentsUpdatedSinceFunc func(ctx context.Context, since time.Time) ([]*model.Incident, error)
+
+	// ListJobsByStatusFunc mocks the ListJobsByStatus method.
+	ListJobsByStatusFunc func(ctx context.Context, status types.JobStatus, limit int) ([]*model.Job, error)
+
 	// ListMessagesFunc mocks the ListMessages method.
 	ListMessagesFunc func(ctx context.Context, channelID types.ChannelID, limit int) ([]*model.Message, error)
 
+	// ListMessagesSinceFunc mocks the ListMessagesSince method.
+	ListMessagesSinceFunc func(ctx context.Context, since time.Time) ([]*model.Message, error)
+
+	// ListSessionsByUserFunc mocks the ListSessionsByUser method.
+	ListSessionsByUserFunc func(ctx context.Context, userID types.UserID) ([]*model.Session, error)
+
+	// ListStakeholderUpdatesFunc mocks the ListStakeholderUpdates method.
+	ListStakeholderUpdatesFunc func(ctx context.Context, incidentID types.IncidentID) ([]*model.StakeholderUpdate, error)
+
+	// ListStakeholderUpdatesSinceFunc mocks the ListStakeholderUpdatesSince method.
+	ListStakeholderUpdatesSinceFunc func(ctx context.Context, since time.Time) ([]*model.StakeholderUpdate, error)
+
+	// ListStatusHistoriesSinceFunc mocks the ListStatusHistoriesSince method.
+	ListStatusHistoriesSinceFunc func(ctx context.Context, since time.Time) ([]*model.StatusHistory, error)
+
 	// ListTasksByIncidentFunc mocks the ListTasksByIncident method.
 	ListTasksByIncidentFunc func(ctx context.Context, incidentID types.IncidentID) ([]*model.Task, error)
 
+	// ListTasksUpdatedSinceFunc mocks the ListTasksUpdatedSince method.
+	ListTasksUpdatedSinceFunc func(ctx context.Context, since time.Time) ([]*model.Task, error)
+
+	// MarkEventProcessedFunc mocks the MarkEventProcessed method.
+	MarkEventProcessedFunc func(ctx context.Context, key string, ttl time.Duration) (bool, error)
+
+	// PutAPITokenFunc mocks the PutAPIToken method.
+	PutAPITokenFunc func(ctx context.Context, token *model.APIToken) error
+
+	// PutAuditEntryFunc mocks the PutAuditEntry method.
+	PutAuditEntryFunc func(ctx context.Context, entry *model.AuditEntry) error
+
 	// PutIncidentFunc mocks the PutIncident method.
 	PutIncidentFunc func(ctx context.Context, incident *model.Incident) error
 
+	// PutJobFunc mocks the PutJob method.
+	PutJobFunc func(ctx context.Context, job *model.Job) error
+
+	// PutReminderFunc mocks the PutReminder method.
+	PutReminderFunc func(ctx context.Context, reminder *model.Reminder) error
+
+	// PutTranscriptFunc mocks the PutTranscript method.
+	PutTranscriptFunc func(ctx context.Context, transcript *model.Transcript) error
+
+	// RenewJobLeaseFunc mocks the RenewJobLease method.
+	RenewJobLeaseFunc func(ctx context.Context, id types.JobID, owner string, leaseToken string, until time.Time) error
+
 	// SaveIncidentRequestFunc mocks the SaveIncidentRequest method.
 	SaveIncidentRequestFunc func(ctx context.Context, request *model.IncidentRequest) error
 
@@ -197,6 +377,18 @@ type RepositoryMock struct {
 	// SaveUserFunc mocks the SaveUser method.
 	SaveUserFunc func(ctx context.Context, user *model.User) error
 
+	// TouchSessionFunc mocks the TouchSession method.
+	TouchSessionFunc func(ctx context.Context, session *model.Session) error
+
+	// UnmarkEventProcessedFunc mocks the UnmarkEventProcessed method.
+	UnmarkEventProcessedFunc func(ctx context.Context, key string) error
+
+	// UpdateAPITokenLastUsedFunc mocks the UpdateAPITokenLastUsed method.
+	UpdateAPITokenLastUsedFunc func(ctx context.Context, id types.APITokenID, lastUsedAt time.Time) error
+
+	// UpdateIncidentAtomicFunc mocks the UpdateIncidentAtomic method.
+	UpdateIncidentAtomicFunc func(ctx context.Context, incidentID types.IncidentID, updateFn func(*model.Incident) error) error
+
 	// UpdateIncidentStatusFunc mocks the UpdateIncidentStatus method.
 	UpdateIncidentStatusFunc func(ctx context.Context, incidentID types.IncidentID, status types.IncidentStatus) error
 
@@ -205,6 +397,13 @@ type RepositoryMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// AddStakeholderUpdate holds details about calls to the AddStakeholderUpdate method.
+		AddStakeholderUpdate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Update is the update argument value.
+			Update *model.StakeholderUpdate
+		}
 		// AddStatusHistory holds details about calls to the AddStatusHistory method.
 		AddStatusHistory []struct {
 			// Ctx is the ctx argument value.
@@ -212,9 +411,31 @@ type RepositoryMock struct {
 			// History is the history argument value.
 			History *model.StatusHistory
 		}
+		// ClaimJobs holds details about calls to the ClaimJobs method.
+		ClaimJobs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner string
+			// Limit is the limit argument value.
+			Limit int
+			// Lease is the lease argument value.
+			Lease time.Duration
+		}
 		// Close holds details about calls to the Close method.
 		Close []struct {
 		}
+		// CompleteJob holds details about calls to the CompleteJob method.
+		CompleteJob []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID types.JobID
+			// Owner is the owner argument value.
+			Owner string
+			// LeaseToken is the leaseToken argument value.
+			LeaseToken string
+		}
 		// CreateTask holds details about calls to the CreateTask method.
 		CreateTask []struct {
 			// Ctx is the ctx argument value.
@@ -222,6 +443,13 @@ type RepositoryMock struct {
 			// Task is the task argument value.
 			Task *model.Task
 		}
+		// DeleteExpiredSessions holds details about calls to the DeleteExpiredSessions method.
+		DeleteExpiredSessions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Now is the now argument value.
+			Now time.Time
+		}
 		// DeleteIncidentRequest holds details about calls to the DeleteIncidentRequest method.
 		DeleteIncidentRequest []struct {
 			// Ctx is the ctx argument value.
@@ -229,6 +457,13 @@ type RepositoryMock struct {
 			// ID is the id argument value.
 			ID types.IncidentRequestID
 		}
+		// DeleteJob holds details about calls to the DeleteJob method.
+		DeleteJob []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID types.JobID
+		}
 		// DeleteSession holds details about calls to the DeleteSession method.
 		DeleteSession []struct {
 			// Ctx is the ctx argument value.
@@ -245,6 +480,24 @@ type RepositoryMock struct {
 			// TaskID is the taskID argument value.
 			TaskID types.TaskID
 		}
+		// FailJob holds details about calls to the FailJob method.
+		FailJob []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Job is the job argument value.
+			Job *model.Job
+			// Owner is the owner argument value.
+			Owner string
+			// LeaseToken is the leaseToken argument value.
+			LeaseToken string
+		}
+		// GetAPIToken holds details about calls to the GetAPIToken method.
+		GetAPIToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID types.APITokenID
+		}
 		// GetIncident holds details about calls to the GetIncident method.
 		GetIncident []struct {
 			// Ctx is the ctx argument value.
@@ -266,6 +519,20 @@ type RepositoryMock struct {
 			// ID is the id argument value.
 			ID types.IncidentRequestID
 		}
+		// GetIncidents holds details about calls to the GetIncidents method.
+		GetIncidents []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Ids is the ids argument value.
+			Ids []types.IncidentID
+		}
+		// GetJob holds details about calls to the GetJob method.
+		GetJob []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID types.JobID
+		}
 		// GetMessage holds details about calls to the GetMessage method.
 		GetMessage []struct {
 			// Ctx is the ctx argument value.
@@ -278,6 +545,13 @@ type RepositoryMock struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// GetReminder holds details about calls to the GetReminder method.
+		GetReminder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
 		// GetSession holds details about calls to the GetSession method.
 		GetSession []struct {
 			// Ctx is the ctx argument value.
@@ -308,6 +582,13 @@ type RepositoryMock struct {
 			// TaskID is the taskID argument value.
 			TaskID types.TaskID
 		}
+		// GetTranscript holds details about calls to the GetTranscript method.
+		GetTranscript []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// IncidentID is the incidentID argument value.
+			IncidentID types.IncidentID
+		}
 		// GetUser holds details about calls to the GetUser method.
 		GetUser []struct {
 			// Ctx is the ctx argument value.
@@ -322,6 +603,18 @@ type RepositoryMock struct {
 			// SlackUserID is the slackUserID argument value.
 			SlackUserID types.SlackUserID
 		}
+		// ListAPITokens holds details about calls to the ListAPITokens method.
+		ListAPITokens []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ListAuditEntries holds details about calls to the ListAuditEntries method.
+		ListAuditEntries []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter model.AuditFilter
+		}
 		// ListIncidents holds details about calls to the ListIncidents method.
 		ListIncidents []struct {
 			// Ctx is the ctx argument value.
@@ -331,6 +624,8 @@ type RepositoryMock struct {
 		ListIncidentsPaginated []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter model.IncidentFilter
 			// Opts is the opts argument value.
 			Opts types.PaginationOptions
 		}
@@ -341,6 +636,22 @@ type RepositoryMock struct {
 			// Since is the since argument value.
 			Since time.Time
 		}
+		// ListIncidentsUpdatedSince holds details about calls to the ListIncidentsUpdatedSince method.
+		ListIncidentsUpdatedSince []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Since is the since argument value.
+			Since time.Time
+		}
+		// ListJobsByStatus holds details about calls to the ListJobsByStatus method.
+		ListJobsByStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Status is the status argument value.
+			Status types.JobStatus
+			// Limit is the limit argument value.
+			Limit int
+		}
 		// ListMessages holds details about calls to the ListMessages method.
 		ListMessages []struct {
 			// Ctx is the ctx argument value.
@@ -350,6 +661,41 @@ type RepositoryMock struct {
 			// Limit is the limit argument value.
 			Limit int
 		}
+		// ListMessagesSince holds details about calls to the ListMessagesSince method.
+		ListMessagesSince []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Since is the since argument value.
+			Since time.Time
+		}
+		// ListSessionsByUser holds details about calls to the ListSessionsByUser method.
+		ListSessionsByUser []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID types.UserID
+		}
+		// ListStakeholderUpdates holds details about calls to the ListStakeholderUpdates method.
+		ListStakeholderUpdates []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// IncidentID is the incidentID argument value.
+			IncidentID types.IncidentID
+		}
+		// ListStakeholderUpdatesSince holds details about calls to the ListStakeholderUpdatesSince method.
+		ListStakeholderUpdatesSince []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Since is the since argument value.
+			Since time.Time
+		}
+		// ListStatusHistoriesSince holds details about calls to the ListStatusHistoriesSince method.
+		ListStatusHistoriesSince []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Since is the since argument value.
+			Since time.Time
+		}
 		// ListTasksByIncident holds details about calls to the ListTasksByIncident method.
 		ListTasksByIncident []struct {
 			// Ctx is the ctx argument value.
@@ -357,6 +703,36 @@ type RepositoryMock struct {
 			// IncidentID is the incidentID argument value.
 			IncidentID types.IncidentID
 		}
+		// ListTasksUpdatedSince holds details about calls to the ListTasksUpdatedSince method.
+		ListTasksUpdatedSince []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Since is the since argument value.
+			Since time.Time
+		}
+		// MarkEventProcessed holds details about calls to the MarkEventProcessed method.
+		MarkEventProcessed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key string
+			// TTL is the ttl argument value.
+			TTL time.Duration
+		}
+		// PutAPIToken holds details about calls to the PutAPIToken method.
+		PutAPIToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token *model.APIToken
+		}
+		// PutAuditEntry holds details about calls to the PutAuditEntry method.
+		PutAuditEntry []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Entry is the entry argument value.
+			Entry *model.AuditEntry
+		}
 		// PutIncident holds details about calls to the PutIncident method.
 		PutIncident []struct {
 			// Ctx is the ctx argument value.
@@ -364,6 +740,40 @@ type RepositoryMock struct {
 			// Incident is the incident argument value.
 			Incident *model.Incident
 		}
+		// PutJob holds details about calls to the PutJob method.
+		PutJob []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Job is the job argument value.
+			Job *model.Job
+		}
+		// PutReminder holds details about calls to the PutReminder method.
+		PutReminder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reminder is the reminder argument value.
+			Reminder *model.Reminder
+		}
+		// PutTranscript holds details about calls to the PutTranscript method.
+		PutTranscript []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Transcript is the transcript argument value.
+			Transcript *model.Transcript
+		}
+		// RenewJobLease holds details about calls to the RenewJobLease method.
+		RenewJobLease []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID types.JobID
+			// Owner is the owner argument value.
+			Owner string
+			// LeaseToken is the leaseToken argument value.
+			LeaseToken string
+			// Until is the until argument value.
+			Until time.Time
+		}
 		// SaveIncidentRequest holds details about calls to the SaveIncidentRequest method.
 		SaveIncidentRequest []struct {
 			// Ctx is the ctx argument value.
@@ -392,6 +802,38 @@ type RepositoryMock struct {
 			// User is the user argument value.
 			User *model.User
 		}
+		// TouchSession holds details about calls to the TouchSession method.
+		TouchSession []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Session is the session argument value.
+			Session *model.Session
+		}
+		// UnmarkEventProcessed holds details about calls to the UnmarkEventProcessed method.
+		UnmarkEventProcessed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Key is the key argument value.
+			Key string
+		}
+		// UpdateAPITokenLastUsed holds details about calls to the UpdateAPITokenLastUsed method.
+		UpdateAPITokenLastUsed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID types.APITokenID
+			// LastUsedAt is the lastUsedAt argument value.
+			LastUsedAt time.Time
+		}
+		// UpdateIncidentAtomic holds details about calls to the UpdateIncidentAtomic method.
+		UpdateIncidentAtomic []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// IncidentID is the incidentID argument value.
+			IncidentID types.IncidentID
+			// UpdateFn is the updateFn argument value.
+			UpdateFn func(*model.Incident) error
+		}
 		// UpdateIncidentStatus holds details about calls to the UpdateIncidentStatus method.
 		UpdateIncidentStatus []struct {
 			// Ctx is the ctx argument value.
@@ -409,35 +851,103 @@ type RepositoryMock struct {
 			Task *model.Task
 		}
 	}
-	lockAddStatusHistory       sync.RWMutex
-	lockClose                  sync.RWMutex
-	lockCreateTask             sync.RWMutex
-	lockDeleteIncidentRequest  sync.RWMutex
-	lockDeleteSession          sync.RWMutex
-	lockDeleteTask             sync.RWMutex
-	lockGetIncident            sync.RWMutex
-	lockGetIncidentByChannelID sync.RWMutex
-	lockGetIncidentRequest     sync.RWMutex
-	lockGetMessage             sync.RWMutex
-	lockGetNextIncidentNumber  sync.RWMutex
-	lockGetSession             sync.RWMutex
-	lockGetStatusHistories     sync.RWMutex
-	lockGetTask                sync.RWMutex
-	lockGetTaskByIncident      sync.RWMutex
-	lockGetUser                sync.RWMutex
-	lockGetUserBySlackID       sync.RWMutex
-	lockListIncidents          sync.RWMutex
-	lockListIncidentsPaginated sync.RWMutex
-	lockListIncidentsSince     sync.RWMutex
-	lockListMessages           sync.RWMutex
-	lockListTasksByIncident    sync.RWMutex
-	lockPutIncident            sync.RWMutex
-	lockSaveIncidentRequest    sync.RWMutex
-	lockSaveMessage            sync.RWMutex
-	lockSaveSession            sync.RWMutex
-	lockSaveUser               sync.RWMutex
-	lockUpdateIncidentStatus   sync.RWMutex
-	lockUpdateTask             sync.RWMutex
+	lockAddStakeholderUpdate        sync.RWMutex
+	lockAddStatusHistory            sync.RWMutex
+	lockClaimJobs                   sync.RWMutex
+	lockClose                       sync.RWMutex
+	lockCompleteJob                 sync.RWMutex
+	lockCreateTask                  sync.RWMutex
+	lockDeleteExpiredSessions       sync.RWMutex
+	lockDeleteIncidentRequest       sync.RWMutex
+	lockDeleteJob                   sync.RWMutex
+	lockDeleteSession               sync.RWMutex
+	lockDeleteTask                  sync.RWMutex
+	lockFailJob                     sync.RWMutex
+	lockGetAPIToken                 sync.RWMutex
+	lockGetIncident                 sync.RWMutex
+	lockGetIncidentByChannelID      sync.RWMutex
+	lockGetIncidentRequest          sync.RWMutex
+	lockGetIncidents                sync.RWMutex
+	lockGetJob                      sync.RWMutex
+	lockGetMessage                  sync.RWMutex
+	lockGetNextIncidentNumber       sync.RWMutex
+	lockGetReminder                 sync.RWMutex
+	lockGetSession                  sync.RWMutex
+	lockGetStatusHistories          sync.RWMutex
+	lockGetTask                     sync.RWMutex
+	lockGetTaskByIncident           sync.RWMutex
+	lockGetTranscript               sync.RWMutex
+	lockGetUser                     sync.RWMutex
+	lockGetUserBySlackID            sync.RWMutex
+	lockListAPITokens               sync.RWMutex
+	lockListAuditEntries            sync.RWMutex
+	lockListIncidents               sync.RWMutex
+	lockListIncidentsPaginated      sync.RWMutex
+	lockListIncidentsSince          sync.RWMutex
+	lockListIncidentsUpdatedSince   sync.RWMutex
+	lockListJobsByStatus            sync.RWMutex
+	lockListMessages                sync.RWMutex
+	lockListMessagesSince           sync.RWMutex
+	lockListSessionsByUser          sync.RWMutex
+	lockListStakeholderUpdates      sync.RWMutex
+	lockListStakeholderUpdatesSince sync.RWMutex
+	lockListStatusHistoriesSince    sync.RWMutex
+	lockListTasksByIncident         sync.RWMutex
+	lockListTasksUpdatedSince       sync.RWMutex
+	lockMarkEventProcessed          sync.RWMutex
+	lockPutAPIToken                 sync.RWMutex
+	lockPutAuditEntry               sync.RWMutex
+	lockPutIncident                 sync.RWMutex
+	lockPutJob                      sync.RWMutex
+	lockPutReminder                 sync.RWMutex
+	lockPutTranscript               sync.RWMutex
+	lockRenewJobLease               sync.RWMutex
+	lockSaveIncidentRequest         sync.RWMutex
+	lockSaveMessage                 sync.RWMutex
+	lockSaveSession                 sync.RWMutex
+	lockSaveUser                    sync.RWMutex
+	lockTouchSession                sync.RWMutex
+	lockUnmarkEventProcessed        sync.RWMutex
+	lockUpdateAPITokenLastUsed      sync.RWMutex
+	lockUpdateIncidentAtomic        sync.RWMutex
+	lockUpdateIncidentStatus        sync.RWMutex
+	lockUpdateTask                  sync.RWMutex
+}
+
+// AddStakeholderUpdate calls AddStakeholderUpdateFunc.
+func (mock *RepositoryMock) AddStakeholderUpdate(ctx context.Context, update *model.StakeholderUpdate) error {
+	if mock.AddStakeholderUpdateFunc == nil {
+		panic("RepositoryMock.AddStakeholderUpdateFunc: method is nil but Repository.AddStakeholderUpdate was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Update *model.StakeholderUpdate
+	}{
+		Ctx:    ctx,
+		Update: update,
+	}
+	mock.lockAddStakeholderUpdate.Lock()
+	mock.calls.AddStakeholderUpdate = append(mock.calls.AddStakeholderUpdate, callInfo)
+	mock.lockAddStakeholderUpdate.Unlock()
+	return mock.AddStakeholderUpdateFunc(ctx, update)
+}
+
+// AddStakeholderUpdateCalls gets all the calls that were made to AddStakeholderUpdate.
+// Check the length with:
+//
+//	len(mockedRepository.AddStakeholderUpdateCalls())
+func (mock *RepositoryMock) AddStakeholderUpdateCalls() []struct {
+	Ctx    context.Context
+	Update *model.StakeholderUpdate
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Update *model.StakeholderUpdate
+	}
+	mock.lockAddStakeholderUpdate.RLock()
+	calls = mock.calls.AddStakeholderUpdate
+	mock.lockAddStakeholderUpdate.RUnlock()
+	return calls
 }
 
 // AddStatusHistory calls AddStatusHistoryFunc.
@@ -476,6 +986,50 @@ func (mock *RepositoryMock) AddStatusHistoryCalls() []struct {
 	return calls
 }
 
+// ClaimJobs calls ClaimJobsFunc.
+func (mock *RepositoryMock) ClaimJobs(ctx context.Context, owner string, limit int, lease time.Duration) ([]*model.Job, error) {
+	if mock.ClaimJobsFunc == nil {
+		panic("RepositoryMock.ClaimJobsFunc: method is nil but Repository.ClaimJobs was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Owner string
+		Limit int
+		Lease time.Duration
+	}{
+		Ctx:   ctx,
+		Owner: owner,
+		Limit: limit,
+		Lease: lease,
+	}
+	mock.lockClaimJobs.Lock()
+	mock.calls.ClaimJobs = append(mock.calls.ClaimJobs, callInfo)
+	mock.lockClaimJobs.Unlock()
+	return mock.ClaimJobsFunc(ctx, owner, limit, lease)
+}
+
+// ClaimJobsCalls gets all the calls that were made to ClaimJobs.
+// Check the length with:
+//
+//	len(mockedRepository.ClaimJobsCalls())
+func (mock *RepositoryMock) ClaimJobsCalls() []struct {
+	Ctx   context.Context
+	Owner string
+	Limit int
+	Lease time.Duration
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Owner string
+		Limit int
+		Lease time.Duration
+	}
+	mock.lockClaimJobs.RLock()
+	calls = mock.calls.ClaimJobs
+	mock.lockClaimJobs.RUnlock()
+	return calls
+}
+
 // Close calls CloseFunc.
 func (mock *RepositoryMock) Close() error {
 	if mock.CloseFunc == nil {
@@ -503,6 +1057,50 @@ func (mock *RepositoryMock) CloseCalls() []struct {
 	return calls
 }
 
+// CompleteJob calls CompleteJobFunc.
+func (mock *RepositoryMock) CompleteJob(ctx context.Context, id types.JobID, owner string, leaseToken string) error {
+	if mock.CompleteJobFunc == nil {
+		panic("RepositoryMock.CompleteJobFunc: method is nil but Repository.CompleteJob was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ID         types.JobID
+		Owner      string
+		LeaseToken string
+	}{
+		Ctx:        ctx,
+		ID:         id,
+		Owner:      owner,
+		LeaseToken: leaseToken,
+	}
+	mock.lockCompleteJob.Lock()
+	mock.calls.CompleteJob = append(mock.calls.CompleteJob, callInfo)
+	mock.lockCompleteJob.Unlock()
+	return mock.CompleteJobFunc(ctx, id, owner, leaseToken)
+}
+
+// CompleteJobCalls gets all the calls that were made to CompleteJob.
+// Check the length with:
+//
+//	len(mockedRepository.CompleteJobCalls())
+func (mock *RepositoryMock) CompleteJobCalls() []struct {
+	Ctx        context.Context
+	ID         types.JobID
+	Owner      string
+	LeaseToken string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ID         types.JobID
+		Owner      string
+		LeaseToken string
+	}
+	mock.lockCompleteJob.RLock()
+	calls = mock.calls.CompleteJob
+	mock.lockCompleteJob.RUnlock()
+	return calls
+}
+
 // CreateTask calls CreateTaskFunc.
 func (mock *RepositoryMock) CreateTask(ctx context.Context, task *model.Task) error {
 	if mock.CreateTaskFunc == nil {
@@ -539,31 +1137,67 @@ func (mock *RepositoryMock) CreateTaskCalls() []struct {
 	return calls
 }
 
-// DeleteIncidentRequest calls DeleteIncidentRequestFunc.
-func (mock *RepositoryMock) DeleteIncidentRequest(ctx context.Context, id types.IncidentRequestID) error {
-	if mock.DeleteIncidentRequestFunc == nil {
-		panic("RepositoryMock.DeleteIncidentRequestFunc: method is nil but Repository.DeleteIncidentRequest was just called")
+// DeleteExpiredSessions calls DeleteExpiredSessionsFunc.
+func (mock *RepositoryMock) DeleteExpiredSessions(ctx context.Context, now time.Time) (int, error) {
+	if mock.DeleteExpiredSessionsFunc == nil {
+		panic("RepositoryMock.DeleteExpiredSessionsFunc: method is nil but Repository.DeleteExpiredSessions was just called")
 	}
 	callInfo := struct {
 		Ctx context.Context
-		ID  types.IncidentRequestID
+		Now time.Time
 	}{
 		Ctx: ctx,
-		ID:  id,
+		Now: now,
 	}
-	mock.lockDeleteIncidentRequest.Lock()
-	mock.calls.DeleteIncidentRequest = append(mock.calls.DeleteIncidentRequest, callInfo)
-	mock.lockDeleteIncidentRequest.Unlock()
-	return mock.DeleteIncidentRequestFunc(ctx, id)
+	mock.lockDeleteExpiredSessions.Lock()
+	mock.calls.DeleteExpiredSessions = append(mock.calls.DeleteExpiredSessions, callInfo)
+	mock.lockDeleteExpiredSessions.Unlock()
+	return mock.DeleteExpiredSessionsFunc(ctx, now)
 }
 
-// DeleteIncidentRequestCalls gets all the calls that were made to DeleteIncidentRequest.
+// DeleteExpiredSessionsCalls gets all the calls that were made to DeleteExpiredSessions.
 // Check the length with:
 //
-//	len(mockedRepository.DeleteIncidentRequestCalls())
-func (mock *RepositoryMock) DeleteIncidentRequestCalls() []struct {
+//	len(mockedRepository.DeleteExpiredSessionsCalls())
+func (mock *RepositoryMock) DeleteExpiredSessionsCalls() []struct {
 	Ctx context.Context
-	ID  types.IncidentRequestID
+	Now time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		Now time.Time
+	}
+	mock.lockDeleteExpiredSessions.RLock()
+	calls = mock.calls.DeleteExpiredSessions
+	mock.lockDeleteExpiredSessions.RUnlock()
+	return calls
+}
+
+// DeleteIncidentRequest calls DeleteIncidentRequestFunc.
+func (mock *RepositoryMock) DeleteIncidentRequest(ctx context.Context, id types.IncidentRequestID) error {
+	if mock.DeleteIncidentRequestFunc == nil {
+		panic("RepositoryMock.DeleteIncidentRequestFunc: method is nil but Repository.DeleteIncidentRequest was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  types.IncidentRequestID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteIncidentRequest.Lock()
+	mock.calls.DeleteIncidentRequest = append(mock.calls.DeleteIncidentRequest, callInfo)
+	mock.lockDeleteIncidentRequest.Unlock()
+	return mock.DeleteIncidentRequestFunc(ctx, id)
+}
+
+// DeleteIncidentRequestCalls gets all the calls that were made to DeleteIncidentRequest.
+// Check the length with:
+//
+//	len(mockedRepository.DeleteIncidentRequestCalls())
+func (mock *RepositoryMock) DeleteIncidentRequestCalls() []struct {
+	Ctx context.Context
+	ID  types.IncidentRequestID
 } {
 	var calls []struct {
 		Ctx context.Context
@@ -575,6 +1209,42 @@ func (mock *RepositoryMock) DeleteIncidentRequestCalls() []struct {
 	return calls
 }
 
+// DeleteJob calls DeleteJobFunc.
+func (mock *RepositoryMock) DeleteJob(ctx context.Context, id types.JobID) error {
+	if mock.DeleteJobFunc == nil {
+		panic("RepositoryMock.DeleteJobFunc: method is nil but Repository.DeleteJob was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  types.JobID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDeleteJob.Lock()
+	mock.calls.DeleteJob = append(mock.calls.DeleteJob, callInfo)
+	mock.lockDeleteJob.Unlock()
+	return mock.DeleteJobFunc(ctx, id)
+}
+
+// DeleteJobCalls gets all the calls that were made to DeleteJob.
+// Check the length with:
+//
+//	len(mockedRepository.DeleteJobCalls())
+func (mock *RepositoryMock) DeleteJobCalls() []struct {
+	Ctx context.Context
+	ID  types.JobID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  types.JobID
+	}
+	mock.lockDeleteJob.RLock()
+	calls = mock.calls.DeleteJob
+	mock.lockDeleteJob.RUnlock()
+	return calls
+}
+
 // DeleteSession calls DeleteSessionFunc.
 func (mock *RepositoryMock) DeleteSession(ctx context.Context, id types.SessionID) error {
 	if mock.DeleteSessionFunc == nil {
@@ -651,6 +1321,86 @@ func (mock *RepositoryMock) DeleteTaskCalls() []struct {
 	return calls
 }
 
+// FailJob calls FailJobFunc.
+func (mock *RepositoryMock) FailJob(ctx context.Context, job *model.Job, owner string, leaseToken string) error {
+	if mock.FailJobFunc == nil {
+		panic("RepositoryMock.FailJobFunc: method is nil but Repository.FailJob was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Job        *model.Job
+		Owner      string
+		LeaseToken string
+	}{
+		Ctx:        ctx,
+		Job:        job,
+		Owner:      owner,
+		LeaseToken: leaseToken,
+	}
+	mock.lockFailJob.Lock()
+	mock.calls.FailJob = append(mock.calls.FailJob, callInfo)
+	mock.lockFailJob.Unlock()
+	return mock.FailJobFunc(ctx, job, owner, leaseToken)
+}
+
+// FailJobCalls gets all the calls that were made to FailJob.
+// Check the length with:
+//
+//	len(mockedRepository.FailJobCalls())
+func (mock *RepositoryMock) FailJobCalls() []struct {
+	Ctx        context.Context
+	Job        *model.Job
+	Owner      string
+	LeaseToken string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Job        *model.Job
+		Owner      string
+		LeaseToken string
+	}
+	mock.lockFailJob.RLock()
+	calls = mock.calls.FailJob
+	mock.lockFailJob.RUnlock()
+	return calls
+}
+
+// GetAPIToken calls GetAPITokenFunc.
+func (mock *RepositoryMock) GetAPIToken(ctx context.Context, id types.APITokenID) (*model.APIToken, error) {
+	if mock.GetAPITokenFunc == nil {
+		panic("RepositoryMock.GetAPITokenFunc: method is nil but Repository.GetAPIToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  types.APITokenID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetAPIToken.Lock()
+	mock.calls.GetAPIToken = append(mock.calls.GetAPIToken, callInfo)
+	mock.lockGetAPIToken.Unlock()
+	return mock.GetAPITokenFunc(ctx, id)
+}
+
+// GetAPITokenCalls gets all the calls that were made to GetAPIToken.
+// Check the length with:
+//
+//	len(mockedRepository.GetAPITokenCalls())
+func (mock *RepositoryMock) GetAPITokenCalls() []struct {
+	Ctx context.Context
+	ID  types.APITokenID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  types.APITokenID
+	}
+	mock.lockGetAPIToken.RLock()
+	calls = mock.calls.GetAPIToken
+	mock.lockGetAPIToken.RUnlock()
+	return calls
+}
+
 // GetIncident calls GetIncidentFunc.
 func (mock *RepositoryMock) GetIncident(ctx context.Context, id types.IncidentID) (*model.Incident, error) {
 	if mock.GetIncidentFunc == nil {
@@ -759,6 +1509,78 @@ func (mock *RepositoryMock) GetIncidentRequestCalls() []struct {
 	return calls
 }
 
+// GetIncidents calls GetIncidentsFunc.
+func (mock *RepositoryMock) GetIncidents(ctx context.Context, ids []types.IncidentID) ([]*model.Incident, error) {
+	if mock.GetIncidentsFunc == nil {
+		panic("RepositoryMock.GetIncidentsFunc: method is nil but Repository.GetIncidents was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Ids []types.IncidentID
+	}{
+		Ctx: ctx,
+		Ids: ids,
+	}
+	mock.lockGetIncidents.Lock()
+	mock.calls.GetIncidents = append(mock.calls.GetIncidents, callInfo)
+	mock.lockGetIncidents.Unlock()
+	return mock.GetIncidentsFunc(ctx, ids)
+}
+
+// GetIncidentsCalls gets all the calls that were made to GetIncidents.
+// Check the length with:
+//
+//	len(mockedRepository.GetIncidentsCalls())
+func (mock *RepositoryMock) GetIncidentsCalls() []struct {
+	Ctx context.Context
+	Ids []types.IncidentID
+} {
+	var calls []struct {
+		Ctx context.Context
+		Ids []types.IncidentID
+	}
+	mock.lockGetIncidents.RLock()
+	calls = mock.calls.GetIncidents
+	mock.lockGetIncidents.RUnlock()
+	return calls
+}
+
+// GetJob calls GetJobFunc.
+func (mock *RepositoryMock) GetJob(ctx context.Context, id types.JobID) (*model.Job, error) {
+	if mock.GetJobFunc == nil {
+		panic("RepositoryMock.GetJobFunc: method is nil but Repository.GetJob was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  types.JobID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetJob.Lock()
+	mock.calls.GetJob = append(mock.calls.GetJob, callInfo)
+	mock.lockGetJob.Unlock()
+	return mock.GetJobFunc(ctx, id)
+}
+
+// GetJobCalls gets all the calls that were made to GetJob.
+// Check the length with:
+//
+//	len(mockedRepository.GetJobCalls())
+func (mock *RepositoryMock) GetJobCalls() []struct {
+	Ctx context.Context
+	ID  types.JobID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  types.JobID
+	}
+	mock.lockGetJob.RLock()
+	calls = mock.calls.GetJob
+	mock.lockGetJob.RUnlock()
+	return calls
+}
+
 // GetMessage calls GetMessageFunc.
 func (mock *RepositoryMock) GetMessage(ctx context.Context, id types.MessageID) (*model.Message, error) {
 	if mock.GetMessageFunc == nil {
@@ -827,6 +1649,42 @@ func (mock *RepositoryMock) GetNextIncidentNumberCalls() []struct {
 	return calls
 }
 
+// GetReminder calls GetReminderFunc.
+func (mock *RepositoryMock) GetReminder(ctx context.Context, id string) (*model.Reminder, error) {
+	if mock.GetReminderFunc == nil {
+		panic("RepositoryMock.GetReminderFunc: method is nil but Repository.GetReminder was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetReminder.Lock()
+	mock.calls.GetReminder = append(mock.calls.GetReminder, callInfo)
+	mock.lockGetReminder.Unlock()
+	return mock.GetReminderFunc(ctx, id)
+}
+
+// GetReminderCalls gets all the calls that were made to GetReminder.
+// Check the length with:
+//
+//	len(mockedRepository.GetReminderCalls())
+func (mock *RepositoryMock) GetReminderCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetReminder.RLock()
+	calls = mock.calls.GetReminder
+	mock.lockGetReminder.RUnlock()
+	return calls
+}
+
 // GetSession calls GetSessionFunc.
 func (mock *RepositoryMock) GetSession(ctx context.Context, id types.SessionID) (*model.Session, error) {
 	if mock.GetSessionFunc == nil {
@@ -975,6 +1833,42 @@ func (mock *RepositoryMock) GetTaskByIncidentCalls() []struct {
 	return calls
 }
 
+// GetTranscript calls GetTranscriptFunc.
+func (mock *RepositoryMock) GetTranscript(ctx context.Context, incidentID types.IncidentID) (*model.Transcript, error) {
+	if mock.GetTranscriptFunc == nil {
+		panic("RepositoryMock.GetTranscriptFunc: method is nil but Repository.GetTranscript was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		IncidentID types.IncidentID
+	}{
+		Ctx:        ctx,
+		IncidentID: incidentID,
+	}
+	mock.lockGetTranscript.Lock()
+	mock.calls.GetTranscript = append(mock.calls.GetTranscript, callInfo)
+	mock.lockGetTranscript.Unlock()
+	return mock.GetTranscriptFunc(ctx, incidentID)
+}
+
+// GetTranscriptCalls gets all the calls that were made to GetTranscript.
+// Check the length with:
+//
+//	len(mockedRepository.GetTranscriptCalls())
+func (mock *RepositoryMock) GetTranscriptCalls() []struct {
+	Ctx        context.Context
+	IncidentID types.IncidentID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		IncidentID types.IncidentID
+	}
+	mock.lockGetTranscript.RLock()
+	calls = mock.calls.GetTranscript
+	mock.lockGetTranscript.RUnlock()
+	return calls
+}
+
 // GetUser calls GetUserFunc.
 func (mock *RepositoryMock) GetUser(ctx context.Context, id types.UserID) (*model.User, error) {
 	if mock.GetUserFunc == nil {
@@ -1047,6 +1941,74 @@ func (mock *RepositoryMock) GetUserBySlackIDCalls() []struct {
 	return calls
 }
 
+// ListAPITokens calls ListAPITokensFunc.
+func (mock *RepositoryMock) ListAPITokens(ctx context.Context) ([]*model.APIToken, error) {
+	if mock.ListAPITokensFunc == nil {
+		panic("RepositoryMock.ListAPITokensFunc: method is nil but Repository.ListAPITokens was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListAPITokens.Lock()
+	mock.calls.ListAPITokens = append(mock.calls.ListAPITokens, callInfo)
+	mock.lockListAPITokens.Unlock()
+	return mock.ListAPITokensFunc(ctx)
+}
+
+// ListAPITokensCalls gets all the calls that were made to ListAPITokens.
+// Check the length with:
+//
+//	len(mockedRepository.ListAPITokensCalls())
+func (mock *RepositoryMock) ListAPITokensCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListAPITokens.RLock()
+	calls = mock.calls.ListAPITokens
+	mock.lockListAPITokens.RUnlock()
+	return calls
+}
+
+// ListAuditEntries calls ListAuditEntriesFunc.
+func (mock *RepositoryMock) ListAuditEntries(ctx context.Context, filter model.AuditFilter) ([]*model.AuditEntry, error) {
+	if mock.ListAuditEntriesFunc == nil {
+		panic("RepositoryMock.ListAuditEntriesFunc: method is nil but Repository.ListAuditEntries was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Filter model.AuditFilter
+	}{
+		Ctx:    ctx,
+		Filter: filter,
+	}
+	mock.lockListAuditEntries.Lock()
+	mock.calls.ListAuditEntries = append(mock.calls.ListAuditEntries, callInfo)
+	mock.lockListAuditEntries.Unlock()
+	return mock.ListAuditEntriesFunc(ctx, filter)
+}
+
+// ListAuditEntriesCalls gets all the calls that were made to ListAuditEntries.
+// Check the length with:
+//
+//	len(mockedRepository.ListAuditEntriesCalls())
+func (mock *RepositoryMock) ListAuditEntriesCalls() []struct {
+	Ctx    context.Context
+	Filter model.AuditFilter
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Filter model.AuditFilter
+	}
+	mock.lockListAuditEntries.RLock()
+	calls = mock.calls.ListAuditEntries
+	mock.lockListAuditEntries.RUnlock()
+	return calls
+}
+
 // ListIncidents calls ListIncidentsFunc.
 func (mock *RepositoryMock) ListIncidents(ctx context.Context) ([]*model.Incident, error) {
 	if mock.ListIncidentsFunc == nil {
@@ -1080,21 +2042,23 @@ func (mock *RepositoryMock) ListIncidentsCalls() []struct {
 }
 
 // ListIncidentsPaginated calls ListIncidentsPaginatedFunc.
-func (mock *RepositoryMock) ListIncidentsPaginated(ctx context.Context, opts types.PaginationOptions) ([]*model.Incident, *types.PaginationResult, error) {
+func (mock *RepositoryMock) ListIncidentsPaginated(ctx context.Context, filter model.IncidentFilter, opts types.PaginationOptions) ([]*model.Incident, *types.PaginationResult, error) {
 	if mock.ListIncidentsPaginatedFunc == nil {
 		panic("RepositoryMock.ListIncidentsPaginatedFunc: method is nil but Repository.ListIncidentsPaginated was just called")
 	}
 	callInfo := struct {
-		Ctx  context.Context
-		Opts types.PaginationOptions
+		Ctx    context.Context
+		Filter model.IncidentFilter
+		Opts   types.PaginationOptions
 	}{
-		Ctx:  ctx,
-		Opts: opts,
+		Ctx:    ctx,
+		Filter: filter,
+		Opts:   opts,
 	}
 	mock.lockListIncidentsPaginated.Lock()
 	mock.calls.ListIncidentsPaginated = append(mock.calls.ListIncidentsPaginated, callInfo)
 	mock.lockListIncidentsPaginated.Unlock()
-	return mock.ListIncidentsPaginatedFunc(ctx, opts)
+	return mock.ListIncidentsPaginatedFunc(ctx, filter, opts)
 }
 
 // ListIncidentsPaginatedCalls gets all the calls that were made to ListIncidentsPaginated.
@@ -1102,12 +2066,14 @@ func (mock *RepositoryMock) ListIncidentsPaginated(ctx context.Context, opts typ
 //
 //	len(mockedRepository.ListIncidentsPaginatedCalls())
 func (mock *RepositoryMock) ListIncidentsPaginatedCalls() []struct {
-	Ctx  context.Context
-	Opts types.PaginationOptions
+	Ctx    context.Context
+	Filter model.IncidentFilter
+	Opts   types.PaginationOptions
 } {
 	var calls []struct {
-		Ctx  context.Context
-		Opts types.PaginationOptions
+		Ctx    context.Context
+		Filter model.IncidentFilter
+		Opts   types.PaginationOptions
 	}
 	mock.lockListIncidentsPaginated.RLock()
 	calls = mock.calls.ListIncidentsPaginated
@@ -1151,6 +2117,82 @@ func (mock *RepositoryMock) ListIncidentsSinceCalls() []struct {
 	return calls
 }
 
+// ListIncidentsUpdatedSince calls ListIncidentsUpdatedSinceFunc.
+func (mock *RepositoryMock) ListIncidentsUpdatedSince(ctx context.Context, since time.Time) ([]*model.Incident, error) {
+	if mock.ListIncidentsUpdatedSinceFunc == nil {
+		panic("RepositoryMock.ListIncidentsUpdatedSinceFunc: method is nil but Repository.ListIncidentsUpdatedSince was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Since time.Time
+	}{
+		Ctx:   ctx,
+		Since: since,
+	}
+	mock.lockListIncidentsUpdatedSince.Lock()
+	mock.calls.ListIncidentsUpdatedSince = append(mock.calls.ListIncidentsUpdatedSince, callInfo)
+	mock.lockListIncidentsUpdatedSince.Unlock()
+	return mock.ListIncidentsUpdatedSinceFunc(ctx, since)
+}
+
+// ListIncidentsUpdatedSinceCalls gets all the calls that were made to ListIncidentsUpdatedSince.
+// Check the length with:
+//
+//	len(mockedRepository.ListIncidentsUpdatedSinceCalls())
+func (mock *RepositoryMock) ListIncidentsUpdatedSinceCalls() []struct {
+	Ctx   context.Context
+	Since time.Time
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Since time.Time
+	}
+	mock.lockListIncidentsUpdatedSince.RLock()
+	calls = mock.calls.ListIncidentsUpdatedSince
+	mock.lockListIncidentsUpdatedSince.RUnlock()
+	return calls
+}
+
+// ListJobsByStatus calls ListJobsByStatusFunc.
+func (mock *RepositoryMock) ListJobsByStatus(ctx context.Context, status types.JobStatus, limit int) ([]*model.Job, error) {
+	if mock.ListJobsByStatusFunc == nil {
+		panic("RepositoryMock.ListJobsByStatusFunc: method is nil but Repository.ListJobsByStatus was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Status types.JobStatus
+		Limit  int
+	}{
+		Ctx:    ctx,
+		Status: status,
+		Limit:  limit,
+	}
+	mock.lockListJobsByStatus.Lock()
+	mock.calls.ListJobsByStatus = append(mock.calls.ListJobsByStatus, callInfo)
+	mock.lockListJobsByStatus.Unlock()
+	return mock.ListJobsByStatusFunc(ctx, status, limit)
+}
+
+// ListJobsByStatusCalls gets all the calls that were made to ListJobsByStatus.
+// Check the length with:
+//
+//	len(mockedRepository.ListJobsByStatusCalls())
+func (mock *RepositoryMock) ListJobsByStatusCalls() []struct {
+	Ctx    context.Context
+	Status types.JobStatus
+	Limit  int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Status types.JobStatus
+		Limit  int
+	}
+	mock.lockListJobsByStatus.RLock()
+	calls = mock.calls.ListJobsByStatus
+	mock.lockListJobsByStatus.RUnlock()
+	return calls
+}
+
 // ListMessages calls ListMessagesFunc.
 func (mock *RepositoryMock) ListMessages(ctx context.Context, channelID types.ChannelID, limit int) ([]*model.Message, error) {
 	if mock.ListMessagesFunc == nil {
@@ -1191,15 +2233,195 @@ func (mock *RepositoryMock) ListMessagesCalls() []struct {
 	return calls
 }
 
-// ListTasksByIncident calls ListTasksByIncidentFunc.
-func (mock *RepositoryMock) ListTasksByIncident(ctx context.Context, incidentID types.IncidentID) ([]*model.Task, error) {
-	if mock.ListTasksByIncidentFunc == nil {
-		panic("RepositoryMock.ListTasksByIncidentFunc: method is nil but Repository.ListTasksByIncident was just called")
+// ListMessagesSince calls ListMessagesSinceFunc.
+func (mock *RepositoryMock) ListMessagesSince(ctx context.Context, since time.Time) ([]*model.Message, error) {
+	if mock.ListMessagesSinceFunc == nil {
+		panic("RepositoryMock.ListMessagesSinceFunc: method is nil but Repository.ListMessagesSince was just called")
 	}
 	callInfo := struct {
-		Ctx        context.Context
-		IncidentID types.IncidentID
-	}{
+		Ctx   context.Context
+		Since time.Time
+	}{
+		Ctx:   ctx,
+		Since: since,
+	}
+	mock.lockListMessagesSince.Lock()
+	mock.calls.ListMessagesSince = append(mock.calls.ListMessagesSince, callInfo)
+	mock.lockListMessagesSince.Unlock()
+	return mock.ListMessagesSinceFunc(ctx, since)
+}
+
+// ListMessagesSinceCalls gets all the calls that were made to ListMessagesSince.
+// Check the length with:
+//
+//	len(mockedRepository.ListMessagesSinceCalls())
+func (mock *RepositoryMock) ListMessagesSinceCalls() []struct {
+	Ctx   context.Context
+	Since time.Time
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Since time.Time
+	}
+	mock.lockListMessagesSince.RLock()
+	calls = mock.calls.ListMessagesSince
+	mock.lockListMessagesSince.RUnlock()
+	return calls
+}
+
+// ListSessionsByUser calls ListSessionsByUserFunc.
+func (mock *RepositoryMock) ListSessionsByUser(ctx context.Context, userID types.UserID) ([]*model.Session, error) {
+	if mock.ListSessionsByUserFunc == nil {
+		panic("RepositoryMock.ListSessionsByUserFunc: method is nil but Repository.ListSessionsByUser was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID types.UserID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockListSessionsByUser.Lock()
+	mock.calls.ListSessionsByUser = append(mock.calls.ListSessionsByUser, callInfo)
+	mock.lockListSessionsByUser.Unlock()
+	return mock.ListSessionsByUserFunc(ctx, userID)
+}
+
+// ListSessionsByUserCalls gets all the calls that were made to ListSessionsByUser.
+// Check the length with:
+//
+//	len(mockedRepository.ListSessionsByUserCalls())
+func (mock *RepositoryMock) ListSessionsByUserCalls() []struct {
+	Ctx    context.Context
+	UserID types.UserID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID types.UserID
+	}
+	mock.lockListSessionsByUser.RLock()
+	calls = mock.calls.ListSessionsByUser
+	mock.lockListSessionsByUser.RUnlock()
+	return calls
+}
+
+// ListStakeholderUpdates calls ListStakeholderUpdatesFunc.
+func (mock *RepositoryMock) ListStakeholderUpdates(ctx context.Context, incidentID types.IncidentID) ([]*model.StakeholderUpdate, error) {
+	if mock.ListStakeholderUpdatesFunc == nil {
+		panic("RepositoryMock.ListStakeholderUpdatesFunc: method is nil but Repository.ListStakeholderUpdates was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		IncidentID types.IncidentID
+	}{
+		Ctx:        ctx,
+		IncidentID: incidentID,
+	}
+	mock.lockListStakeholderUpdates.Lock()
+	mock.calls.ListStakeholderUpdates = append(mock.calls.ListStakeholderUpdates, callInfo)
+	mock.lockListStakeholderUpdates.Unlock()
+	return mock.ListStakeholderUpdatesFunc(ctx, incidentID)
+}
+
+// ListStakeholderUpdatesCalls gets all the calls that were made to ListStakeholderUpdates.
+// Check the length with:
+//
+//	len(mockedRepository.ListStakeholderUpdatesCalls())
+func (mock *RepositoryMock) ListStakeholderUpdatesCalls() []struct {
+	Ctx        context.Context
+	IncidentID types.IncidentID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		IncidentID types.IncidentID
+	}
+	mock.lockListStakeholderUpdates.RLock()
+	calls = mock.calls.ListStakeholderUpdates
+	mock.lockListStakeholderUpdates.RUnlock()
+	return calls
+}
+
+// ListStakeholderUpdatesSince calls ListStakeholderUpdatesSinceFunc.
+func (mock *RepositoryMock) ListStakeholderUpdatesSince(ctx context.Context, since time.Time) ([]*model.StakeholderUpdate, error) {
+	if mock.ListStakeholderUpdatesSinceFunc == nil {
+		panic("RepositoryMock.ListStakeholderUpdatesSinceFunc: method is nil but Repository.ListStakeholderUpdatesSince was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Since time.Time
+	}{
+		Ctx:   ctx,
+		Since: since,
+	}
+	mock.lockListStakeholderUpdatesSince.Lock()
+	mock.calls.ListStakeholderUpdatesSince = append(mock.calls.ListStakeholderUpdatesSince, callInfo)
+	mock.lockListStakeholderUpdatesSince.Unlock()
+	return mock.ListStakeholderUpdatesSinceFunc(ctx, since)
+}
+
+// ListStakeholderUpdatesSinceCalls gets all the calls that were made to ListStakeholderUpdatesSince.
+// Check the length with:
+//
+//	len(mockedRepository.ListStakeholderUpdatesSinceCalls())
+func (mock *RepositoryMock) ListStakeholderUpdatesSinceCalls() []struct {
+	Ctx   context.Context
+	Since time.Time
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Since time.Time
+	}
+	mock.lockListStakeholderUpdatesSince.RLock()
+	calls = mock.calls.ListStakeholderUpdatesSince
+	mock.lockListStakeholderUpdatesSince.RUnlock()
+	return calls
+}
+
+// ListStatusHistoriesSince calls ListStatusHistoriesSinceFunc.
+func (mock *RepositoryMock) ListStatusHistoriesSince(ctx context.Context, since time.Time) ([]*model.StatusHistory, error) {
+	if mock.ListStatusHistoriesSinceFunc == nil {
+		panic("RepositoryMock.ListStatusHistoriesSinceFunc: method is nil but Repository.ListStatusHistoriesSince was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Since time.Time
+	}{
+		Ctx:   ctx,
+		Since: since,
+	}
+	mock.lockListStatusHistoriesSince.Lock()
+	mock.calls.ListStatusHistoriesSince = append(mock.calls.ListStatusHistoriesSince, callInfo)
+	mock.lockListStatusHistoriesSince.Unlock()
+	return mock.ListStatusHistoriesSinceFunc(ctx, since)
+}
+
+// ListStatusHistoriesSinceCalls gets all the calls that were made to ListStatusHistoriesSince.
+// Check the length with:
+//
+//	len(mockedRepository.ListStatusHistoriesSinceCalls())
+func (mock *RepositoryMock) ListStatusHistoriesSinceCalls() []struct {
+	Ctx   context.Context
+	Since time.Time
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Since time.Time
+	}
+	mock.lockListStatusHistoriesSince.RLock()
+	calls = mock.calls.ListStatusHistoriesSince
+	mock.lockListStatusHistoriesSince.RUnlock()
+	return calls
+}
+
+// ListTasksByIncident calls ListTasksByIncidentFunc.
+func (mock *RepositoryMock) ListTasksByIncident(ctx context.Context, incidentID types.IncidentID) ([]*model.Task, error) {
+	if mock.ListTasksByIncidentFunc == nil {
+		panic("RepositoryMock.ListTasksByIncidentFunc: method is nil but Repository.ListTasksByIncident was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		IncidentID types.IncidentID
+	}{
 		Ctx:        ctx,
 		IncidentID: incidentID,
 	}
@@ -1227,6 +2449,154 @@ func (mock *RepositoryMock) ListTasksByIncidentCalls() []struct {
 	return calls
 }
 
+// ListTasksUpdatedSince calls ListTasksUpdatedSinceFunc.
+func (mock *RepositoryMock) ListTasksUpdatedSince(ctx context.Context, since time.Time) ([]*model.Task, error) {
+	if mock.ListTasksUpdatedSinceFunc == nil {
+		panic("RepositoryMock.ListTasksUpdatedSinceFunc: method is nil but Repository.ListTasksUpdatedSince was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Since time.Time
+	}{
+		Ctx:   ctx,
+		Since: since,
+	}
+	mock.lockListTasksUpdatedSince.Lock()
+	mock.calls.ListTasksUpdatedSince = append(mock.calls.ListTasksUpdatedSince, callInfo)
+	mock.lockListTasksUpdatedSince.Unlock()
+	return mock.ListTasksUpdatedSinceFunc(ctx, since)
+}
+
+// ListTasksUpdatedSinceCalls gets all the calls that were made to ListTasksUpdatedSince.
+// Check the length with:
+//
+//	len(mockedRepository.ListTasksUpdatedSinceCalls())
+func (mock *RepositoryMock) ListTasksUpdatedSinceCalls() []struct {
+	Ctx   context.Context
+	Since time.Time
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Since time.Time
+	}
+	mock.lockListTasksUpdatedSince.RLock()
+	calls = mock.calls.ListTasksUpdatedSince
+	mock.lockListTasksUpdatedSince.RUnlock()
+	return calls
+}
+
+// MarkEventProcessed calls MarkEventProcessedFunc.
+func (mock *RepositoryMock) MarkEventProcessed(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	if mock.MarkEventProcessedFunc == nil {
+		panic("RepositoryMock.MarkEventProcessedFunc: method is nil but Repository.MarkEventProcessed was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Key string
+		TTL time.Duration
+	}{
+		Ctx: ctx,
+		Key: key,
+		TTL: ttl,
+	}
+	mock.lockMarkEventProcessed.Lock()
+	mock.calls.MarkEventProcessed = append(mock.calls.MarkEventProcessed, callInfo)
+	mock.lockMarkEventProcessed.Unlock()
+	return mock.MarkEventProcessedFunc(ctx, key, ttl)
+}
+
+// MarkEventProcessedCalls gets all the calls that were made to MarkEventProcessed.
+// Check the length with:
+//
+//	len(mockedRepository.MarkEventProcessedCalls())
+func (mock *RepositoryMock) MarkEventProcessedCalls() []struct {
+	Ctx context.Context
+	Key string
+	TTL time.Duration
+} {
+	var calls []struct {
+		Ctx context.Context
+		Key string
+		TTL time.Duration
+	}
+	mock.lockMarkEventProcessed.RLock()
+	calls = mock.calls.MarkEventProcessed
+	mock.lockMarkEventProcessed.RUnlock()
+	return calls
+}
+
+// PutAPIToken calls PutAPITokenFunc.
+func (mock *RepositoryMock) PutAPIToken(ctx context.Context, token *model.APIToken) error {
+	if mock.PutAPITokenFunc == nil {
+		panic("RepositoryMock.PutAPITokenFunc: method is nil but Repository.PutAPIToken was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Token *model.APIToken
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockPutAPIToken.Lock()
+	mock.calls.PutAPIToken = append(mock.calls.PutAPIToken, callInfo)
+	mock.lockPutAPIToken.Unlock()
+	return mock.PutAPITokenFunc(ctx, token)
+}
+
+// PutAPITokenCalls gets all the calls that were made to PutAPIToken.
+// Check the length with:
+//
+//	len(mockedRepository.PutAPITokenCalls())
+func (mock *RepositoryMock) PutAPITokenCalls() []struct {
+	Ctx   context.Context
+	Token *model.APIToken
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token *model.APIToken
+	}
+	mock.lockPutAPIToken.RLock()
+	calls = mock.calls.PutAPIToken
+	mock.lockPutAPIToken.RUnlock()
+	return calls
+}
+
+// PutAuditEntry calls PutAuditEntryFunc.
+func (mock *RepositoryMock) PutAuditEntry(ctx context.Context, entry *model.AuditEntry) error {
+	if mock.PutAuditEntryFunc == nil {
+		panic("RepositoryMock.PutAuditEntryFunc: method is nil but Repository.PutAuditEntry was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Entry *model.AuditEntry
+	}{
+		Ctx:   ctx,
+		Entry: entry,
+	}
+	mock.lockPutAuditEntry.Lock()
+	mock.calls.PutAuditEntry = append(mock.calls.PutAuditEntry, callInfo)
+	mock.lockPutAuditEntry.Unlock()
+	return mock.PutAuditEntryFunc(ctx, entry)
+}
+
+// PutAuditEntryCalls gets all the calls that were made to PutAuditEntry.
+// Check the length with:
+//
+//	len(mockedRepository.PutAuditEntryCalls())
+func (mock *RepositoryMock) PutAuditEntryCalls() []struct {
+	Ctx   context.Context
+	Entry *model.AuditEntry
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Entry *model.AuditEntry
+	}
+	mock.lockPutAuditEntry.RLock()
+	calls = mock.calls.PutAuditEntry
+	mock.lockPutAuditEntry.RUnlock()
+	return calls
+}
+
 // PutIncident calls PutIncidentFunc.
 func (mock *RepositoryMock) PutIncident(ctx context.Context, incident *model.Incident) error {
 	if mock.PutIncidentFunc == nil {
@@ -1263,6 +2633,162 @@ func (mock *RepositoryMock) PutIncidentCalls() []struct {
 	return calls
 }
 
+// PutJob calls PutJobFunc.
+func (mock *RepositoryMock) PutJob(ctx context.Context, job *model.Job) error {
+	if mock.PutJobFunc == nil {
+		panic("RepositoryMock.PutJobFunc: method is nil but Repository.PutJob was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Job *model.Job
+	}{
+		Ctx: ctx,
+		Job: job,
+	}
+	mock.lockPutJob.Lock()
+	mock.calls.PutJob = append(mock.calls.PutJob, callInfo)
+	mock.lockPutJob.Unlock()
+	return mock.PutJobFunc(ctx, job)
+}
+
+// PutJobCalls gets all the calls that were made to PutJob.
+// Check the length with:
+//
+//	len(mockedRepository.PutJobCalls())
+func (mock *RepositoryMock) PutJobCalls() []struct {
+	Ctx context.Context
+	Job *model.Job
+} {
+	var calls []struct {
+		Ctx context.Context
+		Job *model.Job
+	}
+	mock.lockPutJob.RLock()
+	calls = mock.calls.PutJob
+	mock.lockPutJob.RUnlock()
+	return calls
+}
+
+// PutReminder calls PutReminderFunc.
+func (mock *RepositoryMock) PutReminder(ctx context.Context, reminder *model.Reminder) error {
+	if mock.PutReminderFunc == nil {
+		panic("RepositoryMock.PutReminderFunc: method is nil but Repository.PutReminder was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Reminder *model.Reminder
+	}{
+		Ctx:      ctx,
+		Reminder: reminder,
+	}
+	mock.lockPutReminder.Lock()
+	mock.calls.PutReminder = append(mock.calls.PutReminder, callInfo)
+	mock.lockPutReminder.Unlock()
+	return mock.PutReminderFunc(ctx, reminder)
+}
+
+// PutReminderCalls gets all the calls that were made to PutReminder.
+// Check the length with:
+//
+//	len(mockedRepository.PutReminderCalls())
+func (mock *RepositoryMock) PutReminderCalls() []struct {
+	Ctx      context.Context
+	Reminder *model.Reminder
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Reminder *model.Reminder
+	}
+	mock.lockPutReminder.RLock()
+	calls = mock.calls.PutReminder
+	mock.lockPutReminder.RUnlock()
+	return calls
+}
+
+// PutTranscript calls PutTranscriptFunc.
+func (mock *RepositoryMock) PutTranscript(ctx context.Context, transcript *model.Transcript) error {
+	if mock.PutTranscriptFunc == nil {
+		panic("RepositoryMock.PutTranscriptFunc: method is nil but Repository.PutTranscript was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Transcript *model.Transcript
+	}{
+		Ctx:        ctx,
+		Transcript: transcript,
+	}
+	mock.lockPutTranscript.Lock()
+	mock.calls.PutTranscript = append(mock.calls.PutTranscript, callInfo)
+	mock.lockPutTranscript.Unlock()
+	return mock.PutTranscriptFunc(ctx, transcript)
+}
+
+// PutTranscriptCalls gets all the calls that were made to PutTranscript.
+// Check the length with:
+//
+//	len(mockedRepository.PutTranscriptCalls())
+func (mock *RepositoryMock) PutTranscriptCalls() []struct {
+	Ctx        context.Context
+	Transcript *model.Transcript
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Transcript *model.Transcript
+	}
+	mock.lockPutTranscript.RLock()
+	calls = mock.calls.PutTranscript
+	mock.lockPutTranscript.RUnlock()
+	return calls
+}
+
+// RenewJobLease calls RenewJobLeaseFunc.
+func (mock *RepositoryMock) RenewJobLease(ctx context.Context, id types.JobID, owner string, leaseToken string, until time.Time) error {
+	if mock.RenewJobLeaseFunc == nil {
+		panic("RepositoryMock.RenewJobLeaseFunc: method is nil but Repository.RenewJobLease was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ID         types.JobID
+		Owner      string
+		LeaseToken string
+		Until      time.Time
+	}{
+		Ctx:        ctx,
+		ID:         id,
+		Owner:      owner,
+		LeaseToken: leaseToken,
+		Until:      until,
+	}
+	mock.lockRenewJobLease.Lock()
+	mock.calls.RenewJobLease = append(mock.calls.RenewJobLease, callInfo)
+	mock.lockRenewJobLease.Unlock()
+	return mock.RenewJobLeaseFunc(ctx, id, owner, leaseToken, until)
+}
+
+// RenewJobLeaseCalls gets all the calls that were made to RenewJobLease.
+// Check the length with:
+//
+//	len(mockedRepository.RenewJobLeaseCalls())
+func (mock *RepositoryMock) RenewJobLeaseCalls() []struct {
+	Ctx        context.Context
+	ID         types.JobID
+	Owner      string
+	LeaseToken string
+	Until      time.Time
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ID         types.JobID
+		Owner      string
+		LeaseToken string
+		Until      time.Time
+	}
+	mock.lockRenewJobLease.RLock()
+	calls = mock.calls.RenewJobLease
+	mock.lockRenewJobLease.RUnlock()
+	return calls
+}
+
 // SaveIncidentRequest calls SaveIncidentRequestFunc.
 func (mock *RepositoryMock) SaveIncidentRequest(ctx context.Context, request *model.IncidentRequest) error {
 	if mock.SaveIncidentRequestFunc == nil {
@@ -1407,6 +2933,158 @@ func (mock *RepositoryMock) SaveUserCalls() []struct {
 	return calls
 }
 
+// TouchSession calls TouchSessionFunc.
+func (mock *RepositoryMock) TouchSession(ctx context.Context, session *model.Session) error {
+	if mock.TouchSessionFunc == nil {
+		panic("RepositoryMock.TouchSessionFunc: method is nil but Repository.TouchSession was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Session *model.Session
+	}{
+		Ctx:     ctx,
+		Session: session,
+	}
+	mock.lockTouchSession.Lock()
+	mock.calls.TouchSession = append(mock.calls.TouchSession, callInfo)
+	mock.lockTouchSession.Unlock()
+	return mock.TouchSessionFunc(ctx, session)
+}
+
+// TouchSessionCalls gets all the calls that were made to TouchSession.
+// Check the length with:
+//
+//	len(mockedRepository.TouchSessionCalls())
+func (mock *RepositoryMock) TouchSessionCalls() []struct {
+	Ctx     context.Context
+	Session *model.Session
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Session *model.Session
+	}
+	mock.lockTouchSession.RLock()
+	calls = mock.calls.TouchSession
+	mock.lockTouchSession.RUnlock()
+	return calls
+}
+
+// UnmarkEventProcessed calls UnmarkEventProcessedFunc.
+func (mock *RepositoryMock) UnmarkEventProcessed(ctx context.Context, key string) error {
+	if mock.UnmarkEventProcessedFunc == nil {
+		panic("RepositoryMock.UnmarkEventProcessedFunc: method is nil but Repository.UnmarkEventProcessed was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Key string
+	}{
+		Ctx: ctx,
+		Key: key,
+	}
+	mock.lockUnmarkEventProcessed.Lock()
+	mock.calls.UnmarkEventProcessed = append(mock.calls.UnmarkEventProcessed, callInfo)
+	mock.lockUnmarkEventProcessed.Unlock()
+	return mock.UnmarkEventProcessedFunc(ctx, key)
+}
+
+// UnmarkEventProcessedCalls gets all the calls that were made to UnmarkEventProcessed.
+// Check the length with:
+//
+//	len(mockedRepository.UnmarkEventProcessedCalls())
+func (mock *RepositoryMock) UnmarkEventProcessedCalls() []struct {
+	Ctx context.Context
+	Key string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Key string
+	}
+	mock.lockUnmarkEventProcessed.RLock()
+	calls = mock.calls.UnmarkEventProcessed
+	mock.lockUnmarkEventProcessed.RUnlock()
+	return calls
+}
+
+// UpdateAPITokenLastUsed calls UpdateAPITokenLastUsedFunc.
+func (mock *RepositoryMock) UpdateAPITokenLastUsed(ctx context.Context, id types.APITokenID, lastUsedAt time.Time) error {
+	if mock.UpdateAPITokenLastUsedFunc == nil {
+		panic("RepositoryMock.UpdateAPITokenLastUsedFunc: method is nil but Repository.UpdateAPITokenLastUsed was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ID         types.APITokenID
+		LastUsedAt time.Time
+	}{
+		Ctx:        ctx,
+		ID:         id,
+		LastUsedAt: lastUsedAt,
+	}
+	mock.lockUpdateAPITokenLastUsed.Lock()
+	mock.calls.UpdateAPITokenLastUsed = append(mock.calls.UpdateAPITokenLastUsed, callInfo)
+	mock.lockUpdateAPITokenLastUsed.Unlock()
+	return mock.UpdateAPITokenLastUsedFunc(ctx, id, lastUsedAt)
+}
+
+// UpdateAPITokenLastUsedCalls gets all the calls that were made to UpdateAPITokenLastUsed.
+// Check the length with:
+//
+//	len(mockedRepository.UpdateAPITokenLastUsedCalls())
+func (mock *RepositoryMock) UpdateAPITokenLastUsedCalls() []struct {
+	Ctx        context.Context
+	ID         types.APITokenID
+	LastUsedAt time.Time
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ID         types.APITokenID
+		LastUsedAt time.Time
+	}
+	mock.lockUpdateAPITokenLastUsed.RLock()
+	calls = mock.calls.UpdateAPITokenLastUsed
+	mock.lockUpdateAPITokenLastUsed.RUnlock()
+	return calls
+}
+
+// UpdateIncidentAtomic calls UpdateIncidentAtomicFunc.
+func (mock *RepositoryMock) UpdateIncidentAtomic(ctx context.Context, incidentID types.IncidentID, updateFn func(*model.Incident) error) error {
+	if mock.UpdateIncidentAtomicFunc == nil {
+		panic("RepositoryMock.UpdateIncidentAtomicFunc: method is nil but Repository.UpdateIncidentAtomic was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		IncidentID types.IncidentID
+		UpdateFn   func(*model.Incident) error
+	}{
+		Ctx:        ctx,
+		IncidentID: incidentID,
+		UpdateFn:   updateFn,
+	}
+	mock.lockUpdateIncidentAtomic.Lock()
+	mock.calls.UpdateIncidentAtomic = append(mock.calls.UpdateIncidentAtomic, callInfo)
+	mock.lockUpdateIncidentAtomic.Unlock()
+	return mock.UpdateIncidentAtomicFunc(ctx, incidentID, updateFn)
+}
+
+// UpdateIncidentAtomicCalls gets all the calls that were made to UpdateIncidentAtomic.
+// Check the length with:
+//
+//	len(mockedRepository.UpdateIncidentAtomicCalls())
+func (mock *RepositoryMock) UpdateIncidentAtomicCalls() []struct {
+	Ctx        context.Context
+	IncidentID types.IncidentID
+	UpdateFn   func(*model.Incident) error
+} {
+	var calls []struct {
+		Ctx        context.Context
+		IncidentID types.IncidentID
+		UpdateFn   func(*model.Incident) error
+	}
+	mock.lockUpdateIncidentAtomic.RLock()
+	calls = mock.calls.UpdateIncidentAtomic
+	mock.lockUpdateIncidentAtomic.RUnlock()
+	return calls
+}
+
 // UpdateIncidentStatus calls UpdateIncidentStatusFunc.
 func (mock *RepositoryMock) UpdateIncidentStatus(ctx context.Context, incidentID types.IncidentID, status types.IncidentStatus) error {
 	if mock.UpdateIncidentStatusFunc == nil {
@@ -24,6 +24,9 @@ var _ interfaces.SlackClient = &SlackClientMock{}
 //			AddBookmarkFunc: func(ctx context.Context, channelID string, title string, link string) error {
 //				panic("mock out the AddBookmark method")
 //			},
+//			ArchiveConversationContextFunc: func(ctx context.Context, channelID string) error {
+//				panic("mock out the ArchiveConversationContext method")
+//			},
 //			AuthTestContextFunc: func(ctx context.Context) (*slack.AuthTestResponse, error) {
 //				panic("mock out the AuthTestContext method")
 //			},
@@ -39,6 +42,9 @@ var _ interfaces.SlackClient = &SlackClientMock{}
 //			GetConversationRepliesContextFunc: func(ctx context.Context, params *slack.GetConversationRepliesParameters) ([]slack.Message, bool, bool, error) {
 //				panic("mock out the GetConversationRepliesContext method")
 //			},
+//			GetConversationsForUserContextFunc: func(ctx context.Context, params *slack.GetConversationsForUserParameters) ([]slack.Channel, string, error) {
+//				panic("mock out the GetConversationsForUserContext method")
+//			},
 //			GetUserGroupMembersContextFunc: func(ctx context.Context, groupID string) ([]string, error) {
 //				panic("mock out the GetUserGroupMembersContext method")
 //			},
@@ -63,12 +69,18 @@ var _ interfaces.SlackClient = &SlackClientMock{}
 //			PostMessageFunc: func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
 //				panic("mock out the PostMessage method")
 //			},
+//			RenameConversationContextFunc: func(ctx context.Context, channelID string, name string) (*slack.Channel, error) {
+//				panic("mock out the RenameConversationContext method")
+//			},
 //			SendContextMessageFunc: func(ctx context.Context, channelID string, messageTS string, contextText string) string {
 //				panic("mock out the SendContextMessage method")
 //			},
 //			SetPurposeOfConversationContextFunc: func(ctx context.Context, channelID string, purpose string) (*slack.Channel, error) {
 //				panic("mock out the SetPurposeOfConversationContext method")
 //			},
+//			UnArchiveConversationContextFunc: func(ctx context.Context, channelID string) error {
+//				panic("mock out the UnArchiveConversationContext method")
+//			},
 //			UpdateMessageFunc: func(ctx context.Context, channelID string, timestamp string, options ...slack.MsgOption) (string, string, string, error) {
 //				panic("mock out the UpdateMessage method")
 //			},
@@ -82,6 +94,9 @@ type SlackClientMock struct {
 	// AddBookmarkFunc mocks the AddBookmark method.
 	AddBookmarkFunc func(ctx context.Context, channelID string, title string, link string) error
 
+	// ArchiveConversationContextFunc mocks the ArchiveConversationContext method.
+	ArchiveConversationContextFunc func(ctx context.Context, channelID string) error
+
 	// AuthTestContextFunc mocks the AuthTestContext method.
 	AuthTestContextFunc func(ctx context.Context) (*slack.AuthTestResponse, error)
 
@@ -97,6 +112,9 @@ type SlackClientMock struct {
 	// GetConversationRepliesContextFunc mocks the GetConversationRepliesContext method.
 	GetConversationRepliesContextFunc func(ctx context.Context, params *slack.GetConversationRepliesParameters) ([]slack.Message, bool, bool, error)
 
+	// GetConversationsForUserContextFunc mocks the GetConversationsForUserContext method.
+	GetConversationsForUserContextFunc func(ctx context.Context, params *slack.GetConversationsForUserParameters) ([]slack.Channel, string, error)
+
 	// GetUserGroupMembersContextFunc mocks the GetUserGroupMembersContext method.
 	GetUserGroupMembersContextFunc func(ctx context.Context, groupID string) ([]string, error)
 
@@ -121,12 +139,18 @@ type SlackClientMock struct {
 	// PostMessageFunc mocks the PostMessage method.
 	PostMessageFunc func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error)
 
+	// RenameConversationContextFunc mocks the RenameConversationContext method.
+	RenameConversationContextFunc func(ctx context.Context, channelID string, name string) (*slack.Channel, error)
+
 	// SendContextMessageFunc mocks the SendContextMessage method.
 	SendContextMessageFunc func(ctx context.Context, channelID string, messageTS string, contextText string) string
 
 	// SetPurposeOfConversationContextFunc mocks the SetPurposeOfConversationContext method.
 	SetPurposeOfConversationContextFunc func(ctx context.Context, channelID string, purpose string) (*slack.Channel, error)
 
+	// UnArchiveConversationContextFunc mocks the UnArchiveConversationContext method.
+	UnArchiveConversationContextFunc func(ctx context.Context, channelID string) error
+
 	// UpdateMessageFunc mocks the UpdateMessage method.
 	UpdateMessageFunc func(ctx context.Context, channelID string, timestamp string, options ...slack.MsgOption) (string, string, string, error)
 
@@ -143,6 +167,13 @@ type SlackClientMock struct {
 			// Link is the link argument value.
 			Link string
 		}
+		// ArchiveConversationContext holds details about calls to the ArchiveConversationContext method.
+		ArchiveConversationContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ChannelID is the channelID argument value.
+			ChannelID string
+		}
 		// AuthTestContext holds details about calls to the AuthTestContext method.
 		AuthTestContext []struct {
 			// Ctx is the ctx argument value.
@@ -178,6 +209,13 @@ type SlackClientMock struct {
 			// Params is the params argument value.
 			Params *slack.GetConversationRepliesParameters
 		}
+		// GetConversationsForUserContext holds details about calls to the GetConversationsForUserContext method.
+		GetConversationsForUserContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params *slack.GetConversationsForUserParameters
+		}
 		// GetUserGroupMembersContext holds details about calls to the GetUserGroupMembersContext method.
 		GetUserGroupMembersContext []struct {
 			// Ctx is the ctx argument value.
@@ -236,6 +274,15 @@ type SlackClientMock struct {
 			// Options is the options argument value.
 			Options []slack.MsgOption
 		}
+		// RenameConversationContext holds details about calls to the RenameConversationContext method.
+		RenameConversationContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ChannelID is the channelID argument value.
+			ChannelID string
+			// Name is the name argument value.
+			Name string
+		}
 		// SendContextMessage holds details about calls to the SendContextMessage method.
 		SendContextMessage []struct {
 			// Ctx is the ctx argument value.
@@ -256,6 +303,13 @@ type SlackClientMock struct {
 			// Purpose is the purpose argument value.
 			Purpose string
 		}
+		// UnArchiveConversationContext holds details about calls to the UnArchiveConversationContext method.
+		UnArchiveConversationContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ChannelID is the channelID argument value.
+			ChannelID string
+		}
 		// UpdateMessage holds details about calls to the UpdateMessage method.
 		UpdateMessage []struct {
 			// Ctx is the ctx argument value.
@@ -269,11 +323,13 @@ type SlackClientMock struct {
 		}
 	}
 	lockAddBookmark                     sync.RWMutex
+	lockArchiveConversationContext      sync.RWMutex
 	lockAuthTestContext                 sync.RWMutex
 	lockCreateConversation              sync.RWMutex
 	lockGetConversationHistoryContext   sync.RWMutex
 	lockGetConversationInfo             sync.RWMutex
 	lockGetConversationRepliesContext   sync.RWMutex
+	lockGetConversationsForUserContext  sync.RWMutex
 	lockGetUserGroupMembersContext      sync.RWMutex
 	lockGetUserGroupsContext            sync.RWMutex
 	lockGetUserInfoContext              sync.RWMutex
@@ -282,8 +338,10 @@ type SlackClientMock struct {
 	lockInviteUsersToConversation       sync.RWMutex
 	lockOpenView                        sync.RWMutex
 	lockPostMessage                     sync.RWMutex
+	lockRenameConversationContext       sync.RWMutex
 	lockSendContextMessage              sync.RWMutex
 	lockSetPurposeOfConversationContext sync.RWMutex
+	lockUnArchiveConversationContext    sync.RWMutex
 	lockUpdateMessage                   sync.RWMutex
 }
 
@@ -331,6 +389,42 @@ func (mock *SlackClientMock) AddBookmarkCalls() []struct {
 	return calls
 }
 
+// ArchiveConversationContext calls ArchiveConversationContextFunc.
+func (mock *SlackClientMock) ArchiveConversationContext(ctx context.Context, channelID string) error {
+	if mock.ArchiveConversationContextFunc == nil {
+		panic("SlackClientMock.ArchiveConversationContextFunc: method is nil but SlackClient.ArchiveConversationContext was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ChannelID string
+	}{
+		Ctx:       ctx,
+		ChannelID: channelID,
+	}
+	mock.lockArchiveConversationContext.Lock()
+	mock.calls.ArchiveConversationContext = append(mock.calls.ArchiveConversationContext, callInfo)
+	mock.lockArchiveConversationContext.Unlock()
+	return mock.ArchiveConversationContextFunc(ctx, channelID)
+}
+
+// ArchiveConversationContextCalls gets all the calls that were made to ArchiveConversationContext.
+// Check the length with:
+//
+//	len(mockedSlackClient.ArchiveConversationContextCalls())
+func (mock *SlackClientMock) ArchiveConversationContextCalls() []struct {
+	Ctx       context.Context
+	ChannelID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ChannelID string
+	}
+	mock.lockArchiveConversationContext.RLock()
+	calls = mock.calls.ArchiveConversationContext
+	mock.lockArchiveConversationContext.RUnlock()
+	return calls
+}
+
 // AuthTestContext calls AuthTestContextFunc.
 func (mock *SlackClientMock) AuthTestContext(ctx context.Context) (*slack.AuthTestResponse, error) {
 	if mock.AuthTestContextFunc == nil {
@@ -511,6 +605,42 @@ func (mock *SlackClientMock) GetConversationRepliesContextCalls() []struct {
 	return calls
 }
 
+// GetConversationsForUserContext calls GetConversationsForUserContextFunc.
+func (mock *SlackClientMock) GetConversationsForUserContext(ctx context.Context, params *slack.GetConversationsForUserParameters) ([]slack.Channel, string, error) {
+	if mock.GetConversationsForUserContextFunc == nil {
+		panic("SlackClientMock.GetConversationsForUserContextFunc: method is nil but SlackClient.GetConversationsForUserContext was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Params *slack.GetConversationsForUserParameters
+	}{
+		Ctx:    ctx,
+		Params: params,
+	}
+	mock.lockGetConversationsForUserContext.Lock()
+	mock.calls.GetConversationsForUserContext = append(mock.calls.GetConversationsForUserContext, callInfo)
+	mock.lockGetConversationsForUserContext.Unlock()
+	return mock.GetConversationsForUserContextFunc(ctx, params)
+}
+
+// GetConversationsForUserContextCalls gets all the calls that were made to GetConversationsForUserContext.
+// Check the length with:
+//
+//	len(mockedSlackClient.GetConversationsForUserContextCalls())
+func (mock *SlackClientMock) GetConversationsForUserContextCalls() []struct {
+	Ctx    context.Context
+	Params *slack.GetConversationsForUserParameters
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Params *slack.GetConversationsForUserParameters
+	}
+	mock.lockGetConversationsForUserContext.RLock()
+	calls = mock.calls.GetConversationsForUserContext
+	mock.lockGetConversationsForUserContext.RUnlock()
+	return calls
+}
+
 // GetUserGroupMembersContext calls GetUserGroupMembersContextFunc.
 func (mock *SlackClientMock) GetUserGroupMembersContext(ctx context.Context, groupID string) ([]string, error) {
 	if mock.GetUserGroupMembersContextFunc == nil {
@@ -803,6 +933,46 @@ func (mock *SlackClientMock) PostMessageCalls() []struct {
 	return calls
 }
 
+// RenameConversationContext calls RenameConversationContextFunc.
+func (mock *SlackClientMock) RenameConversationContext(ctx context.Context, channelID string, name string) (*slack.Channel, error) {
+	if mock.RenameConversationContextFunc == nil {
+		panic("SlackClientMock.RenameConversationContextFunc: method is nil but SlackClient.RenameConversationContext was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ChannelID string
+		Name      string
+	}{
+		Ctx:       ctx,
+		ChannelID: channelID,
+		Name:      name,
+	}
+	mock.lockRenameConversationContext.Lock()
+	mock.calls.RenameConversationContext = append(mock.calls.RenameConversationContext, callInfo)
+	mock.lockRenameConversationContext.Unlock()
+	return mock.RenameConversationContextFunc(ctx, channelID, name)
+}
+
+// RenameConversationContextCalls gets all the calls that were made to RenameConversationContext.
+// Check the length with:
+//
+//	len(mockedSlackClient.RenameConversationContextCalls())
+func (mock *SlackClientMock) RenameConversationContextCalls() []struct {
+	Ctx       context.Context
+	ChannelID string
+	Name      string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ChannelID string
+		Name      string
+	}
+	mock.lockRenameConversationContext.RLock()
+	calls = mock.calls.RenameConversationContext
+	mock.lockRenameConversationContext.RUnlock()
+	return calls
+}
+
 // SendContextMessage calls SendContextMessageFunc.
 func (mock *SlackClientMock) SendContextMessage(ctx context.Context, channelID string, messageTS string, contextText string) string {
 	if mock.SendContextMessageFunc == nil {
@@ -887,6 +1057,42 @@ func (mock *SlackClientMock) SetPurposeOfConversationContextCalls() []struct {
 	return calls
 }
 
+// UnArchiveConversationContext calls UnArchiveConversationContextFunc.
+func (mock *SlackClientMock) UnArchiveConversationContext(ctx context.Context, channelID string) error {
+	if mock.UnArchiveConversationContextFunc == nil {
+		panic("SlackClientMock.UnArchiveConversationContextFunc: method is nil but SlackClient.UnArchiveConversationContext was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		ChannelID string
+	}{
+		Ctx:       ctx,
+		ChannelID: channelID,
+	}
+	mock.lockUnArchiveConversationContext.Lock()
+	mock.calls.UnArchiveConversationContext = append(mock.calls.UnArchiveConversationContext, callInfo)
+	mock.lockUnArchiveConversationContext.Unlock()
+	return mock.UnArchiveConversationContextFunc(ctx, channelID)
+}
+
+// UnArchiveConversationContextCalls gets all the calls that were made to UnArchiveConversationContext.
+// Check the length with:
+//
+//	len(mockedSlackClient.UnArchiveConversationContextCalls())
+func (mock *SlackClientMock) UnArchiveConversationContextCalls() []struct {
+	Ctx       context.Context
+	ChannelID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ChannelID string
+	}
+	mock.lockUnArchiveConversationContext.RLock()
+	calls = mock.calls.UnArchiveConversationContext
+	mock.lockUnArchiveConversationContext.RUnlock()
+	return calls
+}
+
 // UpdateMessage calls UpdateMessageFunc.
 func (mock *SlackClientMock) UpdateMessage(ctx context.Context, channelID string, timestamp string, options ...slack.MsgOption) (string, string, string, error) {
 	if mock.UpdateMessageFunc == nil {
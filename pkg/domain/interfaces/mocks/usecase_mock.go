@@ -10,6 +10,7 @@ import (
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
 	"github.com/slack-go/slack/slackevents"
 	"sync"
+	"time"
 )
 
 // Ensure, that SlackMessageMock does implement interfaces.SlackMessage.
@@ -442,6 +443,9 @@ var _ interfaces.Incident = &IncidentMock{}
 //			CreateIncidentFunc: func(ctx context.Context, req *model.CreateIncidentRequest) (*model.Incident, error) {
 //				panic("mock out the CreateIncident method")
 //			},
+//			DeclareIncidentFunc: func(ctx context.Context, req *model.CreateIncidentRequest) (*model.Incident, error) {
+//				panic("mock out the DeclareIncident method")
+//			},
 //			FilterIncidentForUserFunc: func(ctx context.Context, incident *model.Incident, slackUserID types.SlackUserID) *model.Incident {
 //				panic("mock out the FilterIncidentForUser method")
 //			},
@@ -451,6 +455,9 @@ var _ interfaces.Incident = &IncidentMock{}
 //			GetIncidentByChannelIDFunc: func(ctx context.Context, channelID types.ChannelID) (*model.Incident, error) {
 //				panic("mock out the GetIncidentByChannelID method")
 //			},
+//			GetIncidentMetricsFunc: func(ctx context.Context, from time.Time, to time.Time, groupBy types.IncidentMetricsGroupBy) (*model.IncidentMetrics, error) {
+//				panic("mock out the GetIncidentMetrics method")
+//			},
 //			GetIncidentRequestFunc: func(ctx context.Context, requestID string) (*model.IncidentRequest, error) {
 //				panic("mock out the GetIncidentRequest method")
 //			},
@@ -460,11 +467,11 @@ var _ interfaces.Incident = &IncidentMock{}
 //			GetRecentOpenIncidentsFunc: func(ctx context.Context, days int) (map[string][]*model.Incident, error) {
 //				panic("mock out the GetRecentOpenIncidents method")
 //			},
-//			HandleCreateIncidentActionFunc: func(ctx context.Context, requestID string, userID string) (*model.Incident, error) {
-//				panic("mock out the HandleCreateIncidentAction method")
+//			GrantIncidentAccessFunc: func(ctx context.Context, incidentID types.IncidentID, req interfaces.GrantIncidentAccessRequest) (*model.AccessGrant, error) {
+//				panic("mock out the GrantIncidentAccess method")
 //			},
-//			HandleCreateIncidentActionAsyncFunc: func(ctx context.Context, requestID string, userID string, channelID string)  {
-//				panic("mock out the HandleCreateIncidentActionAsync method")
+//			HandleCreateIncidentActionFunc: func(ctx context.Context, requestID string, userID string, channelID string)  {
+//				panic("mock out the HandleCreateIncidentAction method")
 //			},
 //			HandleCreateIncidentWithDetailsFunc: func(ctx context.Context, requestID string, title string, description string, categoryID string, severityID string, userID string) (*model.Incident, error) {
 //				panic("mock out the HandleCreateIncidentWithDetails method")
@@ -475,15 +482,27 @@ var _ interfaces.Incident = &IncidentMock{}
 //			HandleEditIncidentActionFunc: func(ctx context.Context, requestID string, userID string, triggerID string) error {
 //				panic("mock out the HandleEditIncidentAction method")
 //			},
+//			RequestIncidentAccessFunc: func(ctx context.Context, incidentID types.IncidentID, requesterID types.SlackUserID, reason string) error {
+//				panic("mock out the RequestIncidentAccess method")
+//			},
+//			RevokeIncidentAccessFunc: func(ctx context.Context, incidentID types.IncidentID, grantID types.AccessGrantID) error {
+//				panic("mock out the RevokeIncidentAccess method")
+//			},
 //			SyncIncidentMemberWithEventFunc: func(ctx context.Context, incidentID types.IncidentID, channelID types.ChannelID, eventUserID types.SlackUserID, isJoin bool) error {
 //				panic("mock out the SyncIncidentMemberWithEvent method")
 //			},
+//			UpdateIncidentFunc: func(ctx context.Context, incidentID types.IncidentID, req model.UpdateIncidentRequest, updatedBy types.SlackUserID) (*model.Incident, error) {
+//				panic("mock out the UpdateIncident method")
+//			},
 //			UpdateIncidentDetailsFunc: func(ctx context.Context, incidentID types.IncidentID, title string, description string, lead types.SlackUserID, severityID string, updatedBy types.SlackUserID) (*model.Incident, error) {
 //				panic("mock out the UpdateIncidentDetails method")
 //			},
 //			UpdateIncidentDetailsWithAssetsFunc: func(ctx context.Context, incidentID types.IncidentID, title string, description string, lead types.SlackUserID, severityID string, assetIDs []types.AssetID, updatedBy types.SlackUserID) (*model.Incident, error) {
 //				panic("mock out the UpdateIncidentDetailsWithAssets method")
 //			},
+//			ViewIncidentFunc: func(ctx context.Context, incident *model.Incident, slackUserID types.SlackUserID) *model.Incident {
+//				panic("mock out the ViewIncident method")
+//			},
 //		}
 //
 //		// use mockedIncident in code that requires interfaces.Incident
@@ -497,6 +516,9 @@ type IncidentMock struct {
 	// CreateIncidentFunc mocks the CreateIncident method.
 	CreateIncidentFunc func(ctx context.Context, req *model.CreateIncidentRequest) (*model.Incident, error)
 
+	// DeclareIncidentFunc mocks the DeclareIncident method.
+	DeclareIncidentFunc func(ctx context.Context, req *model.CreateIncidentRequest) (*model.Incident, error)
+
 	// FilterIncidentForUserFunc mocks the FilterIncidentForUser method.
 	FilterIncidentForUserFunc func(ctx context.Context, incident *model.Incident, slackUserID types.SlackUserID) *model.Incident
 
@@ -506,6 +528,9 @@ type IncidentMock struct {
 	// GetIncidentByChannelIDFunc mocks the GetIncidentByChannelID method.
 	GetIncidentByChannelIDFunc func(ctx context.Context, channelID types.ChannelID) (*model.Incident, error)
 
+	// GetIncidentMetricsFunc mocks the GetIncidentMetrics method.
+	GetIncidentMetricsFunc func(ctx context.Context, from time.Time, to time.Time, groupBy types.IncidentMetricsGroupBy) (*model.IncidentMetrics, error)
+
 	// GetIncidentRequestFunc mocks the GetIncidentRequest method.
 	GetIncidentRequestFunc func(ctx context.Context, requestID string) (*model.IncidentRequest, error)
 
@@ -515,11 +540,11 @@ type IncidentMock struct {
 	// GetRecentOpenIncidentsFunc mocks the GetRecentOpenIncidents method.
 	GetRecentOpenIncidentsFunc func(ctx context.Context, days int) (map[string][]*model.Incident, error)
 
-	// HandleCreateIncidentActionFunc mocks the HandleCreateIncidentAction method.
-	HandleCreateIncidentActionFunc func(ctx context.Context, requestID string, userID string) (*model.Incident, error)
+	// GrantIncidentAccessFunc mocks the GrantIncidentAccess method.
+	GrantIncidentAccessFunc func(ctx context.Context, incidentID types.IncidentID, req interfaces.GrantIncidentAccessRequest) (*model.AccessGrant, error)
 
-	// HandleCreateIncidentActionAsyncFunc mocks the HandleCreateIncidentActionAsync method.
-	HandleCreateIncidentActionAsyncFunc func(ctx context.Context, requestID string, userID string, channelID string)
+	// HandleCreateIncidentActionFunc mocks the HandleCreateIncidentAction method.
+	HandleCreateIncidentActionFunc func(ctx context.Context, requestID string, userID string, channelID string)
 
 	// HandleCreateIncidentWithDetailsFunc mocks the HandleCreateIncidentWithDetails method.
 	HandleCreateIncidentWithDetailsFunc func(ctx context.Context, requestID string, title string, description string, categoryID string, severityID string, userID string) (*model.Incident, error)
@@ -530,15 +555,27 @@ type IncidentMock struct {
 	// HandleEditIncidentActionFunc mocks the HandleEditIncidentAction method.
 	HandleEditIncidentActionFunc func(ctx context.Context, requestID string, userID string, triggerID string) error
 
+	// RequestIncidentAccessFunc mocks the RequestIncidentAccess method.
+	RequestIncidentAccessFunc func(ctx context.Context, incidentID types.IncidentID, requesterID types.SlackUserID, reason string) error
+
+	// RevokeIncidentAccessFunc mocks the RevokeIncidentAccess method.
+	RevokeIncidentAccessFunc func(ctx context.Context, incidentID types.IncidentID, grantID types.AccessGrantID) error
+
 	// SyncIncidentMemberWithEventFunc mocks the SyncIncidentMemberWithEvent method.
 	SyncIncidentMemberWithEventFunc func(ctx context.Context, incidentID types.IncidentID, channelID types.ChannelID, eventUserID types.SlackUserID, isJoin bool) error
 
+	// UpdateIncidentFunc mocks the UpdateIncident method.
+	UpdateIncidentFunc func(ctx context.Context, incidentID types.IncidentID, req model.UpdateIncidentRequest, updatedBy types.SlackUserID) (*model.Incident, error)
+
 	// UpdateIncidentDetailsFunc mocks the UpdateIncidentDetails method.
 	UpdateIncidentDetailsFunc func(ctx context.Context, incidentID types.IncidentID, title string, description string, lead types.SlackUserID, severityID string, updatedBy types.SlackUserID) (*model.Incident, error)
 
 	// UpdateIncidentDetailsWithAssetsFunc mocks the UpdateIncidentDetailsWithAssets method.
 	UpdateIncidentDetailsWithAssetsFunc func(ctx context.Context, incidentID types.IncidentID, title string, description string, lead types.SlackUserID, severityID string, assetIDs []types.AssetID, updatedBy types.SlackUserID) (*model.Incident, error)
 
+	// ViewIncidentFunc mocks the ViewIncident method.
+	ViewIncidentFunc func(ctx context.Context, incident *model.Incident, slackUserID types.SlackUserID) *model.Incident
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// CanUserAccessIncident holds details about calls to the CanUserAccessIncident method.
@@ -557,6 +594,13 @@ type IncidentMock struct {
 			// Req is the req argument value.
 			Req *model.CreateIncidentRequest
 		}
+		// DeclareIncident holds details about calls to the DeclareIncident method.
+		DeclareIncident []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req *model.CreateIncidentRequest
+		}
 		// FilterIncidentForUser holds details about calls to the FilterIncidentForUser method.
 		FilterIncidentForUser []struct {
 			// Ctx is the ctx argument value.
@@ -580,6 +624,17 @@ type IncidentMock struct {
 			// ChannelID is the channelID argument value.
 			ChannelID types.ChannelID
 		}
+		// GetIncidentMetrics holds details about calls to the GetIncidentMetrics method.
+		GetIncidentMetrics []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+			// GroupBy is the groupBy argument value.
+			GroupBy types.IncidentMetricsGroupBy
+		}
 		// GetIncidentRequest holds details about calls to the GetIncidentRequest method.
 		GetIncidentRequest []struct {
 			// Ctx is the ctx argument value.
@@ -601,17 +656,17 @@ type IncidentMock struct {
 			// Days is the days argument value.
 			Days int
 		}
-		// HandleCreateIncidentAction holds details about calls to the HandleCreateIncidentAction method.
-		HandleCreateIncidentAction []struct {
+		// GrantIncidentAccess holds details about calls to the GrantIncidentAccess method.
+		GrantIncidentAccess []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
-			// RequestID is the requestID argument value.
-			RequestID string
-			// UserID is the userID argument value.
-			UserID string
+			// IncidentID is the incidentID argument value.
+			IncidentID types.IncidentID
+			// Req is the req argument value.
+			Req interfaces.GrantIncidentAccessRequest
 		}
-		// HandleCreateIncidentActionAsync holds details about calls to the HandleCreateIncidentActionAsync method.
-		HandleCreateIncidentActionAsync []struct {
+		// HandleCreateIncidentAction holds details about calls to the HandleCreateIncidentAction method.
+		HandleCreateIncidentAction []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// RequestID is the requestID argument value.
@@ -672,6 +727,26 @@ type IncidentMock struct {
 			// TriggerID is the triggerID argument value.
 			TriggerID string
 		}
+		// RequestIncidentAccess holds details about calls to the RequestIncidentAccess method.
+		RequestIncidentAccess []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// IncidentID is the incidentID argument value.
+			IncidentID types.IncidentID
+			// RequesterID is the requesterID argument value.
+			RequesterID types.SlackUserID
+			// Reason is the reason argument value.
+			Reason string
+		}
+		// RevokeIncidentAccess holds details about calls to the RevokeIncidentAccess method.
+		RevokeIncidentAccess []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// IncidentID is the incidentID argument value.
+			IncidentID types.IncidentID
+			// GrantID is the grantID argument value.
+			GrantID types.AccessGrantID
+		}
 		// SyncIncidentMemberWithEvent holds details about calls to the SyncIncidentMemberWithEvent method.
 		SyncIncidentMemberWithEvent []struct {
 			// Ctx is the ctx argument value.
@@ -685,6 +760,17 @@ type IncidentMock struct {
 			// IsJoin is the isJoin argument value.
 			IsJoin bool
 		}
+		// UpdateIncident holds details about calls to the UpdateIncident method.
+		UpdateIncident []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// IncidentID is the incidentID argument value.
+			IncidentID types.IncidentID
+			// Req is the req argument value.
+			Req model.UpdateIncidentRequest
+			// UpdatedBy is the updatedBy argument value.
+			UpdatedBy types.SlackUserID
+		}
 		// UpdateIncidentDetails holds details about calls to the UpdateIncidentDetails method.
 		UpdateIncidentDetails []struct {
 			// Ctx is the ctx argument value.
@@ -721,23 +807,38 @@ type IncidentMock struct {
 			// UpdatedBy is the updatedBy argument value.
 			UpdatedBy types.SlackUserID
 		}
+		// ViewIncident holds details about calls to the ViewIncident method.
+		ViewIncident []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Incident is the incident argument value.
+			Incident *model.Incident
+			// SlackUserID is the slackUserID argument value.
+			SlackUserID types.SlackUserID
+		}
 	}
 	lockCanUserAccessIncident                    sync.RWMutex
 	lockCreateIncident                           sync.RWMutex
+	lockDeclareIncident                          sync.RWMutex
 	lockFilterIncidentForUser                    sync.RWMutex
 	lockGetIncident                              sync.RWMutex
 	lockGetIncidentByChannelID                   sync.RWMutex
+	lockGetIncidentMetrics                       sync.RWMutex
 	lockGetIncidentRequest                       sync.RWMutex
 	lockGetIncidentTrendBySeverity               sync.RWMutex
 	lockGetRecentOpenIncidents                   sync.RWMutex
+	lockGrantIncidentAccess                      sync.RWMutex
 	lockHandleCreateIncidentAction               sync.RWMutex
-	lockHandleCreateIncidentActionAsync          sync.RWMutex
 	lockHandleCreateIncidentWithDetails          sync.RWMutex
 	lockHandleCreateIncidentWithDetailsAndAssets sync.RWMutex
 	lockHandleEditIncidentAction                 sync.RWMutex
+	lockRequestIncidentAccess                    sync.RWMutex
+	lockRevokeIncidentAccess                     sync.RWMutex
 	lockSyncIncidentMemberWithEvent              sync.RWMutex
+	lockUpdateIncident                           sync.RWMutex
 	lockUpdateIncidentDetails                    sync.RWMutex
 	lockUpdateIncidentDetailsWithAssets          sync.RWMutex
+	lockViewIncident                             sync.RWMutex
 }
 
 // CanUserAccessIncident calls CanUserAccessIncidentFunc.
@@ -816,6 +917,42 @@ func (mock *IncidentMock) CreateIncidentCalls() []struct {
 	return calls
 }
 
+// DeclareIncident calls DeclareIncidentFunc.
+func (mock *IncidentMock) DeclareIncident(ctx context.Context, req *model.CreateIncidentRequest) (*model.Incident, error) {
+	if mock.DeclareIncidentFunc == nil {
+		panic("IncidentMock.DeclareIncidentFunc: method is nil but Incident.DeclareIncident was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req *model.CreateIncidentRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockDeclareIncident.Lock()
+	mock.calls.DeclareIncident = append(mock.calls.DeclareIncident, callInfo)
+	mock.lockDeclareIncident.Unlock()
+	return mock.DeclareIncidentFunc(ctx, req)
+}
+
+// DeclareIncidentCalls gets all the calls that were made to DeclareIncident.
+// Check the length with:
+//
+//	len(mockedIncident.DeclareIncidentCalls())
+func (mock *IncidentMock) DeclareIncidentCalls() []struct {
+	Ctx context.Context
+	Req *model.CreateIncidentRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req *model.CreateIncidentRequest
+	}
+	mock.lockDeclareIncident.RLock()
+	calls = mock.calls.DeclareIncident
+	mock.lockDeclareIncident.RUnlock()
+	return calls
+}
+
 // FilterIncidentForUser calls FilterIncidentForUserFunc.
 func (mock *IncidentMock) FilterIncidentForUser(ctx context.Context, incident *model.Incident, slackUserID types.SlackUserID) *model.Incident {
 	if mock.FilterIncidentForUserFunc == nil {
@@ -928,6 +1065,50 @@ func (mock *IncidentMock) GetIncidentByChannelIDCalls() []struct {
 	return calls
 }
 
+// GetIncidentMetrics calls GetIncidentMetricsFunc.
+func (mock *IncidentMock) GetIncidentMetrics(ctx context.Context, from time.Time, to time.Time, groupBy types.IncidentMetricsGroupBy) (*model.IncidentMetrics, error) {
+	if mock.GetIncidentMetricsFunc == nil {
+		panic("IncidentMock.GetIncidentMetricsFunc: method is nil but Incident.GetIncidentMetrics was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		From    time.Time
+		To      time.Time
+		GroupBy types.IncidentMetricsGroupBy
+	}{
+		Ctx:     ctx,
+		From:    from,
+		To:      to,
+		GroupBy: groupBy,
+	}
+	mock.lockGetIncidentMetrics.Lock()
+	mock.calls.GetIncidentMetrics = append(mock.calls.GetIncidentMetrics, callInfo)
+	mock.lockGetIncidentMetrics.Unlock()
+	return mock.GetIncidentMetricsFunc(ctx, from, to, groupBy)
+}
+
+// GetIncidentMetricsCalls gets all the calls that were made to GetIncidentMetrics.
+// Check the length with:
+//
+//	len(mockedIncident.GetIncidentMetricsCalls())
+func (mock *IncidentMock) GetIncidentMetricsCalls() []struct {
+	Ctx     context.Context
+	From    time.Time
+	To      time.Time
+	GroupBy types.IncidentMetricsGroupBy
+} {
+	var calls []struct {
+		Ctx     context.Context
+		From    time.Time
+		To      time.Time
+		GroupBy types.IncidentMetricsGroupBy
+	}
+	mock.lockGetIncidentMetrics.RLock()
+	calls = mock.calls.GetIncidentMetrics
+	mock.lockGetIncidentMetrics.RUnlock()
+	return calls
+}
+
 // GetIncidentRequest calls GetIncidentRequestFunc.
 func (mock *IncidentMock) GetIncidentRequest(ctx context.Context, requestID string) (*model.IncidentRequest, error) {
 	if mock.GetIncidentRequestFunc == nil {
@@ -1036,50 +1217,50 @@ func (mock *IncidentMock) GetRecentOpenIncidentsCalls() []struct {
 	return calls
 }
 
-// HandleCreateIncidentAction calls HandleCreateIncidentActionFunc.
-func (mock *IncidentMock) HandleCreateIncidentAction(ctx context.Context, requestID string, userID string) (*model.Incident, error) {
-	if mock.HandleCreateIncidentActionFunc == nil {
-		panic("IncidentMock.HandleCreateIncidentActionFunc: method is nil but Incident.HandleCreateIncidentAction was just called")
+// GrantIncidentAccess calls GrantIncidentAccessFunc.
+func (mock *IncidentMock) GrantIncidentAccess(ctx context.Context, incidentID types.IncidentID, req interfaces.GrantIncidentAccessRequest) (*model.AccessGrant, error) {
+	if mock.GrantIncidentAccessFunc == nil {
+		panic("IncidentMock.GrantIncidentAccessFunc: method is nil but Incident.GrantIncidentAccess was just called")
 	}
 	callInfo := struct {
-		Ctx       context.Context
-		RequestID string
-		UserID    string
+		Ctx        context.Context
+		IncidentID types.IncidentID
+		Req        interfaces.GrantIncidentAccessRequest
 	}{
-		Ctx:       ctx,
-		RequestID: requestID,
-		UserID:    userID,
+		Ctx:        ctx,
+		IncidentID: incidentID,
+		Req:        req,
 	}
-	mock.lockHandleCreateIncidentAction.Lock()
-	mock.calls.HandleCreateIncidentAction = append(mock.calls.HandleCreateIncidentAction, callInfo)
-	mock.lockHandleCreateIncidentAction.Unlock()
-	return mock.HandleCreateIncidentActionFunc(ctx, requestID, userID)
+	mock.lockGrantIncidentAccess.Lock()
+	mock.calls.GrantIncidentAccess = append(mock.calls.GrantIncidentAccess, callInfo)
+	mock.lockGrantIncidentAccess.Unlock()
+	return mock.GrantIncidentAccessFunc(ctx, incidentID, req)
 }
 
-// HandleCreateIncidentActionCalls gets all the calls that were made to HandleCreateIncidentAction.
+// GrantIncidentAccessCalls gets all the calls that were made to GrantIncidentAccess.
 // Check the length with:
 //
-//	len(mockedIncident.HandleCreateIncidentActionCalls())
-func (mock *IncidentMock) HandleCreateIncidentActionCalls() []struct {
-	Ctx       context.Context
-	RequestID string
-	UserID    string
+//	len(mockedIncident.GrantIncidentAccessCalls())
+func (mock *IncidentMock) GrantIncidentAccessCalls() []struct {
+	Ctx        context.Context
+	IncidentID types.IncidentID
+	Req        interfaces.GrantIncidentAccessRequest
 } {
 	var calls []struct {
-		Ctx       context.Context
-		RequestID string
-		UserID    string
+		Ctx        context.Context
+		IncidentID types.IncidentID
+		Req        interfaces.GrantIncidentAccessRequest
 	}
-	mock.lockHandleCreateIncidentAction.RLock()
-	calls = mock.calls.HandleCreateIncidentAction
-	mock.lockHandleCreateIncidentAction.RUnlock()
+	mock.lockGrantIncidentAccess.RLock()
+	calls = mock.calls.GrantIncidentAccess
+	mock.lockGrantIncidentAccess.RUnlock()
 	return calls
 }
 
-// HandleCreateIncidentActionAsync calls HandleCreateIncidentActionAsyncFunc.
-func (mock *IncidentMock) HandleCreateIncidentActionAsync(ctx context.Context, requestID string, userID string, channelID string) {
-	if mock.HandleCreateIncidentActionAsyncFunc == nil {
-		panic("IncidentMock.HandleCreateIncidentActionAsyncFunc: method is nil but Incident.HandleCreateIncidentActionAsync was just called")
+// HandleCreateIncidentAction calls HandleCreateIncidentActionFunc.
+func (mock *IncidentMock) HandleCreateIncidentAction(ctx context.Context, requestID string, userID string, channelID string) {
+	if mock.HandleCreateIncidentActionFunc == nil {
+		panic("IncidentMock.HandleCreateIncidentActionFunc: method is nil but Incident.HandleCreateIncidentAction was just called")
 	}
 	callInfo := struct {
 		Ctx       context.Context
@@ -1092,17 +1273,17 @@ func (mock *IncidentMock) HandleCreateIncidentActionAsync(ctx context.Context, r
 		UserID:    userID,
 		ChannelID: channelID,
 	}
-	mock.lockHandleCreateIncidentActionAsync.Lock()
-	mock.calls.HandleCreateIncidentActionAsync = append(mock.calls.HandleCreateIncidentActionAsync, callInfo)
-	mock.lockHandleCreateIncidentActionAsync.Unlock()
-	mock.HandleCreateIncidentActionAsyncFunc(ctx, requestID, userID, channelID)
+	mock.lockHandleCreateIncidentAction.Lock()
+	mock.calls.HandleCreateIncidentAction = append(mock.calls.HandleCreateIncidentAction, callInfo)
+	mock.lockHandleCreateIncidentAction.Unlock()
+	mock.HandleCreateIncidentActionFunc(ctx, requestID, userID, channelID)
 }
 
-// HandleCreateIncidentActionAsyncCalls gets all the calls that were made to HandleCreateIncidentActionAsync.
+// HandleCreateIncidentActionCalls gets all the calls that were made to HandleCreateIncidentAction.
 // Check the length with:
 //
-//	len(mockedIncident.HandleCreateIncidentActionAsyncCalls())
-func (mock *IncidentMock) HandleCreateIncidentActionAsyncCalls() []struct {
+//	len(mockedIncident.HandleCreateIncidentActionCalls())
+func (mock *IncidentMock) HandleCreateIncidentActionCalls() []struct {
 	Ctx       context.Context
 	RequestID string
 	UserID    string
@@ -1114,9 +1295,9 @@ func (mock *IncidentMock) HandleCreateIncidentActionAsyncCalls() []struct {
 		UserID    string
 		ChannelID string
 	}
-	mock.lockHandleCreateIncidentActionAsync.RLock()
-	calls = mock.calls.HandleCreateIncidentActionAsync
-	mock.lockHandleCreateIncidentActionAsync.RUnlock()
+	mock.lockHandleCreateIncidentAction.RLock()
+	calls = mock.calls.HandleCreateIncidentAction
+	mock.lockHandleCreateIncidentAction.RUnlock()
 	return calls
 }
 
@@ -1288,6 +1469,90 @@ func (mock *IncidentMock) HandleEditIncidentActionCalls() []struct {
 	return calls
 }
 
+// RequestIncidentAccess calls RequestIncidentAccessFunc.
+func (mock *IncidentMock) RequestIncidentAccess(ctx context.Context, incidentID types.IncidentID, requesterID types.SlackUserID, reason string) error {
+	if mock.RequestIncidentAccessFunc == nil {
+		panic("IncidentMock.RequestIncidentAccessFunc: method is nil but Incident.RequestIncidentAccess was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		IncidentID  types.IncidentID
+		RequesterID types.SlackUserID
+		Reason      string
+	}{
+		Ctx:         ctx,
+		IncidentID:  incidentID,
+		RequesterID: requesterID,
+		Reason:      reason,
+	}
+	mock.lockRequestIncidentAccess.Lock()
+	mock.calls.RequestIncidentAccess = append(mock.calls.RequestIncidentAccess, callInfo)
+	mock.lockRequestIncidentAccess.Unlock()
+	return mock.RequestIncidentAccessFunc(ctx, incidentID, requesterID, reason)
+}
+
+// RequestIncidentAccessCalls gets all the calls that were made to RequestIncidentAccess.
+// Check the length with:
+//
+//	len(mockedIncident.RequestIncidentAccessCalls())
+func (mock *IncidentMock) RequestIncidentAccessCalls() []struct {
+	Ctx         context.Context
+	IncidentID  types.IncidentID
+	RequesterID types.SlackUserID
+	Reason      string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		IncidentID  types.IncidentID
+		RequesterID types.SlackUserID
+		Reason      string
+	}
+	mock.lockRequestIncidentAccess.RLock()
+	calls = mock.calls.RequestIncidentAccess
+	mock.lockRequestIncidentAccess.RUnlock()
+	return calls
+}
+
+// RevokeIncidentAccess calls RevokeIncidentAccessFunc.
+func (mock *IncidentMock) RevokeIncidentAccess(ctx context.Context, incidentID types.IncidentID, grantID types.AccessGrantID) error {
+	if mock.RevokeIncidentAccessFunc == nil {
+		panic("IncidentMock.RevokeIncidentAccessFunc: method is nil but Incident.RevokeIncidentAccess was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		IncidentID types.IncidentID
+		GrantID    types.AccessGrantID
+	}{
+		Ctx:        ctx,
+		IncidentID: incidentID,
+		GrantID:    grantID,
+	}
+	mock.lockRevokeIncidentAccess.Lock()
+	mock.calls.RevokeIncidentAccess = append(mock.calls.RevokeIncidentAccess, callInfo)
+	mock.lockRevokeIncidentAccess.Unlock()
+	return mock.RevokeIncidentAccessFunc(ctx, incidentID, grantID)
+}
+
+// RevokeIncidentAccessCalls gets all the calls that were made to RevokeIncidentAccess.
+// Check the length with:
+//
+//	len(mockedIncident.RevokeIncidentAccessCalls())
+func (mock *IncidentMock) RevokeIncidentAccessCalls() []struct {
+	Ctx        context.Context
+	IncidentID types.IncidentID
+	GrantID    types.AccessGrantID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		IncidentID types.IncidentID
+		GrantID    types.AccessGrantID
+	}
+	mock.lockRevokeIncidentAccess.RLock()
+	calls = mock.calls.RevokeIncidentAccess
+	mock.lockRevokeIncidentAccess.RUnlock()
+	return calls
+}
+
 // SyncIncidentMemberWithEvent calls SyncIncidentMemberWithEventFunc.
 func (mock *IncidentMock) SyncIncidentMemberWithEvent(ctx context.Context, incidentID types.IncidentID, channelID types.ChannelID, eventUserID types.SlackUserID, isJoin bool) error {
 	if mock.SyncIncidentMemberWithEventFunc == nil {
@@ -1336,6 +1601,50 @@ func (mock *IncidentMock) SyncIncidentMemberWithEventCalls() []struct {
 	return calls
 }
 
+// UpdateIncident calls UpdateIncidentFunc.
+func (mock *IncidentMock) UpdateIncident(ctx context.Context, incidentID types.IncidentID, req model.UpdateIncidentRequest, updatedBy types.SlackUserID) (*model.Incident, error) {
+	if mock.UpdateIncidentFunc == nil {
+		panic("IncidentMock.UpdateIncidentFunc: method is nil but Incident.UpdateIncident was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		IncidentID types.IncidentID
+		Req        model.UpdateIncidentRequest
+		UpdatedBy  types.SlackUserID
+	}{
+		Ctx:        ctx,
+		IncidentID: incidentID,
+		Req:        req,
+		UpdatedBy:  updatedBy,
+	}
+	mock.lockUpdateIncident.Lock()
+	mock.calls.UpdateIncident = append(mock.calls.UpdateIncident, callInfo)
+	mock.lockUpdateIncident.Unlock()
+	return mock.UpdateIncidentFunc(ctx, incidentID, req, updatedBy)
+}
+
+// UpdateIncidentCalls gets all the calls that were made to UpdateIncident.
+// Check the length with:
+//
+//	len(mockedIncident.UpdateIncidentCalls())
+func (mock *IncidentMock) UpdateIncidentCalls() []struct {
+	Ctx        context.Context
+	IncidentID types.IncidentID
+	Req        model.UpdateIncidentRequest
+	UpdatedBy  types.SlackUserID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		IncidentID types.IncidentID
+		Req        model.UpdateIncidentRequest
+		UpdatedBy  types.SlackUserID
+	}
+	mock.lockUpdateIncident.RLock()
+	calls = mock.calls.UpdateIncident
+	mock.lockUpdateIncident.RUnlock()
+	return calls
+}
+
 // UpdateIncidentDetails calls UpdateIncidentDetailsFunc.
 func (mock *IncidentMock) UpdateIncidentDetails(ctx context.Context, incidentID types.IncidentID, title string, description string, lead types.SlackUserID, severityID string, updatedBy types.SlackUserID) (*model.Incident, error) {
 	if mock.UpdateIncidentDetailsFunc == nil {
@@ -1452,6 +1761,46 @@ func (mock *IncidentMock) UpdateIncidentDetailsWithAssetsCalls() []struct {
 	return calls
 }
 
+// ViewIncident calls ViewIncidentFunc.
+func (mock *IncidentMock) ViewIncident(ctx context.Context, incident *model.Incident, slackUserID types.SlackUserID) *model.Incident {
+	if mock.ViewIncidentFunc == nil {
+		panic("IncidentMock.ViewIncidentFunc: method is nil but Incident.ViewIncident was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		Incident    *model.Incident
+		SlackUserID types.SlackUserID
+	}{
+		Ctx:         ctx,
+		Incident:    incident,
+		SlackUserID: slackUserID,
+	}
+	mock.lockViewIncident.Lock()
+	mock.calls.ViewIncident = append(mock.calls.ViewIncident, callInfo)
+	mock.lockViewIncident.Unlock()
+	return mock.ViewIncidentFunc(ctx, incident, slackUserID)
+}
+
+// ViewIncidentCalls gets all the calls that were made to ViewIncident.
+// Check the length with:
+//
+//	len(mockedIncident.ViewIncidentCalls())
+func (mock *IncidentMock) ViewIncidentCalls() []struct {
+	Ctx         context.Context
+	Incident    *model.Incident
+	SlackUserID types.SlackUserID
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Incident    *model.Incident
+		SlackUserID types.SlackUserID
+	}
+	mock.lockViewIncident.RLock()
+	calls = mock.calls.ViewIncident
+	mock.lockViewIncident.RUnlock()
+	return calls
+}
+
 // Ensure, that TaskMock does implement interfaces.Task.
 // If this is not the case, regenerate this file with moq.
 var _ interfaces.Task = &TaskMock{}
@@ -2590,7 +2939,10 @@ var _ interfaces.Auth = &AuthMock{}
 //
 //		// make and configure a mocked interfaces.Auth
 //		mockedAuth := &AuthMock{
-//			CreateSessionFunc: func(ctx context.Context, slackUserID string, userName string, userEmail string) (*model.Session, error) {
+//			CreateAPITokenFunc: func(ctx context.Context, req interfaces.CreateAPITokenRequest) (*model.APIToken, string, error) {
+//				panic("mock out the CreateAPIToken method")
+//			},
+//			CreateSessionFunc: func(ctx context.Context, slackUserID string, userName string, userEmail string, client model.SessionClient) (*model.Session, error) {
 //				panic("mock out the CreateSession method")
 //			},
 //			DeleteSessionFunc: func(ctx context.Context, sessionID string) error {
@@ -2599,6 +2951,9 @@ var _ interfaces.Auth = &AuthMock{}
 //			GenerateOAuthURLFunc: func(ctx context.Context, config interfaces.OAuthConfig) (*interfaces.OAuthURL, error) {
 //				panic("mock out the GenerateOAuthURL method")
 //			},
+//			GetAPITokenFunc: func(ctx context.Context, id types.APITokenID) (*model.APIToken, error) {
+//				panic("mock out the GetAPIToken method")
+//			},
 //			GetChannelMembersFunc: func(ctx context.Context, channelID string) ([]*model.User, error) {
 //				panic("mock out the GetChannelMembers method")
 //			},
@@ -2608,7 +2963,25 @@ var _ interfaces.Auth = &AuthMock{}
 //			HandleCallbackFunc: func(ctx context.Context, code string, redirectURI string) (*model.User, error) {
 //				panic("mock out the HandleCallback method")
 //			},
-//			ValidateSessionFunc: func(ctx context.Context, sessionID string, sessionSecret string) (*model.Session, error) {
+//			ListAPITokensFunc: func(ctx context.Context, ownerID types.SlackUserID) ([]*model.APIToken, error) {
+//				panic("mock out the ListAPITokens method")
+//			},
+//			ListSessionsFunc: func(ctx context.Context, userID types.UserID) ([]*model.Session, error) {
+//				panic("mock out the ListSessions method")
+//			},
+//			RevokeAPITokenFunc: func(ctx context.Context, id types.APITokenID) (*model.APIToken, error) {
+//				panic("mock out the RevokeAPIToken method")
+//			},
+//			RevokeAllSessionsFunc: func(ctx context.Context, userID types.UserID) (int, error) {
+//				panic("mock out the RevokeAllSessions method")
+//			},
+//			RevokeSessionFunc: func(ctx context.Context, userID types.UserID, sessionID types.SessionID) error {
+//				panic("mock out the RevokeSession method")
+//			},
+//			ValidateAPITokenFunc: func(ctx context.Context, token string) (*model.APIToken, error) {
+//				panic("mock out the ValidateAPIToken method")
+//			},
+//			ValidateSessionFunc: func(ctx context.Context, sessionID string, sessionSecret string, client model.SessionClient) (*model.Session, error) {
 //				panic("mock out the ValidateSession method")
 //			},
 //		}
@@ -2618,8 +2991,11 @@ var _ interfaces.Auth = &AuthMock{}
 //
 //	}
 type AuthMock struct {
+	// CreateAPITokenFunc mocks the CreateAPIToken method.
+	CreateAPITokenFunc func(ctx context.Context, req interfaces.CreateAPITokenRequest) (*model.APIToken, string, error)
+
 	// CreateSessionFunc mocks the CreateSession method.
-	CreateSessionFunc func(ctx context.Context, slackUserID string, userName string, userEmail string) (*model.Session, error)
+	CreateSessionFunc func(ctx context.Context, slackUserID string, userName string, userEmail string, client model.SessionClient) (*model.Session, error)
 
 	// DeleteSessionFunc mocks the DeleteSession method.
 	DeleteSessionFunc func(ctx context.Context, sessionID string) error
@@ -2627,6 +3003,9 @@ type AuthMock struct {
 	// GenerateOAuthURLFunc mocks the GenerateOAuthURL method.
 	GenerateOAuthURLFunc func(ctx context.Context, config interfaces.OAuthConfig) (*interfaces.OAuthURL, error)
 
+	// GetAPITokenFunc mocks the GetAPIToken method.
+	GetAPITokenFunc func(ctx context.Context, id types.APITokenID) (*model.APIToken, error)
+
 	// GetChannelMembersFunc mocks the GetChannelMembers method.
 	GetChannelMembersFunc func(ctx context.Context, channelID string) ([]*model.User, error)
 
@@ -2636,11 +3015,36 @@ type AuthMock struct {
 	// HandleCallbackFunc mocks the HandleCallback method.
 	HandleCallbackFunc func(ctx context.Context, code string, redirectURI string) (*model.User, error)
 
+	// ListAPITokensFunc mocks the ListAPITokens method.
+	ListAPITokensFunc func(ctx context.Context, ownerID types.SlackUserID) ([]*model.APIToken, error)
+
+	// ListSessionsFunc mocks the ListSessions method.
+	ListSessionsFunc func(ctx context.Context, userID types.UserID) ([]*model.Session, error)
+
+	// RevokeAPITokenFunc mocks the RevokeAPIToken method.
+	RevokeAPITokenFunc func(ctx context.Context, id types.APITokenID) (*model.APIToken, error)
+
+	// RevokeAllSessionsFunc mocks the RevokeAllSessions method.
+	RevokeAllSessionsFunc func(ctx context.Context, userID types.UserID) (int, error)
+
+	// RevokeSessionFunc mocks the RevokeSession method.
+	RevokeSessionFunc func(ctx context.Context, userID types.UserID, sessionID types.SessionID) error
+
+	// ValidateAPITokenFunc mocks the ValidateAPIToken method.
+	ValidateAPITokenFunc func(ctx context.Context, token string) (*model.APIToken, error)
+
 	// ValidateSessionFunc mocks the ValidateSession method.
-	ValidateSessionFunc func(ctx context.Context, sessionID string, sessionSecret string) (*model.Session, error)
+	ValidateSessionFunc func(ctx context.Context, sessionID string, sessionSecret string, client model.SessionClient) (*model.Session, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// CreateAPIToken holds details about calls to the CreateAPIToken method.
+		CreateAPIToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req interfaces.CreateAPITokenRequest
+		}
 		// CreateSession holds details about calls to the CreateSession method.
 		CreateSession []struct {
 			// Ctx is the ctx argument value.
@@ -2651,6 +3055,8 @@ type AuthMock struct {
 			UserName string
 			// UserEmail is the userEmail argument value.
 			UserEmail string
+			// Client is the client argument value.
+			Client model.SessionClient
 		}
 		// DeleteSession holds details about calls to the DeleteSession method.
 		DeleteSession []struct {
@@ -2666,7 +3072,14 @@ type AuthMock struct {
 			// Config is the config argument value.
 			Config interfaces.OAuthConfig
 		}
-		// GetChannelMembers holds details about calls to the GetChannelMembers method.
+		// GetAPIToken holds details about calls to the GetAPIToken method.
+		GetAPIToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID types.APITokenID
+		}
+		// GetChannelMembers holds details about calls to the GetChannelMembers method.
 		GetChannelMembers []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
@@ -2689,6 +3102,50 @@ type AuthMock struct {
 			// RedirectURI is the redirectURI argument value.
 			RedirectURI string
 		}
+		// ListAPITokens holds details about calls to the ListAPITokens method.
+		ListAPITokens []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID types.SlackUserID
+		}
+		// ListSessions holds details about calls to the ListSessions method.
+		ListSessions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID types.UserID
+		}
+		// RevokeAPIToken holds details about calls to the RevokeAPIToken method.
+		RevokeAPIToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID types.APITokenID
+		}
+		// RevokeAllSessions holds details about calls to the RevokeAllSessions method.
+		RevokeAllSessions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID types.UserID
+		}
+		// RevokeSession holds details about calls to the RevokeSession method.
+		RevokeSession []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID types.UserID
+			// SessionID is the sessionID argument value.
+			SessionID types.SessionID
+		}
+		// ValidateAPIToken holds details about calls to the ValidateAPIToken method.
+		ValidateAPIToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Token is the token argument value.
+			Token string
+		}
 		// ValidateSession holds details about calls to the ValidateSession method.
 		ValidateSession []struct {
 			// Ctx is the ctx argument value.
@@ -2697,19 +3154,65 @@ type AuthMock struct {
 			SessionID string
 			// SessionSecret is the sessionSecret argument value.
 			SessionSecret string
+			// Client is the client argument value.
+			Client model.SessionClient
 		}
 	}
+	lockCreateAPIToken     sync.RWMutex
 	lockCreateSession      sync.RWMutex
 	lockDeleteSession      sync.RWMutex
 	lockGenerateOAuthURL   sync.RWMutex
+	lockGetAPIToken        sync.RWMutex
 	lockGetChannelMembers  sync.RWMutex
 	lockGetUserFromSession sync.RWMutex
 	lockHandleCallback     sync.RWMutex
+	lockListAPITokens      sync.RWMutex
+	lockListSessions       sync.RWMutex
+	lockRevokeAPIToken     sync.RWMutex
+	lockRevokeAllSessions  sync.RWMutex
+	lockRevokeSession      sync.RWMutex
+	lockValidateAPIToken   sync.RWMutex
 	lockValidateSession    sync.RWMutex
 }
 
+// CreateAPIToken calls CreateAPITokenFunc.
+func (mock *AuthMock) CreateAPIToken(ctx context.Context, req interfaces.CreateAPITokenRequest) (*model.APIToken, string, error) {
+	if mock.CreateAPITokenFunc == nil {
+		panic("AuthMock.CreateAPITokenFunc: method is nil but Auth.CreateAPIToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req interfaces.CreateAPITokenRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockCreateAPIToken.Lock()
+	mock.calls.CreateAPIToken = append(mock.calls.CreateAPIToken, callInfo)
+	mock.lockCreateAPIToken.Unlock()
+	return mock.CreateAPITokenFunc(ctx, req)
+}
+
+// CreateAPITokenCalls gets all the calls that were made to CreateAPIToken.
+// Check the length with:
+//
+//	len(mockedAuth.CreateAPITokenCalls())
+func (mock *AuthMock) CreateAPITokenCalls() []struct {
+	Ctx context.Context
+	Req interfaces.CreateAPITokenRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req interfaces.CreateAPITokenRequest
+	}
+	mock.lockCreateAPIToken.RLock()
+	calls = mock.calls.CreateAPIToken
+	mock.lockCreateAPIToken.RUnlock()
+	return calls
+}
+
 // CreateSession calls CreateSessionFunc.
-func (mock *AuthMock) CreateSession(ctx context.Context, slackUserID string, userName string, userEmail string) (*model.Session, error) {
+func (mock *AuthMock) CreateSession(ctx context.Context, slackUserID string, userName string, userEmail string, client model.SessionClient) (*model.Session, error) {
 	if mock.CreateSessionFunc == nil {
 		panic("AuthMock.CreateSessionFunc: method is nil but Auth.CreateSession was just called")
 	}
@@ -2718,16 +3221,18 @@ func (mock *AuthMock) CreateSession(ctx context.Context, slackUserID string, use
 		SlackUserID string
 		UserName    string
 		UserEmail   string
+		Client      model.SessionClient
 	}{
 		Ctx:         ctx,
 		SlackUserID: slackUserID,
 		UserName:    userName,
 		UserEmail:   userEmail,
+		Client:      client,
 	}
 	mock.lockCreateSession.Lock()
 	mock.calls.CreateSession = append(mock.calls.CreateSession, callInfo)
 	mock.lockCreateSession.Unlock()
-	return mock.CreateSessionFunc(ctx, slackUserID, userName, userEmail)
+	return mock.CreateSessionFunc(ctx, slackUserID, userName, userEmail, client)
 }
 
 // CreateSessionCalls gets all the calls that were made to CreateSession.
@@ -2739,12 +3244,14 @@ func (mock *AuthMock) CreateSessionCalls() []struct {
 	SlackUserID string
 	UserName    string
 	UserEmail   string
+	Client      model.SessionClient
 } {
 	var calls []struct {
 		Ctx         context.Context
 		SlackUserID string
 		UserName    string
 		UserEmail   string
+		Client      model.SessionClient
 	}
 	mock.lockCreateSession.RLock()
 	calls = mock.calls.CreateSession
@@ -2824,6 +3331,42 @@ func (mock *AuthMock) GenerateOAuthURLCalls() []struct {
 	return calls
 }
 
+// GetAPIToken calls GetAPITokenFunc.
+func (mock *AuthMock) GetAPIToken(ctx context.Context, id types.APITokenID) (*model.APIToken, error) {
+	if mock.GetAPITokenFunc == nil {
+		panic("AuthMock.GetAPITokenFunc: method is nil but Auth.GetAPIToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  types.APITokenID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetAPIToken.Lock()
+	mock.calls.GetAPIToken = append(mock.calls.GetAPIToken, callInfo)
+	mock.lockGetAPIToken.Unlock()
+	return mock.GetAPITokenFunc(ctx, id)
+}
+
+// GetAPITokenCalls gets all the calls that were made to GetAPIToken.
+// Check the length with:
+//
+//	len(mockedAuth.GetAPITokenCalls())
+func (mock *AuthMock) GetAPITokenCalls() []struct {
+	Ctx context.Context
+	ID  types.APITokenID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  types.APITokenID
+	}
+	mock.lockGetAPIToken.RLock()
+	calls = mock.calls.GetAPIToken
+	mock.lockGetAPIToken.RUnlock()
+	return calls
+}
+
 // GetChannelMembers calls GetChannelMembersFunc.
 func (mock *AuthMock) GetChannelMembers(ctx context.Context, channelID string) ([]*model.User, error) {
 	if mock.GetChannelMembersFunc == nil {
@@ -2936,8 +3479,228 @@ func (mock *AuthMock) HandleCallbackCalls() []struct {
 	return calls
 }
 
+// ListAPITokens calls ListAPITokensFunc.
+func (mock *AuthMock) ListAPITokens(ctx context.Context, ownerID types.SlackUserID) ([]*model.APIToken, error) {
+	if mock.ListAPITokensFunc == nil {
+		panic("AuthMock.ListAPITokensFunc: method is nil but Auth.ListAPITokens was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		OwnerID types.SlackUserID
+	}{
+		Ctx:     ctx,
+		OwnerID: ownerID,
+	}
+	mock.lockListAPITokens.Lock()
+	mock.calls.ListAPITokens = append(mock.calls.ListAPITokens, callInfo)
+	mock.lockListAPITokens.Unlock()
+	return mock.ListAPITokensFunc(ctx, ownerID)
+}
+
+// ListAPITokensCalls gets all the calls that were made to ListAPITokens.
+// Check the length with:
+//
+//	len(mockedAuth.ListAPITokensCalls())
+func (mock *AuthMock) ListAPITokensCalls() []struct {
+	Ctx     context.Context
+	OwnerID types.SlackUserID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		OwnerID types.SlackUserID
+	}
+	mock.lockListAPITokens.RLock()
+	calls = mock.calls.ListAPITokens
+	mock.lockListAPITokens.RUnlock()
+	return calls
+}
+
+// ListSessions calls ListSessionsFunc.
+func (mock *AuthMock) ListSessions(ctx context.Context, userID types.UserID) ([]*model.Session, error) {
+	if mock.ListSessionsFunc == nil {
+		panic("AuthMock.ListSessionsFunc: method is nil but Auth.ListSessions was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID types.UserID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockListSessions.Lock()
+	mock.calls.ListSessions = append(mock.calls.ListSessions, callInfo)
+	mock.lockListSessions.Unlock()
+	return mock.ListSessionsFunc(ctx, userID)
+}
+
+// ListSessionsCalls gets all the calls that were made to ListSessions.
+// Check the length with:
+//
+//	len(mockedAuth.ListSessionsCalls())
+func (mock *AuthMock) ListSessionsCalls() []struct {
+	Ctx    context.Context
+	UserID types.UserID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID types.UserID
+	}
+	mock.lockListSessions.RLock()
+	calls = mock.calls.ListSessions
+	mock.lockListSessions.RUnlock()
+	return calls
+}
+
+// RevokeAPIToken calls RevokeAPITokenFunc.
+func (mock *AuthMock) RevokeAPIToken(ctx context.Context, id types.APITokenID) (*model.APIToken, error) {
+	if mock.RevokeAPITokenFunc == nil {
+		panic("AuthMock.RevokeAPITokenFunc: method is nil but Auth.RevokeAPIToken was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  types.APITokenID
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockRevokeAPIToken.Lock()
+	mock.calls.RevokeAPIToken = append(mock.calls.RevokeAPIToken, callInfo)
+	mock.lockRevokeAPIToken.Unlock()
+	return mock.RevokeAPITokenFunc(ctx, id)
+}
+
+// RevokeAPITokenCalls gets all the calls that were made to RevokeAPIToken.
+// Check the length with:
+//
+//	len(mockedAuth.RevokeAPITokenCalls())
+func (mock *AuthMock) RevokeAPITokenCalls() []struct {
+	Ctx context.Context
+	ID  types.APITokenID
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  types.APITokenID
+	}
+	mock.lockRevokeAPIToken.RLock()
+	calls = mock.calls.RevokeAPIToken
+	mock.lockRevokeAPIToken.RUnlock()
+	return calls
+}
+
+// RevokeAllSessions calls RevokeAllSessionsFunc.
+func (mock *AuthMock) RevokeAllSessions(ctx context.Context, userID types.UserID) (int, error) {
+	if mock.RevokeAllSessionsFunc == nil {
+		panic("AuthMock.RevokeAllSessionsFunc: method is nil but Auth.RevokeAllSessions was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID types.UserID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockRevokeAllSessions.Lock()
+	mock.calls.RevokeAllSessions = append(mock.calls.RevokeAllSessions, callInfo)
+	mock.lockRevokeAllSessions.Unlock()
+	return mock.RevokeAllSessionsFunc(ctx, userID)
+}
+
+// RevokeAllSessionsCalls gets all the calls that were made to RevokeAllSessions.
+// Check the length with:
+//
+//	len(mockedAuth.RevokeAllSessionsCalls())
+func (mock *AuthMock) RevokeAllSessionsCalls() []struct {
+	Ctx    context.Context
+	UserID types.UserID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID types.UserID
+	}
+	mock.lockRevokeAllSessions.RLock()
+	calls = mock.calls.RevokeAllSessions
+	mock.lockRevokeAllSessions.RUnlock()
+	return calls
+}
+
+// RevokeSession calls RevokeSessionFunc.
+func (mock *AuthMock) RevokeSession(ctx context.Context, userID types.UserID, sessionID types.SessionID) error {
+	if mock.RevokeSessionFunc == nil {
+		panic("AuthMock.RevokeSessionFunc: method is nil but Auth.RevokeSession was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		UserID    types.UserID
+		SessionID types.SessionID
+	}{
+		Ctx:       ctx,
+		UserID:    userID,
+		SessionID: sessionID,
+	}
+	mock.lockRevokeSession.Lock()
+	mock.calls.RevokeSession = append(mock.calls.RevokeSession, callInfo)
+	mock.lockRevokeSession.Unlock()
+	return mock.RevokeSessionFunc(ctx, userID, sessionID)
+}
+
+// RevokeSessionCalls gets all the calls that were made to RevokeSession.
+// Check the length with:
+//
+//	len(mockedAuth.RevokeSessionCalls())
+func (mock *AuthMock) RevokeSessionCalls() []struct {
+	Ctx       context.Context
+	UserID    types.UserID
+	SessionID types.SessionID
+} {
+	var calls []struct {
+		Ctx       context.Context
+		UserID    types.UserID
+		SessionID types.SessionID
+	}
+	mock.lockRevokeSession.RLock()
+	calls = mock.calls.RevokeSession
+	mock.lockRevokeSession.RUnlock()
+	return calls
+}
+
+// ValidateAPIToken calls ValidateAPITokenFunc.
+func (mock *AuthMock) ValidateAPIToken(ctx context.Context, token string) (*model.APIToken, error) {
+	if mock.ValidateAPITokenFunc == nil {
+		panic("AuthMock.ValidateAPITokenFunc: method is nil but Auth.ValidateAPIToken was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Token string
+	}{
+		Ctx:   ctx,
+		Token: token,
+	}
+	mock.lockValidateAPIToken.Lock()
+	mock.calls.ValidateAPIToken = append(mock.calls.ValidateAPIToken, callInfo)
+	mock.lockValidateAPIToken.Unlock()
+	return mock.ValidateAPITokenFunc(ctx, token)
+}
+
+// ValidateAPITokenCalls gets all the calls that were made to ValidateAPIToken.
+// Check the length with:
+//
+//	len(mockedAuth.ValidateAPITokenCalls())
+func (mock *AuthMock) ValidateAPITokenCalls() []struct {
+	Ctx   context.Context
+	Token string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Token string
+	}
+	mock.lockValidateAPIToken.RLock()
+	calls = mock.calls.ValidateAPIToken
+	mock.lockValidateAPIToken.RUnlock()
+	return calls
+}
+
 // ValidateSession calls ValidateSessionFunc.
-func (mock *AuthMock) ValidateSession(ctx context.Context, sessionID string, sessionSecret string) (*model.Session, error) {
+func (mock *AuthMock) ValidateSession(ctx context.Context, sessionID string, sessionSecret string, client model.SessionClient) (*model.Session, error) {
 	if mock.ValidateSessionFunc == nil {
 		panic("AuthMock.ValidateSessionFunc: method is nil but Auth.ValidateSession was just called")
 	}
@@ -2945,15 +3708,17 @@ func (mock *AuthMock) ValidateSession(ctx context.Context, sessionID string, ses
 		Ctx           context.Context
 		SessionID     string
 		SessionSecret string
+		Client        model.SessionClient
 	}{
 		Ctx:           ctx,
 		SessionID:     sessionID,
 		SessionSecret: sessionSecret,
+		Client:        client,
 	}
 	mock.lockValidateSession.Lock()
 	mock.calls.ValidateSession = append(mock.calls.ValidateSession, callInfo)
 	mock.lockValidateSession.Unlock()
-	return mock.ValidateSessionFunc(ctx, sessionID, sessionSecret)
+	return mock.ValidateSessionFunc(ctx, sessionID, sessionSecret, client)
 }
 
 // ValidateSessionCalls gets all the calls that were made to ValidateSession.
@@ -2964,14 +3729,770 @@ func (mock *AuthMock) ValidateSessionCalls() []struct {
 	Ctx           context.Context
 	SessionID     string
 	SessionSecret string
+	Client        model.SessionClient
 } {
 	var calls []struct {
 		Ctx           context.Context
 		SessionID     string
 		SessionSecret string
+		Client        model.SessionClient
 	}
 	mock.lockValidateSession.RLock()
 	calls = mock.calls.ValidateSession
 	mock.lockValidateSession.RUnlock()
 	return calls
 }
+
+// Ensure, that AuthorizationMock does implement interfaces.Authorization.
+// If this is not the case, regenerate this file with moq.
+var _ interfaces.Authorization = &AuthorizationMock{}
+
+// AuthorizationMock is a mock implementation of interfaces.Authorization.
+//
+//	func TestSomethingThatUsesAuthorization(t *testing.T) {
+//
+//		// make and configure a mocked interfaces.Authorization
+//		mockedAuthorization := &AuthorizationMock{
+//			AuthorizeIncidentCreationFunc: func(ctx context.Context, userID types.SlackUserID) error {
+//				panic("mock out the AuthorizeIncidentCreation method")
+//			},
+//			AuthorizeIncidentUpdateFunc: func(ctx context.Context, userID types.SlackUserID, incident *model.Incident) error {
+//				panic("mock out the AuthorizeIncidentUpdate method")
+//			},
+//			GetRoleFunc: func(ctx context.Context, userID types.SlackUserID) (types.Role, error) {
+//				panic("mock out the GetRole method")
+//			},
+//		}
+//
+//		// use mockedAuthorization in code that requires interfaces.Authorization
+//		// and then make assertions.
+//
+//	}
+type AuthorizationMock struct {
+	// AuthorizeIncidentCreationFunc mocks the AuthorizeIncidentCreation method.
+	AuthorizeIncidentCreationFunc func(ctx context.Context, userID types.SlackUserID) error
+
+	// AuthorizeIncidentUpdateFunc mocks the AuthorizeIncidentUpdate method.
+	AuthorizeIncidentUpdateFunc func(ctx context.Context, userID types.SlackUserID, incident *model.Incident) error
+
+	// GetRoleFunc mocks the GetRole method.
+	GetRoleFunc func(ctx context.Context, userID types.SlackUserID) (types.Role, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AuthorizeIncidentCreation holds details about calls to the AuthorizeIncidentCreation method.
+		AuthorizeIncidentCreation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID types.SlackUserID
+		}
+		// AuthorizeIncidentUpdate holds details about calls to the AuthorizeIncidentUpdate method.
+		AuthorizeIncidentUpdate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID types.SlackUserID
+			// Incident is the incident argument value.
+			Incident *model.Incident
+		}
+		// GetRole holds details about calls to the GetRole method.
+		GetRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserID is the userID argument value.
+			UserID types.SlackUserID
+		}
+	}
+	lockAuthorizeIncidentCreation sync.RWMutex
+	lockAuthorizeIncidentUpdate   sync.RWMutex
+	lockGetRole                   sync.RWMutex
+}
+
+// AuthorizeIncidentCreation calls AuthorizeIncidentCreationFunc.
+func (mock *AuthorizationMock) AuthorizeIncidentCreation(ctx context.Context, userID types.SlackUserID) error {
+	if mock.AuthorizeIncidentCreationFunc == nil {
+		panic("AuthorizationMock.AuthorizeIncidentCreationFunc: method is nil but Authorization.AuthorizeIncidentCreation was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID types.SlackUserID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockAuthorizeIncidentCreation.Lock()
+	mock.calls.AuthorizeIncidentCreation = append(mock.calls.AuthorizeIncidentCreation, callInfo)
+	mock.lockAuthorizeIncidentCreation.Unlock()
+	return mock.AuthorizeIncidentCreationFunc(ctx, userID)
+}
+
+// AuthorizeIncidentCreationCalls gets all the calls that were made to AuthorizeIncidentCreation.
+// Check the length with:
+//
+//	len(mockedAuthorization.AuthorizeIncidentCreationCalls())
+func (mock *AuthorizationMock) AuthorizeIncidentCreationCalls() []struct {
+	Ctx    context.Context
+	UserID types.SlackUserID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID types.SlackUserID
+	}
+	mock.lockAuthorizeIncidentCreation.RLock()
+	calls = mock.calls.AuthorizeIncidentCreation
+	mock.lockAuthorizeIncidentCreation.RUnlock()
+	return calls
+}
+
+// AuthorizeIncidentUpdate calls AuthorizeIncidentUpdateFunc.
+func (mock *AuthorizationMock) AuthorizeIncidentUpdate(ctx context.Context, userID types.SlackUserID, incident *model.Incident) error {
+	if mock.AuthorizeIncidentUpdateFunc == nil {
+		panic("AuthorizationMock.AuthorizeIncidentUpdateFunc: method is nil but Authorization.AuthorizeIncidentUpdate was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserID   types.SlackUserID
+		Incident *model.Incident
+	}{
+		Ctx:      ctx,
+		UserID:   userID,
+		Incident: incident,
+	}
+	mock.lockAuthorizeIncidentUpdate.Lock()
+	mock.calls.AuthorizeIncidentUpdate = append(mock.calls.AuthorizeIncidentUpdate, callInfo)
+	mock.lockAuthorizeIncidentUpdate.Unlock()
+	return mock.AuthorizeIncidentUpdateFunc(ctx, userID, incident)
+}
+
+// AuthorizeIncidentUpdateCalls gets all the calls that were made to AuthorizeIncidentUpdate.
+// Check the length with:
+//
+//	len(mockedAuthorization.AuthorizeIncidentUpdateCalls())
+func (mock *AuthorizationMock) AuthorizeIncidentUpdateCalls() []struct {
+	Ctx      context.Context
+	UserID   types.SlackUserID
+	Incident *model.Incident
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserID   types.SlackUserID
+		Incident *model.Incident
+	}
+	mock.lockAuthorizeIncidentUpdate.RLock()
+	calls = mock.calls.AuthorizeIncidentUpdate
+	mock.lockAuthorizeIncidentUpdate.RUnlock()
+	return calls
+}
+
+// GetRole calls GetRoleFunc.
+func (mock *AuthorizationMock) GetRole(ctx context.Context, userID types.SlackUserID) (types.Role, error) {
+	if mock.GetRoleFunc == nil {
+		panic("AuthorizationMock.GetRoleFunc: method is nil but Authorization.GetRole was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserID types.SlackUserID
+	}{
+		Ctx:    ctx,
+		UserID: userID,
+	}
+	mock.lockGetRole.Lock()
+	mock.calls.GetRole = append(mock.calls.GetRole, callInfo)
+	mock.lockGetRole.Unlock()
+	return mock.GetRoleFunc(ctx, userID)
+}
+
+// GetRoleCalls gets all the calls that were made to GetRole.
+// Check the length with:
+//
+//	len(mockedAuthorization.GetRoleCalls())
+func (mock *AuthorizationMock) GetRoleCalls() []struct {
+	Ctx    context.Context
+	UserID types.SlackUserID
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserID types.SlackUserID
+	}
+	mock.lockGetRole.RLock()
+	calls = mock.calls.GetRole
+	mock.lockGetRole.RUnlock()
+	return calls
+}
+
+// Ensure, that ReminderMock does implement interfaces.Reminder.
+// If this is not the case, regenerate this file with moq.
+var _ interfaces.Reminder = &ReminderMock{}
+
+// ReminderMock is a mock implementation of interfaces.Reminder.
+//
+//	func TestSomethingThatUsesReminder(t *testing.T) {
+//
+//		// make and configure a mocked interfaces.Reminder
+//		mockedReminder := &ReminderMock{
+//			SnoozeFunc: func(ctx context.Context, reminderID string, d time.Duration, userID types.SlackUserID) (*model.Reminder, error) {
+//				panic("mock out the Snooze method")
+//			},
+//		}
+//
+//		// use mockedReminder in code that requires interfaces.Reminder
+//		// and then make assertions.
+//
+//	}
+type ReminderMock struct {
+	// SnoozeFunc mocks the Snooze method.
+	SnoozeFunc func(ctx context.Context, reminderID string, d time.Duration, userID types.SlackUserID) (*model.Reminder, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Snooze holds details about calls to the Snooze method.
+		Snooze []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ReminderID is the reminderID argument value.
+			ReminderID string
+			// D is the d argument value.
+			D time.Duration
+			// UserID is the userID argument value.
+			UserID types.SlackUserID
+		}
+	}
+	lockSnooze sync.RWMutex
+}
+
+// Snooze calls SnoozeFunc.
+func (mock *ReminderMock) Snooze(ctx context.Context, reminderID string, d time.Duration, userID types.SlackUserID) (*model.Reminder, error) {
+	if mock.SnoozeFunc == nil {
+		panic("ReminderMock.SnoozeFunc: method is nil but Reminder.Snooze was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ReminderID string
+		D          time.Duration
+		UserID     types.SlackUserID
+	}{
+		Ctx:        ctx,
+		ReminderID: reminderID,
+		D:          d,
+		UserID:     userID,
+	}
+	mock.lockSnooze.Lock()
+	mock.calls.Snooze = append(mock.calls.Snooze, callInfo)
+	mock.lockSnooze.Unlock()
+	return mock.SnoozeFunc(ctx, reminderID, d, userID)
+}
+
+// SnoozeCalls gets all the calls that were made to Snooze.
+// Check the length with:
+//
+//	len(mockedReminder.SnoozeCalls())
+func (mock *ReminderMock) SnoozeCalls() []struct {
+	Ctx        context.Context
+	ReminderID string
+	D          time.Duration
+	UserID     types.SlackUserID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ReminderID string
+		D          time.Duration
+		UserID     types.SlackUserID
+	}
+	mock.lockSnooze.RLock()
+	calls = mock.calls.Snooze
+	mock.lockSnooze.RUnlock()
+	return calls
+}
+
+// Ensure, that StakeholderUpdateMock does implement interfaces.StakeholderUpdate.
+// If this is not the case, regenerate this file with moq.
+var _ interfaces.StakeholderUpdate = &StakeholderUpdateMock{}
+
+// StakeholderUpdateMock is a mock implementation of interfaces.StakeholderUpdate.
+//
+//	func TestSomethingThatUsesStakeholderUpdate(t *testing.T) {
+//
+//		// make and configure a mocked interfaces.StakeholderUpdate
+//		mockedStakeholderUpdate := &StakeholderUpdateMock{
+//			ListStakeholderUpdatesFunc: func(ctx context.Context, incidentID types.IncidentID) ([]*model.StakeholderUpdate, error) {
+//				panic("mock out the ListStakeholderUpdates method")
+//			},
+//			OpenStakeholderUpdateModalFunc: func(ctx context.Context, incidentID types.IncidentID, triggerID string) error {
+//				panic("mock out the OpenStakeholderUpdateModal method")
+//			},
+//			PostStakeholderUpdateFunc: func(ctx context.Context, incidentID types.IncidentID, text string, userID types.SlackUserID) (*model.StakeholderUpdate, error) {
+//				panic("mock out the PostStakeholderUpdate method")
+//			},
+//		}
+//
+//		// use mockedStakeholderUpdate in code that requires interfaces.StakeholderUpdate
+//		// and then make assertions.
+//
+//	}
+type StakeholderUpdateMock struct {
+	// ListStakeholderUpdatesFunc mocks the ListStakeholderUpdates method.
+	ListStakeholderUpdatesFunc func(ctx context.Context, incidentID types.IncidentID) ([]*model.StakeholderUpdate, error)
+
+	// OpenStakeholderUpdateModalFunc mocks the OpenStakeholderUpdateModal method.
+	OpenStakeholderUpdateModalFunc func(ctx context.Context, incidentID types.IncidentID, triggerID string) error
+
+	// PostStakeholderUpdateFunc mocks the PostStakeholderUpdate method.
+	PostStakeholderUpdateFunc func(ctx context.Context, incidentID types.IncidentID, text string, userID types.SlackUserID) (*model.StakeholderUpdate, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListStakeholderUpdates holds details about calls to the ListStakeholderUpdates method.
+		ListStakeholderUpdates []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// IncidentID is the incidentID argument value.
+			IncidentID types.IncidentID
+		}
+		// OpenStakeholderUpdateModal holds details about calls to the OpenStakeholderUpdateModal method.
+		OpenStakeholderUpdateModal []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// IncidentID is the incidentID argument value.
+			IncidentID types.IncidentID
+			// TriggerID is the triggerID argument value.
+			TriggerID string
+		}
+		// PostStakeholderUpdate holds details about calls to the PostStakeholderUpdate method.
+		PostStakeholderUpdate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// IncidentID is the incidentID argument value.
+			IncidentID types.IncidentID
+			// Text is the text argument value.
+			Text string
+			// UserID is the userID argument value.
+			UserID types.SlackUserID
+		}
+	}
+	lockListStakeholderUpdates     sync.RWMutex
+	lockOpenStakeholderUpdateModal sync.RWMutex
+	lockPostStakeholderUpdate      sync.RWMutex
+}
+
+// ListStakeholderUpdates calls ListStakeholderUpdatesFunc.
+func (mock *StakeholderUpdateMock) ListStakeholderUpdates(ctx context.Context, incidentID types.IncidentID) ([]*model.StakeholderUpdate, error) {
+	if mock.ListStakeholderUpdatesFunc == nil {
+		panic("StakeholderUpdateMock.ListStakeholderUpdatesFunc: method is nil but StakeholderUpdate.ListStakeholderUpdates was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		IncidentID types.IncidentID
+	}{
+		Ctx:        ctx,
+		IncidentID: incidentID,
+	}
+	mock.lockListStakeholderUpdates.Lock()
+	mock.calls.ListStakeholderUpdates = append(mock.calls.ListStakeholderUpdates, callInfo)
+	mock.lockListStakeholderUpdates.Unlock()
+	return mock.ListStakeholderUpdatesFunc(ctx, incidentID)
+}
+
+// ListStakeholderUpdatesCalls gets all the calls that were made to ListStakeholderUpdates.
+// Check the length with:
+//
+//	len(mockedStakeholderUpdate.ListStakeholderUpdatesCalls())
+func (mock *StakeholderUpdateMock) ListStakeholderUpdatesCalls() []struct {
+	Ctx        context.Context
+	IncidentID types.IncidentID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		IncidentID types.IncidentID
+	}
+	mock.lockListStakeholderUpdates.RLock()
+	calls = mock.calls.ListStakeholderUpdates
+	mock.lockListStakeholderUpdates.RUnlock()
+	return calls
+}
+
+// OpenStakeholderUpdateModal calls OpenStakeholderUpdateModalFunc.
+func (mock *StakeholderUpdateMock) OpenStakeholderUpdateModal(ctx context.Context, incidentID types.IncidentID, triggerID string) error {
+	if mock.OpenStakeholderUpdateModalFunc == nil {
+		panic("StakeholderUpdateMock.OpenStakeholderUpdateModalFunc: method is nil but StakeholderUpdate.OpenStakeholderUpdateModal was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		IncidentID types.IncidentID
+		TriggerID  string
+	}{
+		Ctx:        ctx,
+		IncidentID: incidentID,
+		TriggerID:  triggerID,
+	}
+	mock.lockOpenStakeholderUpdateModal.Lock()
+	mock.calls.OpenStakeholderUpdateModal = append(mock.calls.OpenStakeholderUpdateModal, callInfo)
+	mock.lockOpenStakeholderUpdateModal.Unlock()
+	return mock.OpenStakeholderUpdateModalFunc(ctx, incidentID, triggerID)
+}
+
+// OpenStakeholderUpdateModalCalls gets all the calls that were made to OpenStakeholderUpdateModal.
+// Check the length with:
+//
+//	len(mockedStakeholderUpdate.OpenStakeholderUpdateModalCalls())
+func (mock *StakeholderUpdateMock) OpenStakeholderUpdateModalCalls() []struct {
+	Ctx        context.Context
+	IncidentID types.IncidentID
+	TriggerID  string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		IncidentID types.IncidentID
+		TriggerID  string
+	}
+	mock.lockOpenStakeholderUpdateModal.RLock()
+	calls = mock.calls.OpenStakeholderUpdateModal
+	mock.lockOpenStakeholderUpdateModal.RUnlock()
+	return calls
+}
+
+// PostStakeholderUpdate calls PostStakeholderUpdateFunc.
+func (mock *StakeholderUpdateMock) PostStakeholderUpdate(ctx context.Context, incidentID types.IncidentID, text string, userID types.SlackUserID) (*model.StakeholderUpdate, error) {
+	if mock.PostStakeholderUpdateFunc == nil {
+		panic("StakeholderUpdateMock.PostStakeholderUpdateFunc: method is nil but StakeholderUpdate.PostStakeholderUpdate was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		IncidentID types.IncidentID
+		Text       string
+		UserID     types.SlackUserID
+	}{
+		Ctx:        ctx,
+		IncidentID: incidentID,
+		Text:       text,
+		UserID:     userID,
+	}
+	mock.lockPostStakeholderUpdate.Lock()
+	mock.calls.PostStakeholderUpdate = append(mock.calls.PostStakeholderUpdate, callInfo)
+	mock.lockPostStakeholderUpdate.Unlock()
+	return mock.PostStakeholderUpdateFunc(ctx, incidentID, text, userID)
+}
+
+// PostStakeholderUpdateCalls gets all the calls that were made to PostStakeholderUpdate.
+// Check the length with:
+//
+//	len(mockedStakeholderUpdate.PostStakeholderUpdateCalls())
+func (mock *StakeholderUpdateMock) PostStakeholderUpdateCalls() []struct {
+	Ctx        context.Context
+	IncidentID types.IncidentID
+	Text       string
+	UserID     types.SlackUserID
+} {
+	var calls []struct {
+		Ctx        context.Context
+		IncidentID types.IncidentID
+		Text       string
+		UserID     types.SlackUserID
+	}
+	mock.lockPostStakeholderUpdate.RLock()
+	calls = mock.calls.PostStakeholderUpdate
+	mock.lockPostStakeholderUpdate.RUnlock()
+	return calls
+}
+
+// Ensure, that IncidentRelationMock does implement interfaces.IncidentRelation.
+// If this is not the case, regenerate this file with moq.
+var _ interfaces.IncidentRelation = &IncidentRelationMock{}
+
+// IncidentRelationMock is a mock implementation of interfaces.IncidentRelation.
+//
+//	func TestSomethingThatUsesIncidentRelation(t *testing.T) {
+//
+//		// make and configure a mocked interfaces.IncidentRelation
+//		mockedIncidentRelation := &IncidentRelationMock{
+//			LinkParentFunc: func(ctx context.Context, childID types.IncidentID, parentID types.IncidentID, userID types.SlackUserID) (*model.Incident, error) {
+//				panic("mock out the LinkParent method")
+//			},
+//			ListChildIncidentsFunc: func(ctx context.Context, parentID types.IncidentID) ([]*model.Incident, error) {
+//				panic("mock out the ListChildIncidents method")
+//			},
+//			ListDuplicateIncidentsFunc: func(ctx context.Context, primaryID types.IncidentID) ([]*model.Incident, error) {
+//				panic("mock out the ListDuplicateIncidents method")
+//			},
+//			MergeIncidentFunc: func(ctx context.Context, duplicateID types.IncidentID, primaryID types.IncidentID, userID types.SlackUserID) (*model.Incident, error) {
+//				panic("mock out the MergeIncident method")
+//			},
+//			UnlinkParentFunc: func(ctx context.Context, childID types.IncidentID, userID types.SlackUserID) (*model.Incident, error) {
+//				panic("mock out the UnlinkParent method")
+//			},
+//		}
+//
+//		// use mockedIncidentRelation in code that requires interfaces.IncidentRelation
+//		// and then make assertions.
+//
+//	}
+type IncidentRelationMock struct {
+	// LinkParentFunc mocks the LinkParent method.
+	LinkParentFunc func(ctx context.Context, childID types.IncidentID, parentID types.IncidentID, userID types.SlackUserID) (*model.Incident, error)
+
+	// ListChildIncidentsFunc mocks the ListChildIncidents method.
+	ListChildIncidentsFunc func(ctx context.Context, parentID types.IncidentID) ([]*model.Incident, error)
+
+	// ListDuplicateIncidentsFunc mocks the ListDuplicateIncidents method.
+	ListDuplicateIncidentsFunc func(ctx context.Context, primaryID types.IncidentID) ([]*model.Incident, error)
+
+	// MergeIncidentFunc mocks the MergeIncident method.
+	MergeIncidentFunc func(ctx context.Context, duplicateID types.IncidentID, primaryID types.IncidentID, userID types.SlackUserID) (*model.Incident, error)
+
+	// UnlinkParentFunc mocks the UnlinkParent method.
+	UnlinkParentFunc func(ctx context.Context, childID types.IncidentID, userID types.SlackUserID) (*model.Incident, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// LinkParent holds details about calls to the LinkParent method.
+		LinkParent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ChildID is the childID argument value.
+			ChildID types.IncidentID
+			// ParentID is the parentID argument value.
+			ParentID types.IncidentID
+			// UserID is the userID argument value.
+			UserID types.SlackUserID
+		}
+		// ListChildIncidents holds details about calls to the ListChildIncidents method.
+		ListChildIncidents []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ParentID is the parentID argument value.
+			ParentID types.IncidentID
+		}
+		// ListDuplicateIncidents holds details about calls to the ListDuplicateIncidents method.
+		ListDuplicateIncidents []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PrimaryID is the primaryID argument value.
+			PrimaryID types.IncidentID
+		}
+		// MergeIncident holds details about calls to the MergeIncident method.
+		MergeIncident []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DuplicateID is the duplicateID argument value.
+			DuplicateID types.IncidentID
+			// PrimaryID is the primaryID argument value.
+			PrimaryID types.IncidentID
+			// UserID is the userID argument value.
+			UserID types.SlackUserID
+		}
+		// UnlinkParent holds details about calls to the UnlinkParent method.
+		UnlinkParent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ChildID is the childID argument value.
+			ChildID types.IncidentID
+			// UserID is the userID argument value.
+			UserID types.SlackUserID
+		}
+	}
+	lockLinkParent             sync.RWMutex
+	lockListChildIncidents     sync.RWMutex
+	lockListDuplicateIncidents sync.RWMutex
+	lockMergeIncident          sync.RWMutex
+	lockUnlinkParent           sync.RWMutex
+}
+
+// LinkParent calls LinkParentFunc.
+func (mock *IncidentRelationMock) LinkParent(ctx context.Context, childID types.IncidentID, parentID types.IncidentID, userID types.SlackUserID) (*model.Incident, error) {
+	if mock.LinkParentFunc == nil {
+		panic("IncidentRelationMock.LinkParentFunc: method is nil but IncidentRelation.LinkParent was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ChildID  types.IncidentID
+		ParentID types.IncidentID
+		UserID   types.SlackUserID
+	}{
+		Ctx:      ctx,
+		ChildID:  childID,
+		ParentID: parentID,
+		UserID:   userID,
+	}
+	mock.lockLinkParent.Lock()
+	mock.calls.LinkParent = append(mock.calls.LinkParent, callInfo)
+	mock.lockLinkParent.Unlock()
+	return mock.LinkParentFunc(ctx, childID, parentID, userID)
+}
+
+// LinkParentCalls gets all the calls that were made to LinkParent.
+// Check the length with:
+//
+//	len(mockedIncidentRelation.LinkParentCalls())
+func (mock *IncidentRelationMock) LinkParentCalls() []struct {
+	Ctx      context.Context
+	ChildID  types.IncidentID
+	ParentID types.IncidentID
+	UserID   types.SlackUserID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ChildID  types.IncidentID
+		ParentID types.IncidentID
+		UserID   types.SlackUserID
+	}
+	mock.lockLinkParent.RLock()
+	calls = mock.calls.LinkParent
+	mock.lockLinkParent.RUnlock()
+	return calls
+}
+
+// ListChildIncidents calls ListChildIncidentsFunc.
+func (mock *IncidentRelationMock) ListChildIncidents(ctx context.Context, parentID types.IncidentID) ([]*model.Incident, error) {
+	if mock.ListChildIncidentsFunc == nil {
+		panic("IncidentRelationMock.ListChildIncidentsFunc: method is nil but IncidentRelation.ListChildIncidents was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ParentID types.IncidentID
+	}{
+		Ctx:      ctx,
+		ParentID: parentID,
+	}
+	mock.lockListChildIncidents.Lock()
+	mock.calls.ListChildIncidents = append(mock.calls.ListChildIncidents, callInfo)
+	mock.lockListChildIncidents.Unlock()
+	return mock.ListChildIncidentsFunc(ctx, parentID)
+}
+
+// ListChildIncidentsCalls gets all the calls that were made to ListChildIncidents.
+// Check the length with:
+//
+//	len(mockedIncidentRelation.ListChildIncidentsCalls())
+func (mock *IncidentRelationMock) ListChildIncidentsCalls() []struct {
+	Ctx      context.Context
+	ParentID types.IncidentID
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ParentID types.IncidentID
+	}
+	mock.lockListChildIncidents.RLock()
+	calls = mock.calls.ListChildIncidents
+	mock.lockListChildIncidents.RUnlock()
+	return calls
+}
+
+// ListDuplicateIncidents calls ListDuplicateIncidentsFunc.
+func (mock *IncidentRelationMock) ListDuplicateIncidents(ctx context.Context, primaryID types.IncidentID) ([]*model.Incident, error) {
+	if mock.ListDuplicateIncidentsFunc == nil {
+		panic("IncidentRelationMock.ListDuplicateIncidentsFunc: method is nil but IncidentRelation.ListDuplicateIncidents was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		PrimaryID types.IncidentID
+	}{
+		Ctx:       ctx,
+		PrimaryID: primaryID,
+	}
+	mock.lockListDuplicateIncidents.Lock()
+	mock.calls.ListDuplicateIncidents = append(mock.calls.ListDuplicateIncidents, callInfo)
+	mock.lockListDuplicateIncidents.Unlock()
+	return mock.ListDuplicateIncidentsFunc(ctx, primaryID)
+}
+
+// ListDuplicateIncidentsCalls gets all the calls that were made to ListDuplicateIncidents.
+// Check the length with:
+//
+//	len(mockedIncidentRelation.ListDuplicateIncidentsCalls())
+func (mock *IncidentRelationMock) ListDuplicateIncidentsCalls() []struct {
+	Ctx       context.Context
+	PrimaryID types.IncidentID
+} {
+	var calls []struct {
+		Ctx       context.Context
+		PrimaryID types.IncidentID
+	}
+	mock.lockListDuplicateIncidents.RLock()
+	calls = mock.calls.ListDuplicateIncidents
+	mock.lockListDuplicateIncidents.RUnlock()
+	return calls
+}
+
+// MergeIncident calls MergeIncidentFunc.
+func (mock *IncidentRelationMock) MergeIncident(ctx context.Context, duplicateID types.IncidentID, primaryID types.IncidentID, userID types.SlackUserID) (*model.Incident, error) {
+	if mock.MergeIncidentFunc == nil {
+		panic("IncidentRelationMock.MergeIncidentFunc: method is nil but IncidentRelation.MergeIncident was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		DuplicateID types.IncidentID
+		PrimaryID   types.IncidentID
+		UserID      types.SlackUserID
+	}{
+		Ctx:         ctx,
+		DuplicateID: duplicateID,
+		PrimaryID:   primaryID,
+		UserID:      userID,
+	}
+	mock.lockMergeIncident.Lock()
+	mock.calls.MergeIncident = append(mock.calls.MergeIncident, callInfo)
+	mock.lockMergeIncident.Unlock()
+	return mock.MergeIncidentFunc(ctx, duplicateID, primaryID, userID)
+}
+
+// MergeIncidentCalls gets all the calls that were made to MergeIncident.
+// Check the length with:
+//
+//	len(mockedIncidentRelation.MergeIncidentCalls())
+func (mock *IncidentRelationMock) MergeIncidentCalls() []struct {
+	Ctx         context.Context
+	DuplicateID types.IncidentID
+	PrimaryID   types.IncidentID
+	UserID      types.SlackUserID
+} {
+	var calls []struct {
+		Ctx         context.Context
+		DuplicateID types.IncidentID
+		PrimaryID   types.IncidentID
+		UserID      types.SlackUserID
+	}
+	mock.lockMergeIncident.RLock()
+	calls = mock.calls.MergeIncident
+	mock.lockMergeIncident.RUnlock()
+	return calls
+}
+
+// UnlinkParent calls UnlinkParentFunc.
+func (mock *IncidentRelationMock) UnlinkParent(ctx context.Context, childID types.IncidentID, userID types.SlackUserID) (*model.Incident, error) {
+	if mock.UnlinkParentFunc == nil {
+		panic("IncidentRelationMock.UnlinkParentFunc: method is nil but IncidentRelation.UnlinkParent was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		ChildID types.IncidentID
+		UserID  types.SlackUserID
+	}{
+		Ctx:     ctx,
+		ChildID: childID,
+		UserID:  userID,
+	}
+	mock.lockUnlinkParent.Lock()
+	mock.calls.UnlinkParent = append(mock.calls.UnlinkParent, callInfo)
+	mock.lockUnlinkParent.Unlock()
+	return mock.UnlinkParentFunc(ctx, childID, userID)
+}
+
+// UnlinkParentCalls gets all the calls that were made to UnlinkParent.
+// Check the length with:
+//
+//	len(mockedIncidentRelation.UnlinkParentCalls())
+func (mock *IncidentRelationMock) UnlinkParentCalls() []struct {
+	Ctx     context.Context
+	ChildID types.IncidentID
+	UserID  types.SlackUserID
+} {
+	var calls []struct {
+		Ctx     context.Context
+		ChildID types.IncidentID
+		UserID  types.SlackUserID
+	}
+	mock.lockUnlinkParent.RLock()
+	calls = mock.calls.UnlinkParent
+	mock.lockUnlinkParent.RUnlock()
+	return calls
+}
@@ -16,6 +16,8 @@ type Repository interface {
 	SaveMessage(ctx context.Context, message *model.Message) error
 	GetMessage(ctx context.Context, id types.MessageID) (*model.Message, error)
 	ListMessages(ctx context.Context, channelID types.ChannelID, limit int) ([]*model.Message, error)
+	// ListMessagesSince lists the messages of all channels posted since the given time
+	ListMessagesSince(ctx context.Context, since time.Time) ([]*model.Message, error)
 
 	// User operations
 	SaveUser(ctx context.Context, user *model.User) error
@@ -26,19 +28,33 @@ type Repository interface {
 	SaveSession(ctx context.Context, session *model.Session) error
 	GetSession(ctx context.Context, id types.SessionID) (*model.Session, error)
 	DeleteSession(ctx context.Context, id types.SessionID) error
+	// TouchSession updates only the activity fields of an existing session, so that
+	// it never brings back a session deleted meanwhile
+	TouchSession(ctx context.Context, session *model.Session) error
+	ListSessionsByUser(ctx context.Context, userID types.UserID) ([]*model.Session, error)
+	DeleteExpiredSessions(ctx context.Context, now time.Time) (int, error)
 
 	// Incident operations
 	PutIncident(ctx context.Context, incident *model.Incident) error
 	GetIncident(ctx context.Context, id types.IncidentID) (*model.Incident, error)
+	// GetIncidents gets the incidents with ids in one read. Incidents that do not exist are omitted.
+	GetIncidents(ctx context.Context, ids []types.IncidentID) ([]*model.Incident, error)
 	GetIncidentByChannelID(ctx context.Context, channelID types.ChannelID) (*model.Incident, error)
+	// UpdateIncidentAtomic reads the incident, applies updateFn and writes it back without
+	// losing concurrent writes. updateFn may be called more than once and must not have side effects.
+	UpdateIncidentAtomic(ctx context.Context, incidentID types.IncidentID, updateFn func(*model.Incident) error) error
 	ListIncidents(ctx context.Context) ([]*model.Incident, error)
-	ListIncidentsPaginated(ctx context.Context, opts types.PaginationOptions) ([]*model.Incident, *types.PaginationResult, error)
+	ListIncidentsPaginated(ctx context.Context, filter model.IncidentFilter, opts types.PaginationOptions) ([]*model.Incident, *types.PaginationResult, error)
 	ListIncidentsSince(ctx context.Context, since time.Time) ([]*model.Incident, error)
+	// ListIncidentsUpdatedSince lists the incidents written since the given time (see model.Incident.UpdatedAt)
+	ListIncidentsUpdatedSince(ctx context.Context, since time.Time) ([]*model.Incident, error)
 	GetNextIncidentNumber(ctx context.Context) (types.IncidentID, error)
 
 	// Status history operations
 	AddStatusHistory(ctx context.Context, history *model.StatusHistory) error
 	GetStatusHistories(ctx context.Context, incidentID types.IncidentID) ([]*model.StatusHistory, error)
+	// ListStatusHistoriesSince lists the status changes of all incidents made since the given time
+	ListStatusHistoriesSince(ctx context.Context, since time.Time) ([]*model.StatusHistory, error)
 	UpdateIncidentStatus(ctx context.Context, incidentID types.IncidentID, status types.IncidentStatus) error
 
 	// Incident request operations
@@ -53,6 +69,60 @@ type Repository interface {
 	UpdateTask(ctx context.Context, task *model.Task) error
 	DeleteTask(ctx context.Context, incidentID types.IncidentID, taskID types.TaskID) error
 	ListTasksByIncident(ctx context.Context, incidentID types.IncidentID) ([]*model.Task, error)
+	// ListTasksUpdatedSince lists the tasks of all incidents created or updated since the given time
+	ListTasksUpdatedSince(ctx context.Context, since time.Time) ([]*model.Task, error)
+
+	// Event deduplication operations
+	// MarkEventProcessed atomically records key for ttl. It returns true if the key was
+	// newly recorded, or false if an unexpired record already exists.
+	MarkEventProcessed(ctx context.Context, key string, ttl time.Duration) (bool, error)
+	// UnmarkEventProcessed deletes the record of key, so that a later delivery is accepted again.
+	// Deleting a key that is not recorded is not an error.
+	UnmarkEventProcessed(ctx context.Context, key string) error
+
+	// Job operations
+	PutJob(ctx context.Context, job *model.Job) error
+	GetJob(ctx context.Context, id types.JobID) (*model.Job, error)
+	DeleteJob(ctx context.Context, id types.JobID) error
+	// ClaimJobs atomically leases up to limit claimable jobs (see model.Job.IsClaimable) to owner.
+	// Claimed jobs are marked running, their attempt counter is incremented, the lease is
+	// extended to now+lease and a new LeaseToken is set. Jobs are returned in RunAt order.
+	ClaimJobs(ctx context.Context, owner string, limit int, lease time.Duration) ([]*model.Job, error)
+	// RenewJobLease, CompleteJob and FailJob only act on a job still leased to owner with
+	// leaseToken (see model.Job.IsLeasedBy) and return model.ErrJobLeaseLost otherwise.
+	// CompleteJob deletes the job; FailJob saves job as the outcome of the failed attempt.
+	RenewJobLease(ctx context.Context, id types.JobID, owner, leaseToken string, until time.Time) error
+	CompleteJob(ctx context.Context, id types.JobID, owner, leaseToken string) error
+	FailJob(ctx context.Context, job *model.Job, owner, leaseToken string) error
+	ListJobsByStatus(ctx context.Context, status types.JobStatus, limit int) ([]*model.Job, error)
+
+	// API token operations
+	PutAPIToken(ctx context.Context, token *model.APIToken) error
+	GetAPIToken(ctx context.Context, id types.APITokenID) (*model.APIToken, error)
+	// UpdateAPITokenLastUsed sets only LastUsedAt of an existing token, so that
+	// recording usage never undoes a concurrent revocation
+	UpdateAPITokenLastUsed(ctx context.Context, id types.APITokenID, lastUsedAt time.Time) error
+	ListAPITokens(ctx context.Context) ([]*model.APIToken, error)
+
+	// Audit log operations. Entries are append-only; PutAuditEntry fails if the ID exists.
+	PutAuditEntry(ctx context.Context, entry *model.AuditEntry) error
+	// ListAuditEntries lists entries matching the filter, newest first
+	ListAuditEntries(ctx context.Context, filter model.AuditFilter) ([]*model.AuditEntry, error)
+
+	// Stakeholder update operations
+	AddStakeholderUpdate(ctx context.Context, update *model.StakeholderUpdate) error
+	// ListStakeholderUpdates lists the updates of an incident, oldest first
+	ListStakeholderUpdates(ctx context.Context, incidentID types.IncidentID) ([]*model.StakeholderUpdate, error)
+	// ListStakeholderUpdatesSince lists the updates of all incidents posted since the given time
+	ListStakeholderUpdatesSince(ctx context.Context, since time.Time) ([]*model.StakeholderUpdate, error)
+
+	// Reminder operations
+	PutReminder(ctx context.Context, reminder *model.Reminder) error
+	GetReminder(ctx context.Context, id string) (*model.Reminder, error)
+
+	// Transcript operations. An incident has one transcript, replaced on every export.
+	PutTranscript(ctx context.Context, transcript *model.Transcript) error
+	GetTranscript(ctx context.Context, incidentID types.IncidentID) (*model.Transcript, error)
 
 	// Close closes the repository connection
 	Close() error
@@ -18,6 +18,9 @@ type SlackClient interface {
 	AuthTestContext(ctx context.Context) (*slack.AuthTestResponse, error)
 	GetConversationInfo(ctx context.Context, channelID string, includeLocale bool) (*slack.Channel, error)
 	SetPurposeOfConversationContext(ctx context.Context, channelID, purpose string) (*slack.Channel, error)
+	RenameConversationContext(ctx context.Context, channelID, name string) (*slack.Channel, error)
+	ArchiveConversationContext(ctx context.Context, channelID string) error
+	UnArchiveConversationContext(ctx context.Context, channelID string) error
 	OpenView(ctx context.Context, triggerID string, view slack.ModalViewRequest) (*slack.ViewResponse, error)
 	GetConversationHistoryContext(ctx context.Context, params *slack.GetConversationHistoryParameters) (*slack.GetConversationHistoryResponse, error)
 	GetConversationRepliesContext(ctx context.Context, params *slack.GetConversationRepliesParameters) ([]slack.Message, bool, bool, error)
@@ -29,6 +32,7 @@ type SlackClient interface {
 	GetUserGroupsContext(ctx context.Context) ([]slack.UserGroup, error)
 	GetUserGroupMembersContext(ctx context.Context, groupID string) ([]string, error)
 	GetUsersInConversationContext(ctx context.Context, params *slack.GetUsersInConversationParameters) ([]string, string, error)
+	GetConversationsForUserContext(ctx context.Context, params *slack.GetConversationsForUserParameters) ([]slack.Channel, string, error)
 
 	// Bookmark management
 	AddBookmark(ctx context.Context, channelID, title, link string) error
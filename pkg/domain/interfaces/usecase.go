@@ -1,9 +1,10 @@
 package interfaces
 
-//go:generate moq -out mocks/usecase_mock.go -pkg mocks . SlackMessage Incident Task Invite StatusUseCase Auth
+//go:generate moq -out mocks/usecase_mock.go -pkg mocks . SlackMessage Incident Task Invite StatusUseCase Auth Authorization Reminder StakeholderUpdate IncidentRelation
 
 import (
 	"context"
+	"time"
 
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
@@ -91,39 +92,78 @@ type Auth interface {
 	HandleCallback(ctx context.Context, code, redirectURI string) (*model.User, error)
 
 	// CreateSession creates a new session for a user
-	CreateSession(ctx context.Context, slackUserID, userName, userEmail string) (*model.Session, error)
+	CreateSession(ctx context.Context, slackUserID, userName, userEmail string, client model.SessionClient) (*model.Session, error)
 
-	// ValidateSession validates a session by ID and secret
-	ValidateSession(ctx context.Context, sessionID, sessionSecret string) (*model.Session, error)
+	// ValidateSession validates a session by ID and secret and records the activity
+	ValidateSession(ctx context.Context, sessionID, sessionSecret string, client model.SessionClient) (*model.Session, error)
 
 	// DeleteSession deletes a session
 	DeleteSession(ctx context.Context, sessionID string) error
 
+	// ListSessions lists active sessions of a user, most recently seen first
+	ListSessions(ctx context.Context, userID types.UserID) ([]*model.Session, error)
+
+	// RevokeSession revokes one of the user's sessions
+	RevokeSession(ctx context.Context, userID types.UserID, sessionID types.SessionID) error
+
+	// RevokeAllSessions revokes every session of a user and returns how many were revoked
+	RevokeAllSessions(ctx context.Context, userID types.UserID) (int, error)
+
 	// GetUserFromSession gets user information from a session
 	GetUserFromSession(ctx context.Context, sessionID string) (*model.User, error)
 
 	// GetChannelMembers gets all members of a Slack channel
 	GetChannelMembers(ctx context.Context, channelID string) ([]*model.User, error)
+
+	// CreateAPIToken creates an API token and returns it with the plain token, which is not stored
+	CreateAPIToken(ctx context.Context, req CreateAPITokenRequest) (*model.APIToken, string, error)
+
+	// ListAPITokens lists API tokens owned by ownerID, or all tokens if ownerID is empty
+	ListAPITokens(ctx context.Context, ownerID types.SlackUserID) ([]*model.APIToken, error)
+
+	// GetAPIToken gets an API token by ID
+	GetAPIToken(ctx context.Context, id types.APITokenID) (*model.APIToken, error)
+
+	// RevokeAPIToken revokes an API token so that it can no longer be used
+	RevokeAPIToken(ctx context.Context, id types.APITokenID) (*model.APIToken, error)
+
+	// ValidateAPIToken validates a plain token sent as a Bearer credential
+	ValidateAPIToken(ctx context.Context, token string) (*model.APIToken, error)
+}
+
+// CreateAPITokenRequest represents parameters for creating an API token
+type CreateAPITokenRequest struct {
+	Name      string
+	Kind      types.APITokenKind
+	OwnerID   types.SlackUserID // Required for personal tokens, empty for service tokens
+	Scopes    []types.APITokenScope
+	TTL       time.Duration // Zero creates a token that never expires
+	CreatedBy types.SlackUserID
 }
 
 // Incident defines the interface for incident management
 type Incident interface {
 	CreateIncident(ctx context.Context, req *model.CreateIncidentRequest) (*model.Incident, error)
+	// DeclareIncident creates an incident outside of Slack, e.g. from the web UI, and
+	// announces it in the origin channel. The origin channel name is looked up.
+	DeclareIncident(ctx context.Context, req *model.CreateIncidentRequest) (*model.Incident, error)
 	GetIncident(ctx context.Context, id int) (*model.Incident, error)
 	GetIncidentByChannelID(ctx context.Context, channelID types.ChannelID) (*model.Incident, error)
 	// UpdateIncidentDetails updates incident title, description, lead, and severity
 	UpdateIncidentDetails(ctx context.Context, incidentID types.IncidentID, title, description string, lead types.SlackUserID, severityID string, updatedBy types.SlackUserID) (*model.Incident, error)
 	// HandleCreateIncidentAction handles the create incident button click action
-	// This includes retrieving the request, creating the incident, and cleaning up
-	HandleCreateIncidentAction(ctx context.Context, requestID, userID string) (*model.Incident, error)
-	// HandleCreateIncidentActionAsync handles the create incident button click with async processing and error messaging
-	HandleCreateIncidentActionAsync(ctx context.Context, requestID, userID, channelID string)
+	// This includes retrieving the request, creating the incident, cleaning up, and
+	// posting an error message to channelID if the incident cannot be created
+	HandleCreateIncidentAction(ctx context.Context, requestID, userID, channelID string)
 	// HandleCreateIncidentWithDetails handles the create incident with edited details from modal
 	HandleCreateIncidentWithDetails(ctx context.Context, requestID, title, description, categoryID, severityID, userID string) (*model.Incident, error)
 	// HandleCreateIncidentWithDetailsAndAssets handles the create incident with edited details and assets from modal
 	HandleCreateIncidentWithDetailsAndAssets(ctx context.Context, requestID, title, description, categoryID, severityID string, assetIDs []types.AssetID, isPrivate bool, isTest bool, userID string) (*model.Incident, error)
 	// UpdateIncidentDetailsWithAssets updates incident title, description, lead, severity, and assets
 	UpdateIncidentDetailsWithAssets(ctx context.Context, incidentID types.IncidentID, title, description string, lead types.SlackUserID, severityID string, assetIDs []types.AssetID, updatedBy types.SlackUserID) (*model.Incident, error)
+	// UpdateIncident updates the fields of an incident set in req, e.g. from the web UI.
+	// Title changes rename the incident channel and status or severity changes are notified.
+	UpdateIncident(ctx context.Context, incidentID types.IncidentID, req model.UpdateIncidentRequest, updatedBy types.SlackUserID) (*model.Incident, error)
 	// GetIncidentRequest retrieves an incident request by ID
 	GetIncidentRequest(ctx context.Context, requestID string) (*model.IncidentRequest, error)
 	// HandleEditIncidentAction handles the edit incident button click action
@@ -133,12 +173,32 @@ type Incident interface {
 	GetRecentOpenIncidents(ctx context.Context, days int) (map[string][]*model.Incident, error)
 	// GetIncidentTrendBySeverity retrieves incident trend data by severity for specified weeks
 	GetIncidentTrendBySeverity(ctx context.Context, weeks int) ([]*model.WeeklySeverityCount, error)
+	// GetIncidentMetrics aggregates response times of non-test incidents created in [from, to)
+	GetIncidentMetrics(ctx context.Context, from, to time.Time, groupBy types.IncidentMetricsGroupBy) (*model.IncidentMetrics, error)
 	// SyncIncidentMemberWithEvent syncs incident members based on Slack event
 	SyncIncidentMemberWithEvent(ctx context.Context, incidentID types.IncidentID, channelID types.ChannelID, eventUserID types.SlackUserID, isJoin bool) error
 	// CanUserAccessIncident checks if user can access full incident information
 	CanUserAccessIncident(ctx context.Context, incident *model.Incident, slackUserID types.SlackUserID) bool
 	// FilterIncidentForUser filters incident information based on user access
 	FilterIncidentForUser(ctx context.Context, incident *model.Incident, slackUserID types.SlackUserID) *model.Incident
+	// ViewIncident filters incident for a user opening its details, auditing views of private incidents
+	ViewIncident(ctx context.Context, incident *model.Incident, slackUserID types.SlackUserID) *model.Incident
+	// GrantIncidentAccess grants a user or user group access to a private incident
+	GrantIncidentAccess(ctx context.Context, incidentID types.IncidentID, req GrantIncidentAccessRequest) (*model.AccessGrant, error)
+	// RevokeIncidentAccess removes an access grant from a private incident
+	RevokeIncidentAccess(ctx context.Context, incidentID types.IncidentID, grantID types.AccessGrantID) error
+	// RequestIncidentAccess asks the incident lead in Slack to grant the requester access
+	RequestIncidentAccess(ctx context.Context, incidentID types.IncidentID, requesterID types.SlackUserID, reason string) error
+}
+
+// GrantIncidentAccessRequest represents parameters for granting access to a private incident.
+// Exactly one of UserID and GroupID must be set.
+type GrantIncidentAccessRequest struct {
+	UserID    types.SlackUserID
+	GroupID   string // Slack user group ID or @handle
+	Reason    string
+	TTL       time.Duration // Zero creates a grant that does not expire
+	GrantedBy types.SlackUserID
 }
 
 // TaskUpdateRequest represents parameters for updating a task
@@ -206,3 +266,51 @@ type StatusUseCase interface {
 	// HandleStatusChangeModalSubmission handles status change modal submission processing
 	HandleStatusChangeModalSubmission(ctx context.Context, privateMetadata string, statusValue, noteValue, userID string) error
 }
+
+// Authorization defines the interface for role-based access control
+type Authorization interface {
+	// GetRole returns the role of the user
+	GetRole(ctx context.Context, userID types.SlackUserID) (types.Role, error)
+
+	// AuthorizeIncidentCreation returns model.ErrPermissionDenied if the user may not declare incidents
+	AuthorizeIncidentCreation(ctx context.Context, userID types.SlackUserID) error
+
+	// AuthorizeIncidentUpdate returns model.ErrPermissionDenied if the user may not modify the incident or its tasks
+	AuthorizeIncidentUpdate(ctx context.Context, userID types.SlackUserID, incident *model.Incident) error
+}
+
+// Reminder defines the interface for reminders posted in stale incident channels
+type Reminder interface {
+	// Snooze suppresses the reminder for d
+	Snooze(ctx context.Context, reminderID string, d time.Duration, userID types.SlackUserID) (*model.Reminder, error)
+}
+
+// StakeholderUpdate defines the interface for updates written for people outside the incident response
+type StakeholderUpdate interface {
+	// OpenStakeholderUpdateModal opens the modal for writing an update, prefilled with the previous one
+	OpenStakeholderUpdateModal(ctx context.Context, incidentID types.IncidentID, triggerID string) error
+
+	// PostStakeholderUpdate records an update and posts it to the incident, origin and announcement channels
+	PostStakeholderUpdate(ctx context.Context, incidentID types.IncidentID, text string, userID types.SlackUserID) (*model.StakeholderUpdate, error)
+
+	// ListStakeholderUpdates lists the updates of an incident, oldest first
+	ListStakeholderUpdates(ctx context.Context, incidentID types.IncidentID) ([]*model.StakeholderUpdate, error)
+}
+
+// IncidentRelation defines the interface for relationships between incidents
+type IncidentRelation interface {
+	// ListChildIncidents lists the child incidents of a major incident, newest first
+	ListChildIncidents(ctx context.Context, parentID types.IncidentID) ([]*model.Incident, error)
+
+	// ListDuplicateIncidents lists the incidents merged into a primary incident, newest first
+	ListDuplicateIncidents(ctx context.Context, primaryID types.IncidentID) ([]*model.Incident, error)
+
+	// LinkParent links a child incident to a major incident
+	LinkParent(ctx context.Context, childID, parentID types.IncidentID, userID types.SlackUserID) (*model.Incident, error)
+
+	// UnlinkParent removes a child incident from its major incident
+	UnlinkParent(ctx context.Context, childID types.IncidentID, userID types.SlackUserID) (*model.Incident, error)
+
+	// MergeIncident merges a duplicate incident into a primary incident and closes the duplicate
+	MergeIncident(ctx context.Context, duplicateID, primaryID types.IncidentID, userID types.SlackUserID) (*model.Incident, error)
+}
@@ -0,0 +1,97 @@
+package model
+
+import (
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+// AccessGrant gives a user, or the members of a Slack user group, access to a
+// private incident without joining its channel
+type AccessGrant struct {
+	ID        types.AccessGrantID
+	UserID    types.SlackUserID // Granted user, empty for group grants
+	GroupID   string            // Granted Slack user group ID, empty for user grants
+	Reason    string
+	GrantedBy types.SlackUserID
+	CreatedAt time.Time
+	ExpiresAt time.Time // Zero means the grant does not expire
+}
+
+// NewAccessGrant creates a grant for either a user or a user group. A ttl of
+// zero creates a grant that does not expire.
+func NewAccessGrant(userID types.SlackUserID, groupID, reason string, ttl time.Duration, grantedBy types.SlackUserID) (*AccessGrant, error) {
+	if (userID == "") == (groupID == "") {
+		return nil, goerr.New("exactly one of user ID and group ID is required",
+			goerr.V("userID", userID), goerr.V("groupID", groupID))
+	}
+	if ttl < 0 {
+		return nil, goerr.New("grant lifetime must not be negative", goerr.V("ttl", ttl))
+	}
+
+	now := time.Now()
+	grant := &AccessGrant{
+		ID:        types.NewAccessGrantID(),
+		UserID:    userID,
+		GroupID:   groupID,
+		Reason:    reason,
+		GrantedBy: grantedBy,
+		CreatedAt: now,
+	}
+	if ttl > 0 {
+		grant.ExpiresAt = now.Add(ttl)
+	}
+	return grant, nil
+}
+
+// IsActive checks if the grant is in effect at now
+func (g *AccessGrant) IsActive(now time.Time) bool {
+	return g.ExpiresAt.IsZero() || now.Before(g.ExpiresAt)
+}
+
+// ActiveAccessGrants returns the grants of the incident that are in effect at now
+func (i *Incident) ActiveAccessGrants(now time.Time) []AccessGrant {
+	active := make([]AccessGrant, 0, len(i.AccessGrants))
+	for _, g := range i.AccessGrants {
+		if g.IsActive(now) {
+			active = append(active, g)
+		}
+	}
+	return active
+}
+
+// AddAccessGrant adds a grant, replacing a previous grant for the same user or
+// group and dropping expired ones
+func (i *Incident) AddAccessGrant(grant AccessGrant) {
+	now := time.Now()
+	grants := make([]AccessGrant, 0, len(i.AccessGrants)+1)
+	for _, g := range i.AccessGrants {
+		if !g.IsActive(now) || (g.UserID == grant.UserID && g.GroupID == grant.GroupID) {
+			continue
+		}
+		grants = append(grants, g)
+	}
+	i.AccessGrants = append(grants, grant)
+}
+
+// AccessApprover returns who decides requests to access the incident: the lead,
+// or the creator if there is no lead
+func (i *Incident) AccessApprover() types.SlackUserID {
+	if i.Lead != "" {
+		return i.Lead
+	}
+	return i.CreatedBy
+}
+
+// RemoveAccessGrant removes a grant by ID and returns the removed grant
+func (i *Incident) RemoveAccessGrant(id types.AccessGrantID) (*AccessGrant, error) {
+	for idx, g := range i.AccessGrants {
+		if g.ID == id {
+			i.AccessGrants = append(i.AccessGrants[:idx:idx], i.AccessGrants[idx+1:]...)
+			return &g, nil
+		}
+	}
+	return nil, goerr.Wrap(ErrAccessGrantNotFound, "failed to remove access grant",
+		goerr.V("incidentID", i.ID), goerr.V("grantID", id))
+}
@@ -0,0 +1,62 @@
+package model_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+)
+
+func TestNewAccessGrant(t *testing.T) {
+	t.Run("requires exactly one grantee", func(t *testing.T) {
+		_, err := model.NewAccessGrant("", "", "", time.Hour, "U-LEAD")
+		gt.Error(t, err)
+		_, err = model.NewAccessGrant("U1", "S1", "", time.Hour, "U-LEAD")
+		gt.Error(t, err)
+	})
+
+	t.Run("is time-boxed by ttl", func(t *testing.T) {
+		grant, err := model.NewAccessGrant("U1", "", "forensics", time.Hour, "U-LEAD")
+		gt.NoError(t, err).Required()
+		gt.True(t, grant.IsActive(time.Now()))
+		gt.False(t, grant.IsActive(time.Now().Add(2*time.Hour)))
+	})
+
+	t.Run("does not expire without ttl", func(t *testing.T) {
+		grant, err := model.NewAccessGrant("", "S-CISO", "", 0, "U-LEAD")
+		gt.NoError(t, err).Required()
+		gt.True(t, grant.ExpiresAt.IsZero())
+		gt.True(t, grant.IsActive(time.Now().Add(24*365*time.Hour)))
+	})
+}
+
+func TestIncidentAccessGrants(t *testing.T) {
+	incident := &model.Incident{ID: 1, Private: true}
+
+	first, err := model.NewAccessGrant("U1", "", "", time.Hour, "U-LEAD")
+	gt.NoError(t, err).Required()
+	incident.AddAccessGrant(*first)
+
+	// A new grant for the same user replaces the previous one
+	second, err := model.NewAccessGrant("U1", "", "", 2*time.Hour, "U-LEAD")
+	gt.NoError(t, err).Required()
+	incident.AddAccessGrant(*second)
+	gt.A(t, incident.AccessGrants).Length(1)
+	gt.Equal(t, incident.AccessGrants[0].ID, second.ID)
+
+	group, err := model.NewAccessGrant("", "S-CISO", "", 0, "U-LEAD")
+	gt.NoError(t, err).Required()
+	incident.AddAccessGrant(*group)
+	gt.A(t, incident.ActiveAccessGrants(time.Now())).Length(2)
+	gt.A(t, incident.ActiveAccessGrants(time.Now().Add(3*time.Hour))).Length(1)
+
+	removed, err := incident.RemoveAccessGrant(second.ID)
+	gt.NoError(t, err)
+	gt.Equal(t, removed.UserID, second.UserID)
+	gt.A(t, incident.AccessGrants).Length(1)
+
+	_, err = incident.RemoveAccessGrant(second.ID)
+	gt.True(t, errors.Is(err, model.ErrAccessGrantNotFound))
+}
@@ -0,0 +1,127 @@
+package model
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+// APITokenPrefix marks lycaon API tokens so they are easy to recognize in secret scanners
+const APITokenPrefix = "lyc_"
+
+// APIToken is a credential for machine clients, sent as "Authorization: Bearer <token>".
+// Only a hash of the secret is stored; the plain token is shown once on creation.
+type APIToken struct {
+	ID         types.APITokenID      `json:"id"`
+	Name       string                `json:"name"`
+	Kind       types.APITokenKind    `json:"kind"`
+	OwnerID    types.SlackUserID     `json:"owner_id,omitempty"` // User a personal token acts as
+	Scopes     []types.APITokenScope `json:"scopes"`
+	SecretHash string                `json:"-"`
+	CreatedBy  types.SlackUserID     `json:"created_by,omitempty"`
+	CreatedAt  time.Time             `json:"created_at"`
+	ExpiresAt  time.Time             `json:"expires_at,omitzero"` // Zero means the token never expires
+	LastUsedAt time.Time             `json:"last_used_at,omitzero"`
+	RevokedAt  time.Time             `json:"revoked_at,omitzero"`
+}
+
+// NewAPIToken creates a token and returns it with the plain token string to hand to the client.
+// A ttl of zero creates a token without expiration.
+func NewAPIToken(name string, kind types.APITokenKind, ownerID types.SlackUserID, scopes []types.APITokenScope, ttl time.Duration, createdBy types.SlackUserID) (*APIToken, string, error) {
+	if name == "" {
+		return nil, "", goerr.New("token name is required")
+	}
+	if !kind.IsValid() {
+		return nil, "", goerr.New("invalid token kind", goerr.V("kind", kind))
+	}
+	if kind == types.APITokenKindPersonal && ownerID == "" {
+		return nil, "", goerr.New("personal token requires an owner")
+	}
+	if kind == types.APITokenKindService && ownerID != "" {
+		return nil, "", goerr.New("service token must not have an owner", goerr.V("ownerID", ownerID))
+	}
+	if len(scopes) == 0 {
+		return nil, "", goerr.New("at least one scope is required")
+	}
+	for _, s := range scopes {
+		if !s.IsValid() {
+			return nil, "", goerr.New("invalid token scope", goerr.V("scope", s))
+		}
+	}
+	if ttl < 0 {
+		return nil, "", goerr.New("token lifetime must not be negative", goerr.V("ttl", ttl))
+	}
+
+	secret, err := generateRandomSecret(32)
+	if err != nil {
+		return nil, "", goerr.Wrap(err, "failed to generate token secret")
+	}
+
+	now := time.Now()
+	token := &APIToken{
+		ID:         types.NewAPITokenID(),
+		Name:       name,
+		Kind:       kind,
+		OwnerID:    ownerID,
+		Scopes:     slices.Clone(scopes),
+		SecretHash: hashAPITokenSecret(secret),
+		CreatedBy:  createdBy,
+		CreatedAt:  now,
+	}
+	if ttl > 0 {
+		token.ExpiresAt = now.Add(ttl)
+	}
+
+	return token, APITokenPrefix + token.ID.String() + "_" + secret, nil
+}
+
+// ParseAPIToken splits a plain token string into its ID and secret
+func ParseAPIToken(raw string) (types.APITokenID, string, error) {
+	body, ok := strings.CutPrefix(raw, APITokenPrefix)
+	if !ok {
+		return "", "", goerr.New("not a lycaon API token")
+	}
+	id, secret, ok := strings.Cut(body, "_")
+	if !ok || id == "" || secret == "" {
+		return "", "", goerr.New("malformed API token")
+	}
+	return types.APITokenID(id), secret, nil
+}
+
+// VerifySecret checks the secret against the stored hash in constant time
+func (t *APIToken) VerifySecret(secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(t.SecretHash), []byte(hashAPITokenSecret(secret))) == 1
+}
+
+// IsExpired checks if the token has passed its expiration
+func (t *APIToken) IsExpired() bool {
+	return !t.ExpiresAt.IsZero() && time.Now().After(t.ExpiresAt)
+}
+
+// IsRevoked checks if the token has been revoked
+func (t *APIToken) IsRevoked() bool {
+	return !t.RevokedAt.IsZero()
+}
+
+// IsActive checks if the token can be used to authenticate
+func (t *APIToken) IsActive() bool {
+	return !t.IsExpired() && !t.IsRevoked()
+}
+
+// HasScope checks if the token grants the scope. Write implies read.
+func (t *APIToken) HasScope(scope types.APITokenScope) bool {
+	return types.ScopesAllow(t.Scopes, scope)
+}
+
+// hashAPITokenSecret hashes a token secret. Secrets are 256-bit random values,
+// so a plain SHA-256 is sufficient and keeps validation cheap.
+func hashAPITokenSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
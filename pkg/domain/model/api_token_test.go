@@ -0,0 +1,82 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+func TestNewAPIToken(t *testing.T) {
+	read := []types.APITokenScope{types.APITokenScopeRead}
+
+	t.Run("creates personal token", func(t *testing.T) {
+		token, plain, err := model.NewAPIToken("cli", types.APITokenKindPersonal, "U123", read, time.Hour, "U123")
+		gt.NoError(t, err).Required()
+		gt.True(t, strings.HasPrefix(plain, model.APITokenPrefix))
+		gt.S(t, token.SecretHash).NotContains(plain)
+		gt.True(t, token.IsActive())
+		gt.False(t, token.ExpiresAt.IsZero())
+
+		id, secret, err := model.ParseAPIToken(plain)
+		gt.NoError(t, err)
+		gt.Equal(t, id, token.ID)
+		gt.True(t, token.VerifySecret(secret))
+		gt.False(t, token.VerifySecret(secret+"x"))
+	})
+
+	t.Run("zero TTL never expires", func(t *testing.T) {
+		token, _, err := model.NewAPIToken("bot", types.APITokenKindService, "", read, 0, "U123")
+		gt.NoError(t, err).Required()
+		gt.True(t, token.ExpiresAt.IsZero())
+		gt.False(t, token.IsExpired())
+	})
+
+	t.Run("validates input", func(t *testing.T) {
+		_, _, err := model.NewAPIToken("", types.APITokenKindService, "", read, 0, "")
+		gt.Error(t, err)
+		_, _, err = model.NewAPIToken("bot", types.APITokenKindPersonal, "", read, 0, "")
+		gt.Error(t, err)
+		_, _, err = model.NewAPIToken("bot", types.APITokenKindService, "U123", read, 0, "")
+		gt.Error(t, err)
+		_, _, err = model.NewAPIToken("bot", types.APITokenKindService, "", nil, 0, "")
+		gt.Error(t, err)
+		_, _, err = model.NewAPIToken("bot", types.APITokenKindService, "", []types.APITokenScope{"admin"}, 0, "")
+		gt.Error(t, err)
+		_, _, err = model.NewAPIToken("bot", types.APITokenKindService, "", read, -time.Hour, "")
+		gt.Error(t, err)
+	})
+}
+
+func TestParseAPIToken(t *testing.T) {
+	for _, raw := range []string{"", "abc", "lyc_", "lyc_id", "lyc_id_", "lyc__secret", "xoxb-123"} {
+		_, _, err := model.ParseAPIToken(raw)
+		gt.Error(t, err)
+	}
+}
+
+func TestAPITokenState(t *testing.T) {
+	token := &model.APIToken{
+		Scopes:    []types.APITokenScope{types.APITokenScopeWrite},
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}
+	gt.True(t, token.IsExpired())
+	gt.False(t, token.IsActive())
+
+	token.ExpiresAt = time.Now().Add(time.Minute)
+	gt.True(t, token.IsActive())
+
+	token.RevokedAt = time.Now()
+	gt.True(t, token.IsRevoked())
+	gt.False(t, token.IsActive())
+
+	// Write implies read
+	gt.True(t, token.HasScope(types.APITokenScopeRead))
+	gt.True(t, token.HasScope(types.APITokenScopeWrite))
+
+	token.Scopes = []types.APITokenScope{types.APITokenScopeRead}
+	gt.False(t, token.HasScope(types.APITokenScopeWrite))
+}
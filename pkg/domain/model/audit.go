@@ -0,0 +1,156 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+const auditActorKey contextKey = "auditActor"
+
+// AuditEntry is an append-only record of a state-changing action
+type AuditEntry struct {
+	ID         types.AuditEntryID    `json:"id"`
+	Timestamp  time.Time             `json:"timestamp"`
+	ActorID    string                `json:"actor_id"`
+	Source     types.AuditSource     `json:"source"`
+	Action     types.AuditAction     `json:"action"`
+	TargetType types.AuditTargetType `json:"target_type"`
+	TargetID   string                `json:"target_id"`
+	// IncidentID is set for actions on an incident or its tasks, for filtering
+	IncidentID types.IncidentID `json:"incident_id,omitempty"`
+	Changes    []AuditChange    `json:"changes,omitempty"`
+	RequestID  string           `json:"request_id,omitempty"`
+}
+
+// AuditChange is a changed field with its JSON encoded values before and after
+type AuditChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// AuditActor identifies who performs actions within a context
+type AuditActor struct {
+	ID        string
+	Source    types.AuditSource
+	RequestID string
+}
+
+// WithAuditActor sets the actor that audit entries recorded within ctx are attributed to
+func WithAuditActor(ctx context.Context, actor AuditActor) context.Context {
+	return context.WithValue(ctx, auditActorKey, actor)
+}
+
+// GetAuditActor retrieves the actor set by WithAuditActor
+func GetAuditActor(ctx context.Context) (AuditActor, bool) {
+	actor, ok := ctx.Value(auditActorKey).(AuditActor)
+	return actor, ok
+}
+
+// AuditFilter narrows down audit log queries. Zero values match everything.
+type AuditFilter struct {
+	IncidentID types.IncidentID
+	ActorID    string
+	Action     types.AuditAction
+	Source     types.AuditSource
+	Since      time.Time
+	Until      time.Time
+	Limit      int
+}
+
+// Match checks if the entry satisfies the filter, ignoring Limit
+func (f AuditFilter) Match(e *AuditEntry) bool {
+	if f.IncidentID != 0 && e.IncidentID != f.IncidentID {
+		return false
+	}
+	if f.ActorID != "" && e.ActorID != f.ActorID {
+		return false
+	}
+	if f.Action != "" && e.Action != f.Action {
+		return false
+	}
+	if f.Source != "" && e.Source != f.Source {
+		return false
+	}
+	if !f.Since.IsZero() && e.Timestamp.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !e.Timestamp.Before(f.Until) {
+		return false
+	}
+	return true
+}
+
+// SortAuditEntries sorts entries newest first. IDs are time-ordered and break ties.
+func SortAuditEntries(entries []*AuditEntry) {
+	slices.SortFunc(entries, func(a, b *AuditEntry) int {
+		if c := b.Timestamp.Compare(a.Timestamp); c != 0 {
+			return c
+		}
+		return strings.Compare(string(b.ID), string(a.ID))
+	})
+}
+
+// auditIgnoredFields are bookkeeping fields that change on every write
+var auditIgnoredFields = []string{"UpdatedAt"}
+
+// DiffAuditFields compares the exported fields of two values of the same type and
+// returns the changed ones. Either value may be nil for creations and deletions.
+func DiffAuditFields(before, after any) []AuditChange {
+	b := auditFields(before)
+	a := auditFields(after)
+
+	keys := make([]string, 0, len(b)+len(a))
+	for k := range b {
+		keys = append(keys, k)
+	}
+	for k := range a {
+		if _, ok := b[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	var changes []AuditChange
+	for _, k := range keys {
+		if slices.Contains(auditIgnoredFields, k) {
+			continue
+		}
+		bv, av := string(b[k]), string(a[k])
+		if bv == av {
+			continue
+		}
+		changes = append(changes, AuditChange{Field: k, Before: bv, After: av})
+	}
+	return changes
+}
+
+// auditFields encodes each field of v as JSON. Zero values are dropped so that
+// creations only list the fields that were set.
+func auditFields(v any) map[string]json.RawMessage {
+	fields := map[string]json.RawMessage{}
+	if v == nil {
+		return fields
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fields
+	}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return map[string]json.RawMessage{}
+	}
+
+	for k, val := range fields {
+		switch string(val) {
+		case `""`, "null", "0", "false", "[]", "{}", `"0001-01-01T00:00:00Z"`:
+			delete(fields, k)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,57 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+func TestDiffAuditFields(t *testing.T) {
+	before := model.Task{Title: "Check replicas", Status: model.TaskStatusTodo, UpdatedAt: time.Now()}
+
+	t.Run("lists changed fields only", func(t *testing.T) {
+		after := before
+		after.Status = model.TaskStatusCompleted
+		after.UpdatedAt = before.UpdatedAt.Add(time.Minute)
+
+		changes := model.DiffAuditFields(&before, &after)
+		gt.A(t, changes).Length(1).At(0, func(t testing.TB, v model.AuditChange) {
+			gt.Equal(t, v.Field, "Status")
+			gt.Equal(t, v.Before, `"todo"`)
+			gt.Equal(t, v.After, `"completed"`)
+		})
+	})
+
+	t.Run("lists set fields on creation", func(t *testing.T) {
+		changes := model.DiffAuditFields(nil, &before)
+		gt.A(t, changes).Length(2)
+		gt.Equal(t, changes[0].Field, "Status")
+		gt.Equal(t, changes[0].Before, "")
+		gt.Equal(t, changes[1].Field, "Title")
+	})
+
+	t.Run("returns nothing for unchanged values", func(t *testing.T) {
+		gt.A(t, model.DiffAuditFields(&before, &before)).Length(0)
+	})
+}
+
+func TestAuditFilterMatch(t *testing.T) {
+	now := time.Now()
+	entry := &model.AuditEntry{
+		Timestamp:  now,
+		ActorID:    "U123",
+		Source:     types.AuditSourceSlack,
+		Action:     types.AuditActionIncidentUpdate,
+		IncidentID: 42,
+	}
+
+	gt.True(t, model.AuditFilter{}.Match(entry))
+	gt.True(t, model.AuditFilter{IncidentID: 42, ActorID: "U123", Source: types.AuditSourceSlack}.Match(entry))
+	gt.False(t, model.AuditFilter{IncidentID: 7}.Match(entry))
+	gt.False(t, model.AuditFilter{Action: types.AuditActionTaskCreate}.Match(entry))
+	gt.True(t, model.AuditFilter{Since: now, Until: now.Add(time.Second)}.Match(entry))
+	gt.False(t, model.AuditFilter{Until: now}.Match(entry))
+}
@@ -2,6 +2,9 @@ package model
 
 import (
 	"context"
+	"slices"
+
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
 )
 
 // contextKey is a custom type for context keys to avoid collisions
@@ -19,6 +22,10 @@ type AuthContext struct {
 	UserID      string `json:"user_id,omitempty"`
 	SlackUserID string `json:"slack_user_id,omitempty"`
 	SessionID   string `json:"session_id,omitempty"`
+	// API token information, set when the request is authenticated by a Bearer token
+	APITokenID   string                `json:"api_token_id,omitempty"`
+	APITokenKind types.APITokenKind    `json:"api_token_kind,omitempty"`
+	Scopes       []types.APITokenScope `json:"scopes,omitempty"`
 }
 
 // NewAuthContext creates a new AuthContext
@@ -54,8 +61,41 @@ func (a *AuthContext) Clone() *AuthContext {
 		return nil
 	}
 	return &AuthContext{
-		UserID:      a.UserID,
-		SlackUserID: a.SlackUserID,
-		SessionID:   a.SessionID,
+		UserID:       a.UserID,
+		SlackUserID:  a.SlackUserID,
+		SessionID:    a.SessionID,
+		APITokenID:   a.APITokenID,
+		APITokenKind: a.APITokenKind,
+		Scopes:       slices.Clone(a.Scopes),
+	}
+}
+
+// IsAPIToken checks if the request is authenticated by an API token
+func (a *AuthContext) IsAPIToken() bool {
+	return a.APITokenID != ""
+}
+
+// IsServiceToken checks if the request is authenticated by a service token,
+// which is not bound to a Slack user
+func (a *AuthContext) IsServiceToken() bool {
+	return a.IsAPIToken() && a.APITokenKind == types.APITokenKindService
+}
+
+// HasScope checks if the request may perform operations of the scope.
+// Browser sessions have all scopes; for API tokens write implies read.
+func (a *AuthContext) HasScope(scope types.APITokenScope) bool {
+	if !a.IsAPIToken() {
+		return true
+	}
+	return types.ScopesAllow(a.Scopes, scope)
+}
+
+// ServiceTokenRole returns the role a service token acts with. Service tokens
+// are not bound to a Slack user, so their scope decides: write tokens may modify
+// any incident like an admin, read tokens are viewers.
+func (a *AuthContext) ServiceTokenRole() types.Role {
+	if a.HasScope(types.APITokenScopeWrite) {
+		return types.RoleAdmin
 	}
+	return types.RoleViewer
 }
@@ -76,9 +76,14 @@ func (c *CategoriesConfig) FindCategoryByIDWithFallback(id string) *Category {
 
 // Config represents the unified configuration with categories and severities
 type Config struct {
-	Categories []Category `yaml:"categories"`
-	Severities []Severity `yaml:"severities,omitempty"`
-	Assets     []Asset    `yaml:"assets,omitempty"`
+	Categories         []Category                `yaml:"categories"`
+	Severities         []Severity                `yaml:"severities,omitempty"`
+	Assets             []Asset                   `yaml:"assets,omitempty"`
+	Roles              *RolesConfig              `yaml:"roles,omitempty"`
+	Reminders          *RemindersConfig          `yaml:"reminders,omitempty"`
+	StakeholderUpdates *StakeholderUpdatesConfig `yaml:"stakeholder_updates,omitempty"`
+	Notifications      *NotificationsConfig      `yaml:"notifications,omitempty"`
+	Archive            *ArchiveConfig            `yaml:"archive,omitempty"`
 
 	// Cached asset map for O(1) lookup
 	assetMap map[types.AssetID]*Asset
@@ -121,6 +126,41 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	// Validate roles if present (optional, access control is disabled without it)
+	if c.Roles != nil {
+		if err := c.Roles.Validate(); err != nil {
+			return goerr.Wrap(err, "invalid roles")
+		}
+	}
+
+	// Validate reminders if present (optional, no reminders are posted without it)
+	if c.Reminders != nil {
+		if err := c.Reminders.Validate(c.Severities); err != nil {
+			return goerr.Wrap(err, "invalid reminders")
+		}
+	}
+
+	// Validate stakeholder updates if present (optional, updates are only posted on demand without it)
+	if c.StakeholderUpdates != nil {
+		if err := c.StakeholderUpdates.Validate(c.Severities); err != nil {
+			return goerr.Wrap(err, "invalid stakeholder updates")
+		}
+	}
+
+	// Validate notifications if present (optional, changes are only posted in the incident channel without it)
+	if c.Notifications != nil {
+		if err := c.Notifications.Validate(c.Severities); err != nil {
+			return goerr.Wrap(err, "invalid notifications")
+		}
+	}
+
+	// Validate archive if present (optional, channels are left as they are after closure without it)
+	if c.Archive != nil {
+		if err := c.Archive.Validate(); err != nil {
+			return goerr.Wrap(err, "invalid archive")
+		}
+	}
+
 	return nil
 }
 
@@ -236,6 +236,64 @@ func TestConfigValidate(t *testing.T) {
 		gt.Error(t, config.Validate())
 	})
 
+	t.Run("valid configuration with roles", func(t *testing.T) {
+		config := model.Config{
+			Categories: []model.Category{
+				{ID: "unknown", Name: "Unknown"},
+			},
+			Roles: &model.RolesConfig{
+				Default: types.RoleViewer,
+				Bindings: []model.RoleBinding{
+					{Role: types.RoleAdmin, Users: []string{"U001", "@alice"}},
+					{Role: types.RoleResponder, Groups: []string{"S001", "@oncall"}},
+				},
+			},
+		}
+		gt.NoError(t, config.Validate())
+	})
+
+	t.Run("error when role is invalid", func(t *testing.T) {
+		config := model.Config{
+			Categories: []model.Category{
+				{ID: "unknown", Name: "Unknown"},
+			},
+			Roles: &model.RolesConfig{
+				Bindings: []model.RoleBinding{
+					{Role: "owner", Users: []string{"U001"}},
+				},
+			},
+		}
+		gt.Error(t, config.Validate())
+	})
+
+	t.Run("error when role binding has no members", func(t *testing.T) {
+		config := model.Config{
+			Categories: []model.Category{
+				{ID: "unknown", Name: "Unknown"},
+			},
+			Roles: &model.RolesConfig{
+				Bindings: []model.RoleBinding{
+					{Role: types.RoleAdmin},
+				},
+			},
+		}
+		gt.Error(t, config.Validate())
+	})
+
+	t.Run("error when role group has invalid format", func(t *testing.T) {
+		config := model.Config{
+			Categories: []model.Category{
+				{ID: "unknown", Name: "Unknown"},
+			},
+			Roles: &model.RolesConfig{
+				Bindings: []model.RoleBinding{
+					{Role: types.RoleAdmin, Groups: []string{"oncall"}},
+				},
+			},
+		}
+		gt.Error(t, config.Validate())
+	})
+
 	t.Run("error when asset has empty ID", func(t *testing.T) {
 		config := model.Config{
 			Categories: []model.Category{
@@ -7,4 +7,13 @@ var (
 	ErrIncidentRequestNotFound = goerr.New("incident request not found")
 	ErrIncidentNotFound        = goerr.New("incident not found")
 	ErrTaskNotFound            = goerr.New("task not found")
+	ErrJobNotFound             = goerr.New("job not found")
+	ErrJobLeaseLost            = goerr.New("job lease lost")
+	ErrPermissionDenied        = goerr.New("permission denied")
+	ErrAPITokenNotFound        = goerr.New("API token not found")
+	ErrSessionNotFound         = goerr.New("session not found")
+	ErrAccessGrantNotFound     = goerr.New("access grant not found")
+	ErrReminderNotFound        = goerr.New("reminder not found")
+	ErrTranscriptNotFound      = goerr.New("transcript not found")
+	ErrReopenReasonRequired    = goerr.New("a reason is required to reopen a closed incident")
 )
@@ -3,12 +3,43 @@
 package graphql
 
 import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
 )
 
+type AuditLogFilter struct {
+	IncidentID *string            `json:"incidentId,omitempty"`
+	ActorID    *string            `json:"actorId,omitempty"`
+	Action     *string            `json:"action,omitempty"`
+	Source     *types.AuditSource `json:"source,omitempty"`
+	Since      *time.Time         `json:"since,omitempty"`
+	Until      *time.Time         `json:"until,omitempty"`
+}
+
+type CreateAPITokenInput struct {
+	Name          string                `json:"name"`
+	Kind          *types.APITokenKind   `json:"kind,omitempty"`
+	Scopes        []types.APITokenScope `json:"scopes"`
+	ExpiresInDays *int                  `json:"expiresInDays,omitempty"`
+}
+
+type CreateIncidentInput struct {
+	Title           string   `json:"title"`
+	Description     *string  `json:"description,omitempty"`
+	CategoryID      string   `json:"categoryId"`
+	SeverityID      *string  `json:"severityId,omitempty"`
+	AssetIds        []string `json:"assetIds,omitempty"`
+	OriginChannelID string   `json:"originChannelId"`
+	Private         *bool    `json:"private,omitempty"`
+	IsTest          *bool    `json:"isTest,omitempty"`
+}
+
 type CreateTaskInput struct {
 	IncidentID  string  `json:"incidentId"`
 	Title       string  `json:"title"`
@@ -16,6 +47,18 @@ type CreateTaskInput struct {
 	AssigneeID  *string `json:"assigneeId,omitempty"`
 }
 
+type CreatedAPIToken struct {
+	APIToken *model.APIToken `json:"apiToken"`
+	Token    string          `json:"token"`
+}
+
+type GrantIncidentAccessInput struct {
+	UserID         *string `json:"userId,omitempty"`
+	GroupID        *string `json:"groupId,omitempty"`
+	Reason         *string `json:"reason,omitempty"`
+	ExpiresInHours *int    `json:"expiresInHours,omitempty"`
+}
+
 type GroupedIncidents struct {
 	Date      time.Time         `json:"date"`
 	Incidents []*model.Incident `json:"incidents"`
@@ -32,6 +75,27 @@ type IncidentEdge struct {
 	Cursor string          `json:"cursor"`
 }
 
+type IncidentFilterInput struct {
+	Statuses         []types.IncidentStatus `json:"statuses,omitempty"`
+	SeverityLevelMin *int                   `json:"severityLevelMin,omitempty"`
+	SeverityLevelMax *int                   `json:"severityLevelMax,omitempty"`
+	CategoryID       *string                `json:"categoryId,omitempty"`
+	AssetID          *string                `json:"assetId,omitempty"`
+	Lead             *string                `json:"lead,omitempty"`
+	CreatedBy        *string                `json:"createdBy,omitempty"`
+	IsTest           *bool                  `json:"isTest,omitempty"`
+	Private          *bool                  `json:"private,omitempty"`
+	ParentID         *string                `json:"parentId,omitempty"`
+	CreatedAfter     *time.Time             `json:"createdAfter,omitempty"`
+	CreatedBefore    *time.Time             `json:"createdBefore,omitempty"`
+	Text             *string                `json:"text,omitempty"`
+}
+
+type IncidentSortInput struct {
+	Field     types.IncidentSortField `json:"field"`
+	Direction SortDirection           `json:"direction"`
+}
+
 type Mutation struct {
 }
 
@@ -45,6 +109,18 @@ type PageInfo struct {
 type Query struct {
 }
 
+type SearchFilterInput struct {
+	Kinds      []types.SearchDocumentKind `json:"kinds,omitempty"`
+	IncidentID *string                    `json:"incidentId,omitempty"`
+	Since      *time.Time                 `json:"since,omitempty"`
+	Until      *time.Time                 `json:"until,omitempty"`
+}
+
+type SearchResult struct {
+	Hits       []*model.SearchHit `json:"hits"`
+	TotalCount int                `json:"totalCount"`
+}
+
 type SeverityCount struct {
 	SeverityID    string `json:"severityId"`
 	SeverityName  string `json:"severityName"`
@@ -52,6 +128,14 @@ type SeverityCount struct {
 	Count         int    `json:"count"`
 }
 
+type SlackChannel struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Subscription struct {
+}
+
 type UpdateIncidentInput struct {
 	Title       *string               `json:"title,omitempty"`
 	Description *string               `json:"description,omitempty"`
@@ -67,3 +151,58 @@ type UpdateTaskInput struct {
 	Status      *model.TaskStatus `json:"status,omitempty"`
 	AssigneeID  *string           `json:"assigneeId,omitempty"`
 }
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "asc"
+	SortDirectionDesc SortDirection = "desc"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SortDirection) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SortDirection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
@@ -23,9 +24,11 @@ type Incident struct {
 	ChannelName       types.ChannelName // Dedicated incident channel name (e.g., "inc-1-database-outage")
 	OriginChannelID   types.ChannelID   // Origin channel ID where incident was created
 	OriginChannelName types.ChannelName // Origin channel name where incident was created
+	OriginMessageTS   types.MessageTS   // Timestamp of the message the incident was declared from (optional)
 	TeamID            types.TeamID      // Slack workspace/team ID
 	CreatedBy         types.SlackUserID // Slack user ID who created the incident
 	CreatedAt         time.Time         // Creation timestamp
+	UpdatedAt         time.Time         // Last write, set by the repository on every save
 	WelcomeMessageTS  string            // Timestamp of the welcome message in the incident channel
 	// Status management fields
 	Status        types.IncidentStatus // Current status of the incident
@@ -34,8 +37,20 @@ type Incident struct {
 	// Private mode fields
 	Private         bool                // Private incident flag
 	JoinedMemberIDs []types.SlackUserID // List of Slack user IDs who joined this incident channel
+	AccessGrants    []AccessGrant       // Explicit access for users and user groups outside the channel
 	// Test mode field
 	IsTest bool // Test mode flag - test incidents are excluded from statistics
+	// Resolution summarizes how the incident was resolved, set when it is closed
+	Resolution string
+	// Closure and archival fields
+	ClosedAt             time.Time // When the incident was last closed, zero while open
+	FirstClosedAt        time.Time // When the incident was first closed, kept across reopens
+	ReopenCount          int       // How many times the incident was reopened after closure
+	TranscriptExportedAt time.Time // When the channel transcript was last exported
+	ChannelArchived      bool      // Whether the incident channel has been archived
+	// Relationship fields
+	ParentID    types.IncidentID // Major incident this incident is part of, zero if none
+	DuplicateOf types.IncidentID // Primary incident this duplicate was merged into, zero if none
 }
 
 // CreateIncidentRequest represents parameters for creating an incident
@@ -47,6 +62,7 @@ type CreateIncidentRequest struct {
 	AssetIDs          []types.AssetID
 	OriginChannelID   string
 	OriginChannelName string
+	OriginMessageTS   string // Message the incident was declared from, if declared from one
 	TeamID            string
 	CreatedBy         string
 	InitialTriage     bool // Whether to start with Triage status
@@ -112,32 +128,58 @@ func NewIncident(prefix string, id types.IncidentID, title, description, categor
 
 // formatIncidentChannelName creates a Slack-compatible channel name from incident ID and title
 func formatIncidentChannelName(prefix string, id types.IncidentID, title string) string {
+	return IncidentChannelName(prefix, id, title, false).String()
+}
+
+// maxChannelNameLength is the maximum length of a Slack channel name
+const maxChannelNameLength = 80
+
+// IncidentChannelName creates the channel name of an incident: "<prefix>-<id>",
+// "-test" for test incidents, then the sanitized title, within Slack's length limit
+func IncidentChannelName(prefix string, id types.IncidentID, title string, isTest bool) types.ChannelName {
 	// Use "inc" as fallback if prefix is empty to avoid channel names starting with "-"
 	if prefix == "" {
 		prefix = "inc"
 	}
-	baseChannelName := fmt.Sprintf("%s-%d", prefix, id)
-
-	if title == "" {
-		return baseChannelName
+	fullName := fmt.Sprintf("%s-%d", prefix, id)
+	if isTest {
+		fullName += "-test"
 	}
 
 	// Sanitize title for Slack channel name
-	sanitized := sanitizeForSlackChannelName(title)
-	if sanitized == "" {
-		return baseChannelName
+	if sanitized := sanitizeForSlackChannelName(title); sanitized != "" {
+		fullName += "-" + sanitized
 	}
 
-	fullName := fmt.Sprintf("%s-%s", baseChannelName, sanitized)
-
 	// Slack channel names must be 80 characters or less
-	if len(fullName) > 80 {
-		fullName = fullName[:80]
+	if len(fullName) > maxChannelNameLength {
+		cut := maxChannelNameLength
+		// Do not split a multibyte character
+		for cut > 0 && !utf8.RuneStart(fullName[cut]) {
+			cut--
+		}
 		// Ensure the truncated name doesn't end with a hyphen
-		fullName = strings.TrimRight(fullName, "-")
+		fullName = strings.TrimRight(fullName[:cut], "-")
 	}
 
-	return fullName
+	return types.ChannelName(fullName)
+}
+
+// ChannelNameForTitle returns the channel name matching the current title of the
+// incident. The "<prefix>-<id>" part of the current channel name is kept, so
+// channels created with another prefix keep it; defaultPrefix is used when the
+// current name does not have one.
+func (i *Incident) ChannelNameForTitle(defaultPrefix string) types.ChannelName {
+	prefix := defaultPrefix
+	name := i.ChannelName.String()
+	marker := fmt.Sprintf("-%d", i.ID)
+	if idx := strings.Index(name, marker); idx > 0 {
+		rest := name[idx+len(marker):]
+		if rest == "" || strings.HasPrefix(rest, "-") {
+			prefix = name[:idx]
+		}
+	}
+	return IncidentChannelName(prefix, i.ID, i.Title, i.IsTest)
 }
 
 // sanitizeForSlackChannelName converts text to be compatible with Slack channel names
@@ -182,3 +224,27 @@ func sanitizeForSlackChannelName(text string) string {
 
 	return text
 }
+
+// Redacted returns a copy of a private incident with only the fields that users
+// without access may see
+func (i *Incident) Redacted() *Incident {
+	return &Incident{
+		ID:          i.ID,
+		Title:       "Private Incident", // Hide title
+		Description: "",                 // Hide description
+		CategoryID:  i.CategoryID,       // Keep category for display
+		SeverityID:  i.SeverityID,       // Keep severity
+		Status:      i.Status,           // Keep status
+		CreatedAt:   i.CreatedAt,        // Keep created at
+		Private:     true,
+		// Hide other sensitive fields
+		AssetIDs:          nil,
+		ChannelID:         "",
+		ChannelName:       "",
+		OriginChannelID:   "",
+		OriginChannelName: "",
+		TeamID:            "",
+		CreatedBy:         "",
+		Lead:              "",
+	}
+}
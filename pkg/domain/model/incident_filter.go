@@ -0,0 +1,100 @@
+package model
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+// IncidentFilter narrows down incident listings. Zero values match everything.
+type IncidentFilter struct {
+	Statuses    []types.IncidentStatus
+	SeverityIDs []types.SeverityID
+	CategoryID  string
+	AssetID     types.AssetID
+	Lead        types.SlackUserID
+	CreatedBy   types.SlackUserID
+	IsTest      *bool
+	Private     *bool
+	// ParentID matches the children of a major incident
+	ParentID types.IncidentID
+	// DuplicateOf matches the duplicates merged into an incident
+	DuplicateOf types.IncidentID
+	// CreatedAfter is inclusive and CreatedBefore exclusive
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	// Text matches the title or description case-insensitively
+	Text string
+	// Include, when set, excludes incidents for which it returns false, e.g.
+	// private incidents the viewer cannot see when filtering by hidden fields
+	Include func(*Incident) bool
+}
+
+// Match checks if the incident satisfies the filter
+func (f IncidentFilter) Match(i *Incident) bool {
+	if len(f.Statuses) > 0 && !slices.Contains(f.Statuses, i.Status) {
+		return false
+	}
+	if len(f.SeverityIDs) > 0 && !slices.Contains(f.SeverityIDs, i.SeverityID) {
+		return false
+	}
+	if f.CategoryID != "" && i.CategoryID != f.CategoryID {
+		return false
+	}
+	if f.AssetID != "" && !slices.Contains(i.AssetIDs, f.AssetID) {
+		return false
+	}
+	if f.Lead != "" && i.Lead != f.Lead {
+		return false
+	}
+	if f.CreatedBy != "" && i.CreatedBy != f.CreatedBy {
+		return false
+	}
+	if f.IsTest != nil && i.IsTest != *f.IsTest {
+		return false
+	}
+	if f.Private != nil && i.Private != *f.Private {
+		return false
+	}
+	if f.ParentID != 0 && i.ParentID != f.ParentID {
+		return false
+	}
+	if f.DuplicateOf != 0 && i.DuplicateOf != f.DuplicateOf {
+		return false
+	}
+	if !f.CreatedAfter.IsZero() && i.CreatedAt.Before(f.CreatedAfter) {
+		return false
+	}
+	if !f.CreatedBefore.IsZero() && !i.CreatedAt.Before(f.CreatedBefore) {
+		return false
+	}
+	if f.Text != "" {
+		text := strings.ToLower(f.Text)
+		if !strings.Contains(strings.ToLower(i.Title), text) &&
+			!strings.Contains(strings.ToLower(i.Description), text) {
+			return false
+		}
+	}
+	if f.Include != nil && !f.Include(i) {
+		return false
+	}
+	return true
+}
+
+// CompareIncidents returns a negative number when a is listed before b in sort order
+func CompareIncidents(a, b *Incident, sort types.IncidentSort) int {
+	var c int
+	if sort.Field == types.IncidentSortTitle {
+		c = strings.Compare(a.Title, b.Title)
+	}
+	if c == 0 {
+		c = cmp.Compare(a.ID, b.ID)
+	}
+	if !sort.Ascending {
+		c = -c
+	}
+	return c
+}
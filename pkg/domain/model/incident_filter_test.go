@@ -0,0 +1,76 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+func TestIncidentFilterMatch(t *testing.T) {
+	createdAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	incident := &model.Incident{
+		ID:          1,
+		Title:       "Database outage",
+		Description: "Primary replica is not responding",
+		CategoryID:  "system_failure",
+		SeverityID:  "high",
+		AssetIDs:    []types.AssetID{"database", "api"},
+		Status:      types.IncidentStatusHandling,
+		Lead:        "U-LEAD",
+		CreatedBy:   "U-REPORTER",
+		CreatedAt:   createdAt,
+		ParentID:    10,
+	}
+	yes, no := true, false
+
+	testCases := []struct {
+		name   string
+		filter model.IncidentFilter
+		want   bool
+	}{
+		{name: "empty filter", filter: model.IncidentFilter{}, want: true},
+		{name: "status", filter: model.IncidentFilter{Statuses: []types.IncidentStatus{types.IncidentStatusTriage, types.IncidentStatusHandling}}, want: true},
+		{name: "other status", filter: model.IncidentFilter{Statuses: []types.IncidentStatus{types.IncidentStatusClosed}}, want: false},
+		{name: "severity", filter: model.IncidentFilter{SeverityIDs: []types.SeverityID{"critical"}}, want: false},
+		{name: "asset", filter: model.IncidentFilter{AssetID: "api"}, want: true},
+		{name: "other asset", filter: model.IncidentFilter{AssetID: "frontend"}, want: false},
+		{name: "lead", filter: model.IncidentFilter{Lead: "U-OTHER"}, want: false},
+		{name: "creator", filter: model.IncidentFilter{CreatedBy: "U-REPORTER"}, want: true},
+		{name: "not a test incident", filter: model.IncidentFilter{IsTest: &no}, want: true},
+		{name: "private only", filter: model.IncidentFilter{Private: &yes}, want: false},
+		{name: "created after is inclusive", filter: model.IncidentFilter{CreatedAfter: createdAt}, want: true},
+		{name: "created before is exclusive", filter: model.IncidentFilter{CreatedBefore: createdAt}, want: false},
+		{name: "text in title", filter: model.IncidentFilter{Text: "OUTAGE"}, want: true},
+		{name: "text in description", filter: model.IncidentFilter{Text: "replica"}, want: true},
+		{name: "missing text", filter: model.IncidentFilter{Text: "network"}, want: false},
+		{name: "parent", filter: model.IncidentFilter{ParentID: 10}, want: true},
+		{name: "other parent", filter: model.IncidentFilter{ParentID: 11}, want: false},
+		{name: "not a duplicate", filter: model.IncidentFilter{DuplicateOf: 10}, want: false},
+		{name: "include", filter: model.IncidentFilter{Include: func(*model.Incident) bool { return false }}, want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gt.Equal(t, tc.filter.Match(incident), tc.want)
+		})
+	}
+}
+
+func TestCompareIncidents(t *testing.T) {
+	a := &model.Incident{ID: 1, Title: "Beta"}
+	b := &model.Incident{ID: 2, Title: "Alpha"}
+	c := &model.Incident{ID: 3, Title: "Alpha"}
+
+	// The zero value lists newest first
+	gt.True(t, model.CompareIncidents(b, a, types.IncidentSort{}) < 0)
+	gt.True(t, model.CompareIncidents(a, b, types.IncidentSort{Ascending: true}) < 0)
+
+	byTitle := types.IncidentSort{Field: types.IncidentSortTitle, Ascending: true}
+	gt.True(t, model.CompareIncidents(b, a, byTitle) < 0)
+	// Equal titles fall back to the ID
+	gt.True(t, model.CompareIncidents(b, c, byTitle) < 0)
+	gt.True(t, model.CompareIncidents(c, b, types.IncidentSort{Field: types.IncidentSortTitle}) < 0)
+}
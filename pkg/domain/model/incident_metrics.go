@@ -0,0 +1,245 @@
+package model
+
+import (
+	"slices"
+	"time"
+
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+// IncidentDurations are the response times of an incident derived from its
+// status history
+type IncidentDurations struct {
+	IncidentID types.IncidentID
+	// TimeToAcknowledge is from creation until the incident first left triage.
+	// It is only set when Acknowledged is true.
+	TimeToAcknowledge time.Duration
+	Acknowledged      bool
+	// TimeToClose is from creation until the incident was last closed. It is
+	// only set when Closed is true.
+	TimeToClose time.Duration
+	Closed      bool
+	// TimeToFirstClose is from creation until the incident was first closed,
+	// which differs from TimeToClose for reopened incidents. It is only set
+	// when FirstClosed is true.
+	TimeToFirstClose time.Duration
+	FirstClosed      bool
+	// Reopens counts how many times the incident left the closed status
+	Reopens int
+	// TimeInStatus sums the time spent in each open status the incident has
+	// been in. The current status counts until the time the durations were
+	// computed at.
+	TimeInStatus map[types.IncidentStatus]time.Duration
+}
+
+// NewIncidentDurations computes the durations of incident from its status
+// histories. Time before the first history entry is not attributed to any
+// status, as the initial status is recorded when the incident is created.
+func NewIncidentDurations(incident *Incident, histories []*StatusHistory, now time.Time) *IncidentDurations {
+	// Entries copied from merged duplicates are not status changes of this incident
+	sorted := slices.DeleteFunc(slices.Clone(histories), func(h *StatusHistory) bool {
+		return h.SourceIncidentID != 0
+	})
+	slices.SortStableFunc(sorted, func(a, b *StatusHistory) int {
+		return a.ChangedAt.Compare(b.ChangedAt)
+	})
+
+	d := &IncidentDurations{
+		IncidentID:   incident.ID,
+		TimeInStatus: make(map[types.IncidentStatus]time.Duration),
+	}
+
+	var lastClosed time.Time
+	for i, h := range sorted {
+		if !d.Acknowledged && h.Status != types.IncidentStatusTriage {
+			d.TimeToAcknowledge = max(0, h.ChangedAt.Sub(incident.CreatedAt))
+			d.Acknowledged = true
+		}
+		if h.Status == types.IncidentStatusClosed {
+			if !d.FirstClosed {
+				d.TimeToFirstClose = max(0, h.ChangedAt.Sub(incident.CreatedAt))
+				d.FirstClosed = true
+			}
+			lastClosed = h.ChangedAt
+			continue
+		}
+		if i > 0 && sorted[i-1].Status == types.IncidentStatusClosed {
+			d.Reopens++
+		}
+
+		end := now
+		if i+1 < len(sorted) {
+			end = sorted[i+1].ChangedAt
+		}
+		d.TimeInStatus[h.Status] += max(0, end.Sub(h.ChangedAt))
+	}
+
+	if incident.Status == types.IncidentStatusClosed && !lastClosed.IsZero() {
+		d.TimeToClose = max(0, lastClosed.Sub(incident.CreatedAt))
+		d.Closed = true
+	}
+
+	return d
+}
+
+// TimeToAcknowledgeSeconds returns the time to acknowledge in seconds, or nil
+// if the incident has not been acknowledged
+func (d *IncidentDurations) TimeToAcknowledgeSeconds() *float64 {
+	if !d.Acknowledged {
+		return nil
+	}
+	s := d.TimeToAcknowledge.Seconds()
+	return &s
+}
+
+// TimeToCloseSeconds returns the time to close in seconds, or nil if the
+// incident is not closed
+func (d *IncidentDurations) TimeToCloseSeconds() *float64 {
+	if !d.Closed {
+		return nil
+	}
+	s := d.TimeToClose.Seconds()
+	return &s
+}
+
+// TimeToFirstCloseSeconds returns the time to the first closure in seconds, or
+// nil if the incident has never been closed
+func (d *IncidentDurations) TimeToFirstCloseSeconds() *float64 {
+	if !d.FirstClosed {
+		return nil
+	}
+	s := d.TimeToFirstClose.Seconds()
+	return &s
+}
+
+// TimeInTriageSeconds returns the time spent in triage in seconds
+func (d *IncidentDurations) TimeInTriageSeconds() float64 {
+	return d.TimeInStatus[types.IncidentStatusTriage].Seconds()
+}
+
+// TimeInHandlingSeconds returns the time spent in handling in seconds
+func (d *IncidentDurations) TimeInHandlingSeconds() float64 {
+	return d.TimeInStatus[types.IncidentStatusHandling].Seconds()
+}
+
+// TimeInMonitoringSeconds returns the time spent in monitoring in seconds
+func (d *IncidentDurations) TimeInMonitoringSeconds() float64 {
+	return d.TimeInStatus[types.IncidentStatusMonitoring].Seconds()
+}
+
+// DurationStats summarizes a set of durations
+type DurationStats struct {
+	// Count is the number of incidents the duration is known for
+	Count  int
+	Mean   time.Duration
+	Median time.Duration
+}
+
+// NewDurationStats summarizes values
+func NewDurationStats(values []time.Duration) DurationStats {
+	if len(values) == 0 {
+		return DurationStats{}
+	}
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	var sum time.Duration
+	for _, v := range sorted {
+		sum += v
+	}
+
+	median := sorted[len(sorted)/2]
+	if len(sorted)%2 == 0 {
+		median = (sorted[len(sorted)/2-1] + sorted[len(sorted)/2]) / 2
+	}
+
+	return DurationStats{
+		Count:  len(sorted),
+		Mean:   sum / time.Duration(len(sorted)),
+		Median: median,
+	}
+}
+
+// MeanSeconds returns the mean in seconds, or nil if there are no values
+func (s DurationStats) MeanSeconds() *float64 {
+	if s.Count == 0 {
+		return nil
+	}
+	v := s.Mean.Seconds()
+	return &v
+}
+
+// MedianSeconds returns the median in seconds, or nil if there are no values
+func (s DurationStats) MedianSeconds() *float64 {
+	if s.Count == 0 {
+		return nil
+	}
+	v := s.Median.Seconds()
+	return &v
+}
+
+// IncidentMetricsGroup aggregates the durations of the incidents in a group
+type IncidentMetricsGroup struct {
+	Key           string
+	Label         string
+	IncidentCount int
+	ClosedCount   int
+	// ReopenedCount is the number of incidents reopened at least once
+	ReopenedCount     int
+	TimeToAcknowledge DurationStats
+	TimeToClose       DurationStats
+	TimeToFirstClose  DurationStats
+	TimeInTriage      DurationStats
+	TimeInHandling    DurationStats
+	TimeInMonitoring  DurationStats
+}
+
+// NewIncidentMetricsGroup aggregates durations into a group. Time in a status
+// only counts incidents that have been in the status.
+func NewIncidentMetricsGroup(key, label string, durations []*IncidentDurations) *IncidentMetricsGroup {
+	var tta, ttc, ttfc []time.Duration
+	reopened := 0
+	inStatus := make(map[types.IncidentStatus][]time.Duration)
+	for _, d := range durations {
+		if d.Acknowledged {
+			tta = append(tta, d.TimeToAcknowledge)
+		}
+		if d.Closed {
+			ttc = append(ttc, d.TimeToClose)
+		}
+		if d.FirstClosed {
+			ttfc = append(ttfc, d.TimeToFirstClose)
+		}
+		if d.Reopens > 0 {
+			reopened++
+		}
+		for status, v := range d.TimeInStatus {
+			inStatus[status] = append(inStatus[status], v)
+		}
+	}
+
+	return &IncidentMetricsGroup{
+		Key:               key,
+		Label:             label,
+		IncidentCount:     len(durations),
+		ClosedCount:       len(ttc),
+		ReopenedCount:     reopened,
+		TimeToAcknowledge: NewDurationStats(tta),
+		TimeToClose:       NewDurationStats(ttc),
+		TimeToFirstClose:  NewDurationStats(ttfc),
+		TimeInTriage:      NewDurationStats(inStatus[types.IncidentStatusTriage]),
+		TimeInHandling:    NewDurationStats(inStatus[types.IncidentStatusHandling]),
+		TimeInMonitoring:  NewDurationStats(inStatus[types.IncidentStatusMonitoring]),
+	}
+}
+
+// IncidentMetrics is a report of incident response times over a period
+type IncidentMetrics struct {
+	// From is inclusive and To exclusive, both on the creation time of incidents
+	From    time.Time
+	To      time.Time
+	GroupBy types.IncidentMetricsGroupBy
+	Total   *IncidentMetricsGroup
+	Groups  []*IncidentMetricsGroup
+}
@@ -0,0 +1,98 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+func TestNewIncidentDurations(t *testing.T) {
+	createdAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return createdAt.Add(time.Duration(minutes) * time.Minute) }
+	history := func(status types.IncidentStatus, minutes int) *model.StatusHistory {
+		return &model.StatusHistory{Status: status, ChangedAt: at(minutes)}
+	}
+
+	t.Run("closed incident", func(t *testing.T) {
+		incident := &model.Incident{ID: 1, Status: types.IncidentStatusClosed, CreatedAt: createdAt}
+		// Histories are not necessarily stored in order
+		d := model.NewIncidentDurations(incident, []*model.StatusHistory{
+			history(types.IncidentStatusMonitoring, 90),
+			history(types.IncidentStatusTriage, 0),
+			history(types.IncidentStatusHandling, 15),
+			history(types.IncidentStatusClosed, 120),
+		}, at(600))
+
+		gt.True(t, d.Acknowledged)
+		gt.Equal(t, d.TimeToAcknowledge, 15*time.Minute)
+		gt.True(t, d.Closed)
+		gt.Equal(t, d.TimeToClose, 2*time.Hour)
+		gt.Equal(t, d.TimeInStatus[types.IncidentStatusTriage], 15*time.Minute)
+		gt.Equal(t, d.TimeInStatus[types.IncidentStatusHandling], 75*time.Minute)
+		gt.Equal(t, d.TimeInStatus[types.IncidentStatusMonitoring], 30*time.Minute)
+		gt.Equal(t, *d.TimeToCloseSeconds(), 7200.0)
+	})
+
+	t.Run("open incident counts the current status until now", func(t *testing.T) {
+		incident := &model.Incident{ID: 2, Status: types.IncidentStatusTriage, CreatedAt: createdAt}
+		d := model.NewIncidentDurations(incident, []*model.StatusHistory{
+			history(types.IncidentStatusTriage, 0),
+		}, at(45))
+
+		gt.False(t, d.Acknowledged)
+		gt.False(t, d.Closed)
+		gt.Nil(t, d.TimeToAcknowledgeSeconds())
+		gt.Nil(t, d.TimeToCloseSeconds())
+		gt.Nil(t, d.TimeToFirstCloseSeconds())
+		gt.Equal(t, d.Reopens, 0)
+		gt.Equal(t, d.TimeInStatus[types.IncidentStatusTriage], 45*time.Minute)
+	})
+
+	t.Run("reopened incident is closed at its last closure", func(t *testing.T) {
+		incident := &model.Incident{ID: 3, Status: types.IncidentStatusClosed, CreatedAt: createdAt}
+		d := model.NewIncidentDurations(incident, []*model.StatusHistory{
+			history(types.IncidentStatusHandling, 0),
+			history(types.IncidentStatusClosed, 30),
+			history(types.IncidentStatusHandling, 60),
+			history(types.IncidentStatusClosed, 100),
+		}, at(600))
+
+		gt.Equal(t, d.TimeToAcknowledge, time.Duration(0))
+		gt.Equal(t, d.TimeToClose, 100*time.Minute)
+		gt.Equal(t, d.TimeInStatus[types.IncidentStatusHandling], 70*time.Minute)
+		gt.True(t, d.FirstClosed)
+		gt.Equal(t, d.TimeToFirstClose, 30*time.Minute)
+		gt.Equal(t, d.Reopens, 1)
+	})
+
+	t.Run("entries copied from merged duplicates are ignored", func(t *testing.T) {
+		incident := &model.Incident{ID: 4, Status: types.IncidentStatusHandling, CreatedAt: createdAt}
+		copied := history(types.IncidentStatusClosed, 20)
+		copied.SourceIncidentID = 5
+		d := model.NewIncidentDurations(incident, []*model.StatusHistory{
+			history(types.IncidentStatusHandling, 10),
+			copied,
+		}, at(60))
+
+		gt.False(t, d.FirstClosed)
+		gt.Equal(t, d.TimeInStatus[types.IncidentStatusHandling], 50*time.Minute)
+	})
+}
+
+func TestNewDurationStats(t *testing.T) {
+	empty := model.NewDurationStats(nil)
+	gt.Equal(t, empty.Count, 0)
+	gt.Nil(t, empty.MeanSeconds())
+
+	odd := model.NewDurationStats([]time.Duration{3 * time.Minute, time.Minute, 20 * time.Minute})
+	gt.Equal(t, odd.Count, 3)
+	gt.Equal(t, odd.Mean, 8*time.Minute)
+	gt.Equal(t, odd.Median, 3*time.Minute)
+
+	even := model.NewDurationStats([]time.Duration{time.Minute, 3 * time.Minute})
+	gt.Equal(t, even.Median, 2*time.Minute)
+	gt.Equal(t, *even.MedianSeconds(), 120.0)
+}
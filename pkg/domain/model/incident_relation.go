@@ -0,0 +1,20 @@
+package model
+
+// IncidentRelations are the incidents related to an incident. Large outages are
+// tracked as a major incident with child incidents, and incidents declared twice
+// for the same event are merged into a primary incident as duplicates.
+type IncidentRelations struct {
+	// Parent is the major incident the incident is part of
+	Parent *Incident
+	// DuplicateOf is the primary incident the incident was merged into
+	DuplicateOf *Incident
+	// Children are the incidents that are part of the incident
+	Children []*Incident
+	// Duplicates are the incidents merged into the incident
+	Duplicates []*Incident
+}
+
+// IsEmpty checks if the incident has no related incidents
+func (r *IncidentRelations) IsEmpty() bool {
+	return r == nil || (r.Parent == nil && r.DuplicateOf == nil && len(r.Children) == 0 && len(r.Duplicates) == 0)
+}
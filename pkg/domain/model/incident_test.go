@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/m-mizutani/gt"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
@@ -234,6 +235,30 @@ func TestIncidentWithCustomPrefix(t *testing.T) {
 	}
 }
 
+func TestIncidentChannelName(t *testing.T) {
+	gt.Equal(t, model.IncidentChannelName("inc", 7, "Slow API", true), types.ChannelName("inc-7-test-slow-api"))
+	gt.Equal(t, model.IncidentChannelName("inc", 7, "", true), types.ChannelName("inc-7-test"))
+
+	// Test incidents stay within the limit and multibyte titles are not cut mid-character
+	long := model.IncidentChannelName("inc", 7, strings.Repeat("障害", 30), true)
+	gt.True(t, len(long) <= 80)
+	gt.True(t, utf8.ValidString(long.String()))
+	gt.True(t, strings.HasPrefix(long.String(), "inc-7-test-障害"))
+}
+
+func TestIncidentChannelNameForTitle(t *testing.T) {
+	incident := &model.Incident{ID: 12, Title: "Database outage", ChannelName: "sec-12-slow-queries"}
+	gt.Equal(t, incident.ChannelNameForTitle("inc"), types.ChannelName("sec-12-database-outage"))
+
+	incident.IsTest = true
+	incident.ChannelName = "sec-12-test-slow-queries"
+	gt.Equal(t, incident.ChannelNameForTitle("inc"), types.ChannelName("sec-12-test-database-outage"))
+
+	// Channels without the incident number get the default prefix
+	incident.ChannelName = "renamed-by-hand"
+	gt.Equal(t, incident.ChannelNameForTitle("inc"), types.ChannelName("inc-12-test-database-outage"))
+}
+
 func TestSanitizeForSlackChannelName(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+// Job represents a persisted unit of background work.
+// Jobs survive process restarts: a job whose lease expires while running is picked up again.
+type Job struct {
+	ID          types.JobID     // Unique identifier
+	Kind        string          // Handler name the job is dispatched to
+	Payload     []byte          // Handler-specific payload (usually JSON)
+	Status      types.JobStatus // Current state
+	Attempts    int             // Number of started attempts
+	MaxAttempts int             // Attempts allowed before the job is dead-lettered
+	LastError   string          // Error message of the last failed attempt
+	RequestID   string          // Request ID of the originating request, for log correlation
+	TraceParent string          // W3C traceparent of the originating request, to continue its trace
+	RunAt       time.Time       // Earliest time the job may run (used for retry backoff)
+	LeaseOwner  string          // Worker that currently holds the job
+	LeaseUntil  time.Time       // Lease expiration; after this the job can be reclaimed
+	LeaseToken  string          // Identifies the claim holding the lease, renewed on every claim
+	CreatedAt   time.Time       // Creation timestamp
+	UpdatedAt   time.Time       // Update timestamp
+}
+
+// NewJob creates a new pending job that is runnable immediately
+func NewJob(kind string, payload []byte, maxAttempts int) (*Job, error) {
+	if kind == "" {
+		return nil, goerr.New("job kind is required")
+	}
+	if maxAttempts <= 0 {
+		return nil, goerr.New("max attempts must be positive", goerr.V("maxAttempts", maxAttempts))
+	}
+
+	now := time.Now()
+	return &Job{
+		ID:          types.NewJobID(),
+		Kind:        kind,
+		Payload:     payload,
+		Status:      types.JobStatusPending,
+		MaxAttempts: maxAttempts,
+		RunAt:       now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// IsClaimable checks if the job can be picked up by a worker at the given time.
+// Pending jobs are claimable once RunAt has passed; running jobs only after their lease expired.
+func (j *Job) IsClaimable(now time.Time) bool {
+	switch j.Status {
+	case types.JobStatusPending:
+		return !j.RunAt.After(now)
+	case types.JobStatusRunning:
+		return j.LeaseUntil.Before(now)
+	default:
+		return false
+	}
+}
+
+// IsLeasedBy checks if the job is still running under the given claim. A worker whose
+// lease expired and was reclaimed by another must not record an outcome for the job.
+func (j *Job) IsLeasedBy(owner, leaseToken string) bool {
+	return j.Status == types.JobStatusRunning && j.LeaseOwner == owner && j.LeaseToken == leaseToken
+}
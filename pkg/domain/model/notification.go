@@ -0,0 +1,54 @@
+package model
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+// NotificationsConfig configures notifications of status and severity changes
+// outside the incident channel. When it is omitted, changes are only posted in
+// the incident channel.
+type NotificationsConfig struct {
+	// OriginThread replies in the thread of the message the incident was declared from
+	OriginThread bool `yaml:"origin_thread,omitempty"`
+	// BroadcastChannels lists the channel IDs notified of changes per severity ID
+	BroadcastChannels map[string][]types.ChannelID `yaml:"broadcast_channels,omitempty"`
+}
+
+// Validate validates the notifications configuration. Broadcast channels must
+// be listed under severities defined in severities.
+func (c *NotificationsConfig) Validate(severities []Severity) error {
+	sevConfig := &SeveritiesConfig{Severities: severities}
+	for id, channels := range c.BroadcastChannels {
+		if sevConfig.FindSeverityByID(id) == nil {
+			return goerr.New("unknown severity in broadcast_channels",
+				goerr.V("severity", id))
+		}
+		for i, ch := range channels {
+			if ch == "" || strings.HasPrefix(ch.String(), "#") {
+				return goerr.New("broadcast channel must be a channel ID",
+					goerr.V("severity", id),
+					goerr.V("index", i),
+					goerr.V("channel", ch))
+			}
+		}
+	}
+	return nil
+}
+
+// BroadcastChannelsFor returns the channels notified of changes of incidents
+// with any of the given severities, without duplicates
+func (c *NotificationsConfig) BroadcastChannelsFor(severityIDs ...types.SeverityID) []types.ChannelID {
+	var channels []types.ChannelID
+	for _, id := range severityIDs {
+		for _, ch := range c.BroadcastChannels[id.String()] {
+			if !slices.Contains(channels, ch) {
+				channels = append(channels, ch)
+			}
+		}
+	}
+	return channels
+}
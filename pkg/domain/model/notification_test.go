@@ -0,0 +1,34 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+func TestNotificationsConfig(t *testing.T) {
+	severities := []model.Severity{
+		{ID: "critical", Name: "Critical", Level: 90},
+		{ID: "high", Name: "High", Level: 70},
+	}
+
+	cfg := &model.NotificationsConfig{
+		OriginThread: true,
+		BroadcastChannels: map[string][]types.ChannelID{
+			"critical": {"C-EXEC", "C-SECURITY"},
+			"high":     {"C-SECURITY"},
+		},
+	}
+	gt.NoError(t, cfg.Validate(severities))
+	gt.A(t, cfg.BroadcastChannelsFor("high", "critical")).Equal([]types.ChannelID{"C-SECURITY", "C-EXEC"})
+	gt.A(t, cfg.BroadcastChannelsFor("low")).Length(0)
+
+	gt.Error(t, (&model.NotificationsConfig{
+		BroadcastChannels: map[string][]types.ChannelID{"unknown-severity": {"C-EXEC"}},
+	}).Validate(severities))
+	gt.Error(t, (&model.NotificationsConfig{
+		BroadcastChannels: map[string][]types.ChannelID{"critical": {"#exec"}},
+	}).Validate(severities))
+}
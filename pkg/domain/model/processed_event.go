@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// ProcessedEvent records a Slack delivery (event or interaction) that has already been accepted.
+// It is used to drop duplicate deliveries caused by Slack retries.
+type ProcessedEvent struct {
+	Key       string    // Deduplication key, e.g. "event:Ev0123" or "interaction:<trigger_id>"
+	CreatedAt time.Time // When the delivery was first accepted
+	ExpiresAt time.Time // After this time the key may be accepted again
+}
+
+// IsExpired checks if the record is no longer valid at the given time
+func (e *ProcessedEvent) IsExpired(now time.Time) bool {
+	return !now.Before(e.ExpiresAt)
+}
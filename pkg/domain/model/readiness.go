@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// DependencyStatus is the result of checking one dependency for readiness
+type DependencyStatus struct {
+	Name      string
+	OK        bool
+	Skipped   bool          // The dependency is not configured, which does not affect readiness
+	Error     string        // Failure reason, empty when OK
+	Latency   time.Duration // Time the check took
+	CheckedAt time.Time
+}
+
+// ReadinessReport is the readiness of the server and its dependencies
+type ReadinessReport struct {
+	Ready        bool
+	Dependencies []*DependencyStatus
+}
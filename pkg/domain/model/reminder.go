@@ -0,0 +1,142 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+// DefaultReminderInterval is how often open incidents are scanned for reminders
+const DefaultReminderInterval = 5 * time.Minute
+
+// ReminderKind identifies the condition a reminder is posted for
+type ReminderKind string
+
+const (
+	// ReminderKindStatusUpdate is posted when an active incident has no status update for a while
+	ReminderKindStatusUpdate ReminderKind = "status_update"
+	// ReminderKindNoLead is posted when no lead has been assigned
+	ReminderKindNoLead ReminderKind = "no_lead"
+	// ReminderKindStaleTask is posted when a task in follow_up or an assigned task is untouched
+	ReminderKindStaleTask ReminderKind = "stale_task"
+	// ReminderKindMonitoring is posted when an incident stays in monitoring, suggesting closure
+	ReminderKindMonitoring ReminderKind = "monitoring"
+	// ReminderKindStakeholderUpdate prompts the lead of an incident in handling for a stakeholder update
+	ReminderKindStakeholderUpdate ReminderKind = "stakeholder_update"
+)
+
+// RemindersConfig configures reminders posted in incident channels.
+// When it is omitted from the configuration, no reminders are posted.
+// A zero duration disables the corresponding reminder.
+type RemindersConfig struct {
+	// Interval is how often open incidents are scanned (default: 5m)
+	Interval time.Duration `yaml:"interval,omitempty"`
+	// StatusUpdate is the default time without a status update in triage or handling
+	StatusUpdate time.Duration `yaml:"status_update,omitempty"`
+	// StatusUpdateBySeverity overrides StatusUpdate per severity ID
+	StatusUpdateBySeverity map[string]time.Duration `yaml:"status_update_by_severity,omitempty"`
+	// NoLead is the time after creation without a lead
+	NoLead time.Duration `yaml:"no_lead,omitempty"`
+	// StaleTask is the time a follow_up task or an assigned task may stay untouched
+	StaleTask time.Duration `yaml:"stale_task,omitempty"`
+	// Monitoring is the time an incident may stay in monitoring before closure is suggested
+	Monitoring time.Duration `yaml:"monitoring,omitempty"`
+}
+
+// Validate validates the reminders configuration. Severity overrides must
+// reference severities defined in severities.
+func (c *RemindersConfig) Validate(severities []Severity) error {
+	durations := map[string]time.Duration{
+		"interval":      c.Interval,
+		"status_update": c.StatusUpdate,
+		"no_lead":       c.NoLead,
+		"stale_task":    c.StaleTask,
+		"monitoring":    c.Monitoring,
+	}
+	for name, d := range durations {
+		if d < 0 {
+			return goerr.New("reminder duration must not be negative",
+				goerr.V("name", name),
+				goerr.V("duration", d))
+		}
+	}
+
+	sevConfig := &SeveritiesConfig{Severities: severities}
+	for id, d := range c.StatusUpdateBySeverity {
+		if d < 0 {
+			return goerr.New("reminder duration must not be negative",
+				goerr.V("name", "status_update_by_severity"),
+				goerr.V("severity", id),
+				goerr.V("duration", d))
+		}
+		if sevConfig.FindSeverityByID(id) == nil {
+			return goerr.New("unknown severity in status_update_by_severity",
+				goerr.V("severity", id))
+		}
+	}
+
+	return nil
+}
+
+// ScanInterval returns how often open incidents are scanned
+func (c *RemindersConfig) ScanInterval() time.Duration {
+	if c.Interval <= 0 {
+		return DefaultReminderInterval
+	}
+	return c.Interval
+}
+
+// StatusUpdateThreshold returns the time without a status update after which
+// an incident of the given severity is reminded. Zero means disabled.
+func (c *RemindersConfig) StatusUpdateThreshold(severityID types.SeverityID) time.Duration {
+	if d, ok := c.StatusUpdateBySeverity[severityID.String()]; ok {
+		return d
+	}
+	return c.StatusUpdate
+}
+
+// Reminder records when a reminder was last posted and whether it is snoozed
+type Reminder struct {
+	ID             string            // Reminder key, see ReminderKey
+	IncidentID     types.IncidentID  // Incident the reminder belongs to
+	Kind           ReminderKind      // Condition the reminder is posted for
+	TaskID         types.TaskID      // Task for stale task reminders (optional)
+	LastRemindedAt time.Time         // When the reminder was last posted
+	SnoozedUntil   time.Time         // Reminder is not posted before this time
+	SnoozedBy      types.SlackUserID // User who snoozed the reminder (optional)
+}
+
+// ReminderKey returns the key of the reminder for an incident, kind and optional task
+func ReminderKey(incidentID types.IncidentID, kind ReminderKind, taskID types.TaskID) string {
+	if taskID != "" {
+		return fmt.Sprintf("%d:%s:%s", incidentID, kind, taskID)
+	}
+	return fmt.Sprintf("%d:%s", incidentID, kind)
+}
+
+// NewReminder creates a reminder that has never been posted
+func NewReminder(incidentID types.IncidentID, kind ReminderKind, taskID types.TaskID) *Reminder {
+	return &Reminder{
+		ID:         ReminderKey(incidentID, kind, taskID),
+		IncidentID: incidentID,
+		Kind:       kind,
+		TaskID:     taskID,
+	}
+}
+
+// IsDue checks if a reminder whose condition has held since since is due at now.
+// A reminder is repeated at most once per threshold and never while snoozed.
+func (r *Reminder) IsDue(since time.Time, threshold time.Duration, now time.Time) bool {
+	if threshold <= 0 || now.Sub(since) < threshold {
+		return false
+	}
+	if now.Before(r.SnoozedUntil) {
+		return false
+	}
+	if !r.LastRemindedAt.IsZero() && r.LastRemindedAt.After(since) && now.Sub(r.LastRemindedAt) < threshold {
+		return false
+	}
+	return true
+}
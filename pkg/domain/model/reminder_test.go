@@ -0,0 +1,45 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+)
+
+func TestRemindersConfigValidate(t *testing.T) {
+	severities := []model.Severity{{ID: "critical", Name: "Critical", Level: 90}}
+
+	valid := &model.RemindersConfig{
+		StatusUpdate:           4 * time.Hour,
+		StatusUpdateBySeverity: map[string]time.Duration{"critical": time.Hour},
+	}
+	gt.NoError(t, valid.Validate(severities))
+	gt.Equal(t, valid.ScanInterval(), model.DefaultReminderInterval)
+	gt.Equal(t, valid.StatusUpdateThreshold("critical"), time.Hour)
+	gt.Equal(t, valid.StatusUpdateThreshold("low"), 4*time.Hour)
+
+	gt.Error(t, (&model.RemindersConfig{NoLead: -time.Minute}).Validate(severities))
+	gt.Error(t, (&model.RemindersConfig{
+		StatusUpdateBySeverity: map[string]time.Duration{"unknown-severity": time.Hour},
+	}).Validate(severities))
+}
+
+func TestReminderIsDue(t *testing.T) {
+	now := time.Now()
+	since := now.Add(-2 * time.Hour)
+
+	reminder := model.NewReminder(1, model.ReminderKindStatusUpdate, "")
+	gt.True(t, reminder.IsDue(since, time.Hour, now))
+	gt.False(t, reminder.IsDue(since, 3*time.Hour, now))
+	gt.False(t, reminder.IsDue(since, 0, now))
+
+	reminder.LastRemindedAt = now.Add(-30 * time.Minute)
+	gt.False(t, reminder.IsDue(since, time.Hour, now))
+	gt.True(t, reminder.IsDue(since, time.Hour, now.Add(31*time.Minute)))
+
+	reminder.SnoozedUntil = now.Add(2 * time.Hour)
+	gt.False(t, reminder.IsDue(since, time.Hour, now.Add(time.Hour)))
+	gt.True(t, reminder.IsDue(since, time.Hour, now.Add(2*time.Hour)))
+}
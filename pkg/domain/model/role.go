@@ -0,0 +1,61 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+// RolesConfig assigns roles to Slack users and user groups.
+// When it is omitted from the configuration, access control is disabled and
+// every authenticated user is treated as an admin.
+type RolesConfig struct {
+	// Default is the role of users not matched by any binding (default: viewer)
+	Default  types.Role    `yaml:"default,omitempty"`
+	Bindings []RoleBinding `yaml:"bindings"`
+}
+
+// RoleBinding grants a role to users and user groups
+type RoleBinding struct {
+	Role   types.Role `yaml:"role"`
+	Users  []string   `yaml:"users,omitempty"`  // Slack user ID or @username
+	Groups []string   `yaml:"groups,omitempty"` // User group ID (S...) or @handle
+}
+
+// Validate validates the roles configuration
+func (c *RolesConfig) Validate() error {
+	if c.Default != "" && !c.Default.IsValid() {
+		return goerr.New("invalid default role", goerr.V("role", c.Default))
+	}
+
+	for i, b := range c.Bindings {
+		if !b.Role.IsValid() {
+			return goerr.New("invalid role in binding",
+				goerr.V("index", i),
+				goerr.V("role", b.Role))
+		}
+		if len(b.Users) == 0 && len(b.Groups) == 0 {
+			return goerr.New("role binding requires users or groups",
+				goerr.V("index", i),
+				goerr.V("role", b.Role))
+		}
+		for _, g := range b.Groups {
+			if !strings.HasPrefix(g, "@") && !strings.HasPrefix(g, "S") {
+				return goerr.New("invalid group format, use group ID or @handle",
+					goerr.V("index", i),
+					goerr.V("group", g))
+			}
+		}
+	}
+
+	return nil
+}
+
+// DefaultRole returns the role of users not matched by any binding
+func (c *RolesConfig) DefaultRole() types.Role {
+	if c.Default == "" {
+		return types.RoleViewer
+	}
+	return c.Default
+}
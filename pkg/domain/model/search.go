@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+// SearchDocument is a piece of incident content in the search index
+type SearchDocument struct {
+	// ID is unique across kinds, e.g. "task:<task ID>"
+	ID         string
+	Kind       types.SearchDocumentKind
+	IncidentID types.IncidentID
+	ChannelID  types.ChannelID
+	// MessageTS is the Slack message the document was posted as, if any
+	MessageTS string
+	Title     string
+	Text      string
+	Timestamp time.Time
+}
+
+// Permalink returns a link to the document in Slack, the message if known or
+// else the channel. It is empty when the document has no channel.
+func (d *SearchDocument) Permalink() string {
+	if d.ChannelID == "" {
+		return ""
+	}
+	if d.MessageTS != "" {
+		return slackMessageURL(d.ChannelID, d.MessageTS)
+	}
+	return "https://slack.com/archives/" + string(d.ChannelID)
+}
+
+// SearchFilter narrows down search hits. Zero values match everything.
+type SearchFilter struct {
+	Kinds      []types.SearchDocumentKind
+	IncidentID types.IncidentID
+	// Since is inclusive and Until exclusive
+	Since time.Time
+	Until time.Time
+	// Include, when set, is called once with the incidents of all matching hits
+	// and returns the incidents whose hits may be returned
+	Include func([]types.IncidentID) map[types.IncidentID]bool
+}
+
+// TextRange is a range of runes in a highlighted fragment, end exclusive
+type TextRange struct {
+	Start int
+	End   int
+}
+
+// SearchHighlight is an excerpt of a document field with the matched terms marked
+type SearchHighlight struct {
+	// Field is "title" or "text"
+	Field    string
+	Fragment string
+	Ranges   []TextRange
+}
+
+// SearchHit is a document matching a search query
+type SearchHit struct {
+	SearchDocument
+	Score      float64
+	Highlights []SearchHighlight
+}
@@ -15,6 +15,17 @@ type Session struct {
 	UserID    types.UserID        `json:"user_id"` // Associated user ID
 	CreatedAt time.Time           `json:"created_at"`
 	ExpiresAt time.Time           `json:"expires_at"`
+
+	// Client activity, updated as the session is used
+	LastSeenAt time.Time `json:"last_seen_at"`
+	IPAddress  string    `json:"ip_address,omitempty"`
+	UserAgent  string    `json:"user_agent,omitempty"`
+}
+
+// SessionClient describes the client a session is used from
+type SessionClient struct {
+	IPAddress string
+	UserAgent string
 }
 
 // NewSession creates a new Session with UUID v7 ID and random Secret
@@ -33,14 +44,35 @@ func NewSession(userID types.UserID, duration time.Duration) (*Session, error) {
 
 	now := time.Now()
 	return &Session{
-		ID:        sessionID,
-		Secret:    types.SessionSecret(sessionSecret),
-		UserID:    userID,
-		CreatedAt: now,
-		ExpiresAt: now.Add(duration),
+		ID:         sessionID,
+		Secret:     types.SessionSecret(sessionSecret),
+		UserID:     userID,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(duration),
+		LastSeenAt: now,
 	}, nil
 }
 
+// Touch records activity from the client and slides the expiration to ttl from
+// now, but never beyond maxLifetime after the session was created
+func (s *Session) Touch(now time.Time, client SessionClient, ttl, maxLifetime time.Duration) {
+	s.LastSeenAt = now
+	if client.IPAddress != "" {
+		s.IPAddress = client.IPAddress
+	}
+	if client.UserAgent != "" {
+		s.UserAgent = client.UserAgent
+	}
+
+	expiresAt := now.Add(ttl)
+	if limit := s.CreatedAt.Add(maxLifetime); expiresAt.After(limit) {
+		expiresAt = limit
+	}
+	if expiresAt.After(s.ExpiresAt) {
+		s.ExpiresAt = expiresAt
+	}
+}
+
 // IsExpired checks if the session has expired
 func (s *Session) IsExpired() bool {
 	return time.Now().After(s.ExpiresAt)
@@ -0,0 +1,36 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+)
+
+func TestSessionTouch(t *testing.T) {
+	session, err := model.NewSession("U123", time.Hour)
+	gt.NoError(t, err).Required()
+	gt.Equal(t, session.LastSeenAt, session.CreatedAt)
+
+	t.Run("slides expiry and records client", func(t *testing.T) {
+		now := session.CreatedAt.Add(30 * time.Minute)
+		session.Touch(now, model.SessionClient{IPAddress: "192.0.2.1", UserAgent: "curl/8"}, time.Hour, 24*time.Hour)
+
+		gt.Equal(t, session.LastSeenAt, now)
+		gt.Equal(t, session.ExpiresAt, now.Add(time.Hour))
+		gt.Equal(t, session.IPAddress, "192.0.2.1")
+		gt.Equal(t, session.UserAgent, "curl/8")
+	})
+
+	t.Run("keeps client info when not provided", func(t *testing.T) {
+		session.Touch(session.LastSeenAt.Add(time.Minute), model.SessionClient{}, time.Hour, 24*time.Hour)
+		gt.Equal(t, session.IPAddress, "192.0.2.1")
+	})
+
+	t.Run("is capped by max lifetime", func(t *testing.T) {
+		now := session.CreatedAt.Add(23*time.Hour + 30*time.Minute)
+		session.Touch(now, model.SessionClient{}, time.Hour, 24*time.Hour)
+		gt.Equal(t, session.ExpiresAt, session.CreatedAt.Add(24*time.Hour))
+	})
+}
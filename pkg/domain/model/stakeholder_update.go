@@ -0,0 +1,100 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+// maxStakeholderUpdateLength is the maximum length of a stakeholder update in characters
+const maxStakeholderUpdateLength = 3000
+
+// StakeholderUpdate is a summary of the incident written for people outside the
+// response, posted to the incident, origin and announcement channels
+type StakeholderUpdate struct {
+	ID         types.StakeholderUpdateID `json:"id"`
+	IncidentID types.IncidentID          `json:"incidentId"`
+	Text       string                    `json:"text"`
+	Status     types.IncidentStatus      `json:"status"` // Incident status when the update was posted
+	PostedBy   types.SlackUserID         `json:"postedBy"`
+	PostedAt   time.Time                 `json:"postedAt"`
+}
+
+// NewStakeholderUpdate creates a new stakeholder update of incident
+func NewStakeholderUpdate(incident *Incident, text string, postedBy types.SlackUserID) (*StakeholderUpdate, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, goerr.New("stakeholder update text is required")
+	}
+	if len([]rune(text)) > maxStakeholderUpdateLength {
+		return nil, goerr.New("stakeholder update is too long",
+			goerr.V("length", len([]rune(text))),
+			goerr.V("max", maxStakeholderUpdateLength))
+	}
+	if postedBy == "" {
+		return nil, goerr.New("poster user ID is required")
+	}
+
+	return &StakeholderUpdate{
+		ID:         types.NewStakeholderUpdateID(),
+		IncidentID: incident.ID,
+		Text:       text,
+		Status:     incident.Status,
+		PostedBy:   postedBy,
+		PostedAt:   time.Now(),
+	}, nil
+}
+
+// StakeholderUpdatesConfig configures stakeholder updates. Updates can always be
+// posted; the configuration adds periodic prompts and announcement channels.
+type StakeholderUpdatesConfig struct {
+	// Interval is how often the lead of an incident in handling is prompted for an update.
+	// Zero disables prompts.
+	Interval time.Duration `yaml:"interval,omitempty"`
+	// IntervalBySeverity overrides Interval per severity ID
+	IntervalBySeverity map[string]time.Duration `yaml:"interval_by_severity,omitempty"`
+	// AnnouncementChannels are channel IDs every update of a public incident is cross-posted to
+	AnnouncementChannels []types.ChannelID `yaml:"announcement_channels,omitempty"`
+}
+
+// Validate validates the stakeholder updates configuration. Severity overrides
+// must reference severities defined in severities.
+func (c *StakeholderUpdatesConfig) Validate(severities []Severity) error {
+	if c.Interval < 0 {
+		return goerr.New("stakeholder update interval must not be negative", goerr.V("interval", c.Interval))
+	}
+
+	sevConfig := &SeveritiesConfig{Severities: severities}
+	for id, d := range c.IntervalBySeverity {
+		if d < 0 {
+			return goerr.New("stakeholder update interval must not be negative",
+				goerr.V("severity", id),
+				goerr.V("interval", d))
+		}
+		if sevConfig.FindSeverityByID(id) == nil {
+			return goerr.New("unknown severity in interval_by_severity",
+				goerr.V("severity", id))
+		}
+	}
+
+	for i, ch := range c.AnnouncementChannels {
+		if ch == "" || strings.HasPrefix(ch.String(), "#") {
+			return goerr.New("announcement channel must be a channel ID",
+				goerr.V("index", i),
+				goerr.V("channel", ch))
+		}
+	}
+
+	return nil
+}
+
+// PromptInterval returns how often the lead of an incident of the given severity
+// is prompted for an update. Zero means disabled.
+func (c *StakeholderUpdatesConfig) PromptInterval(severityID types.SeverityID) time.Duration {
+	if d, ok := c.IntervalBySeverity[severityID.String()]; ok {
+		return d
+	}
+	return c.Interval
+}
@@ -0,0 +1,45 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+func TestStakeholderUpdatesConfigValidate(t *testing.T) {
+	severities := []model.Severity{{ID: "critical", Name: "Critical", Level: 90}}
+
+	valid := &model.StakeholderUpdatesConfig{
+		Interval:             2 * time.Hour,
+		IntervalBySeverity:   map[string]time.Duration{"critical": 30 * time.Minute},
+		AnnouncementChannels: []types.ChannelID{"C0123456789"},
+	}
+	gt.NoError(t, valid.Validate(severities))
+	gt.Equal(t, valid.PromptInterval("critical"), 30*time.Minute)
+	gt.Equal(t, valid.PromptInterval("low"), 2*time.Hour)
+
+	gt.Error(t, (&model.StakeholderUpdatesConfig{Interval: -time.Minute}).Validate(severities))
+	gt.Error(t, (&model.StakeholderUpdatesConfig{
+		IntervalBySeverity: map[string]time.Duration{"unknown-severity": time.Hour},
+	}).Validate(severities))
+	gt.Error(t, (&model.StakeholderUpdatesConfig{
+		AnnouncementChannels: []types.ChannelID{"#announcements"},
+	}).Validate(severities))
+}
+
+func TestNewStakeholderUpdate(t *testing.T) {
+	incident := &model.Incident{ID: 7, Status: types.IncidentStatusMonitoring}
+
+	update, err := model.NewStakeholderUpdate(incident, "\n Service restored \n", "U-LEAD")
+	gt.NoError(t, err).Required()
+	gt.Equal(t, update.IncidentID, types.IncidentID(7))
+	gt.Equal(t, update.Text, "Service restored")
+	gt.Equal(t, update.Status, types.IncidentStatusMonitoring)
+	gt.False(t, update.ID == "")
+
+	_, err = model.NewStakeholderUpdate(incident, "Service restored", "")
+	gt.Error(t, err)
+}
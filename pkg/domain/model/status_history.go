@@ -15,6 +15,11 @@ type StatusHistory struct {
 	ChangedBy  types.SlackUserID     `json:"changedBy"`
 	ChangedAt  time.Time             `json:"changedAt"`
 	Note       string                `json:"note,omitempty"`
+	// Reopened marks the entry that brought a closed incident back, with the reason as note
+	Reopened bool `json:"reopened,omitempty"`
+	// SourceIncidentID is set on entries copied from a duplicate merged into the incident.
+	// They are shown in the timeline but are not status changes of the incident itself.
+	SourceIncidentID types.IncidentID `json:"sourceIncidentId,omitempty"`
 }
 
 // StatusHistoryWithUser represents a status history entry with user information
@@ -164,10 +164,14 @@ func (t *Task) GetSlackMessageURL(channelID types.ChannelID) string {
 		return ""
 	}
 
+	return slackMessageURL(channelID, t.MessageTS)
+}
+
+func slackMessageURL(channelID types.ChannelID, messageTS string) string {
 	// Convert message timestamp to permalink format
 	// Remove the dot from the timestamp for the URL
 	// e.g., "1234567890.123456" becomes "1234567890123456"
-	formattedTS := strings.Replace(t.MessageTS, ".", "", 1)
+	formattedTS := strings.Replace(messageTS, ".", "", 1)
 
 	return "https://slack.com/archives/" + string(channelID) + "/p" + formattedTS
 }
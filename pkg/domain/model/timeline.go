@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+// TimelineEvent is a human readable entry of what happened during an incident.
+// Timeline events are pushed to subscribers as they happen and are not stored.
+type TimelineEvent struct {
+	IncidentID types.IncidentID        `json:"incidentId"`
+	Kind       types.TimelineEventKind `json:"kind"`
+	// ActorID is a Slack user ID, "token:<id>" or "cli:<user>"; empty for system actions
+	ActorID   string    `json:"actorId,omitempty"`
+	Summary   string    `json:"summary"`
+	Timestamp time.Time `json:"timestamp"`
+}
@@ -0,0 +1,96 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+// TranscriptMessage is a message of an incident channel kept in its transcript
+type TranscriptMessage struct {
+	TS       string            `json:"ts"`
+	ThreadTS string            `json:"threadTs,omitempty"` // Parent message of a thread reply
+	UserID   types.SlackUserID `json:"userId,omitempty"`
+	BotID    string            `json:"botId,omitempty"`
+	Text     string            `json:"text"`
+	PostedAt time.Time         `json:"postedAt"`
+}
+
+// IsReply checks if the message is a reply in a thread
+func (m *TranscriptMessage) IsReply() bool {
+	return m.ThreadTS != "" && m.ThreadTS != m.TS
+}
+
+// Transcript is the exported history of an incident channel, kept so that the
+// record of the response survives Slack retention policies
+type Transcript struct {
+	IncidentID types.IncidentID    `json:"incidentId"`
+	ChannelID  types.ChannelID     `json:"channelId"`
+	ExportedAt time.Time           `json:"exportedAt"`
+	Messages   []TranscriptMessage `json:"messages"` // Oldest first, thread replies after their parent
+}
+
+// Markdown renders the transcript as a Markdown document
+func (t *Transcript) Markdown(incident *Incident) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Incident #%d: %s\n\n", incident.ID, incident.Title)
+	fmt.Fprintf(&b, "- Channel: #%s (%s)\n", incident.ChannelName, t.ChannelID)
+	fmt.Fprintf(&b, "- Status: %s\n", incident.Status)
+	if incident.Resolution != "" {
+		fmt.Fprintf(&b, "- Resolution: %s\n", incident.Resolution)
+	}
+	fmt.Fprintf(&b, "- Exported: %s\n\n", t.ExportedAt.UTC().Format(time.RFC3339))
+	b.WriteString("## Messages\n\n")
+
+	for _, msg := range t.Messages {
+		author := msg.UserID.String()
+		if author == "" {
+			author = "bot " + msg.BotID
+		}
+		indent := ""
+		if msg.IsReply() {
+			indent = "  "
+		}
+		text := strings.ReplaceAll(msg.Text, "\n", "\n"+indent+"  ")
+		fmt.Fprintf(&b, "%s- **%s** `%s`: %s\n", indent, msg.PostedAt.UTC().Format("2006-01-02 15:04:05"), author, text)
+	}
+
+	return b.String()
+}
+
+// DefaultArchiveInterval is how often closed incidents are scanned for archival
+const DefaultArchiveInterval = 5 * time.Minute
+
+// ArchiveConfig configures what happens to incident channels after closure.
+// When it is omitted from the configuration, channels are left as they are.
+type ArchiveConfig struct {
+	// Interval is how often closed incidents are scanned (default: 5m)
+	Interval time.Duration `yaml:"interval,omitempty"`
+	// GracePeriod is how long after closure the transcript is exported, so that
+	// responders can wrap up in the channel first
+	GracePeriod time.Duration `yaml:"grace_period,omitempty"`
+	// ArchiveChannel archives the channel once its transcript has been exported
+	ArchiveChannel bool `yaml:"archive_channel,omitempty"`
+}
+
+// Validate validates the archive configuration
+func (c *ArchiveConfig) Validate() error {
+	if c.Interval < 0 {
+		return goerr.New("archive interval must not be negative", goerr.V("interval", c.Interval))
+	}
+	if c.GracePeriod < 0 {
+		return goerr.New("archive grace period must not be negative", goerr.V("gracePeriod", c.GracePeriod))
+	}
+	return nil
+}
+
+// ScanInterval returns how often closed incidents are scanned
+func (c *ArchiveConfig) ScanInterval() time.Duration {
+	if c.Interval <= 0 {
+		return DefaultArchiveInterval
+	}
+	return c.Interval
+}
@@ -0,0 +1,43 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+func TestTranscriptMarkdown(t *testing.T) {
+	postedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	incident := &model.Incident{ID: 7, Title: "Database outage", ChannelName: "inc-7-database-outage", Status: types.IncidentStatusClosed, Resolution: "Failed over"}
+	transcript := &model.Transcript{
+		IncidentID: 7,
+		ChannelID:  "C-INC",
+		ExportedAt: postedAt.Add(time.Hour),
+		Messages: []model.TranscriptMessage{
+			{TS: "1714557600.000100", UserID: "U-LEAD", Text: "Looking into it", PostedAt: postedAt},
+			{TS: "1714557660.000100", ThreadTS: "1714557600.000100", UserID: "U-ANALYST", Text: "Replica lag\nis high", PostedAt: postedAt.Add(time.Minute)},
+			{TS: "1714557720.000100", BotID: "B-LYCAON", Text: "Status changed", PostedAt: postedAt.Add(2 * time.Minute)},
+		},
+	}
+
+	md := transcript.Markdown(incident)
+	gt.True(t, strings.HasPrefix(md, "# Incident #7: Database outage\n"))
+	gt.S(t, md).Contains("- Resolution: Failed over")
+	gt.S(t, md).Contains("- **2024-05-01 10:00:00** `U-LEAD`: Looking into it\n")
+	// Thread replies are nested under their parent, keeping multi-line text in the item
+	gt.S(t, md).Contains("  - **2024-05-01 10:01:00** `U-ANALYST`: Replica lag\n    is high\n")
+	gt.S(t, md).Contains("`bot B-LYCAON`: Status changed")
+}
+
+func TestArchiveConfig(t *testing.T) {
+	gt.NoError(t, (&model.ArchiveConfig{GracePeriod: time.Hour}).Validate())
+	gt.Error(t, (&model.ArchiveConfig{GracePeriod: -time.Hour}).Validate())
+	gt.Error(t, (&model.ArchiveConfig{Interval: -time.Minute}).Validate())
+
+	gt.Equal(t, (&model.ArchiveConfig{}).ScanInterval(), model.DefaultArchiveInterval)
+	gt.Equal(t, (&model.ArchiveConfig{Interval: time.Minute}).ScanInterval(), time.Minute)
+}
@@ -0,0 +1,61 @@
+package types
+
+// APITokenKind distinguishes tokens acting as a user from tokens for automation
+type APITokenKind string
+
+const (
+	// APITokenKindPersonal acts as the Slack user who owns it, with that user's role
+	APITokenKindPersonal APITokenKind = "personal"
+	// APITokenKindService is not bound to a user and is meant for automation
+	APITokenKindService APITokenKind = "service"
+)
+
+// String returns the string representation of the kind
+func (k APITokenKind) String() string {
+	return string(k)
+}
+
+// IsValid checks if the kind is valid
+func (k APITokenKind) IsValid() bool {
+	switch k {
+	case APITokenKindPersonal, APITokenKindService:
+		return true
+	default:
+		return false
+	}
+}
+
+// APITokenScope limits what an API token may do
+type APITokenScope string
+
+const (
+	// APITokenScopeRead allows queries
+	APITokenScopeRead APITokenScope = "read"
+	// APITokenScopeWrite allows queries and mutations
+	APITokenScopeWrite APITokenScope = "write"
+)
+
+// String returns the string representation of the scope
+func (s APITokenScope) String() string {
+	return string(s)
+}
+
+// IsValid checks if the scope is valid
+func (s APITokenScope) IsValid() bool {
+	switch s {
+	case APITokenScopeRead, APITokenScopeWrite:
+		return true
+	default:
+		return false
+	}
+}
+
+// ScopesAllow checks if the granted scopes allow the scope. Write implies read.
+func ScopesAllow(granted []APITokenScope, scope APITokenScope) bool {
+	for _, g := range granted {
+		if g == scope || (g == APITokenScopeWrite && scope == APITokenScopeRead) {
+			return true
+		}
+	}
+	return false
+}
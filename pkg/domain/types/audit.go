@@ -0,0 +1,75 @@
+package types
+
+// AuditSource identifies the interface through which an audited action was made
+type AuditSource string
+
+const (
+	// AuditSourceSlack is an action from Slack events, buttons, modals or commands
+	AuditSourceSlack AuditSource = "slack"
+	// AuditSourceGraphQL is an action through the GraphQL API (web UI or API tokens)
+	AuditSourceGraphQL AuditSource = "graphql"
+	// AuditSourceCLI is an action from the lycaon command line
+	AuditSourceCLI AuditSource = "cli"
+	// AuditSourceWebhook is an action triggered by an inbound webhook
+	AuditSourceWebhook AuditSource = "webhook"
+	// AuditSourceSystem is an action taken by lycaon itself, e.g. background jobs
+	AuditSourceSystem AuditSource = "system"
+)
+
+// String returns the string representation of the source
+func (s AuditSource) String() string {
+	return string(s)
+}
+
+// IsValid checks if the source is valid
+func (s AuditSource) IsValid() bool {
+	switch s {
+	case AuditSourceSlack, AuditSourceGraphQL, AuditSourceCLI, AuditSourceWebhook, AuditSourceSystem:
+		return true
+	default:
+		return false
+	}
+}
+
+// AuditAction names an audited action as "<target>.<verb>"
+type AuditAction string
+
+const (
+	AuditActionIncidentCreate            AuditAction = "incident.create"
+	AuditActionIncidentUpdate            AuditAction = "incident.update"
+	AuditActionIncidentStatusChange      AuditAction = "incident.status_change"
+	AuditActionIncidentMemberChange      AuditAction = "incident.member_change"
+	AuditActionIncidentPrivateView       AuditAction = "incident.private_access"
+	AuditActionIncidentAccessGrant       AuditAction = "incident.access_grant"
+	AuditActionIncidentAccessRevoke      AuditAction = "incident.access_revoke"
+	AuditActionIncidentAccessRequest     AuditAction = "incident.access_request"
+	AuditActionIncidentStakeholderUpdate AuditAction = "incident.stakeholder_update"
+	AuditActionIncidentLink              AuditAction = "incident.link"
+	AuditActionIncidentMerge             AuditAction = "incident.merge"
+	AuditActionTaskCreate                AuditAction = "task.create"
+	AuditActionTaskUpdate                AuditAction = "task.update"
+	AuditActionTaskDelete                AuditAction = "task.delete"
+	AuditActionAPITokenCreate            AuditAction = "api_token.create"
+	AuditActionAPITokenRevoke            AuditAction = "api_token.revoke"
+	AuditActionSessionRevoke             AuditAction = "session.revoke"
+)
+
+// String returns the string representation of the action
+func (a AuditAction) String() string {
+	return string(a)
+}
+
+// AuditTargetType is the kind of object an audited action was applied to
+type AuditTargetType string
+
+const (
+	AuditTargetIncident AuditTargetType = "incident"
+	AuditTargetTask     AuditTargetType = "task"
+	AuditTargetAPIToken AuditTargetType = "api_token"
+	AuditTargetSession  AuditTargetType = "session"
+)
+
+// String returns the string representation of the target type
+func (t AuditTargetType) String() string {
+	return string(t)
+}
@@ -0,0 +1,32 @@
+package types
+
+// IncidentMetricsGroupBy is the dimension incident metrics are aggregated by
+type IncidentMetricsGroupBy string
+
+const (
+	// IncidentMetricsGroupBySeverity groups incidents by severity
+	IncidentMetricsGroupBySeverity IncidentMetricsGroupBy = "severity"
+	// IncidentMetricsGroupByCategory groups incidents by category
+	IncidentMetricsGroupByCategory IncidentMetricsGroupBy = "category"
+	// IncidentMetricsGroupByAsset groups incidents by affected asset. An incident
+	// affecting several assets counts in each of their groups.
+	IncidentMetricsGroupByAsset IncidentMetricsGroupBy = "asset"
+	// IncidentMetricsGroupByMonth groups incidents by the month they were created in (UTC)
+	IncidentMetricsGroupByMonth IncidentMetricsGroupBy = "month"
+)
+
+// String returns the string representation of the dimension
+func (g IncidentMetricsGroupBy) String() string {
+	return string(g)
+}
+
+// IsValid checks if the dimension is valid
+func (g IncidentMetricsGroupBy) IsValid() bool {
+	switch g {
+	case IncidentMetricsGroupBySeverity, IncidentMetricsGroupByCategory,
+		IncidentMetricsGroupByAsset, IncidentMetricsGroupByMonth:
+		return true
+	default:
+		return false
+	}
+}
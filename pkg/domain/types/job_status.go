@@ -0,0 +1,28 @@
+package types
+
+// JobStatus represents the state of a background job
+type JobStatus string
+
+const (
+	// JobStatusPending means the job is waiting to be picked up (first run or retry)
+	JobStatusPending JobStatus = "pending"
+	// JobStatusRunning means a worker holds the lease on the job
+	JobStatusRunning JobStatus = "running"
+	// JobStatusDead means the job exhausted its attempts and is kept for inspection
+	JobStatusDead JobStatus = "dead"
+)
+
+// String returns the string representation of the status
+func (s JobStatus) String() string {
+	return string(s)
+}
+
+// IsValid checks if the status is valid
+func (s JobStatus) IsValid() bool {
+	switch s {
+	case JobStatusPending, JobStatusRunning, JobStatusDead:
+		return true
+	default:
+		return false
+	}
+}
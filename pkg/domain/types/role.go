@@ -0,0 +1,47 @@
+package types
+
+// Role is the access level of a user in lycaon
+type Role string
+
+const (
+	// RoleAdmin can modify any incident and task
+	RoleAdmin Role = "admin"
+	// RoleResponder can declare incidents and modify incidents they take part in
+	RoleResponder Role = "responder"
+	// RoleViewer has read-only access
+	RoleViewer Role = "viewer"
+)
+
+// String returns the string representation of the role
+func (r Role) String() string {
+	return string(r)
+}
+
+// IsValid checks if the role is valid
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleAdmin, RoleResponder, RoleViewer:
+		return true
+	default:
+		return false
+	}
+}
+
+// level returns the rank of the role; higher is more privileged
+func (r Role) level() int {
+	switch r {
+	case RoleAdmin:
+		return 3
+	case RoleResponder:
+		return 2
+	case RoleViewer:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// AtLeast checks if the role grants at least the privileges of other
+func (r Role) AtLeast(other Role) bool {
+	return r.level() >= other.level()
+}
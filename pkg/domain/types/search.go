@@ -0,0 +1,30 @@
+package types
+
+// SearchDocumentKind is the kind of content a search hit comes from
+type SearchDocumentKind string
+
+const (
+	// SearchKindIncident is the title and description of an incident
+	SearchKindIncident SearchDocumentKind = "incident"
+	// SearchKindTask is the title and description of a task
+	SearchKindTask SearchDocumentKind = "task"
+	// SearchKindTimeline is a status change of an incident with its note
+	SearchKindTimeline SearchDocumentKind = "timeline"
+	// SearchKindMessage is a Slack message posted in an incident channel
+	SearchKindMessage SearchDocumentKind = "message"
+)
+
+// String returns the string representation of the kind
+func (k SearchDocumentKind) String() string {
+	return string(k)
+}
+
+// IsValid checks if the kind is valid
+func (k SearchDocumentKind) IsValid() bool {
+	switch k {
+	case SearchKindIncident, SearchKindTask, SearchKindTimeline, SearchKindMessage:
+		return true
+	default:
+		return false
+	}
+}
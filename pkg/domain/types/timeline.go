@@ -0,0 +1,32 @@
+package types
+
+// TimelineEventKind is the kind of an incident timeline event
+type TimelineEventKind string
+
+const (
+	// TimelineEventIncidentCreated is emitted when an incident is declared
+	TimelineEventIncidentCreated TimelineEventKind = "incident_created"
+	// TimelineEventIncidentUpdated is emitted when incident details change
+	TimelineEventIncidentUpdated TimelineEventKind = "incident_updated"
+	// TimelineEventStatusChanged is emitted when the incident status changes
+	TimelineEventStatusChanged TimelineEventKind = "status_changed"
+	// TimelineEventIncidentReopened is emitted when a closed incident is reopened
+	TimelineEventIncidentReopened TimelineEventKind = "incident_reopened"
+	// TimelineEventIncidentLinked is emitted when a child incident is linked to or unlinked from a major incident
+	TimelineEventIncidentLinked TimelineEventKind = "incident_linked"
+	// TimelineEventIncidentMerged is emitted when a duplicate incident is merged into a primary incident
+	TimelineEventIncidentMerged TimelineEventKind = "incident_merged"
+	// TimelineEventTaskCreated is emitted when a task is added to the incident
+	TimelineEventTaskCreated TimelineEventKind = "task_created"
+	// TimelineEventTaskUpdated is emitted when a task of the incident changes
+	TimelineEventTaskUpdated TimelineEventKind = "task_updated"
+	// TimelineEventTaskDeleted is emitted when a task is removed from the incident
+	TimelineEventTaskDeleted TimelineEventKind = "task_deleted"
+	// TimelineEventStakeholderUpdate is emitted when a stakeholder update is posted
+	TimelineEventStakeholderUpdate TimelineEventKind = "stakeholder_update"
+)
+
+// String returns the string representation of the kind
+func (k TimelineEventKind) String() string {
+	return string(k)
+}
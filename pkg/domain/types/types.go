@@ -160,6 +160,19 @@ func NewTaskID() TaskID {
 	return TaskID(uuid.New().String())
 }
 
+// JobID represents a background job identifier
+type JobID string
+
+// String returns the string representation
+func (id JobID) String() string {
+	return string(id)
+}
+
+// NewJobID creates a new JobID
+func NewJobID() JobID {
+	return JobID(uuid.New().String())
+}
+
 // SeverityID represents a severity identifier
 type SeverityID string
 
@@ -182,6 +195,41 @@ type PaginationOptions struct {
 	Limit int
 	// After is the cursor to start after (for forward pagination)
 	After *IncidentID
+	// Sort is the order of items; the zero value lists newest first
+	Sort IncidentSort
+}
+
+// IncidentSortField is the field incidents are listed by
+type IncidentSortField string
+
+const (
+	// IncidentSortCreatedAt orders incidents by creation, i.e. by their serial number
+	IncidentSortCreatedAt IncidentSortField = "created_at"
+	// IncidentSortTitle orders incidents alphabetically by title
+	IncidentSortTitle IncidentSortField = "title"
+)
+
+// String returns the string representation of the field
+func (f IncidentSortField) String() string {
+	return string(f)
+}
+
+// IsValid checks if the field is valid
+func (f IncidentSortField) IsValid() bool {
+	switch f {
+	case IncidentSortCreatedAt, IncidentSortTitle:
+		return true
+	default:
+		return false
+	}
+}
+
+// IncidentSort is the order of an incident listing. Ties are broken by incident
+// ID in the same direction.
+type IncidentSort struct {
+	// Field defaults to IncidentSortCreatedAt when empty
+	Field     IncidentSortField
+	Ascending bool
 }
 
 // PaginationResult represents pagination information for a result set
@@ -193,3 +241,55 @@ type PaginationResult struct {
 	// TotalCount is the total number of items (may be estimated)
 	TotalCount int
 }
+
+// APITokenID represents an API token identifier
+type APITokenID string
+
+// String returns the string representation
+func (id APITokenID) String() string {
+	return string(id)
+}
+
+// NewAPITokenID creates a new APITokenID
+func NewAPITokenID() APITokenID {
+	return APITokenID(uuid.New().String())
+}
+
+// AccessGrantID represents a private incident access grant identifier
+type AccessGrantID string
+
+// String returns the string representation
+func (id AccessGrantID) String() string {
+	return string(id)
+}
+
+// NewAccessGrantID creates a new AccessGrantID
+func NewAccessGrantID() AccessGrantID {
+	return AccessGrantID(uuid.New().String())
+}
+
+// AuditEntryID represents an audit log entry identifier
+type AuditEntryID string
+
+// String returns the string representation
+func (id AuditEntryID) String() string {
+	return string(id)
+}
+
+// NewAuditEntryID creates a new time-ordered AuditEntryID
+func NewAuditEntryID() AuditEntryID {
+	return AuditEntryID(uuid.Must(uuid.NewV7()).String())
+}
+
+// StakeholderUpdateID represents a stakeholder update identifier
+type StakeholderUpdateID string
+
+// String returns the string representation
+func (id StakeholderUpdateID) String() string {
+	return string(id)
+}
+
+// NewStakeholderUpdateID creates a new time-ordered StakeholderUpdateID
+func NewStakeholderUpdateID() StakeholderUpdateID {
+	return StakeholderUpdateID(uuid.Must(uuid.NewV7()).String())
+}
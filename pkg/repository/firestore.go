@@ -2,11 +2,14 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"time"
 
 	"cloud.google.com/go/firestore"
+	"cloud.google.com/go/firestore/apiv1/firestorepb"
+	"github.com/google/uuid"
 	"github.com/m-mizutani/ctxlog"
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
@@ -19,14 +22,22 @@ import (
 
 const (
 	// Collection names - ALL MUST BE snake_case
-	messagesCollection         = "messages"
-	usersCollection            = "users"
-	sessionsCollection         = "sessions"
-	incidentsCollection        = "incidents"
-	incidentRequestsCollection = "incident_requests"
-	countersCollection         = "counters"
-	tasksCollection            = "tasks"
-	statusHistoriesCollection  = "status_histories"
+	messagesCollection           = "messages"
+	usersCollection              = "users"
+	sessionsCollection           = "sessions"
+	incidentsCollection          = "incidents"
+	incidentRequestsCollection   = "incident_requests"
+	countersCollection           = "counters"
+	tasksCollection              = "tasks"
+	statusHistoriesCollection    = "status_histories"
+	processedEventsCollection    = "processed_events"
+	jobsCollection               = "jobs"
+	apiTokensCollection          = "api_tokens"
+	auditLogsCollection          = "audit_logs"
+	remindersCollection          = "reminders"
+	stakeholderUpdatesCollection = "stakeholder_updates"
+	transcriptsCollection        = "transcripts"
+	transcriptMessagesCollection = "messages"
 
 	// Document IDs
 	incidentCounterDocID = "incident"
@@ -163,6 +174,33 @@ func (f *Firestore) ListMessages(ctx context.Context, channelID types.ChannelID,
 	return messages, nil
 }
 
+// ListMessagesSince lists the messages of all channels posted since the given time
+func (f *Firestore) ListMessagesSince(ctx context.Context, since time.Time) ([]*model.Message, error) {
+	iter := f.client.Collection(messagesCollection).
+		Where("Timestamp", ">=", since).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var messages []*model.Message
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate messages")
+		}
+
+		var message model.Message
+		if err := doc.DataTo(&message); err != nil {
+			return nil, goerr.Wrap(err, "failed to decode message")
+		}
+		messages = append(messages, &message)
+	}
+
+	return messages, nil
+}
+
 // SaveUser saves a user to Firestore
 func (f *Firestore) SaveUser(ctx context.Context, user *model.User) error {
 	if user == nil {
@@ -252,6 +290,32 @@ func (f *Firestore) GetSession(ctx context.Context, id types.SessionID) (*model.
 	return &session, nil
 }
 
+// TouchSession updates the activity fields of an existing session in Firestore.
+// Update fails if the document does not exist, so deleted sessions stay deleted.
+func (f *Firestore) TouchSession(ctx context.Context, session *model.Session) error {
+	if session == nil {
+		return goerr.New("session is nil")
+	}
+	if session.ID == "" {
+		return goerr.New("session ID is empty")
+	}
+
+	_, err := f.client.Collection(sessionsCollection).Doc(session.ID.String()).Update(ctx, []firestore.Update{
+		{Path: "LastSeenAt", Value: session.LastSeenAt},
+		{Path: "IPAddress", Value: session.IPAddress},
+		{Path: "UserAgent", Value: session.UserAgent},
+		{Path: "ExpiresAt", Value: session.ExpiresAt},
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return goerr.Wrap(model.ErrSessionNotFound, "failed to touch session", goerr.V("sessionID", session.ID))
+		}
+		return goerr.Wrap(err, "failed to touch session in firestore", goerr.V("sessionID", session.ID))
+	}
+
+	return nil
+}
+
 // DeleteSession deletes a session from Firestore
 func (f *Firestore) DeleteSession(ctx context.Context, id types.SessionID) error {
 	if id == "" {
@@ -277,6 +341,67 @@ func (f *Firestore) DeleteSession(ctx context.Context, id types.SessionID) error
 	return nil
 }
 
+// ListSessionsByUser lists all sessions of a user, most recently seen first
+func (f *Firestore) ListSessionsByUser(ctx context.Context, userID types.UserID) ([]*model.Session, error) {
+	if userID == "" {
+		return nil, goerr.New("user ID is empty")
+	}
+
+	iter := f.client.Collection(sessionsCollection).
+		Where("UserID", "==", userID.String()).
+		Documents(ctx)
+	defer iter.Stop()
+
+	sessions := make([]*model.Session, 0)
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate sessions", goerr.V("userID", userID))
+		}
+
+		var session model.Session
+		if err := doc.DataTo(&session); err != nil {
+			return nil, goerr.Wrap(err, "failed to decode session")
+		}
+		sessions = append(sessions, &session)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt)
+	})
+
+	return sessions, nil
+}
+
+// DeleteExpiredSessions deletes sessions that expired before now and returns how many were deleted
+func (f *Firestore) DeleteExpiredSessions(ctx context.Context, now time.Time) (int, error) {
+	iter := f.client.Collection(sessionsCollection).
+		Where("ExpiresAt", "<", now).
+		Documents(ctx)
+	defer iter.Stop()
+
+	deleted := 0
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return deleted, goerr.Wrap(err, "failed to iterate expired sessions")
+		}
+
+		if _, err := doc.Ref.Delete(ctx); err != nil {
+			return deleted, goerr.Wrap(err, "failed to delete expired session", goerr.V("sessionID", doc.Ref.ID))
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
+
 // PutIncident saves an incident to Firestore
 func (f *Firestore) PutIncident(ctx context.Context, incident *model.Incident) error {
 	if incident == nil {
@@ -286,9 +411,13 @@ func (f *Firestore) PutIncident(ctx context.Context, incident *model.Incident) e
 		return goerr.New("incident ID must be positive")
 	}
 
+	// Stamp a copy so that the caller's incident is left as it is
+	doc := *incident
+	doc.UpdatedAt = time.Now()
+
 	// Convert ID to string for document ID
 	docID := incident.ID.String()
-	_, err := f.client.Collection(incidentsCollection).Doc(docID).Set(ctx, incident)
+	_, err := f.client.Collection(incidentsCollection).Doc(docID).Set(ctx, &doc)
 	if err != nil {
 		return goerr.Wrap(err, "failed to save incident to firestore")
 	}
@@ -320,6 +449,40 @@ func (f *Firestore) GetIncident(ctx context.Context, id types.IncidentID) (*mode
 	return &incident, nil
 }
 
+// GetIncidents gets the incidents with ids from Firestore in one batch
+func (f *Firestore) GetIncidents(ctx context.Context, ids []types.IncidentID) ([]*model.Incident, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	refs := make([]*firestore.DocumentRef, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, goerr.New("incident ID must be positive", goerr.V("incidentID", id))
+		}
+		refs = append(refs, f.client.Collection(incidentsCollection).Doc(id.String()))
+	}
+
+	docs, err := f.client.GetAll(ctx, refs)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get incidents from firestore")
+	}
+
+	incidents := make([]*model.Incident, 0, len(docs))
+	for _, doc := range docs {
+		if !doc.Exists() {
+			continue
+		}
+		var incident model.Incident
+		if err := doc.DataTo(&incident); err != nil {
+			return nil, goerr.Wrap(err, "failed to decode incident", goerr.V("docID", doc.Ref.ID))
+		}
+		incidents = append(incidents, &incident)
+	}
+
+	return incidents, nil
+}
+
 // GetIncidentByChannelID gets an incident by channel ID from Firestore
 func (f *Firestore) GetIncidentByChannelID(ctx context.Context, channelID types.ChannelID) (*model.Incident, error) {
 	if channelID == "" {
@@ -401,8 +564,37 @@ func (f *Firestore) ListIncidentsSince(ctx context.Context, since time.Time) ([]
 	return incidents, nil
 }
 
-// ListIncidentsPaginated retrieves incidents from Firestore with pagination
-func (f *Firestore) ListIncidentsPaginated(ctx context.Context, opts types.PaginationOptions) ([]*model.Incident, *types.PaginationResult, error) {
+// ListIncidentsUpdatedSince retrieves incidents written since the specified time from Firestore
+func (f *Firestore) ListIncidentsUpdatedSince(ctx context.Context, since time.Time) ([]*model.Incident, error) {
+	iter := f.client.Collection(incidentsCollection).
+		Where("UpdatedAt", ">=", since).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var incidents []*model.Incident
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate incidents")
+		}
+
+		var incident model.Incident
+		if err := doc.DataTo(&incident); err != nil {
+			return nil, goerr.Wrap(err, "failed to unmarshal incident")
+		}
+		incidents = append(incidents, &incident)
+	}
+
+	return incidents, nil
+}
+
+// ListIncidentsPaginated retrieves incidents matching filter from Firestore with pagination.
+// Filters that Firestore can evaluate are applied in the query (see firestore.indexes.json);
+// the rest, such as text, are applied while reading.
+func (f *Firestore) ListIncidentsPaginated(ctx context.Context, filter model.IncidentFilter, opts types.PaginationOptions) ([]*model.Incident, *types.PaginationResult, error) {
 	// Default limit if not specified
 	limit := opts.Limit
 	if limit <= 0 {
@@ -412,19 +604,40 @@ func (f *Firestore) ListIncidentsPaginated(ctx context.Context, opts types.Pagin
 		limit = 100 // Cap at 100 to prevent excessive data fetching
 	}
 
-	// Start with base query ordered by ID descending (newest first)
-	query := f.client.Collection(incidentsCollection).OrderBy("ID", firestore.Desc)
-
-	// Apply cursor if provided
+	var cursor *model.Incident
 	if opts.After != nil {
-		// Start after the provided cursor
-		query = query.Where("ID", "<", int(*opts.After))
+		var err error
+		cursor, err = f.GetIncident(ctx, *opts.After)
+		if err != nil {
+			if !errors.Is(err, model.ErrIncidentNotFound) {
+				return nil, nil, goerr.Wrap(err, "failed to get cursor incident", goerr.V("cursor", *opts.After))
+			}
+			// The cursor incident is gone; its ID still orders it for the default sort
+			cursor = &model.Incident{ID: *opts.After}
+		}
+	}
+
+	query, complete := incidentFilterQuery(f.client.Collection(incidentsCollection).Query, filter, opts.Sort)
+	if !complete {
+		return listIncidentsScanning(ctx, query, filter, opts, cursor, limit)
 	}
 
-	// Fetch limit+1 to determine if there's a next page
-	query = query.Limit(limit + 1)
+	// The whole filter is evaluated by Firestore, so the page is read with one more
+	// incident to tell whether there is a next one and the total is counted separately
+	totalCount, err := countQuery(ctx, query)
+	if err != nil {
+		return nil, nil, goerr.Wrap(err, "failed to count incidents")
+	}
 
-	iter := query.Documents(ctx)
+	page := query
+	if cursor != nil {
+		if opts.Sort.Field == types.IncidentSortTitle {
+			page = page.StartAfter(cursor.Title, int(cursor.ID))
+		} else {
+			page = page.StartAfter(int(cursor.ID))
+		}
+	}
+	iter := page.Limit(limit + 1).Documents(ctx)
 	defer iter.Stop()
 
 	var incidents []*model.Incident
@@ -441,32 +654,58 @@ func (f *Firestore) ListIncidentsPaginated(ctx context.Context, opts types.Pagin
 		if err := doc.DataTo(&incident); err != nil {
 			return nil, nil, goerr.Wrap(err, "failed to unmarshal incident")
 		}
-
 		incidents = append(incidents, &incident)
 	}
 
-	// Determine pagination info
-	hasNextPage := false
-	if len(incidents) > limit {
-		hasNextPage = true
-		incidents = incidents[:limit] // Remove the extra item
+	hasNextPage := len(incidents) > limit
+	if hasNextPage {
+		incidents = incidents[:limit]
+	}
+
+	result := &types.PaginationResult{
+		HasNextPage:     hasNextPage,
+		HasPreviousPage: opts.After != nil, // If we have a cursor, there are previous items
+		TotalCount:      totalCount,
 	}
 
-	// For total count, we need a separate query (expensive operation)
-	// Consider caching this or making it optional
+	return incidents, result, nil
+}
+
+// listIncidentsScanning pages through query when part of filter can only be matched
+// while reading. All matching incidents are read to count them.
+func listIncidentsScanning(ctx context.Context, query firestore.Query, filter model.IncidentFilter, opts types.PaginationOptions, cursor *model.Incident, limit int) ([]*model.Incident, *types.PaginationResult, error) {
+	iter := query.Documents(ctx)
+	defer iter.Stop()
+
+	var incidents []*model.Incident
 	totalCount := 0
-	countQuery := f.client.Collection(incidentsCollection).Documents(ctx)
-	defer countQuery.Stop()
+	hasNextPage := false
 	for {
-		_, err := countQuery.Next()
+		doc, err := iter.Next()
 		if err == iterator.Done {
 			break
 		}
 		if err != nil {
-			// Log but don't fail - total count is optional
-			break
+			return nil, nil, goerr.Wrap(err, "failed to iterate incidents")
+		}
+
+		var incident model.Incident
+		if err := doc.DataTo(&incident); err != nil {
+			return nil, nil, goerr.Wrap(err, "failed to unmarshal incident")
+		}
+		if !filter.Match(&incident) {
+			continue
 		}
 		totalCount++
+
+		if cursor != nil && model.CompareIncidents(&incident, cursor, opts.Sort) <= 0 {
+			continue
+		}
+		if len(incidents) == limit {
+			hasNextPage = true
+			continue
+		}
+		incidents = append(incidents, &incident)
 	}
 
 	result := &types.PaginationResult{
@@ -478,6 +717,98 @@ func (f *Firestore) ListIncidentsPaginated(ctx context.Context, opts types.Pagin
 	return incidents, result, nil
 }
 
+// countQuery counts the documents matching query without reading them
+func countQuery(ctx context.Context, query firestore.Query) (int, error) {
+	result, err := query.NewAggregationQuery().WithCount("count").Get(ctx)
+	if err != nil {
+		return 0, goerr.Wrap(err, "failed to run count query")
+	}
+	value, ok := result["count"].(*firestorepb.Value)
+	if !ok {
+		return 0, goerr.New("count query returned no count")
+	}
+	return int(value.GetIntegerValue()), nil
+}
+
+// incidentFilterQuery narrows query down by the filters Firestore can evaluate and
+// orders it. Each equality field has composite indexes with the sort fields and the
+// creation time, so that Firestore can merge them for any combination of filters.
+// complete reports whether the query evaluates the whole filter, so that no incident
+// needs to be matched while reading.
+func incidentFilterQuery(query firestore.Query, filter model.IncidentFilter, order types.IncidentSort) (_ firestore.Query, complete bool) {
+	complete = filter.Text == "" && filter.Include == nil
+
+	// Firestore allows a single "in" filter per query; further ones are matched while reading
+	inUsed := false
+	switch len(filter.Statuses) {
+	case 0:
+	case 1:
+		query = query.Where("Status", "==", filter.Statuses[0])
+	default:
+		query = query.Where("Status", "in", filter.Statuses)
+		inUsed = true
+	}
+	switch {
+	case len(filter.SeverityIDs) == 1:
+		query = query.Where("SeverityID", "==", filter.SeverityIDs[0])
+	case len(filter.SeverityIDs) > 1 && !inUsed:
+		query = query.Where("SeverityID", "in", filter.SeverityIDs)
+	case len(filter.SeverityIDs) > 1:
+		complete = false
+	}
+
+	if filter.CategoryID != "" {
+		query = query.Where("CategoryID", "==", filter.CategoryID)
+	}
+	if filter.AssetID != "" {
+		query = query.Where("AssetIDs", "array-contains", filter.AssetID)
+	}
+	if filter.Lead != "" {
+		query = query.Where("Lead", "==", filter.Lead)
+	}
+	if filter.CreatedBy != "" {
+		query = query.Where("CreatedBy", "==", filter.CreatedBy)
+	}
+	// Incidents stored before a flag existed lack the field, so only true is queried
+	if filter.IsTest != nil {
+		if *filter.IsTest {
+			query = query.Where("IsTest", "==", true)
+		} else {
+			complete = false
+		}
+	}
+	if filter.Private != nil {
+		if *filter.Private {
+			query = query.Where("Private", "==", true)
+		} else {
+			complete = false
+		}
+	}
+	if filter.ParentID != 0 {
+		query = query.Where("ParentID", "==", int(filter.ParentID))
+	}
+	if filter.DuplicateOf != 0 {
+		query = query.Where("DuplicateOf", "==", int(filter.DuplicateOf))
+	}
+	// Firestore orders by the range field after the sort fields, in the direction of the
+	// last one, so the indexes for date ranges end with CreatedAt
+	if !filter.CreatedAfter.IsZero() {
+		query = query.Where("CreatedAt", ">=", filter.CreatedAfter)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		query = query.Where("CreatedAt", "<", filter.CreatedBefore)
+	}
+
+	direction := firestore.Desc
+	if order.Ascending {
+		direction = firestore.Asc
+	}
+	if order.Field == types.IncidentSortTitle {
+		query = query.OrderBy("Title", direction)
+	}
+	return query.OrderBy("ID", direction), complete
+}
+
 // GetNextIncidentNumber returns the next available incident number using atomic increment
 func (f *Firestore) GetNextIncidentNumber(ctx context.Context) (types.IncidentID, error) {
 	counterDoc := f.client.Collection(countersCollection).Doc(incidentCounterDocID)
@@ -581,78 +912,385 @@ func (f *Firestore) DeleteIncidentRequest(ctx context.Context, id types.Incident
 	return nil
 }
 
-// Close closes the Firestore client
-// CreateTask creates a new task in Firestore
-func (f *Firestore) CreateTask(ctx context.Context, task *model.Task) error {
-	if task == nil {
-		return goerr.New("task is nil")
+// MarkEventProcessed records a processed event key if it has not been recorded yet.
+// The check and write run in a transaction so that concurrent replicas agree on a single winner.
+// ExpiresAt can be configured as a Firestore TTL field to purge old records.
+func (f *Firestore) MarkEventProcessed(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	if key == "" {
+		return false, goerr.New("event key is empty")
 	}
-	if task.ID == "" {
-		return goerr.New("task ID is empty")
+
+	docRef := f.client.Collection(processedEventsCollection).Doc(key)
+
+	var marked bool
+	err := f.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		marked = false
+		now := time.Now()
+
+		doc, err := tx.Get(docRef)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return goerr.Wrap(err, "failed to get processed event")
+		}
+
+		if err == nil {
+			var existing model.ProcessedEvent
+			if err := doc.DataTo(&existing); err != nil {
+				return goerr.Wrap(err, "failed to decode processed event")
+			}
+			if !existing.IsExpired(now) {
+				return nil
+			}
+		}
+
+		marked = true
+		return tx.Set(docRef, &model.ProcessedEvent{
+			Key:       key,
+			CreatedAt: now,
+			ExpiresAt: now.Add(ttl),
+		})
+	})
+	if err != nil {
+		return false, goerr.Wrap(err, "failed to mark event processed", goerr.V("key", key))
 	}
-	if task.IncidentID <= 0 {
-		return goerr.New("incident ID must be positive", goerr.V("incidentID", task.IncidentID))
+
+	return marked, nil
+}
+
+// UnmarkEventProcessed deletes a processed event key
+func (f *Firestore) UnmarkEventProcessed(ctx context.Context, key string) error {
+	if key == "" {
+		return goerr.New("event key is empty")
 	}
 
-	// Store task as a subcollection under the incident
-	incidentDoc := f.client.Collection(incidentsCollection).Doc(task.IncidentID.String())
-	_, err := incidentDoc.Collection(tasksCollection).Doc(task.ID.String()).Set(ctx, task)
-	if err != nil {
-		return goerr.Wrap(err, "failed to create task in firestore",
-			goerr.V("taskID", task.ID),
-			goerr.V("incidentID", task.IncidentID))
+	if _, err := f.client.Collection(processedEventsCollection).Doc(key).Delete(ctx); err != nil {
+		return goerr.Wrap(err, "failed to unmark event processed", goerr.V("key", key))
 	}
 
 	return nil
 }
 
-// GetTask retrieves a task by ID
-func (f *Firestore) GetTask(ctx context.Context, taskID types.TaskID) (*model.Task, error) {
-	if taskID == "" {
-		return nil, goerr.New("task ID is empty")
+// PutJob saves a job to Firestore
+func (f *Firestore) PutJob(ctx context.Context, job *model.Job) error {
+	if job == nil {
+		return goerr.New("job is nil")
+	}
+	if job.ID == "" {
+		return goerr.New("job ID is empty")
 	}
 
-	// Search through all incidents for the task
-	// This is inefficient but necessary without knowing the incident ID
-	incidentsIter := f.client.Collection(incidentsCollection).Documents(ctx)
-	defer incidentsIter.Stop()
+	_, err := f.client.Collection(jobsCollection).Doc(job.ID.String()).Set(ctx, job)
+	if err != nil {
+		return goerr.Wrap(err, "failed to save job", goerr.V("jobID", job.ID))
+	}
 
-	for {
-		incidentDoc, err := incidentsIter.Next()
-		if err == iterator.Done {
-			break
-		}
-		if err != nil {
-			return nil, goerr.Wrap(err, "failed to iterate incidents")
-		}
+	return nil
+}
 
-		// Check tasks subcollection for this incident
-		taskDoc, err := incidentDoc.Ref.Collection(tasksCollection).Doc(taskID.String()).Get(ctx)
-		if err != nil {
-			if status.Code(err) == codes.NotFound {
-				continue // Task not found in this incident, try next
-			}
-			return nil, goerr.Wrap(err, "failed to get task from firestore", goerr.V("taskID", taskID))
-		}
+// GetJob retrieves a job from Firestore
+func (f *Firestore) GetJob(ctx context.Context, id types.JobID) (*model.Job, error) {
+	if id == "" {
+		return nil, goerr.New("job ID is empty")
+	}
 
-		var task model.Task
-		if err := taskDoc.DataTo(&task); err != nil {
-			return nil, goerr.Wrap(err, "failed to unmarshal task", goerr.V("taskID", taskID))
+	doc, err := f.client.Collection(jobsCollection).Doc(id.String()).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, goerr.Wrap(model.ErrJobNotFound, "failed to get job", goerr.V("jobID", id))
 		}
+		return nil, goerr.Wrap(err, "failed to get job", goerr.V("jobID", id))
+	}
 
-		return &task, nil
+	var job model.Job
+	if err := doc.DataTo(&job); err != nil {
+		return nil, goerr.Wrap(err, "failed to decode job")
 	}
 
-	return nil, goerr.Wrap(model.ErrTaskNotFound, "failed to get task", goerr.V("taskID", taskID))
+	return &job, nil
 }
 
-// GetTaskByIncident retrieves a task by incident ID and task ID efficiently
-func (f *Firestore) GetTaskByIncident(ctx context.Context, incidentID types.IncidentID, taskID types.TaskID) (*model.Task, error) {
-	if incidentID <= 0 {
-		return nil, goerr.New("incident ID is invalid", goerr.V("incidentID", incidentID))
+// DeleteJob deletes a job from Firestore
+func (f *Firestore) DeleteJob(ctx context.Context, id types.JobID) error {
+	if id == "" {
+		return goerr.New("job ID is empty")
 	}
-	if taskID == "" {
-		return nil, goerr.New("task ID is empty")
+
+	_, err := f.client.Collection(jobsCollection).Doc(id.String()).Delete(ctx, firestore.Exists)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return goerr.Wrap(model.ErrJobNotFound, "failed to delete job", goerr.V("jobID", id))
+		}
+		return goerr.Wrap(err, "failed to delete job", goerr.V("jobID", id))
+	}
+
+	return nil
+}
+
+// ClaimJobs leases claimable jobs to the given owner.
+// Candidates are due pending jobs and running jobs whose lease expired, each selected with
+// a limited query backed by a composite index. Every candidate is then claimed in its own
+// transaction so that concurrent replicas never run the same job twice.
+func (f *Firestore) ClaimJobs(ctx context.Context, owner string, limit int, lease time.Duration) ([]*model.Job, error) {
+	if owner == "" {
+		return nil, goerr.New("owner is empty")
+	}
+	if limit <= 0 {
+		return []*model.Job{}, nil
+	}
+
+	now := time.Now()
+	jobs := f.client.Collection(jobsCollection)
+	queries := []firestore.Query{
+		jobs.Where("Status", "==", types.JobStatusPending.String()).
+			Where("RunAt", "<=", now).
+			OrderBy("RunAt", firestore.Asc).
+			Limit(limit),
+		jobs.Where("Status", "==", types.JobStatusRunning.String()).
+			Where("LeaseUntil", "<", now).
+			OrderBy("LeaseUntil", firestore.Asc).
+			Limit(limit),
+	}
+
+	var candidates []*model.Job
+	for _, query := range queries {
+		iter := query.Documents(ctx)
+		for {
+			doc, err := iter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				iter.Stop()
+				return nil, goerr.Wrap(err, "failed to iterate jobs")
+			}
+
+			var job model.Job
+			if err := doc.DataTo(&job); err != nil {
+				iter.Stop()
+				return nil, goerr.Wrap(err, "failed to decode job")
+			}
+			candidates = append(candidates, &job)
+		}
+		iter.Stop()
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].RunAt.Before(candidates[j].RunAt)
+	})
+
+	claimed := make([]*model.Job, 0, limit)
+	for _, candidate := range candidates {
+		if len(claimed) >= limit {
+			break
+		}
+
+		docRef := jobs.Doc(candidate.ID.String())
+		var job model.Job
+		var ok bool
+		err := f.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+			ok = false
+			doc, err := tx.Get(docRef)
+			if err != nil {
+				if status.Code(err) == codes.NotFound {
+					return nil // Deleted by another worker
+				}
+				return goerr.Wrap(err, "failed to get job")
+			}
+			if err := doc.DataTo(&job); err != nil {
+				return goerr.Wrap(err, "failed to decode job")
+			}
+
+			txNow := time.Now()
+			if !job.IsClaimable(txNow) {
+				return nil // Claimed by another worker
+			}
+
+			job.Status = types.JobStatusRunning
+			job.Attempts++
+			job.LeaseOwner = owner
+			job.LeaseUntil = txNow.Add(lease)
+			job.LeaseToken = uuid.NewString()
+			job.UpdatedAt = txNow
+			ok = true
+			return tx.Set(docRef, &job)
+		})
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to claim job", goerr.V("jobID", candidate.ID))
+		}
+		if ok {
+			jobCopy := job
+			claimed = append(claimed, &jobCopy)
+		}
+	}
+
+	return claimed, nil
+}
+
+// updateLeasedJob runs update in a transaction on a job still leased to owner with leaseToken
+func (f *Firestore) updateLeasedJob(ctx context.Context, id types.JobID, owner, leaseToken string, update func(tx *firestore.Transaction, docRef *firestore.DocumentRef) error) error {
+	if id == "" {
+		return goerr.New("job ID is empty")
+	}
+
+	docRef := f.client.Collection(jobsCollection).Doc(id.String())
+	return f.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(docRef)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return goerr.Wrap(model.ErrJobLeaseLost, "job no longer exists", goerr.V("jobID", id))
+			}
+			return goerr.Wrap(err, "failed to get job", goerr.V("jobID", id))
+		}
+
+		var job model.Job
+		if err := doc.DataTo(&job); err != nil {
+			return goerr.Wrap(err, "failed to decode job")
+		}
+		if !job.IsLeasedBy(owner, leaseToken) {
+			return goerr.Wrap(model.ErrJobLeaseLost, "job is not leased to owner",
+				goerr.V("jobID", id), goerr.V("owner", owner))
+		}
+
+		return update(tx, docRef)
+	})
+}
+
+// RenewJobLease extends the lease of a job held by owner
+func (f *Firestore) RenewJobLease(ctx context.Context, id types.JobID, owner, leaseToken string, until time.Time) error {
+	return f.updateLeasedJob(ctx, id, owner, leaseToken, func(tx *firestore.Transaction, docRef *firestore.DocumentRef) error {
+		return tx.Update(docRef, []firestore.Update{
+			{Path: "LeaseUntil", Value: until},
+			{Path: "UpdatedAt", Value: time.Now()},
+		})
+	})
+}
+
+// CompleteJob deletes a job held by owner
+func (f *Firestore) CompleteJob(ctx context.Context, id types.JobID, owner, leaseToken string) error {
+	return f.updateLeasedJob(ctx, id, owner, leaseToken, func(tx *firestore.Transaction, docRef *firestore.DocumentRef) error {
+		return tx.Delete(docRef)
+	})
+}
+
+// FailJob saves the outcome of a failed attempt of a job held by owner
+func (f *Firestore) FailJob(ctx context.Context, job *model.Job, owner, leaseToken string) error {
+	if job == nil {
+		return goerr.New("job is nil")
+	}
+	return f.updateLeasedJob(ctx, job.ID, owner, leaseToken, func(tx *firestore.Transaction, docRef *firestore.DocumentRef) error {
+		return tx.Set(docRef, job)
+	})
+}
+
+// ListJobsByStatus lists jobs in the given status ordered by update time (newest first)
+func (f *Firestore) ListJobsByStatus(ctx context.Context, jobStatus types.JobStatus, limit int) ([]*model.Job, error) {
+	if !jobStatus.IsValid() {
+		return nil, goerr.New("invalid job status", goerr.V("status", jobStatus))
+	}
+
+	iter := f.client.Collection(jobsCollection).
+		Where("Status", "==", jobStatus.String()).
+		Documents(ctx)
+	defer iter.Stop()
+
+	jobs := make([]*model.Job, 0)
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate jobs")
+		}
+
+		var job model.Job
+		if err := doc.DataTo(&job); err != nil {
+			return nil, goerr.Wrap(err, "failed to decode job")
+		}
+		jobs = append(jobs, &job)
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].UpdatedAt.After(jobs[j].UpdatedAt)
+	})
+	if limit > 0 && len(jobs) > limit {
+		jobs = jobs[:limit]
+	}
+
+	return jobs, nil
+}
+
+// Close closes the Firestore client
+// CreateTask creates a new task in Firestore
+func (f *Firestore) CreateTask(ctx context.Context, task *model.Task) error {
+	if task == nil {
+		return goerr.New("task is nil")
+	}
+	if task.ID == "" {
+		return goerr.New("task ID is empty")
+	}
+	if task.IncidentID <= 0 {
+		return goerr.New("incident ID must be positive", goerr.V("incidentID", task.IncidentID))
+	}
+
+	// Store task as a subcollection under the incident
+	incidentDoc := f.client.Collection(incidentsCollection).Doc(task.IncidentID.String())
+	_, err := incidentDoc.Collection(tasksCollection).Doc(task.ID.String()).Set(ctx, task)
+	if err != nil {
+		return goerr.Wrap(err, "failed to create task in firestore",
+			goerr.V("taskID", task.ID),
+			goerr.V("incidentID", task.IncidentID))
+	}
+
+	return nil
+}
+
+// GetTask retrieves a task by ID
+func (f *Firestore) GetTask(ctx context.Context, taskID types.TaskID) (*model.Task, error) {
+	if taskID == "" {
+		return nil, goerr.New("task ID is empty")
+	}
+
+	// Search through all incidents for the task
+	// This is inefficient but necessary without knowing the incident ID
+	incidentsIter := f.client.Collection(incidentsCollection).Documents(ctx)
+	defer incidentsIter.Stop()
+
+	for {
+		incidentDoc, err := incidentsIter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate incidents")
+		}
+
+		// Check tasks subcollection for this incident
+		taskDoc, err := incidentDoc.Ref.Collection(tasksCollection).Doc(taskID.String()).Get(ctx)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				continue // Task not found in this incident, try next
+			}
+			return nil, goerr.Wrap(err, "failed to get task from firestore", goerr.V("taskID", taskID))
+		}
+
+		var task model.Task
+		if err := taskDoc.DataTo(&task); err != nil {
+			return nil, goerr.Wrap(err, "failed to unmarshal task", goerr.V("taskID", taskID))
+		}
+
+		return &task, nil
+	}
+
+	return nil, goerr.Wrap(model.ErrTaskNotFound, "failed to get task", goerr.V("taskID", taskID))
+}
+
+// GetTaskByIncident retrieves a task by incident ID and task ID efficiently
+func (f *Firestore) GetTaskByIncident(ctx context.Context, incidentID types.IncidentID, taskID types.TaskID) (*model.Task, error) {
+	if incidentID <= 0 {
+		return nil, goerr.New("incident ID is invalid", goerr.V("incidentID", incidentID))
+	}
+	if taskID == "" {
+		return nil, goerr.New("task ID is empty")
 	}
 
 	// Direct access to the specific task document
@@ -792,6 +1430,34 @@ func (f *Firestore) ListTasksByIncident(ctx context.Context, incidentID types.In
 	return tasks, nil
 }
 
+// ListTasksUpdatedSince retrieves the tasks of all incidents updated since the specified
+// time with a collection group query (see fieldOverrides in firestore.indexes.json)
+func (f *Firestore) ListTasksUpdatedSince(ctx context.Context, since time.Time) ([]*model.Task, error) {
+	iter := f.client.CollectionGroup(tasksCollection).
+		Where("UpdatedAt", ">=", since).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var tasks []*model.Task
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate tasks")
+		}
+
+		var task model.Task
+		if err := doc.DataTo(&task); err != nil {
+			return nil, goerr.Wrap(err, "failed to unmarshal task", goerr.V("taskID", doc.Ref.ID))
+		}
+		tasks = append(tasks, &task)
+	}
+
+	return tasks, nil
+}
+
 // UpdateIncidentAtomic updates an incident atomically using a transaction
 func (f *Firestore) UpdateIncidentAtomic(ctx context.Context, incidentID types.IncidentID, updateFn func(*model.Incident) error) error {
 	if incidentID <= 0 {
@@ -821,6 +1487,7 @@ func (f *Firestore) UpdateIncidentAtomic(ctx context.Context, incidentID types.I
 		}
 
 		// Write the updated incident back
+		incident.UpdatedAt = time.Now()
 		return tx.Set(docRef, &incident)
 	})
 }
@@ -925,6 +1592,34 @@ func (f *Firestore) GetStatusHistories(ctx context.Context, incidentID types.Inc
 	return histories, nil
 }
 
+// ListStatusHistoriesSince retrieves the status histories of all incidents changed since the
+// specified time with a collection group query (see fieldOverrides in firestore.indexes.json)
+func (f *Firestore) ListStatusHistoriesSince(ctx context.Context, since time.Time) ([]*model.StatusHistory, error) {
+	iter := f.client.CollectionGroup(statusHistoriesCollection).
+		Where("ChangedAt", ">=", since).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var histories []*model.StatusHistory
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate status histories")
+		}
+
+		var history model.StatusHistory
+		if err := doc.DataTo(&history); err != nil {
+			return nil, goerr.Wrap(err, "failed to decode status history")
+		}
+		histories = append(histories, &history)
+	}
+
+	return histories, nil
+}
+
 // UpdateIncidentStatus updates the current status of an incident
 func (f *Firestore) UpdateIncidentStatus(ctx context.Context, incidentID types.IncidentID, incidentStatus types.IncidentStatus) error {
 	if err := incidentID.Validate(); err != nil {
@@ -937,6 +1632,7 @@ func (f *Firestore) UpdateIncidentStatus(ctx context.Context, incidentID types.I
 	docRef := f.client.Collection(incidentsCollection).Doc(incidentID.String())
 	_, err := docRef.Update(ctx, []firestore.Update{
 		{Path: "Status", Value: incidentStatus},
+		{Path: "UpdatedAt", Value: time.Now()},
 	})
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
@@ -956,3 +1652,403 @@ func (f *Firestore) Close() error {
 }
 
 var _ interfaces.Repository = (*Firestore)(nil) // Compile-time interface check
+
+// PutAPIToken saves an API token to Firestore
+func (f *Firestore) PutAPIToken(ctx context.Context, token *model.APIToken) error {
+	if token == nil {
+		return goerr.New("API token is nil")
+	}
+	if token.ID == "" {
+		return goerr.New("API token ID is empty")
+	}
+
+	_, err := f.client.Collection(apiTokensCollection).Doc(token.ID.String()).Set(ctx, token)
+	if err != nil {
+		return goerr.Wrap(err, "failed to save API token", goerr.V("tokenID", token.ID))
+	}
+
+	return nil
+}
+
+// GetAPIToken retrieves an API token from Firestore
+func (f *Firestore) GetAPIToken(ctx context.Context, id types.APITokenID) (*model.APIToken, error) {
+	if id == "" {
+		return nil, goerr.New("API token ID is empty")
+	}
+
+	doc, err := f.client.Collection(apiTokensCollection).Doc(id.String()).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, goerr.Wrap(model.ErrAPITokenNotFound, "failed to get API token", goerr.V("tokenID", id))
+		}
+		return nil, goerr.Wrap(err, "failed to get API token", goerr.V("tokenID", id))
+	}
+
+	var token model.APIToken
+	if err := doc.DataTo(&token); err != nil {
+		return nil, goerr.Wrap(err, "failed to decode API token")
+	}
+
+	return &token, nil
+}
+
+// UpdateAPITokenLastUsed sets the last usage time of an existing API token in Firestore
+func (f *Firestore) UpdateAPITokenLastUsed(ctx context.Context, id types.APITokenID, lastUsedAt time.Time) error {
+	if id == "" {
+		return goerr.New("API token ID is empty")
+	}
+
+	_, err := f.client.Collection(apiTokensCollection).Doc(id.String()).Update(ctx, []firestore.Update{
+		{Path: "LastUsedAt", Value: lastUsedAt},
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return goerr.Wrap(model.ErrAPITokenNotFound, "failed to update API token usage", goerr.V("tokenID", id))
+		}
+		return goerr.Wrap(err, "failed to update API token usage", goerr.V("tokenID", id))
+	}
+
+	return nil
+}
+
+// ListAPITokens lists all API tokens ordered by creation time (newest first)
+func (f *Firestore) ListAPITokens(ctx context.Context) ([]*model.APIToken, error) {
+	iter := f.client.Collection(apiTokensCollection).Documents(ctx)
+	defer iter.Stop()
+
+	tokens := make([]*model.APIToken, 0)
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate API tokens")
+		}
+
+		var token model.APIToken
+		if err := doc.DataTo(&token); err != nil {
+			return nil, goerr.Wrap(err, "failed to decode API token")
+		}
+		tokens = append(tokens, &token)
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].CreatedAt.After(tokens[j].CreatedAt)
+	})
+
+	return tokens, nil
+}
+
+// PutAuditEntry appends an audit entry. Existing entries are never overwritten.
+func (f *Firestore) PutAuditEntry(ctx context.Context, entry *model.AuditEntry) error {
+	if entry == nil {
+		return goerr.New("audit entry is nil")
+	}
+	if entry.ID == "" {
+		return goerr.New("audit entry ID is empty")
+	}
+
+	_, err := f.client.Collection(auditLogsCollection).Doc(entry.ID.String()).Create(ctx, entry)
+	if err != nil {
+		return goerr.Wrap(err, "failed to save audit entry", goerr.V("entryID", entry.ID))
+	}
+
+	return nil
+}
+
+// ListAuditEntries lists audit entries matching the filter, newest first
+func (f *Firestore) ListAuditEntries(ctx context.Context, filter model.AuditFilter) ([]*model.AuditEntry, error) {
+	// One equality filter and the time range are applied in the query, ordered newest first,
+	// so that only one composite index per field is needed (see firestore.indexes.json).
+	// The rest is filtered while reading.
+	query := f.client.Collection(auditLogsCollection).Query
+	rest := filter
+	switch {
+	case filter.IncidentID != 0:
+		query = query.Where("IncidentID", "==", int(filter.IncidentID))
+		rest.IncidentID = 0
+	case filter.ActorID != "":
+		query = query.Where("ActorID", "==", filter.ActorID)
+		rest.ActorID = ""
+	case filter.Action != "":
+		query = query.Where("Action", "==", string(filter.Action))
+		rest.Action = ""
+	case filter.Source != "":
+		query = query.Where("Source", "==", string(filter.Source))
+		rest.Source = ""
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where("Timestamp", ">=", filter.Since)
+		rest.Since = time.Time{}
+	}
+	if !filter.Until.IsZero() {
+		query = query.Where("Timestamp", "<", filter.Until)
+		rest.Until = time.Time{}
+	}
+	query = query.OrderBy("Timestamp", firestore.Desc).OrderBy(firestore.DocumentID, firestore.Desc)
+
+	// Without filters left to apply here, the query returns exactly the entries needed
+	rest.Limit = 0
+	if filter.Limit > 0 && rest == (model.AuditFilter{}) {
+		query = query.Limit(filter.Limit)
+	}
+
+	iter := query.Documents(ctx)
+	defer iter.Stop()
+
+	entries := make([]*model.AuditEntry, 0)
+	for filter.Limit <= 0 || len(entries) < filter.Limit {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate audit entries")
+		}
+
+		var entry model.AuditEntry
+		if err := doc.DataTo(&entry); err != nil {
+			return nil, goerr.Wrap(err, "failed to decode audit entry")
+		}
+		if rest.Match(&entry) {
+			entries = append(entries, &entry)
+		}
+	}
+
+	return entries, nil
+}
+
+// PutReminder saves a reminder to Firestore
+func (f *Firestore) PutReminder(ctx context.Context, reminder *model.Reminder) error {
+	if reminder == nil {
+		return goerr.New("reminder is nil")
+	}
+	if reminder.ID == "" {
+		return goerr.New("reminder ID is empty")
+	}
+
+	_, err := f.client.Collection(remindersCollection).Doc(reminder.ID).Set(ctx, reminder)
+	if err != nil {
+		return goerr.Wrap(err, "failed to save reminder", goerr.V("reminderID", reminder.ID))
+	}
+
+	return nil
+}
+
+// GetReminder retrieves a reminder from Firestore
+func (f *Firestore) GetReminder(ctx context.Context, id string) (*model.Reminder, error) {
+	if id == "" {
+		return nil, goerr.New("reminder ID is empty")
+	}
+
+	doc, err := f.client.Collection(remindersCollection).Doc(id).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, goerr.Wrap(model.ErrReminderNotFound, "failed to get reminder", goerr.V("reminderID", id))
+		}
+		return nil, goerr.Wrap(err, "failed to get reminder", goerr.V("reminderID", id))
+	}
+
+	var reminder model.Reminder
+	if err := doc.DataTo(&reminder); err != nil {
+		return nil, goerr.Wrap(err, "failed to decode reminder")
+	}
+
+	return &reminder, nil
+}
+
+// AddStakeholderUpdate adds a stakeholder update to the subcollection of its incident
+func (f *Firestore) AddStakeholderUpdate(ctx context.Context, update *model.StakeholderUpdate) error {
+	if update == nil {
+		return goerr.New("stakeholder update is nil")
+	}
+	if err := update.IncidentID.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid incident ID")
+	}
+	if update.ID == "" {
+		return goerr.New("stakeholder update ID is empty")
+	}
+
+	incidentDocRef := f.client.Collection(incidentsCollection).Doc(update.IncidentID.String())
+	_, err := incidentDocRef.Collection(stakeholderUpdatesCollection).Doc(update.ID.String()).Set(ctx, update)
+	if err != nil {
+		return goerr.Wrap(err, "failed to add stakeholder update to firestore",
+			goerr.V("incidentID", update.IncidentID))
+	}
+
+	return nil
+}
+
+// ListStakeholderUpdates lists the stakeholder updates of an incident, oldest first
+func (f *Firestore) ListStakeholderUpdates(ctx context.Context, incidentID types.IncidentID) ([]*model.StakeholderUpdate, error) {
+	if err := incidentID.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "invalid incident ID")
+	}
+
+	incidentDocRef := f.client.Collection(incidentsCollection).Doc(incidentID.String())
+	iter := incidentDocRef.Collection(stakeholderUpdatesCollection).
+		OrderBy("PostedAt", firestore.Asc).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var updates []*model.StakeholderUpdate
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate stakeholder updates")
+		}
+
+		var update model.StakeholderUpdate
+		if err := doc.DataTo(&update); err != nil {
+			return nil, goerr.Wrap(err, "failed to decode stakeholder update")
+		}
+		updates = append(updates, &update)
+	}
+
+	return updates, nil
+}
+
+// ListStakeholderUpdatesSince lists the stakeholder updates of all incidents posted since the
+// given time with a collection group query (see fieldOverrides in firestore.indexes.json)
+func (f *Firestore) ListStakeholderUpdatesSince(ctx context.Context, since time.Time) ([]*model.StakeholderUpdate, error) {
+	iter := f.client.CollectionGroup(stakeholderUpdatesCollection).
+		Where("PostedAt", ">=", since).
+		Documents(ctx)
+	defer iter.Stop()
+
+	var updates []*model.StakeholderUpdate
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate stakeholder updates")
+		}
+
+		var update model.StakeholderUpdate
+		if err := doc.DataTo(&update); err != nil {
+			return nil, goerr.Wrap(err, "failed to decode stakeholder update")
+		}
+		updates = append(updates, &update)
+	}
+
+	return updates, nil
+}
+
+// firestoreTranscript is the transcript document. Messages are kept in a
+// subcollection as a long transcript exceeds the document size limit.
+type firestoreTranscript struct {
+	IncidentID   types.IncidentID
+	ChannelID    types.ChannelID
+	ExportedAt   time.Time
+	MessageCount int
+}
+
+// PutTranscript saves the transcript of an incident, replacing any previous one
+func (f *Firestore) PutTranscript(ctx context.Context, transcript *model.Transcript) error {
+	if transcript == nil {
+		return goerr.New("transcript is nil")
+	}
+	if err := transcript.IncidentID.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid incident ID")
+	}
+
+	// Messages are written first, so that the document never counts messages
+	// that are not stored yet. Messages beyond the count are left from longer
+	// transcripts and ignored.
+	docRef := f.client.Collection(transcriptsCollection).Doc(transcript.IncidentID.String())
+	writer := f.client.BulkWriter(ctx)
+	jobs := make([]*firestore.BulkWriterJob, 0, len(transcript.Messages))
+	for i, msg := range transcript.Messages {
+		job, err := writer.Set(docRef.Collection(transcriptMessagesCollection).Doc(transcriptMessageDocID(i)), msg)
+		if err != nil {
+			writer.End()
+			return goerr.Wrap(err, "failed to queue transcript message", goerr.V("incidentID", transcript.IncidentID))
+		}
+		jobs = append(jobs, job)
+	}
+	writer.End()
+	for _, job := range jobs {
+		if _, err := job.Results(); err != nil {
+			return goerr.Wrap(err, "failed to save transcript message", goerr.V("incidentID", transcript.IncidentID))
+		}
+	}
+
+	_, err := docRef.Set(ctx, firestoreTranscript{
+		IncidentID:   transcript.IncidentID,
+		ChannelID:    transcript.ChannelID,
+		ExportedAt:   transcript.ExportedAt,
+		MessageCount: len(transcript.Messages),
+	})
+	if err != nil {
+		return goerr.Wrap(err, "failed to save transcript", goerr.V("incidentID", transcript.IncidentID))
+	}
+
+	return nil
+}
+
+// GetTranscript retrieves the transcript of an incident
+func (f *Firestore) GetTranscript(ctx context.Context, incidentID types.IncidentID) (*model.Transcript, error) {
+	if err := incidentID.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "invalid incident ID")
+	}
+
+	docRef := f.client.Collection(transcriptsCollection).Doc(incidentID.String())
+	doc, err := docRef.Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, goerr.Wrap(model.ErrTranscriptNotFound, "failed to get transcript", goerr.V("incidentID", incidentID))
+		}
+		return nil, goerr.Wrap(err, "failed to get transcript", goerr.V("incidentID", incidentID))
+	}
+
+	var header firestoreTranscript
+	if err := doc.DataTo(&header); err != nil {
+		return nil, goerr.Wrap(err, "failed to decode transcript")
+	}
+
+	transcript := &model.Transcript{
+		IncidentID: header.IncidentID,
+		ChannelID:  header.ChannelID,
+		ExportedAt: header.ExportedAt,
+		Messages:   make([]model.TranscriptMessage, 0, header.MessageCount),
+	}
+	if header.MessageCount == 0 {
+		return transcript, nil
+	}
+
+	iter := docRef.Collection(transcriptMessagesCollection).
+		OrderBy(firestore.DocumentID, firestore.Asc).
+		Limit(header.MessageCount).
+		Documents(ctx)
+	defer iter.Stop()
+
+	for {
+		msgDoc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to iterate transcript messages")
+		}
+
+		var msg model.TranscriptMessage
+		if err := msgDoc.DataTo(&msg); err != nil {
+			return nil, goerr.Wrap(err, "failed to decode transcript message")
+		}
+		transcript.Messages = append(transcript.Messages, msg)
+	}
+
+	return transcript, nil
+}
+
+// transcriptMessageDocID returns the document ID of the i-th transcript message,
+// zero padded so that document IDs sort in message order
+func transcriptMessageDocID(i int) string {
+	return fmt.Sprintf("%08d", i)
+}
@@ -2,16 +2,22 @@ package repository
 
 import (
 	"context"
+	"slices"
 	"sort"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
 )
 
+// processedEventsSweepThreshold is the minimum number of processed event records
+// kept before expired ones are swept
+const processedEventsSweepThreshold = 1024
+
 // Memory implements Repository interface with in-memory storage
 type Memory struct {
 	mu               sync.RWMutex
@@ -22,7 +28,17 @@ type Memory struct {
 	incidentRequests map[types.IncidentRequestID]*model.IncidentRequest
 	tasks            map[types.IncidentID]map[types.TaskID]*model.Task
 	statusHistories  map[types.IncidentID][]*model.StatusHistory
+	processedEvents  map[string]*model.ProcessedEvent
+	jobs             map[types.JobID]*model.Job
+	apiTokens        map[types.APITokenID]*model.APIToken
+	auditEntries     map[types.AuditEntryID]*model.AuditEntry
+	reminders        map[string]*model.Reminder
+	updates          map[types.IncidentID][]*model.StakeholderUpdate
+	transcripts      map[types.IncidentID]*model.Transcript
 	incidentCounter  types.IncidentID
+	// processedSweepAt is the number of processed event records at which expired
+	// ones are swept next
+	processedSweepAt int
 }
 
 // NewMemory creates a new memory repository
@@ -35,6 +51,13 @@ func NewMemory() interfaces.Repository {
 		incidentRequests: make(map[types.IncidentRequestID]*model.IncidentRequest),
 		tasks:            make(map[types.IncidentID]map[types.TaskID]*model.Task),
 		statusHistories:  make(map[types.IncidentID][]*model.StatusHistory),
+		processedEvents:  make(map[string]*model.ProcessedEvent),
+		jobs:             make(map[types.JobID]*model.Job),
+		apiTokens:        make(map[types.APITokenID]*model.APIToken),
+		auditEntries:     make(map[types.AuditEntryID]*model.AuditEntry),
+		reminders:        make(map[string]*model.Reminder),
+		updates:          make(map[types.IncidentID][]*model.StakeholderUpdate),
+		transcripts:      make(map[types.IncidentID]*model.Transcript),
 		incidentCounter:  0,
 	}
 }
@@ -104,6 +127,21 @@ func (m *Memory) ListMessages(ctx context.Context, channelID types.ChannelID, li
 	return messages, nil
 }
 
+// ListMessagesSince lists the messages of all channels posted since the given time
+func (m *Memory) ListMessagesSince(ctx context.Context, since time.Time) ([]*model.Message, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	messages := make([]*model.Message, 0)
+	for _, msg := range m.messages {
+		if !msg.Timestamp.Before(since) {
+			msgCopy := *msg
+			messages = append(messages, &msgCopy)
+		}
+	}
+	return messages, nil
+}
+
 // SaveUser saves a user to memory
 func (m *Memory) SaveUser(ctx context.Context, user *model.User) error {
 	if user == nil {
@@ -191,6 +229,27 @@ func (m *Memory) GetSession(ctx context.Context, id types.SessionID) (*model.Ses
 	return &sessionCopy, nil
 }
 
+// TouchSession updates the activity fields of an existing session in memory
+func (m *Memory) TouchSession(ctx context.Context, session *model.Session) error {
+	if session == nil {
+		return goerr.New("session is nil")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, exists := m.sessions[session.ID]
+	if !exists {
+		return goerr.Wrap(model.ErrSessionNotFound, "failed to touch session", goerr.V("sessionID", session.ID))
+	}
+
+	stored.LastSeenAt = session.LastSeenAt
+	stored.IPAddress = session.IPAddress
+	stored.UserAgent = session.UserAgent
+	stored.ExpiresAt = session.ExpiresAt
+	return nil
+}
+
 // DeleteSession deletes a session from memory
 func (m *Memory) DeleteSession(ctx context.Context, id types.SessionID) error {
 	if id == "" {
@@ -208,6 +267,46 @@ func (m *Memory) DeleteSession(ctx context.Context, id types.SessionID) error {
 	return nil
 }
 
+// ListSessionsByUser lists all sessions of a user, most recently seen first
+func (m *Memory) ListSessionsByUser(ctx context.Context, userID types.UserID) ([]*model.Session, error) {
+	if userID == "" {
+		return nil, goerr.New("user ID is empty")
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	sessions := make([]*model.Session, 0)
+	for _, session := range m.sessions {
+		if session.UserID == userID {
+			sessionCopy := *session
+			sessions = append(sessions, &sessionCopy)
+		}
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt)
+	})
+
+	return sessions, nil
+}
+
+// DeleteExpiredSessions deletes sessions that expired before now and returns how many were deleted
+func (m *Memory) DeleteExpiredSessions(ctx context.Context, now time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	deleted := 0
+	for id, session := range m.sessions {
+		if session.ExpiresAt.Before(now) {
+			delete(m.sessions, id)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
 // Close does nothing for memory repository
 func (m *Memory) Close() error {
 	return nil
@@ -227,6 +326,7 @@ func (m *Memory) PutIncident(ctx context.Context, incident *model.Incident) erro
 
 	// Deep copy to prevent external modifications
 	incidentCopy := *incident
+	incidentCopy.UpdatedAt = time.Now()
 	m.incidents[incident.ID] = &incidentCopy
 
 	return nil
@@ -251,6 +351,50 @@ func (m *Memory) GetIncident(ctx context.Context, id types.IncidentID) (*model.I
 	return &incidentCopy, nil
 }
 
+// UpdateIncidentAtomic updates an incident while holding the lock
+func (m *Memory) UpdateIncidentAtomic(ctx context.Context, incidentID types.IncidentID, updateFn func(*model.Incident) error) error {
+	if incidentID <= 0 {
+		return goerr.New("incident ID must be positive", goerr.V("incidentID", incidentID))
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current, exists := m.incidents[incidentID]
+	if !exists {
+		return goerr.Wrap(model.ErrIncidentNotFound, "incident not found", goerr.V("incidentID", incidentID))
+	}
+
+	// Work on a copy so that a failed update leaves the stored incident untouched
+	incident := *current
+	if err := updateFn(&incident); err != nil {
+		return goerr.Wrap(err, "failed to update incident", goerr.V("incidentID", incidentID))
+	}
+	incident.UpdatedAt = time.Now()
+	m.incidents[incidentID] = &incident
+
+	return nil
+}
+
+// GetIncidents gets the incidents with ids from memory
+func (m *Memory) GetIncidents(ctx context.Context, ids []types.IncidentID) ([]*model.Incident, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	incidents := make([]*model.Incident, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, goerr.New("incident ID must be positive", goerr.V("incidentID", id))
+		}
+		if incident, exists := m.incidents[id]; exists {
+			incidentCopy := *incident
+			incidents = append(incidents, &incidentCopy)
+		}
+	}
+
+	return incidents, nil
+}
+
 // GetIncidentByChannelID gets an incident by channel ID from memory
 func (m *Memory) GetIncidentByChannelID(ctx context.Context, channelID types.ChannelID) (*model.Incident, error) {
 	if channelID == "" {
@@ -314,8 +458,23 @@ func (m *Memory) ListIncidentsSince(ctx context.Context, since time.Time) ([]*mo
 	return incidents, nil
 }
 
-// ListIncidentsPaginated retrieves incidents from memory with pagination
-func (m *Memory) ListIncidentsPaginated(ctx context.Context, opts types.PaginationOptions) ([]*model.Incident, *types.PaginationResult, error) {
+// ListIncidentsUpdatedSince retrieves incidents written since the specified time from memory
+func (m *Memory) ListIncidentsUpdatedSince(ctx context.Context, since time.Time) ([]*model.Incident, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	incidents := make([]*model.Incident, 0)
+	for _, incident := range m.incidents {
+		if !incident.UpdatedAt.Before(since) {
+			incidentCopy := *incident
+			incidents = append(incidents, &incidentCopy)
+		}
+	}
+	return incidents, nil
+}
+
+// ListIncidentsPaginated retrieves incidents matching filter from memory with pagination
+func (m *Memory) ListIncidentsPaginated(ctx context.Context, filter model.IncidentFilter, opts types.PaginationOptions) ([]*model.Incident, *types.PaginationResult, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
@@ -328,37 +487,35 @@ func (m *Memory) ListIncidentsPaginated(ctx context.Context, opts types.Paginati
 		limit = 100 // Cap at 100
 	}
 
-	// Convert map to slice and sort by ID descending (newest first)
+	// Collect matching incidents and sort them
 	allIncidents := make([]*model.Incident, 0, len(m.incidents))
 	for _, incident := range m.incidents {
+		if !filter.Match(incident) {
+			continue
+		}
 		// Create a copy to prevent external modifications
 		incidentCopy := *incident
 		allIncidents = append(allIncidents, &incidentCopy)
 	}
-
-	// Sort by ID descending (newest first)
-	sort.Slice(allIncidents, func(i, j int) bool {
-		return allIncidents[i].ID > allIncidents[j].ID
+	slices.SortFunc(allIncidents, func(a, b *model.Incident) int {
+		return model.CompareIncidents(a, b, opts.Sort)
 	})
 
 	// Apply cursor filtering if provided
 	startIndex := 0
 	if opts.After != nil {
-		// Find the index after the cursor
+		cursor, ok := m.incidents[*opts.After]
+		if !ok {
+			// The cursor incident is gone; its ID still orders it for the default sort
+			cursor = &model.Incident{ID: *opts.After}
+		}
+		startIndex = len(allIncidents)
 		for i, incident := range allIncidents {
-			if incident.ID < *opts.After {
+			if model.CompareIncidents(incident, cursor, opts.Sort) > 0 {
 				startIndex = i
 				break
 			}
 		}
-		// If cursor not found or at the end, return empty
-		if startIndex == 0 && (len(allIncidents) == 0 || allIncidents[0].ID >= *opts.After) {
-			return []*model.Incident{}, &types.PaginationResult{
-				HasNextPage:     false,
-				HasPreviousPage: true,
-				TotalCount:      len(allIncidents),
-			}, nil
-		}
 	}
 
 	// Calculate end index
@@ -401,6 +558,14 @@ func (m *Memory) Clear() {
 	m.incidents = make(map[types.IncidentID]*model.Incident)
 	m.incidentRequests = make(map[types.IncidentRequestID]*model.IncidentRequest)
 	m.tasks = make(map[types.IncidentID]map[types.TaskID]*model.Task)
+	m.processedEvents = make(map[string]*model.ProcessedEvent)
+	m.processedSweepAt = 0
+	m.jobs = make(map[types.JobID]*model.Job)
+	m.apiTokens = make(map[types.APITokenID]*model.APIToken)
+	m.auditEntries = make(map[types.AuditEntryID]*model.AuditEntry)
+	m.reminders = make(map[string]*model.Reminder)
+	m.updates = make(map[types.IncidentID][]*model.StakeholderUpdate)
+	m.transcripts = make(map[types.IncidentID]*model.Transcript)
 	m.incidentCounter = 0
 }
 
@@ -626,6 +791,23 @@ func (m *Memory) ListTasksByIncident(ctx context.Context, incidentID types.Incid
 	return tasks, nil
 }
 
+// ListTasksUpdatedSince retrieves tasks of all incidents updated since the specified time from memory
+func (m *Memory) ListTasksUpdatedSince(ctx context.Context, since time.Time) ([]*model.Task, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	tasks := make([]*model.Task, 0)
+	for _, incidentTasks := range m.tasks {
+		for _, task := range incidentTasks {
+			if !task.UpdatedAt.Before(since) {
+				taskCopy := *task
+				tasks = append(tasks, &taskCopy)
+			}
+		}
+	}
+	return tasks, nil
+}
+
 // AddStatusHistory adds a status history entry to memory
 func (m *Memory) AddStatusHistory(ctx context.Context, history *model.StatusHistory) error {
 	if history == nil {
@@ -681,6 +863,23 @@ func (m *Memory) GetStatusHistories(ctx context.Context, incidentID types.Incide
 	return result, nil
 }
 
+// ListStatusHistoriesSince retrieves status histories of all incidents changed since the specified time from memory
+func (m *Memory) ListStatusHistoriesSince(ctx context.Context, since time.Time) ([]*model.StatusHistory, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]*model.StatusHistory, 0)
+	for _, histories := range m.statusHistories {
+		for _, history := range histories {
+			if !history.ChangedAt.Before(since) {
+				historyCopy := *history
+				result = append(result, &historyCopy)
+			}
+		}
+	}
+	return result, nil
+}
+
 // UpdateIncidentStatus updates the current status of an incident
 func (m *Memory) UpdateIncidentStatus(ctx context.Context, incidentID types.IncidentID, incidentStatus types.IncidentStatus) error {
 	if err := incidentID.Validate(); err != nil {
@@ -700,8 +899,472 @@ func (m *Memory) UpdateIncidentStatus(ctx context.Context, incidentID types.Inci
 
 	// Update the status
 	incident.Status = incidentStatus
+	incident.UpdatedAt = time.Now()
+
+	return nil
+}
+
+// MarkEventProcessed records a processed event key if it has not been recorded yet
+func (m *Memory) MarkEventProcessed(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	if key == "" {
+		return false, goerr.New("event key is empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+
+	// Sweep expired records to keep memory bounded. The threshold doubles with the
+	// records left, so the cost of sweeping stays constant per call on average.
+	if len(m.processedEvents) >= m.processedSweepAt {
+		for k, e := range m.processedEvents {
+			if e.IsExpired(now) {
+				delete(m.processedEvents, k)
+			}
+		}
+		m.processedSweepAt = max(processedEventsSweepThreshold, 2*len(m.processedEvents))
+	}
+
+	if e, exists := m.processedEvents[key]; exists && !e.IsExpired(now) {
+		return false, nil
+	}
+
+	m.processedEvents[key] = &model.ProcessedEvent{
+		Key:       key,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+	return true, nil
+}
+
+// UnmarkEventProcessed deletes a processed event key
+func (m *Memory) UnmarkEventProcessed(ctx context.Context, key string) error {
+	if key == "" {
+		return goerr.New("event key is empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.processedEvents, key)
+	return nil
+}
 
+// PutJob saves a job to memory
+func (m *Memory) PutJob(ctx context.Context, job *model.Job) error {
+	if job == nil {
+		return goerr.New("job is nil")
+	}
+	if job.ID == "" {
+		return goerr.New("job ID is empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	jobCopy := *job
+	m.jobs[job.ID] = &jobCopy
+	return nil
+}
+
+// GetJob retrieves a job by ID
+func (m *Memory) GetJob(ctx context.Context, id types.JobID) (*model.Job, error) {
+	if id == "" {
+		return nil, goerr.New("job ID is empty")
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	job, exists := m.jobs[id]
+	if !exists {
+		return nil, goerr.Wrap(model.ErrJobNotFound, "failed to get job", goerr.V("jobID", id))
+	}
+
+	jobCopy := *job
+	return &jobCopy, nil
+}
+
+// DeleteJob deletes a job from memory
+func (m *Memory) DeleteJob(ctx context.Context, id types.JobID) error {
+	if id == "" {
+		return goerr.New("job ID is empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.jobs[id]; !exists {
+		return goerr.Wrap(model.ErrJobNotFound, "failed to delete job", goerr.V("jobID", id))
+	}
+	delete(m.jobs, id)
 	return nil
 }
 
+// ClaimJobs leases claimable jobs to the given owner
+func (m *Memory) ClaimJobs(ctx context.Context, owner string, limit int, lease time.Duration) ([]*model.Job, error) {
+	if owner == "" {
+		return nil, goerr.New("owner is empty")
+	}
+	if limit <= 0 {
+		return []*model.Job{}, nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	var candidates []*model.Job
+	for _, job := range m.jobs {
+		if job.IsClaimable(now) {
+			candidates = append(candidates, job)
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].RunAt.Before(candidates[j].RunAt)
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	result := make([]*model.Job, 0, len(candidates))
+	for _, job := range candidates {
+		job.Status = types.JobStatusRunning
+		job.Attempts++
+		job.LeaseOwner = owner
+		job.LeaseUntil = now.Add(lease)
+		job.LeaseToken = uuid.NewString()
+		job.UpdatedAt = now
+
+		jobCopy := *job
+		result = append(result, &jobCopy)
+	}
+
+	return result, nil
+}
+
+// leasedJob returns the stored job if it is still leased to owner with leaseToken.
+// The caller must hold the lock.
+func (m *Memory) leasedJob(id types.JobID, owner, leaseToken string) (*model.Job, error) {
+	job, exists := m.jobs[id]
+	if !exists || !job.IsLeasedBy(owner, leaseToken) {
+		return nil, goerr.Wrap(model.ErrJobLeaseLost, "job is not leased to owner",
+			goerr.V("jobID", id), goerr.V("owner", owner))
+	}
+	return job, nil
+}
+
+// RenewJobLease extends the lease of a job held by owner
+func (m *Memory) RenewJobLease(ctx context.Context, id types.JobID, owner, leaseToken string, until time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	job, err := m.leasedJob(id, owner, leaseToken)
+	if err != nil {
+		return err
+	}
+	job.LeaseUntil = until
+	job.UpdatedAt = time.Now()
+	return nil
+}
+
+// CompleteJob deletes a job held by owner
+func (m *Memory) CompleteJob(ctx context.Context, id types.JobID, owner, leaseToken string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, err := m.leasedJob(id, owner, leaseToken); err != nil {
+		return err
+	}
+	delete(m.jobs, id)
+	return nil
+}
+
+// FailJob saves the outcome of a failed attempt of a job held by owner
+func (m *Memory) FailJob(ctx context.Context, job *model.Job, owner, leaseToken string) error {
+	if job == nil {
+		return goerr.New("job is nil")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, err := m.leasedJob(job.ID, owner, leaseToken); err != nil {
+		return err
+	}
+	jobCopy := *job
+	m.jobs[job.ID] = &jobCopy
+	return nil
+}
+
+// ListJobsByStatus lists jobs in the given status ordered by update time (newest first)
+func (m *Memory) ListJobsByStatus(ctx context.Context, status types.JobStatus, limit int) ([]*model.Job, error) {
+	if !status.IsValid() {
+		return nil, goerr.New("invalid job status", goerr.V("status", status))
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]*model.Job, 0)
+	for _, job := range m.jobs {
+		if job.Status == status {
+			jobCopy := *job
+			result = append(result, &jobCopy)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].UpdatedAt.After(result[j].UpdatedAt)
+	})
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+
+	return result, nil
+}
+
 var _ interfaces.Repository = (*Memory)(nil) // Compile-time interface check
+
+// PutAPIToken saves an API token to memory
+func (m *Memory) PutAPIToken(ctx context.Context, token *model.APIToken) error {
+	if token == nil {
+		return goerr.New("API token is nil")
+	}
+	if token.ID == "" {
+		return goerr.New("API token ID is empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tokenCopy := *token
+	tokenCopy.Scopes = slices.Clone(token.Scopes)
+	m.apiTokens[token.ID] = &tokenCopy
+	return nil
+}
+
+// GetAPIToken retrieves an API token by ID
+func (m *Memory) GetAPIToken(ctx context.Context, id types.APITokenID) (*model.APIToken, error) {
+	if id == "" {
+		return nil, goerr.New("API token ID is empty")
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	token, exists := m.apiTokens[id]
+	if !exists {
+		return nil, goerr.Wrap(model.ErrAPITokenNotFound, "failed to get API token", goerr.V("tokenID", id))
+	}
+
+	tokenCopy := *token
+	tokenCopy.Scopes = slices.Clone(token.Scopes)
+	return &tokenCopy, nil
+}
+
+// UpdateAPITokenLastUsed sets the last usage time of an existing API token in memory
+func (m *Memory) UpdateAPITokenLastUsed(ctx context.Context, id types.APITokenID, lastUsedAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	token, exists := m.apiTokens[id]
+	if !exists {
+		return goerr.Wrap(model.ErrAPITokenNotFound, "failed to update API token usage", goerr.V("tokenID", id))
+	}
+	token.LastUsedAt = lastUsedAt
+	return nil
+}
+
+// ListAPITokens lists all API tokens ordered by creation time (newest first)
+func (m *Memory) ListAPITokens(ctx context.Context) ([]*model.APIToken, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]*model.APIToken, 0, len(m.apiTokens))
+	for _, token := range m.apiTokens {
+		tokenCopy := *token
+		tokenCopy.Scopes = slices.Clone(token.Scopes)
+		result = append(result, &tokenCopy)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+// PutAuditEntry appends an audit entry. Existing entries are never overwritten.
+func (m *Memory) PutAuditEntry(ctx context.Context, entry *model.AuditEntry) error {
+	if entry == nil {
+		return goerr.New("audit entry is nil")
+	}
+	if entry.ID == "" {
+		return goerr.New("audit entry ID is empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.auditEntries[entry.ID]; exists {
+		return goerr.New("audit entry already exists", goerr.V("entryID", entry.ID))
+	}
+
+	entryCopy := *entry
+	entryCopy.Changes = slices.Clone(entry.Changes)
+	m.auditEntries[entry.ID] = &entryCopy
+	return nil
+}
+
+// ListAuditEntries lists audit entries matching the filter, newest first
+func (m *Memory) ListAuditEntries(ctx context.Context, filter model.AuditFilter) ([]*model.AuditEntry, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entries := make([]*model.AuditEntry, 0)
+	for _, entry := range m.auditEntries {
+		if filter.Match(entry) {
+			entryCopy := *entry
+			entryCopy.Changes = slices.Clone(entry.Changes)
+			entries = append(entries, &entryCopy)
+		}
+	}
+
+	model.SortAuditEntries(entries)
+	if filter.Limit > 0 && len(entries) > filter.Limit {
+		entries = entries[:filter.Limit]
+	}
+
+	return entries, nil
+}
+
+// PutReminder saves a reminder to memory
+func (m *Memory) PutReminder(ctx context.Context, reminder *model.Reminder) error {
+	if reminder == nil {
+		return goerr.New("reminder is nil")
+	}
+	if reminder.ID == "" {
+		return goerr.New("reminder ID is empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	reminderCopy := *reminder
+	m.reminders[reminder.ID] = &reminderCopy
+	return nil
+}
+
+// GetReminder retrieves a reminder by ID
+func (m *Memory) GetReminder(ctx context.Context, id string) (*model.Reminder, error) {
+	if id == "" {
+		return nil, goerr.New("reminder ID is empty")
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	reminder, exists := m.reminders[id]
+	if !exists {
+		return nil, goerr.Wrap(model.ErrReminderNotFound, "failed to get reminder", goerr.V("reminderID", id))
+	}
+
+	reminderCopy := *reminder
+	return &reminderCopy, nil
+}
+
+// AddStakeholderUpdate saves a stakeholder update to memory
+func (m *Memory) AddStakeholderUpdate(ctx context.Context, update *model.StakeholderUpdate) error {
+	if update == nil {
+		return goerr.New("stakeholder update is nil")
+	}
+	if err := update.IncidentID.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid incident ID")
+	}
+	if update.ID == "" {
+		return goerr.New("stakeholder update ID is empty")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	updateCopy := *update
+	m.updates[update.IncidentID] = append(m.updates[update.IncidentID], &updateCopy)
+	return nil
+}
+
+// ListStakeholderUpdates lists the stakeholder updates of an incident, oldest first
+func (m *Memory) ListStakeholderUpdates(ctx context.Context, incidentID types.IncidentID) ([]*model.StakeholderUpdate, error) {
+	if err := incidentID.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "invalid incident ID")
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]*model.StakeholderUpdate, 0, len(m.updates[incidentID]))
+	for _, update := range m.updates[incidentID] {
+		updateCopy := *update
+		result = append(result, &updateCopy)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].PostedAt.Before(result[j].PostedAt)
+	})
+	return result, nil
+}
+
+// ListStakeholderUpdatesSince lists the stakeholder updates of all incidents posted since the given time
+func (m *Memory) ListStakeholderUpdatesSince(ctx context.Context, since time.Time) ([]*model.StakeholderUpdate, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]*model.StakeholderUpdate, 0)
+	for _, updates := range m.updates {
+		for _, update := range updates {
+			if !update.PostedAt.Before(since) {
+				updateCopy := *update
+				result = append(result, &updateCopy)
+			}
+		}
+	}
+	return result, nil
+}
+
+// PutTranscript saves the transcript of an incident, replacing any previous one
+func (m *Memory) PutTranscript(ctx context.Context, transcript *model.Transcript) error {
+	if transcript == nil {
+		return goerr.New("transcript is nil")
+	}
+	if err := transcript.IncidentID.Validate(); err != nil {
+		return goerr.Wrap(err, "invalid incident ID")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	transcriptCopy := *transcript
+	transcriptCopy.Messages = slices.Clone(transcript.Messages)
+	m.transcripts[transcript.IncidentID] = &transcriptCopy
+	return nil
+}
+
+// GetTranscript retrieves the transcript of an incident
+func (m *Memory) GetTranscript(ctx context.Context, incidentID types.IncidentID) (*model.Transcript, error) {
+	if err := incidentID.Validate(); err != nil {
+		return nil, goerr.Wrap(err, "invalid incident ID")
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	transcript, exists := m.transcripts[incidentID]
+	if !exists {
+		return nil, goerr.Wrap(model.ErrTranscriptNotFound, "failed to get transcript", goerr.V("incidentID", incidentID))
+	}
+
+	transcriptCopy := *transcript
+	transcriptCopy.Messages = slices.Clone(transcript.Messages)
+	return &transcriptCopy, nil
+}
@@ -2,9 +2,11 @@ package repository_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
+	"slices"
 	"sort"
 	"testing"
 	"time"
@@ -382,6 +384,38 @@ func testRepository(t *testing.T, newRepo func(t *testing.T) interfaces.Reposito
 		gt.S(t, err.Error()).Contains("not found")
 	})
 
+	t.Run("GetIncidents", func(t *testing.T) {
+		repo := newRepo(t)
+		defer repo.Close()
+
+		ctx := context.Background()
+
+		// Use random IDs to avoid conflicts in parallel tests
+		base := types.IncidentID(time.Now().UnixNano())
+		for _, id := range []types.IncidentID{base, base + 1} {
+			gt.NoError(t, repo.PutIncident(ctx, &model.Incident{
+				ID:        id,
+				Title:     fmt.Sprintf("Incident %d", id),
+				CreatedAt: time.Now(),
+			})).Required()
+		}
+
+		// Incidents that do not exist are omitted
+		incidents, err := repo.GetIncidents(ctx, []types.IncidentID{base, base + 1, base + 2})
+		gt.NoError(t, err).Required()
+		gt.A(t, incidents).Length(2)
+		found := map[types.IncidentID]string{}
+		for _, incident := range incidents {
+			found[incident.ID] = incident.Title
+		}
+		gt.Equal(t, found[base], fmt.Sprintf("Incident %d", base))
+		gt.Equal(t, found[base+1], fmt.Sprintf("Incident %d", base+1))
+
+		incidents, err = repo.GetIncidents(ctx, nil)
+		gt.NoError(t, err)
+		gt.A(t, incidents).Length(0)
+	})
+
 	t.Run("GetIncidentByChannelID", func(t *testing.T) {
 		repo := newRepo(t)
 		defer repo.Close()
@@ -472,7 +506,7 @@ func testRepository(t *testing.T, newRepo func(t *testing.T) interfaces.Reposito
 			}
 
 			// First page
-			result, pageInfo, err := repo.ListIncidentsPaginated(ctx, opts)
+			result, pageInfo, err := repo.ListIncidentsPaginated(ctx, model.IncidentFilter{}, opts)
 			gt.NoError(t, err).Required()
 			gt.Equal(t, 10, len(result))
 			gt.True(t, pageInfo.HasNextPage)
@@ -500,7 +534,7 @@ func testRepository(t *testing.T, newRepo func(t *testing.T) interfaces.Reposito
 				Limit: 10,
 				After: &cursor,
 			}
-			result2, pageInfo2, err := repo.ListIncidentsPaginated(ctx, opts)
+			result2, pageInfo2, err := repo.ListIncidentsPaginated(ctx, model.IncidentFilter{}, opts)
 			gt.NoError(t, err).Required()
 			gt.True(t, len(result2) <= 10)
 			gt.True(t, pageInfo2.HasPreviousPage)
@@ -525,7 +559,7 @@ func testRepository(t *testing.T, newRepo func(t *testing.T) interfaces.Reposito
 					Limit: 50,
 					After: lastCursor,
 				}
-				pageResult, pageInfo, err := repo.ListIncidentsPaginated(ctx, opts)
+				pageResult, pageInfo, err := repo.ListIncidentsPaginated(ctx, model.IncidentFilter{}, opts)
 				gt.NoError(t, err).Required()
 
 				for _, inc := range pageResult {
@@ -558,7 +592,7 @@ func testRepository(t *testing.T, newRepo func(t *testing.T) interfaces.Reposito
 				Limit: 10,
 				After: &veryLargeCursor,
 			}
-			result, pageInfo, err := repo.ListIncidentsPaginated(ctx, opts)
+			result, pageInfo, err := repo.ListIncidentsPaginated(ctx, model.IncidentFilter{}, opts)
 			gt.NoError(t, err).Required()
 			gt.Equal(t, 0, len(result))
 			gt.False(t, pageInfo.HasNextPage)
@@ -591,7 +625,7 @@ func testRepository(t *testing.T, newRepo func(t *testing.T) interfaces.Reposito
 				Limit: 10,
 				After: nil,
 			}
-			result, _, err := repo.ListIncidentsPaginated(ctx, opts)
+			result, _, err := repo.ListIncidentsPaginated(ctx, model.IncidentFilter{}, opts)
 			gt.NoError(t, err).Required()
 			// Should get at least our 5 incidents
 			gt.True(t, len(result) >= 5)
@@ -601,10 +635,189 @@ func testRepository(t *testing.T, newRepo func(t *testing.T) interfaces.Reposito
 				Limit: 0,
 				After: nil,
 			}
-			result, _, err = repo.ListIncidentsPaginated(ctx, opts)
+			result, _, err = repo.ListIncidentsPaginated(ctx, model.IncidentFilter{}, opts)
 			gt.NoError(t, err).Required()
 			gt.True(t, len(result) > 0)
 		})
+
+		t.Run("FilterAndSort", func(t *testing.T) {
+			repo := newRepo(t)
+			defer repo.Close()
+			ctx := context.Background()
+
+			now := time.Now()
+			baseID := now.UnixNano() / 1000000
+			category := fmt.Sprintf("filter-test-%d", baseID)
+			newIncident := func(offset int64, title string, status types.IncidentStatus, severity types.SeverityID, asset types.AssetID) *model.Incident {
+				return &model.Incident{
+					ID:          types.IncidentID(baseID + offset),
+					Title:       title,
+					Description: "Test Description",
+					CategoryID:  category,
+					SeverityID:  severity,
+					AssetIDs:    []types.AssetID{asset},
+					Status:      status,
+					CreatedBy:   types.SlackUserID("test-user"),
+					CreatedAt:   now.Add(time.Duration(offset) * time.Hour),
+				}
+			}
+
+			gatewayErrors := newIncident(1, "Gateway errors", types.IncidentStatusHandling, "critical", "api_gateway")
+			gatewayErrors.Lead = "U-LEAD"
+			gatewayLatency := newIncident(2, "Gateway latency", types.IncidentStatusClosed, "critical", "api_gateway")
+			diskFull := newIncident(3, "Disk full", types.IncidentStatusHandling, "low", "database")
+			diskFull.IsTest = true
+			authBypass := newIncident(4, "Auth bypass on gateway", types.IncidentStatusTriage, "critical", "api_gateway")
+			authBypass.Private = true
+			for _, incident := range []*model.Incident{gatewayErrors, gatewayLatency, diskFull, authBypass} {
+				gt.NoError(t, repo.PutIncident(ctx, incident))
+			}
+
+			ids := func(incidents []*model.Incident) []types.IncidentID {
+				result := make([]types.IncidentID, len(incidents))
+				for i, incident := range incidents {
+					result[i] = incident.ID
+				}
+				return result
+			}
+			notPrivate := false
+			isTest := true
+
+			testCases := []struct {
+				name   string
+				filter model.IncidentFilter
+				want   []types.IncidentID
+			}{
+				{
+					name: "open critical incidents on an asset",
+					filter: model.IncidentFilter{
+						CategoryID:  category,
+						Statuses:    []types.IncidentStatus{types.IncidentStatusTriage, types.IncidentStatusHandling},
+						SeverityIDs: []types.SeverityID{"critical"},
+						AssetID:     "api_gateway",
+					},
+					want: []types.IncidentID{authBypass.ID, gatewayErrors.ID},
+				},
+				{
+					name:   "text and private flag",
+					filter: model.IncidentFilter{CategoryID: category, Text: "GATEWAY", Private: &notPrivate},
+					want:   []types.IncidentID{gatewayLatency.ID, gatewayErrors.ID},
+				},
+				{
+					name:   "lead",
+					filter: model.IncidentFilter{CategoryID: category, Lead: "U-LEAD"},
+					want:   []types.IncidentID{gatewayErrors.ID},
+				},
+				{
+					name:   "test incidents",
+					filter: model.IncidentFilter{CategoryID: category, IsTest: &isTest},
+					want:   []types.IncidentID{diskFull.ID},
+				},
+				{
+					name: "created date range",
+					filter: model.IncidentFilter{
+						CategoryID:    category,
+						CreatedAfter:  gatewayLatency.CreatedAt,
+						CreatedBefore: authBypass.CreatedAt,
+					},
+					want: []types.IncidentID{diskFull.ID, gatewayLatency.ID},
+				},
+			}
+			for _, tc := range testCases {
+				t.Run(tc.name, func(t *testing.T) {
+					result, pageInfo, err := repo.ListIncidentsPaginated(ctx, tc.filter, types.PaginationOptions{Limit: 10})
+					gt.NoError(t, err).Required()
+					gt.Equal(t, ids(result), tc.want)
+					gt.Equal(t, pageInfo.TotalCount, len(tc.want))
+				})
+			}
+
+			t.Run("sorted by title with cursor", func(t *testing.T) {
+				filter := model.IncidentFilter{CategoryID: category}
+				opts := types.PaginationOptions{
+					Limit: 2,
+					Sort:  types.IncidentSort{Field: types.IncidentSortTitle, Ascending: true},
+				}
+
+				page1, pageInfo, err := repo.ListIncidentsPaginated(ctx, filter, opts)
+				gt.NoError(t, err).Required()
+				gt.Equal(t, ids(page1), []types.IncidentID{authBypass.ID, diskFull.ID})
+				gt.True(t, pageInfo.HasNextPage)
+				gt.Equal(t, pageInfo.TotalCount, 4)
+
+				opts.After = &page1[1].ID
+				page2, pageInfo, err := repo.ListIncidentsPaginated(ctx, filter, opts)
+				gt.NoError(t, err).Required()
+				gt.Equal(t, ids(page2), []types.IncidentID{gatewayErrors.ID, gatewayLatency.ID})
+				gt.False(t, pageInfo.HasNextPage)
+			})
+
+			t.Run("oldest first", func(t *testing.T) {
+				result, _, err := repo.ListIncidentsPaginated(ctx, model.IncidentFilter{CategoryID: category},
+					types.PaginationOptions{Limit: 10, Sort: types.IncidentSort{Ascending: true}})
+				gt.NoError(t, err).Required()
+				gt.Equal(t, ids(result), []types.IncidentID{gatewayErrors.ID, gatewayLatency.ID, diskFull.ID, authBypass.ID})
+			})
+
+			t.Run("date range across pages", func(t *testing.T) {
+				filter := model.IncidentFilter{CategoryID: category, CreatedAfter: gatewayLatency.CreatedAt}
+				opts := types.PaginationOptions{Limit: 2}
+
+				page1, pageInfo, err := repo.ListIncidentsPaginated(ctx, filter, opts)
+				gt.NoError(t, err).Required()
+				gt.Equal(t, ids(page1), []types.IncidentID{authBypass.ID, diskFull.ID})
+				gt.True(t, pageInfo.HasNextPage)
+				gt.Equal(t, pageInfo.TotalCount, 3)
+
+				opts.After = &page1[1].ID
+				page2, pageInfo, err := repo.ListIncidentsPaginated(ctx, filter, opts)
+				gt.NoError(t, err).Required()
+				gt.Equal(t, ids(page2), []types.IncidentID{gatewayLatency.ID})
+				gt.False(t, pageInfo.HasNextPage)
+				gt.True(t, pageInfo.HasPreviousPage)
+				gt.Equal(t, pageInfo.TotalCount, 3)
+			})
+		})
+	})
+
+	t.Run("ChangesSince", func(t *testing.T) {
+		repo := newRepo(t)
+		defer repo.Close()
+		ctx := context.Background()
+
+		now := time.Now()
+		since := now.Add(-time.Minute)
+		incidentID := types.IncidentID(now.UnixNano()/1000000 + 7)
+		channelID := types.ChannelID(fmt.Sprintf("channel-since-%d", now.UnixNano()))
+
+		gt.NoError(t, repo.PutIncident(ctx, &model.Incident{ID: incidentID, Title: "Changed", ChannelID: channelID, CreatedAt: now.Add(-time.Hour)}))
+		gt.NoError(t, repo.CreateTask(ctx, &model.Task{ID: types.TaskID(fmt.Sprintf("task-new-%d", now.UnixNano())), IncidentID: incidentID, UpdatedAt: now}))
+		gt.NoError(t, repo.CreateTask(ctx, &model.Task{ID: types.TaskID(fmt.Sprintf("task-old-%d", now.UnixNano())), IncidentID: incidentID, UpdatedAt: now.Add(-time.Hour)}))
+		gt.NoError(t, repo.AddStatusHistory(ctx, &model.StatusHistory{ID: types.StatusHistoryID(fmt.Sprintf("history-%d", now.UnixNano())), IncidentID: incidentID, Status: types.IncidentStatusHandling, ChangedBy: "U-ALICE", ChangedAt: now}))
+		gt.NoError(t, repo.AddStakeholderUpdate(ctx, &model.StakeholderUpdate{ID: types.StakeholderUpdateID(fmt.Sprintf("update-%d", now.UnixNano())), IncidentID: incidentID, Text: "Mitigated", PostedAt: now.Add(-time.Hour)}))
+		gt.NoError(t, repo.SaveMessage(ctx, &model.Message{ID: types.MessageID(fmt.Sprintf("msg-since-%d", now.UnixNano())), ChannelID: channelID, Text: "hello", Timestamp: now}))
+
+		incidents, err := repo.ListIncidentsUpdatedSince(ctx, since)
+		gt.NoError(t, err).Required()
+		gt.True(t, slices.ContainsFunc(incidents, func(i *model.Incident) bool { return i.ID == incidentID }))
+
+		tasks, err := repo.ListTasksUpdatedSince(ctx, since)
+		gt.NoError(t, err).Required()
+		tasks = slices.DeleteFunc(tasks, func(task *model.Task) bool { return task.IncidentID != incidentID })
+		gt.A(t, tasks).Length(1)
+		gt.Equal(t, tasks[0].ID, types.TaskID(fmt.Sprintf("task-new-%d", now.UnixNano())))
+
+		histories, err := repo.ListStatusHistoriesSince(ctx, since)
+		gt.NoError(t, err).Required()
+		gt.True(t, slices.ContainsFunc(histories, func(h *model.StatusHistory) bool { return h.IncidentID == incidentID }))
+
+		updates, err := repo.ListStakeholderUpdatesSince(ctx, since)
+		gt.NoError(t, err).Required()
+		gt.False(t, slices.ContainsFunc(updates, func(u *model.StakeholderUpdate) bool { return u.IncidentID == incidentID }))
+
+		messages, err := repo.ListMessagesSince(ctx, since)
+		gt.NoError(t, err).Required()
+		gt.True(t, slices.ContainsFunc(messages, func(m *model.Message) bool { return m.ChannelID == channelID }))
 	})
 
 	t.Run("StatusHistory", func(t *testing.T) {
@@ -765,6 +978,461 @@ func testRepository(t *testing.T, newRepo func(t *testing.T) interfaces.Reposito
 			gt.NoError(t, err)
 		})
 	})
+
+	t.Run("Job", func(t *testing.T) {
+		t.Run("PutGetDelete", func(t *testing.T) {
+			repo := newRepo(t)
+			defer repo.Close()
+			ctx := context.Background()
+
+			job, err := model.NewJob("test_kind", []byte(`{"key":"value"}`), 3)
+			gt.NoError(t, err).Required()
+			gt.NoError(t, repo.PutJob(ctx, job)).Required()
+
+			got, err := repo.GetJob(ctx, job.ID)
+			gt.NoError(t, err).Required()
+			gt.Equal(t, job.Kind, got.Kind)
+			gt.Equal(t, string(job.Payload), string(got.Payload))
+			gt.Equal(t, types.JobStatusPending, got.Status)
+			gt.Equal(t, 3, got.MaxAttempts)
+
+			gt.NoError(t, repo.DeleteJob(ctx, job.ID)).Required()
+			_, err = repo.GetJob(ctx, job.ID)
+			gt.Error(t, err)
+			gt.True(t, errors.Is(err, model.ErrJobNotFound))
+		})
+
+		t.Run("ClaimJobs", func(t *testing.T) {
+			repo := newRepo(t)
+			defer repo.Close()
+			ctx := context.Background()
+
+			due, err := model.NewJob("claim_test", nil, 3)
+			gt.NoError(t, err).Required()
+			gt.NoError(t, repo.PutJob(ctx, due)).Required()
+
+			future, err := model.NewJob("claim_test", nil, 3)
+			gt.NoError(t, err).Required()
+			future.RunAt = time.Now().Add(time.Hour)
+			gt.NoError(t, repo.PutJob(ctx, future)).Required()
+
+			claimed, err := repo.ClaimJobs(ctx, "worker-1", 10, time.Minute)
+			gt.NoError(t, err).Required()
+
+			var found bool
+			for _, job := range claimed {
+				gt.NotEqual(t, future.ID, job.ID)
+				if job.ID == due.ID {
+					found = true
+					gt.Equal(t, types.JobStatusRunning, job.Status)
+					gt.Equal(t, 1, job.Attempts)
+					gt.Equal(t, "worker-1", job.LeaseOwner)
+				}
+			}
+			gt.True(t, found)
+
+			// A leased job is not claimed again by another worker
+			claimed, err = repo.ClaimJobs(ctx, "worker-2", 10, time.Minute)
+			gt.NoError(t, err).Required()
+			for _, job := range claimed {
+				gt.NotEqual(t, due.ID, job.ID)
+			}
+		})
+
+		t.Run("ClaimExpiredLease", func(t *testing.T) {
+			repo := newRepo(t)
+			defer repo.Close()
+			ctx := context.Background()
+
+			job, err := model.NewJob("lease_test", nil, 3)
+			gt.NoError(t, err).Required()
+			job.Status = types.JobStatusRunning
+			job.Attempts = 1
+			job.LeaseOwner = "crashed-worker"
+			job.LeaseUntil = time.Now().Add(-time.Second)
+			gt.NoError(t, repo.PutJob(ctx, job)).Required()
+
+			claimed, err := repo.ClaimJobs(ctx, "worker-1", 10, time.Minute)
+			gt.NoError(t, err).Required()
+
+			var found bool
+			for _, c := range claimed {
+				if c.ID == job.ID {
+					found = true
+					gt.Equal(t, 2, c.Attempts)
+					gt.Equal(t, "worker-1", c.LeaseOwner)
+				}
+			}
+			gt.True(t, found)
+		})
+
+		t.Run("LeasedJobOperations", func(t *testing.T) {
+			repo := newRepo(t)
+			defer repo.Close()
+			ctx := context.Background()
+
+			job, err := model.NewJob("lease_ops_test", nil, 3)
+			gt.NoError(t, err).Required()
+			job.Status = types.JobStatusRunning
+			job.LeaseOwner = "stale-worker"
+			job.LeaseToken = "stale-token"
+			job.LeaseUntil = time.Now().Add(-time.Second)
+			gt.NoError(t, repo.PutJob(ctx, job)).Required()
+
+			claimed, err := repo.ClaimJobs(ctx, "worker-1", 10, time.Minute)
+			gt.NoError(t, err).Required()
+			var current *model.Job
+			for _, c := range claimed {
+				if c.ID == job.ID {
+					current = c
+				}
+			}
+			gt.NotEqual(t, current, nil)
+			gt.NotEqual(t, current.LeaseToken, "stale-token")
+
+			// The worker whose lease expired can no longer touch the job
+			err = repo.RenewJobLease(ctx, job.ID, "stale-worker", "stale-token", time.Now().Add(time.Minute))
+			gt.True(t, errors.Is(err, model.ErrJobLeaseLost))
+			err = repo.CompleteJob(ctx, job.ID, "stale-worker", "stale-token")
+			gt.True(t, errors.Is(err, model.ErrJobLeaseLost))
+			failed := *job
+			failed.Status = types.JobStatusPending
+			err = repo.FailJob(ctx, &failed, "stale-worker", "stale-token")
+			gt.True(t, errors.Is(err, model.ErrJobLeaseLost))
+
+			until := time.Now().Add(time.Hour)
+			gt.NoError(t, repo.RenewJobLease(ctx, job.ID, "worker-1", current.LeaseToken, until))
+			renewed, err := repo.GetJob(ctx, job.ID)
+			gt.NoError(t, err).Required()
+			gt.Equal(t, renewed.Status, types.JobStatusRunning)
+			gt.True(t, renewed.LeaseUntil.After(time.Now().Add(30*time.Minute)))
+
+			gt.NoError(t, repo.CompleteJob(ctx, job.ID, "worker-1", current.LeaseToken))
+			_, err = repo.GetJob(ctx, job.ID)
+			gt.True(t, errors.Is(err, model.ErrJobNotFound))
+		})
+
+		t.Run("ListJobsByStatus", func(t *testing.T) {
+			repo := newRepo(t)
+			defer repo.Close()
+			ctx := context.Background()
+
+			dead, err := model.NewJob("dead_test", nil, 1)
+			gt.NoError(t, err).Required()
+			dead.Status = types.JobStatusDead
+			dead.LastError = "boom"
+			gt.NoError(t, repo.PutJob(ctx, dead)).Required()
+
+			pending, err := model.NewJob("dead_test", nil, 1)
+			gt.NoError(t, err).Required()
+			pending.RunAt = time.Now().Add(time.Hour)
+			gt.NoError(t, repo.PutJob(ctx, pending)).Required()
+
+			jobs, err := repo.ListJobsByStatus(ctx, types.JobStatusDead, 0)
+			gt.NoError(t, err).Required()
+
+			var found bool
+			for _, job := range jobs {
+				gt.Equal(t, types.JobStatusDead, job.Status)
+				if job.ID == dead.ID {
+					found = true
+					gt.Equal(t, "boom", job.LastError)
+				}
+			}
+			gt.True(t, found)
+		})
+	})
+
+	t.Run("MarkEventProcessed", func(t *testing.T) {
+		t.Run("FirstDeliveryIsMarked", func(t *testing.T) {
+			repo := newRepo(t)
+			defer repo.Close()
+			ctx := context.Background()
+
+			key := fmt.Sprintf("event:Ev%d", time.Now().UnixNano())
+			marked, err := repo.MarkEventProcessed(ctx, key, time.Minute)
+			gt.NoError(t, err).Required()
+			gt.True(t, marked)
+
+			// Retry of the same delivery is rejected
+			marked, err = repo.MarkEventProcessed(ctx, key, time.Minute)
+			gt.NoError(t, err).Required()
+			gt.False(t, marked)
+		})
+
+		t.Run("UnmarkedKeyIsMarkedAgain", func(t *testing.T) {
+			repo := newRepo(t)
+			defer repo.Close()
+			ctx := context.Background()
+
+			key := fmt.Sprintf("event:Ev%d", time.Now().UnixNano())
+			marked, err := repo.MarkEventProcessed(ctx, key, time.Minute)
+			gt.NoError(t, err).Required()
+			gt.True(t, marked)
+
+			gt.NoError(t, repo.UnmarkEventProcessed(ctx, key)).Required()
+
+			marked, err = repo.MarkEventProcessed(ctx, key, time.Minute)
+			gt.NoError(t, err).Required()
+			gt.True(t, marked)
+
+			// Unmarking an unknown key is not an error
+			gt.NoError(t, repo.UnmarkEventProcessed(ctx, key+"-unknown"))
+		})
+
+		t.Run("ExpiredKeyIsMarkedAgain", func(t *testing.T) {
+			repo := newRepo(t)
+			defer repo.Close()
+			ctx := context.Background()
+
+			key := fmt.Sprintf("event:Ev%d", time.Now().UnixNano())
+			marked, err := repo.MarkEventProcessed(ctx, key, time.Millisecond)
+			gt.NoError(t, err).Required()
+			gt.True(t, marked)
+
+			time.Sleep(10 * time.Millisecond)
+
+			marked, err = repo.MarkEventProcessed(ctx, key, time.Minute)
+			gt.NoError(t, err).Required()
+			gt.True(t, marked)
+		})
+
+		t.Run("ConcurrentDeliveries", func(t *testing.T) {
+			repo := newRepo(t)
+			defer repo.Close()
+			ctx := context.Background()
+
+			key := fmt.Sprintf("event:Ev%d", time.Now().UnixNano())
+			const workers = 5
+			results := make(chan bool, workers)
+			for range workers {
+				go func() {
+					marked, err := repo.MarkEventProcessed(ctx, key, time.Minute)
+					gt.NoError(t, err)
+					results <- marked
+				}()
+			}
+
+			markedCount := 0
+			for range workers {
+				if <-results {
+					markedCount++
+				}
+			}
+			gt.Equal(t, 1, markedCount)
+		})
+
+		t.Run("EmptyKey", func(t *testing.T) {
+			repo := newRepo(t)
+			defer repo.Close()
+
+			_, err := repo.MarkEventProcessed(context.Background(), "", time.Minute)
+			gt.Error(t, err)
+		})
+	})
+
+	t.Run("APIToken", func(t *testing.T) {
+		repo := newRepo(t)
+		defer repo.Close()
+
+		ctx := context.Background()
+		ownerID := types.SlackUserID(fmt.Sprintf("U-TOKEN-%d", time.Now().UnixNano()))
+
+		token, _, err := model.NewAPIToken("automation", types.APITokenKindPersonal, ownerID,
+			[]types.APITokenScope{types.APITokenScopeRead}, time.Hour, ownerID)
+		gt.NoError(t, err).Required()
+		gt.NoError(t, repo.PutAPIToken(ctx, token)).Required()
+
+		got, err := repo.GetAPIToken(ctx, token.ID)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, got.Name, "automation")
+		gt.Equal(t, got.OwnerID, ownerID)
+		gt.Equal(t, got.SecretHash, token.SecretHash)
+		gt.A(t, got.Scopes).Length(1).At(0, func(t testing.TB, v types.APITokenScope) {
+			gt.Equal(t, v, types.APITokenScopeRead)
+		})
+
+		// Update is an upsert
+		got.RevokedAt = time.Now()
+		gt.NoError(t, repo.PutAPIToken(ctx, got))
+		revoked, err := repo.GetAPIToken(ctx, token.ID)
+		gt.NoError(t, err).Required()
+		gt.True(t, revoked.IsRevoked())
+
+		// Recording usage keeps the revocation
+		gt.NoError(t, repo.UpdateAPITokenLastUsed(ctx, token.ID, time.Now()))
+		used, err := repo.GetAPIToken(ctx, token.ID)
+		gt.NoError(t, err).Required()
+		gt.True(t, used.IsRevoked())
+		gt.False(t, used.LastUsedAt.IsZero())
+
+		tokens, err := repo.ListAPITokens(ctx)
+		gt.NoError(t, err)
+		found := false
+		for _, v := range tokens {
+			if v.ID == token.ID {
+				found = true
+			}
+		}
+		gt.True(t, found)
+
+		_, err = repo.GetAPIToken(ctx, types.NewAPITokenID())
+		gt.True(t, errors.Is(err, model.ErrAPITokenNotFound))
+		err = repo.UpdateAPITokenLastUsed(ctx, types.NewAPITokenID(), time.Now())
+		gt.True(t, errors.Is(err, model.ErrAPITokenNotFound))
+	})
+
+	t.Run("SessionsByUserAndExpiry", func(t *testing.T) {
+		repo := newRepo(t)
+		defer repo.Close()
+
+		ctx := context.Background()
+		now := time.Now()
+		userID := types.UserID(fmt.Sprintf("U-SESSIONS-%d", now.UnixNano()))
+
+		newSession := func(lastSeen, expires time.Time) *model.Session {
+			session, err := model.NewSession(userID, time.Hour)
+			gt.NoError(t, err).Required()
+			session.LastSeenAt = lastSeen
+			session.ExpiresAt = expires
+			gt.NoError(t, repo.SaveSession(ctx, session)).Required()
+			return session
+		}
+
+		older := newSession(now.Add(-time.Hour), now.Add(time.Hour))
+		newer := newSession(now, now.Add(time.Hour))
+		expired := newSession(now.Add(-2*time.Hour), now.Add(-time.Minute))
+
+		sessions, err := repo.ListSessionsByUser(ctx, userID)
+		gt.NoError(t, err).Required()
+		gt.A(t, sessions).Length(3)
+		gt.Equal(t, sessions[0].ID, newer.ID)
+		gt.Equal(t, sessions[1].ID, older.ID)
+
+		deleted, err := repo.DeleteExpiredSessions(ctx, now)
+		gt.NoError(t, err)
+		gt.True(t, deleted >= 1)
+
+		_, err = repo.GetSession(ctx, expired.ID)
+		gt.Error(t, err)
+		sessions, err = repo.ListSessionsByUser(ctx, userID)
+		gt.NoError(t, err)
+		gt.A(t, sessions).Length(2)
+
+		// Touching updates activity but never brings back a deleted session
+		newer.LastSeenAt = now.Add(time.Minute)
+		newer.ExpiresAt = now.Add(2 * time.Hour)
+		gt.NoError(t, repo.TouchSession(ctx, newer))
+		touched, err := repo.GetSession(ctx, newer.ID)
+		gt.NoError(t, err).Required()
+		gt.True(t, touched.ExpiresAt.Equal(newer.ExpiresAt))
+		gt.Equal(t, touched.Secret, newer.Secret)
+
+		gt.NoError(t, repo.DeleteSession(ctx, older.ID))
+		err = repo.TouchSession(ctx, older)
+		gt.True(t, errors.Is(err, model.ErrSessionNotFound))
+		_, err = repo.GetSession(ctx, older.ID)
+		gt.Error(t, err)
+	})
+
+	t.Run("AuditEntries", func(t *testing.T) {
+		repo := newRepo(t)
+		defer repo.Close()
+
+		ctx := context.Background()
+		now := time.Now()
+		incidentID := types.IncidentID(now.UnixNano())
+		actorID := fmt.Sprintf("U-AUDIT-%d", now.UnixNano())
+
+		newEntry := func(ts time.Time, action types.AuditAction) *model.AuditEntry {
+			entry := &model.AuditEntry{
+				ID:         types.NewAuditEntryID(),
+				Timestamp:  ts,
+				ActorID:    actorID,
+				Source:     types.AuditSourceGraphQL,
+				Action:     action,
+				TargetType: types.AuditTargetIncident,
+				TargetID:   incidentID.String(),
+				IncidentID: incidentID,
+				Changes:    []model.AuditChange{{Field: "Title", Before: `"a"`, After: `"b"`}},
+			}
+			gt.NoError(t, repo.PutAuditEntry(ctx, entry)).Required()
+			return entry
+		}
+
+		older := newEntry(now.Add(-time.Hour), types.AuditActionIncidentCreate)
+		newer := newEntry(now, types.AuditActionIncidentUpdate)
+
+		// Entries are append-only
+		gt.Error(t, repo.PutAuditEntry(ctx, older))
+
+		entries, err := repo.ListAuditEntries(ctx, model.AuditFilter{IncidentID: incidentID})
+		gt.NoError(t, err).Required()
+		gt.A(t, entries).Length(2).Required()
+		gt.Equal(t, entries[0].ID, newer.ID)
+		gt.Equal(t, entries[1].ID, older.ID)
+		gt.A(t, entries[0].Changes).Length(1)
+
+		entries, err = repo.ListAuditEntries(ctx, model.AuditFilter{ActorID: actorID, Action: types.AuditActionIncidentCreate})
+		gt.NoError(t, err)
+		gt.A(t, entries).Length(1)
+
+		entries, err = repo.ListAuditEntries(ctx, model.AuditFilter{IncidentID: incidentID, Since: now.Add(-time.Minute)})
+		gt.NoError(t, err)
+		gt.A(t, entries).Length(1)
+
+		entries, err = repo.ListAuditEntries(ctx, model.AuditFilter{IncidentID: incidentID, Limit: 1})
+		gt.NoError(t, err)
+		gt.A(t, entries).Length(1)
+		gt.Equal(t, entries[0].ID, newer.ID)
+
+		// The limit applies after all filters, not to the entries read
+		entries, err = repo.ListAuditEntries(ctx, model.AuditFilter{IncidentID: incidentID, Action: types.AuditActionIncidentCreate, Limit: 1})
+		gt.NoError(t, err)
+		gt.A(t, entries).Length(1)
+		gt.Equal(t, entries[0].ID, older.ID)
+
+		entries, err = repo.ListAuditEntries(ctx, model.AuditFilter{IncidentID: incidentID, Until: now})
+		gt.NoError(t, err)
+		gt.A(t, entries).Length(1)
+		gt.Equal(t, entries[0].ID, older.ID)
+	})
+
+	t.Run("Transcript", func(t *testing.T) {
+		repo := newRepo(t)
+		defer repo.Close()
+
+		ctx := context.Background()
+		now := time.Now()
+		incidentID := types.IncidentID(now.UnixNano())
+
+		_, err := repo.GetTranscript(ctx, incidentID)
+		gt.True(t, errors.Is(err, model.ErrTranscriptNotFound))
+
+		newTranscript := func(n int) *model.Transcript {
+			transcript := &model.Transcript{IncidentID: incidentID, ChannelID: "C-TRANSCRIPT", ExportedAt: now}
+			for i := range n {
+				transcript.Messages = append(transcript.Messages, model.TranscriptMessage{
+					TS:       fmt.Sprintf("%d.000100", 1000+i),
+					UserID:   "U-AUTHOR",
+					Text:     fmt.Sprintf("message %d", i),
+					PostedAt: now.Add(time.Duration(i) * time.Second),
+				})
+			}
+			return transcript
+		}
+
+		gt.NoError(t, repo.PutTranscript(ctx, newTranscript(12))).Required()
+		// A shorter export replaces the longer one
+		gt.NoError(t, repo.PutTranscript(ctx, newTranscript(3))).Required()
+
+		transcript, err := repo.GetTranscript(ctx, incidentID)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, transcript.ChannelID, types.ChannelID("C-TRANSCRIPT"))
+		gt.A(t, transcript.Messages).Length(3).Required()
+		gt.Equal(t, transcript.Messages[0].Text, "message 0")
+		gt.Equal(t, transcript.Messages[2].Text, "message 2")
+	})
 }
 
 func TestMemoryRepository(t *testing.T) {
@@ -0,0 +1,523 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/utils/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing records a span for each call to the wrapped repository
+type Tracing struct {
+	repo interfaces.Repository
+}
+
+var _ interfaces.Repository = (*Tracing)(nil)
+
+// NewTracing wraps repo so that its calls are traced
+func NewTracing(repo interfaces.Repository) *Tracing {
+	return &Tracing{repo: repo}
+}
+
+// start starts a client span named after the repository method. Calls outside
+// of a trace, e.g. polling by job workers, are not traced to avoid a root span
+// per call.
+func (t *Tracing) start(ctx context.Context, method string) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+	return tracing.Start(ctx, "repository."+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("db.operation.name", method)),
+	)
+}
+
+// SaveMessage traces Repository.SaveMessage
+func (t *Tracing) SaveMessage(ctx context.Context, message *model.Message) error {
+	ctx, span := t.start(ctx, "SaveMessage")
+	err := t.repo.SaveMessage(ctx, message)
+	tracing.End(span, err)
+	return err
+}
+
+// GetMessage traces Repository.GetMessage
+func (t *Tracing) GetMessage(ctx context.Context, id types.MessageID) (*model.Message, error) {
+	ctx, span := t.start(ctx, "GetMessage")
+	r0, err := t.repo.GetMessage(ctx, id)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// ListMessages traces Repository.ListMessages
+func (t *Tracing) ListMessages(ctx context.Context, channelID types.ChannelID, limit int) ([]*model.Message, error) {
+	ctx, span := t.start(ctx, "ListMessages")
+	r0, err := t.repo.ListMessages(ctx, channelID, limit)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// ListMessagesSince traces Repository.ListMessagesSince
+func (t *Tracing) ListMessagesSince(ctx context.Context, since time.Time) ([]*model.Message, error) {
+	ctx, span := t.start(ctx, "ListMessagesSince")
+	r0, err := t.repo.ListMessagesSince(ctx, since)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// SaveUser traces Repository.SaveUser
+func (t *Tracing) SaveUser(ctx context.Context, user *model.User) error {
+	ctx, span := t.start(ctx, "SaveUser")
+	err := t.repo.SaveUser(ctx, user)
+	tracing.End(span, err)
+	return err
+}
+
+// GetUser traces Repository.GetUser
+func (t *Tracing) GetUser(ctx context.Context, id types.UserID) (*model.User, error) {
+	ctx, span := t.start(ctx, "GetUser")
+	r0, err := t.repo.GetUser(ctx, id)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// GetUserBySlackID traces Repository.GetUserBySlackID
+func (t *Tracing) GetUserBySlackID(ctx context.Context, slackUserID types.SlackUserID) (*model.User, error) {
+	ctx, span := t.start(ctx, "GetUserBySlackID")
+	r0, err := t.repo.GetUserBySlackID(ctx, slackUserID)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// SaveSession traces Repository.SaveSession
+func (t *Tracing) SaveSession(ctx context.Context, session *model.Session) error {
+	ctx, span := t.start(ctx, "SaveSession")
+	err := t.repo.SaveSession(ctx, session)
+	tracing.End(span, err)
+	return err
+}
+
+// GetSession traces Repository.GetSession
+func (t *Tracing) GetSession(ctx context.Context, id types.SessionID) (*model.Session, error) {
+	ctx, span := t.start(ctx, "GetSession")
+	r0, err := t.repo.GetSession(ctx, id)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// TouchSession traces Repository.TouchSession
+func (t *Tracing) TouchSession(ctx context.Context, session *model.Session) error {
+	ctx, span := t.start(ctx, "TouchSession")
+	err := t.repo.TouchSession(ctx, session)
+	tracing.End(span, err)
+	return err
+}
+
+// DeleteSession traces Repository.DeleteSession
+func (t *Tracing) DeleteSession(ctx context.Context, id types.SessionID) error {
+	ctx, span := t.start(ctx, "DeleteSession")
+	err := t.repo.DeleteSession(ctx, id)
+	tracing.End(span, err)
+	return err
+}
+
+// ListSessionsByUser traces Repository.ListSessionsByUser
+func (t *Tracing) ListSessionsByUser(ctx context.Context, userID types.UserID) ([]*model.Session, error) {
+	ctx, span := t.start(ctx, "ListSessionsByUser")
+	r0, err := t.repo.ListSessionsByUser(ctx, userID)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// DeleteExpiredSessions traces Repository.DeleteExpiredSessions
+func (t *Tracing) DeleteExpiredSessions(ctx context.Context, now time.Time) (int, error) {
+	ctx, span := t.start(ctx, "DeleteExpiredSessions")
+	r0, err := t.repo.DeleteExpiredSessions(ctx, now)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// PutIncident traces Repository.PutIncident
+func (t *Tracing) PutIncident(ctx context.Context, incident *model.Incident) error {
+	ctx, span := t.start(ctx, "PutIncident")
+	err := t.repo.PutIncident(ctx, incident)
+	tracing.End(span, err)
+	return err
+}
+
+// GetIncident traces Repository.GetIncident
+func (t *Tracing) GetIncident(ctx context.Context, id types.IncidentID) (*model.Incident, error) {
+	ctx, span := t.start(ctx, "GetIncident")
+	r0, err := t.repo.GetIncident(ctx, id)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// UpdateIncidentAtomic traces Repository.UpdateIncidentAtomic
+func (t *Tracing) UpdateIncidentAtomic(ctx context.Context, incidentID types.IncidentID, updateFn func(*model.Incident) error) error {
+	ctx, span := t.start(ctx, "UpdateIncidentAtomic")
+	err := t.repo.UpdateIncidentAtomic(ctx, incidentID, updateFn)
+	tracing.End(span, err)
+	return err
+}
+
+// GetIncidents traces Repository.GetIncidents
+func (t *Tracing) GetIncidents(ctx context.Context, ids []types.IncidentID) ([]*model.Incident, error) {
+	ctx, span := t.start(ctx, "GetIncidents")
+	r0, err := t.repo.GetIncidents(ctx, ids)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// GetIncidentByChannelID traces Repository.GetIncidentByChannelID
+func (t *Tracing) GetIncidentByChannelID(ctx context.Context, channelID types.ChannelID) (*model.Incident, error) {
+	ctx, span := t.start(ctx, "GetIncidentByChannelID")
+	r0, err := t.repo.GetIncidentByChannelID(ctx, channelID)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// ListIncidents traces Repository.ListIncidents
+func (t *Tracing) ListIncidents(ctx context.Context) ([]*model.Incident, error) {
+	ctx, span := t.start(ctx, "ListIncidents")
+	r0, err := t.repo.ListIncidents(ctx)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// ListIncidentsPaginated traces Repository.ListIncidentsPaginated
+func (t *Tracing) ListIncidentsPaginated(ctx context.Context, filter model.IncidentFilter, opts types.PaginationOptions) ([]*model.Incident, *types.PaginationResult, error) {
+	ctx, span := t.start(ctx, "ListIncidentsPaginated")
+	r0, r1, err := t.repo.ListIncidentsPaginated(ctx, filter, opts)
+	tracing.End(span, err)
+	return r0, r1, err
+}
+
+// ListIncidentsSince traces Repository.ListIncidentsSince
+func (t *Tracing) ListIncidentsSince(ctx context.Context, since time.Time) ([]*model.Incident, error) {
+	ctx, span := t.start(ctx, "ListIncidentsSince")
+	r0, err := t.repo.ListIncidentsSince(ctx, since)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// ListIncidentsUpdatedSince traces Repository.ListIncidentsUpdatedSince
+func (t *Tracing) ListIncidentsUpdatedSince(ctx context.Context, since time.Time) ([]*model.Incident, error) {
+	ctx, span := t.start(ctx, "ListIncidentsUpdatedSince")
+	r0, err := t.repo.ListIncidentsUpdatedSince(ctx, since)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// GetNextIncidentNumber traces Repository.GetNextIncidentNumber
+func (t *Tracing) GetNextIncidentNumber(ctx context.Context) (types.IncidentID, error) {
+	ctx, span := t.start(ctx, "GetNextIncidentNumber")
+	r0, err := t.repo.GetNextIncidentNumber(ctx)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// AddStatusHistory traces Repository.AddStatusHistory
+func (t *Tracing) AddStatusHistory(ctx context.Context, history *model.StatusHistory) error {
+	ctx, span := t.start(ctx, "AddStatusHistory")
+	err := t.repo.AddStatusHistory(ctx, history)
+	tracing.End(span, err)
+	return err
+}
+
+// GetStatusHistories traces Repository.GetStatusHistories
+func (t *Tracing) GetStatusHistories(ctx context.Context, incidentID types.IncidentID) ([]*model.StatusHistory, error) {
+	ctx, span := t.start(ctx, "GetStatusHistories")
+	r0, err := t.repo.GetStatusHistories(ctx, incidentID)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// ListStatusHistoriesSince traces Repository.ListStatusHistoriesSince
+func (t *Tracing) ListStatusHistoriesSince(ctx context.Context, since time.Time) ([]*model.StatusHistory, error) {
+	ctx, span := t.start(ctx, "ListStatusHistoriesSince")
+	r0, err := t.repo.ListStatusHistoriesSince(ctx, since)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// UpdateIncidentStatus traces Repository.UpdateIncidentStatus
+func (t *Tracing) UpdateIncidentStatus(ctx context.Context, incidentID types.IncidentID, status types.IncidentStatus) error {
+	ctx, span := t.start(ctx, "UpdateIncidentStatus")
+	err := t.repo.UpdateIncidentStatus(ctx, incidentID, status)
+	tracing.End(span, err)
+	return err
+}
+
+// SaveIncidentRequest traces Repository.SaveIncidentRequest
+func (t *Tracing) SaveIncidentRequest(ctx context.Context, request *model.IncidentRequest) error {
+	ctx, span := t.start(ctx, "SaveIncidentRequest")
+	err := t.repo.SaveIncidentRequest(ctx, request)
+	tracing.End(span, err)
+	return err
+}
+
+// GetIncidentRequest traces Repository.GetIncidentRequest
+func (t *Tracing) GetIncidentRequest(ctx context.Context, id types.IncidentRequestID) (*model.IncidentRequest, error) {
+	ctx, span := t.start(ctx, "GetIncidentRequest")
+	r0, err := t.repo.GetIncidentRequest(ctx, id)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// DeleteIncidentRequest traces Repository.DeleteIncidentRequest
+func (t *Tracing) DeleteIncidentRequest(ctx context.Context, id types.IncidentRequestID) error {
+	ctx, span := t.start(ctx, "DeleteIncidentRequest")
+	err := t.repo.DeleteIncidentRequest(ctx, id)
+	tracing.End(span, err)
+	return err
+}
+
+// CreateTask traces Repository.CreateTask
+func (t *Tracing) CreateTask(ctx context.Context, task *model.Task) error {
+	ctx, span := t.start(ctx, "CreateTask")
+	err := t.repo.CreateTask(ctx, task)
+	tracing.End(span, err)
+	return err
+}
+
+// GetTask traces Repository.GetTask
+func (t *Tracing) GetTask(ctx context.Context, taskID types.TaskID) (*model.Task, error) {
+	ctx, span := t.start(ctx, "GetTask")
+	r0, err := t.repo.GetTask(ctx, taskID)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// GetTaskByIncident traces Repository.GetTaskByIncident
+func (t *Tracing) GetTaskByIncident(ctx context.Context, incidentID types.IncidentID, taskID types.TaskID) (*model.Task, error) {
+	ctx, span := t.start(ctx, "GetTaskByIncident")
+	r0, err := t.repo.GetTaskByIncident(ctx, incidentID, taskID)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// UpdateTask traces Repository.UpdateTask
+func (t *Tracing) UpdateTask(ctx context.Context, task *model.Task) error {
+	ctx, span := t.start(ctx, "UpdateTask")
+	err := t.repo.UpdateTask(ctx, task)
+	tracing.End(span, err)
+	return err
+}
+
+// DeleteTask traces Repository.DeleteTask
+func (t *Tracing) DeleteTask(ctx context.Context, incidentID types.IncidentID, taskID types.TaskID) error {
+	ctx, span := t.start(ctx, "DeleteTask")
+	err := t.repo.DeleteTask(ctx, incidentID, taskID)
+	tracing.End(span, err)
+	return err
+}
+
+// ListTasksByIncident traces Repository.ListTasksByIncident
+func (t *Tracing) ListTasksByIncident(ctx context.Context, incidentID types.IncidentID) ([]*model.Task, error) {
+	ctx, span := t.start(ctx, "ListTasksByIncident")
+	r0, err := t.repo.ListTasksByIncident(ctx, incidentID)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// ListTasksUpdatedSince traces Repository.ListTasksUpdatedSince
+func (t *Tracing) ListTasksUpdatedSince(ctx context.Context, since time.Time) ([]*model.Task, error) {
+	ctx, span := t.start(ctx, "ListTasksUpdatedSince")
+	r0, err := t.repo.ListTasksUpdatedSince(ctx, since)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// MarkEventProcessed traces Repository.MarkEventProcessed
+func (t *Tracing) MarkEventProcessed(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	ctx, span := t.start(ctx, "MarkEventProcessed")
+	r0, err := t.repo.MarkEventProcessed(ctx, key, ttl)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// UnmarkEventProcessed traces Repository.UnmarkEventProcessed
+func (t *Tracing) UnmarkEventProcessed(ctx context.Context, key string) error {
+	ctx, span := t.start(ctx, "UnmarkEventProcessed")
+	err := t.repo.UnmarkEventProcessed(ctx, key)
+	tracing.End(span, err)
+	return err
+}
+
+// PutJob traces Repository.PutJob
+func (t *Tracing) PutJob(ctx context.Context, job *model.Job) error {
+	ctx, span := t.start(ctx, "PutJob")
+	err := t.repo.PutJob(ctx, job)
+	tracing.End(span, err)
+	return err
+}
+
+// GetJob traces Repository.GetJob
+func (t *Tracing) GetJob(ctx context.Context, id types.JobID) (*model.Job, error) {
+	ctx, span := t.start(ctx, "GetJob")
+	r0, err := t.repo.GetJob(ctx, id)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// DeleteJob traces Repository.DeleteJob
+func (t *Tracing) DeleteJob(ctx context.Context, id types.JobID) error {
+	ctx, span := t.start(ctx, "DeleteJob")
+	err := t.repo.DeleteJob(ctx, id)
+	tracing.End(span, err)
+	return err
+}
+
+// ClaimJobs traces Repository.ClaimJobs
+func (t *Tracing) ClaimJobs(ctx context.Context, owner string, limit int, lease time.Duration) ([]*model.Job, error) {
+	ctx, span := t.start(ctx, "ClaimJobs")
+	r0, err := t.repo.ClaimJobs(ctx, owner, limit, lease)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// RenewJobLease traces Repository.RenewJobLease
+func (t *Tracing) RenewJobLease(ctx context.Context, id types.JobID, owner, leaseToken string, until time.Time) error {
+	ctx, span := t.start(ctx, "RenewJobLease")
+	err := t.repo.RenewJobLease(ctx, id, owner, leaseToken, until)
+	tracing.End(span, err)
+	return err
+}
+
+// CompleteJob traces Repository.CompleteJob
+func (t *Tracing) CompleteJob(ctx context.Context, id types.JobID, owner, leaseToken string) error {
+	ctx, span := t.start(ctx, "CompleteJob")
+	err := t.repo.CompleteJob(ctx, id, owner, leaseToken)
+	tracing.End(span, err)
+	return err
+}
+
+// FailJob traces Repository.FailJob
+func (t *Tracing) FailJob(ctx context.Context, job *model.Job, owner, leaseToken string) error {
+	ctx, span := t.start(ctx, "FailJob")
+	err := t.repo.FailJob(ctx, job, owner, leaseToken)
+	tracing.End(span, err)
+	return err
+}
+
+// ListJobsByStatus traces Repository.ListJobsByStatus
+func (t *Tracing) ListJobsByStatus(ctx context.Context, status types.JobStatus, limit int) ([]*model.Job, error) {
+	ctx, span := t.start(ctx, "ListJobsByStatus")
+	r0, err := t.repo.ListJobsByStatus(ctx, status, limit)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// PutAPIToken traces Repository.PutAPIToken
+func (t *Tracing) PutAPIToken(ctx context.Context, token *model.APIToken) error {
+	ctx, span := t.start(ctx, "PutAPIToken")
+	err := t.repo.PutAPIToken(ctx, token)
+	tracing.End(span, err)
+	return err
+}
+
+// UpdateAPITokenLastUsed traces Repository.UpdateAPITokenLastUsed
+func (t *Tracing) UpdateAPITokenLastUsed(ctx context.Context, id types.APITokenID, lastUsedAt time.Time) error {
+	ctx, span := t.start(ctx, "UpdateAPITokenLastUsed")
+	err := t.repo.UpdateAPITokenLastUsed(ctx, id, lastUsedAt)
+	tracing.End(span, err)
+	return err
+}
+
+// GetAPIToken traces Repository.GetAPIToken
+func (t *Tracing) GetAPIToken(ctx context.Context, id types.APITokenID) (*model.APIToken, error) {
+	ctx, span := t.start(ctx, "GetAPIToken")
+	r0, err := t.repo.GetAPIToken(ctx, id)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// ListAPITokens traces Repository.ListAPITokens
+func (t *Tracing) ListAPITokens(ctx context.Context) ([]*model.APIToken, error) {
+	ctx, span := t.start(ctx, "ListAPITokens")
+	r0, err := t.repo.ListAPITokens(ctx)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// PutAuditEntry traces Repository.PutAuditEntry
+func (t *Tracing) PutAuditEntry(ctx context.Context, entry *model.AuditEntry) error {
+	ctx, span := t.start(ctx, "PutAuditEntry")
+	err := t.repo.PutAuditEntry(ctx, entry)
+	tracing.End(span, err)
+	return err
+}
+
+// ListAuditEntries traces Repository.ListAuditEntries
+func (t *Tracing) ListAuditEntries(ctx context.Context, filter model.AuditFilter) ([]*model.AuditEntry, error) {
+	ctx, span := t.start(ctx, "ListAuditEntries")
+	r0, err := t.repo.ListAuditEntries(ctx, filter)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// AddStakeholderUpdate traces Repository.AddStakeholderUpdate
+func (t *Tracing) AddStakeholderUpdate(ctx context.Context, update *model.StakeholderUpdate) error {
+	ctx, span := t.start(ctx, "AddStakeholderUpdate")
+	err := t.repo.AddStakeholderUpdate(ctx, update)
+	tracing.End(span, err)
+	return err
+}
+
+// ListStakeholderUpdates traces Repository.ListStakeholderUpdates
+func (t *Tracing) ListStakeholderUpdates(ctx context.Context, incidentID types.IncidentID) ([]*model.StakeholderUpdate, error) {
+	ctx, span := t.start(ctx, "ListStakeholderUpdates")
+	r0, err := t.repo.ListStakeholderUpdates(ctx, incidentID)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// ListStakeholderUpdatesSince traces Repository.ListStakeholderUpdatesSince
+func (t *Tracing) ListStakeholderUpdatesSince(ctx context.Context, since time.Time) ([]*model.StakeholderUpdate, error) {
+	ctx, span := t.start(ctx, "ListStakeholderUpdatesSince")
+	r0, err := t.repo.ListStakeholderUpdatesSince(ctx, since)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// PutReminder traces Repository.PutReminder
+func (t *Tracing) PutReminder(ctx context.Context, reminder *model.Reminder) error {
+	ctx, span := t.start(ctx, "PutReminder")
+	err := t.repo.PutReminder(ctx, reminder)
+	tracing.End(span, err)
+	return err
+}
+
+// GetReminder traces Repository.GetReminder
+func (t *Tracing) GetReminder(ctx context.Context, id string) (*model.Reminder, error) {
+	ctx, span := t.start(ctx, "GetReminder")
+	r0, err := t.repo.GetReminder(ctx, id)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// PutTranscript traces Repository.PutTranscript
+func (t *Tracing) PutTranscript(ctx context.Context, transcript *model.Transcript) error {
+	ctx, span := t.start(ctx, "PutTranscript")
+	err := t.repo.PutTranscript(ctx, transcript)
+	tracing.End(span, err)
+	return err
+}
+
+// GetTranscript traces Repository.GetTranscript
+func (t *Tracing) GetTranscript(ctx context.Context, incidentID types.IncidentID) (*model.Transcript, error) {
+	ctx, span := t.start(ctx, "GetTranscript")
+	r0, err := t.repo.GetTranscript(ctx, incidentID)
+	tracing.End(span, err)
+	return r0, err
+}
+
+// Close closes the wrapped repository
+func (t *Tracing) Close() error {
+	return t.repo.Close()
+}
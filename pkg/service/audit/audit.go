@@ -0,0 +1,103 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
+)
+
+// Recorder appends audit entries for state-changing actions
+type Recorder struct {
+	repo interfaces.Repository
+}
+
+// New creates a Recorder storing entries in repo
+func New(repo interfaces.Repository) *Recorder {
+	return &Recorder{repo: repo}
+}
+
+// Target identifies the object an action was applied to
+type Target struct {
+	Type       types.AuditTargetType
+	ID         string
+	IncidentID types.IncidentID
+}
+
+// IncidentTarget returns the target for an incident
+func IncidentTarget(id types.IncidentID) Target {
+	return Target{Type: types.AuditTargetIncident, ID: id.String(), IncidentID: id}
+}
+
+// TaskTarget returns the target for a task of an incident
+func TaskTarget(task *model.Task) Target {
+	return Target{Type: types.AuditTargetTask, ID: task.ID.String(), IncidentID: task.IncidentID}
+}
+
+// Record appends an entry for action on target. before and after are compared
+// field by field; pass nil for either on creation or deletion. The actor is taken
+// from the context (see model.WithAuditActor), falling back to the authenticated
+// user and then to fallbackActor. Failures are reported but never fail the action.
+func (r *Recorder) Record(ctx context.Context, action types.AuditAction, target Target, fallbackActor types.SlackUserID, before, after any) {
+	if r == nil || r.repo == nil {
+		return
+	}
+
+	actor := resolveActor(ctx, fallbackActor)
+	entry := &model.AuditEntry{
+		ID:         types.NewAuditEntryID(),
+		Timestamp:  time.Now(),
+		ActorID:    actor.ID,
+		Source:     actor.Source,
+		Action:     action,
+		TargetType: target.Type,
+		TargetID:   target.ID,
+		IncidentID: target.IncidentID,
+		Changes:    model.DiffAuditFields(before, after),
+		RequestID:  actor.RequestID,
+	}
+
+	if err := r.repo.PutAuditEntry(ctx, entry); err != nil {
+		apperr.Handle(ctx, goerr.Wrap(err, "failed to record audit entry",
+			goerr.V("action", action), goerr.V("targetID", target.ID)))
+		return
+	}
+
+	ctxlog.From(ctx).Debug("Audit entry recorded",
+		"action", action,
+		"actor", entry.ActorID,
+		"source", entry.Source,
+		"targetID", target.ID,
+	)
+}
+
+// resolveActor determines who is acting in ctx
+func resolveActor(ctx context.Context, fallbackActor types.SlackUserID) model.AuditActor {
+	actor, ok := model.GetAuditActor(ctx)
+	if !ok {
+		actor.Source = types.AuditSourceSystem
+	}
+
+	if actor.ID == "" {
+		if authCtx, ok := model.GetAuthContext(ctx); ok && authCtx != nil {
+			actor.ID = ActorIDFromAuthContext(authCtx)
+		}
+	}
+	if actor.ID == "" {
+		actor.ID = fallbackActor.String()
+	}
+	return actor
+}
+
+// ActorIDFromAuthContext returns the Slack user, or "token:<id>" for service tokens
+func ActorIDFromAuthContext(authCtx *model.AuthContext) string {
+	if authCtx.IsServiceToken() {
+		return "token:" + authCtx.APITokenID
+	}
+	return authCtx.SlackUserID
+}
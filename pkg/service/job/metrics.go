@@ -0,0 +1,47 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/utils/metrics"
+)
+
+// depthLimit caps the number of jobs counted per status when reporting the queue depth
+const depthLimit = 10000
+
+var (
+	jobRuns = metrics.NewCounterVec("lycaon_job_runs_total",
+		"Job attempts by kind and result (success, retry or dead).", "kind", "result")
+	jobDuration = metrics.NewHistogramVec("lycaon_job_duration_seconds",
+		"Time spent running jobs by kind.", metrics.DefaultBuckets, "kind")
+	jobStartDelay = metrics.NewHistogramVec("lycaon_job_start_delay_seconds",
+		"Time jobs waited in the queue after becoming runnable, by kind.", metrics.DefaultBuckets, "kind")
+)
+
+// RegisterMetrics exposes the number of persisted jobs per status to reg. The
+// queue is shared by all replicas, so every replica reports the same depth.
+func (q *Queue) RegisterMetrics(reg *metrics.Registry) error {
+	statuses := []types.JobStatus{types.JobStatusPending, types.JobStatusRunning, types.JobStatusDead}
+
+	return reg.Register(metrics.NewGaugeFunc("lycaon_job_queue_jobs",
+		"Persisted jobs by status, counted up to 10000 per status.", []string{"status"},
+		func(ctx context.Context) ([]metrics.Sample, error) {
+			samples := make([]metrics.Sample, 0, len(statuses))
+			for _, status := range statuses {
+				jobs, err := q.repo.ListJobsByStatus(ctx, status, depthLimit)
+				if err != nil {
+					return nil, goerr.Wrap(err, "failed to list jobs", goerr.V("status", status))
+				}
+				samples = append(samples, metrics.Sample{LabelValues: []string{status.String()}, Value: float64(len(jobs))})
+			}
+			return samples, nil
+		}))
+}
+
+// observeStart records how long a job waited after it became runnable
+func observeStart(kind string, runAt time.Time) {
+	jobStartDelay.Observe(max(0, time.Since(runAt).Seconds()), kind)
+}
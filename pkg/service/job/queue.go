@@ -0,0 +1,455 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
+	"github.com/secmon-lab/lycaon/pkg/utils/async"
+	"github.com/secmon-lab/lycaon/pkg/utils/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultConcurrency  = 8
+	defaultMaxAttempts  = 5
+	defaultPollInterval = time.Second
+	defaultLease        = 5 * time.Minute
+	defaultBaseBackoff  = 2 * time.Second
+	defaultMaxBackoff   = 5 * time.Minute
+)
+
+// Handler processes the payload of a job. Returning an error schedules a retry
+// with backoff until the job's attempts are exhausted, after which it is dead-lettered.
+type Handler func(ctx context.Context, payload []byte) error
+
+type kindConfig struct {
+	handler     Handler
+	maxAttempts int
+}
+
+// Queue is a persisted job queue with a bounded worker pool.
+// Jobs are stored in the repository before they run, so work accepted before a
+// restart is picked up again by the next process (or another replica).
+type Queue struct {
+	repo         interfaces.Repository
+	owner        string
+	concurrency  int
+	maxAttempts  int
+	pollInterval time.Duration
+	lease        time.Duration
+	baseBackoff  time.Duration
+	maxBackoff   time.Duration
+
+	mu    sync.RWMutex
+	kinds map[string]kindConfig
+
+	slots    chan struct{}
+	wake     chan struct{}
+	stop     chan struct{}
+	loopDone chan struct{}
+	workers  sync.WaitGroup
+	started  atomic.Bool
+	stopOnce sync.Once
+}
+
+// Option configures a Queue
+type Option func(*Queue)
+
+// WithConcurrency sets the maximum number of jobs running at the same time
+func WithConcurrency(n int) Option {
+	return func(q *Queue) {
+		if n > 0 {
+			q.concurrency = n
+		}
+	}
+}
+
+// WithMaxAttempts sets the default number of attempts before a job is dead-lettered
+func WithMaxAttempts(n int) Option {
+	return func(q *Queue) {
+		if n > 0 {
+			q.maxAttempts = n
+		}
+	}
+}
+
+// WithPollInterval sets how often the queue looks for due jobs (e.g. retries)
+func WithPollInterval(d time.Duration) Option {
+	return func(q *Queue) {
+		if d > 0 {
+			q.pollInterval = d
+		}
+	}
+}
+
+// WithLease sets how long a claimed job is reserved for a worker. The lease is renewed
+// while the handler runs, so a job is only run again once its worker stopped renewing
+// it (e.g. the process died).
+func WithLease(d time.Duration) Option {
+	return func(q *Queue) {
+		if d > 0 {
+			q.lease = d
+		}
+	}
+}
+
+// WithBackoff sets the exponential retry backoff (base * 2^(attempt-1), capped at max)
+func WithBackoff(base, max time.Duration) Option {
+	return func(q *Queue) {
+		if base > 0 {
+			q.baseBackoff = base
+		}
+		if max > 0 {
+			q.maxBackoff = max
+		}
+	}
+}
+
+// RegisterOption configures a job kind
+type RegisterOption func(*kindConfig)
+
+// WithKindMaxAttempts overrides the number of attempts for a job kind
+func WithKindMaxAttempts(n int) RegisterOption {
+	return func(c *kindConfig) {
+		if n > 0 {
+			c.maxAttempts = n
+		}
+	}
+}
+
+// New creates a new Queue. Call Start to begin processing.
+func New(repo interfaces.Repository, opts ...Option) *Queue {
+	hostname, _ := os.Hostname()
+	q := &Queue{
+		repo:         repo,
+		owner:        fmt.Sprintf("%s-%s", hostname, uuid.New().String()[:8]),
+		concurrency:  defaultConcurrency,
+		maxAttempts:  defaultMaxAttempts,
+		pollInterval: defaultPollInterval,
+		lease:        defaultLease,
+		baseBackoff:  defaultBaseBackoff,
+		maxBackoff:   defaultMaxBackoff,
+		kinds:        make(map[string]kindConfig),
+		wake:         make(chan struct{}, 1),
+		stop:         make(chan struct{}),
+		loopDone:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(q)
+	}
+	q.slots = make(chan struct{}, q.concurrency)
+	return q
+}
+
+// Register binds a handler to a job kind
+func (q *Queue) Register(kind string, handler Handler, opts ...RegisterOption) {
+	cfg := kindConfig{
+		handler:     handler,
+		maxAttempts: q.maxAttempts,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.kinds[kind] = cfg
+}
+
+// Enqueue persists a new job and wakes up the workers
+func (q *Queue) Enqueue(ctx context.Context, kind string, payload []byte) (*model.Job, error) {
+	q.mu.RLock()
+	cfg, ok := q.kinds[kind]
+	q.mu.RUnlock()
+	if !ok {
+		return nil, goerr.New("job kind is not registered", goerr.V("kind", kind))
+	}
+
+	job, err := model.NewJob(kind, payload, cfg.maxAttempts)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to create job")
+	}
+	job.RequestID = middleware.GetReqID(ctx)
+	job.TraceParent = tracing.Inject(ctx)
+
+	if err := q.repo.PutJob(ctx, job); err != nil {
+		return nil, goerr.Wrap(err, "failed to persist job", goerr.V("kind", kind))
+	}
+
+	ctxlog.From(ctx).Debug("Job enqueued", "jobID", job.ID, "kind", kind)
+	q.notify()
+	return job, nil
+}
+
+// Start begins claiming and running jobs in the background
+func (q *Queue) Start(ctx context.Context) {
+	if !q.started.CompareAndSwap(false, true) {
+		return
+	}
+	baseCtx := async.NewBackgroundContext(ctx)
+	go q.loop(baseCtx)
+}
+
+// Shutdown stops claiming new jobs and waits for running jobs to finish.
+// Jobs not finished before ctx is done keep their lease and are retried after it expires.
+func (q *Queue) Shutdown(ctx context.Context) error {
+	q.stopOnce.Do(func() { close(q.stop) })
+	if !q.started.Load() {
+		return nil
+	}
+
+	done := make(chan struct{})
+	go func() {
+		<-q.loopDone
+		q.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return goerr.Wrap(ctx.Err(), "job queue did not drain before deadline")
+	}
+}
+
+// ListDeadLetters returns jobs that exhausted their attempts, newest first
+func (q *Queue) ListDeadLetters(ctx context.Context, limit int) ([]*model.Job, error) {
+	jobs, err := q.repo.ListJobsByStatus(ctx, types.JobStatusDead, limit)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list dead-lettered jobs")
+	}
+	return jobs, nil
+}
+
+// Retry moves a dead-lettered job back to pending with a fresh attempt budget
+func (q *Queue) Retry(ctx context.Context, id types.JobID) error {
+	job, err := q.repo.GetJob(ctx, id)
+	if err != nil {
+		return goerr.Wrap(err, "failed to get job")
+	}
+	if job.Status != types.JobStatusDead {
+		return goerr.New("only dead-lettered jobs can be retried",
+			goerr.V("jobID", id),
+			goerr.V("status", job.Status))
+	}
+
+	now := time.Now()
+	job.Status = types.JobStatusPending
+	job.Attempts = 0
+	job.RunAt = now
+	job.LeaseOwner = ""
+	job.LeaseUntil = time.Time{}
+	job.LeaseToken = ""
+	job.UpdatedAt = now
+	if err := q.repo.PutJob(ctx, job); err != nil {
+		return goerr.Wrap(err, "failed to requeue job")
+	}
+
+	q.notify()
+	return nil
+}
+
+// notify wakes up the claim loop without blocking
+func (q *Queue) notify() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// loop claims due jobs whenever a worker slot is free
+func (q *Queue) loop(ctx context.Context) {
+	defer close(q.loopDone)
+
+	ticker := time.NewTicker(q.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		q.claim(ctx)
+
+		select {
+		case <-q.stop:
+			return
+		case <-ticker.C:
+		case <-q.wake:
+		}
+	}
+}
+
+// claim leases as many jobs as there are free worker slots and starts them
+func (q *Queue) claim(ctx context.Context) {
+	free := cap(q.slots) - len(q.slots)
+	if free <= 0 {
+		return
+	}
+
+	jobs, err := q.repo.ClaimJobs(ctx, q.owner, free, q.lease)
+	if err != nil {
+		apperr.Handle(ctx, goerr.Wrap(err, "failed to claim jobs"))
+		return
+	}
+
+	for _, job := range jobs {
+		q.slots <- struct{}{}
+		q.workers.Add(1)
+		go func() {
+			defer func() {
+				<-q.slots
+				q.workers.Done()
+				q.notify()
+			}()
+			q.run(ctx, job)
+		}()
+	}
+}
+
+// run executes a claimed job and records its outcome
+func (q *Queue) run(ctx context.Context, job *model.Job) {
+	logger := ctxlog.From(ctx).With("jobID", job.ID, "kind", job.Kind, "attempt", job.Attempts)
+	if job.RequestID != "" {
+		logger = logger.With("request_id", job.RequestID)
+	}
+
+	ctx, span := tracing.Start(tracing.Extract(ctx, job.TraceParent), "job "+job.Kind,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("job.id", job.ID.String()),
+			attribute.String("job.kind", job.Kind),
+			attribute.Int("job.attempt", job.Attempts),
+		),
+	)
+	if traceID := tracing.TraceID(ctx); traceID != "" {
+		ctx = async.WithTraceID(ctx, traceID)
+		logger = logger.With("trace_id", traceID)
+	}
+	ctx = ctxlog.With(ctx, logger)
+	observeStart(job.Kind, job.RunAt)
+	started := time.Now()
+
+	q.mu.RLock()
+	cfg, ok := q.kinds[job.Kind]
+	q.mu.RUnlock()
+
+	leaseToken := job.LeaseToken
+	stopHeartbeat := q.heartbeat(ctx, job)
+
+	var err error
+	if !ok {
+		err = goerr.New("no handler registered for job kind", goerr.V("kind", job.Kind))
+		job.Attempts = job.MaxAttempts // Retrying cannot help
+	} else {
+		err = safeCall(ctx, cfg.handler, job.Payload)
+	}
+	stopHeartbeat()
+	jobDuration.Observe(time.Since(started).Seconds(), job.Kind)
+	tracing.End(span, err)
+
+	if err == nil {
+		jobRuns.Inc(job.Kind, "success")
+		if err := q.repo.CompleteJob(ctx, job.ID, q.owner, leaseToken); err != nil {
+			apperr.Handle(ctx, goerr.Wrap(err, "failed to complete job"))
+		}
+		logger.Debug("Job completed")
+		return
+	}
+
+	now := time.Now()
+	job.LastError = err.Error()
+	job.LeaseOwner = ""
+	job.LeaseUntil = time.Time{}
+	job.LeaseToken = ""
+	job.UpdatedAt = now
+
+	if job.Attempts >= job.MaxAttempts {
+		job.Status = types.JobStatusDead
+		jobRuns.Inc(job.Kind, "dead")
+		logger.Error("Job failed permanently, moved to dead letter", "error", err)
+	} else {
+		job.Status = types.JobStatusPending
+		job.RunAt = now.Add(q.backoff(job.Attempts))
+		jobRuns.Inc(job.Kind, "retry")
+		logger.Warn("Job failed, scheduling retry", "error", err, "runAt", job.RunAt)
+	}
+
+	if err := q.repo.FailJob(ctx, job, q.owner, leaseToken); err != nil {
+		apperr.Handle(ctx, goerr.Wrap(err, "failed to record job failure"))
+	}
+}
+
+// heartbeat renews the lease of a running job every third of the lease until the
+// returned function is called. If the lease is lost, the job may run again elsewhere
+// and the outcome of this run is not recorded.
+func (q *Queue) heartbeat(ctx context.Context, job *model.Job) func() {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(q.lease / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				err := q.repo.RenewJobLease(ctx, job.ID, q.owner, job.LeaseToken, time.Now().Add(q.lease))
+				if errors.Is(err, model.ErrJobLeaseLost) {
+					ctxlog.From(ctx).Warn("Job lease lost while running", "jobID", job.ID, "kind", job.Kind)
+					return
+				}
+				if err != nil {
+					apperr.Handle(ctx, goerr.Wrap(err, "failed to renew job lease", goerr.V("jobID", job.ID)))
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// backoff returns the retry delay after the given number of attempts
+func (q *Queue) backoff(attempts int) time.Duration {
+	d := q.baseBackoff
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= q.maxBackoff {
+			return q.maxBackoff
+		}
+	}
+	return d
+}
+
+// safeCall runs the handler and converts a panic into an error
+func safeCall(ctx context.Context, handler Handler, payload []byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			ctxlog.From(ctx).Error("Panic in job handler",
+				"recover", r,
+				"stack", string(debug.Stack()),
+			)
+			err = goerr.New("panic in job handler", goerr.V("recover", r))
+		}
+	}()
+
+	return handler(ctx, payload)
+}
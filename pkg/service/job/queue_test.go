@@ -0,0 +1,322 @@
+package job_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	"github.com/secmon-lab/lycaon/pkg/service/job"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newTestQueue(t *testing.T, opts ...job.Option) (*job.Queue, context.Context) {
+	repo := repository.NewMemory()
+	opts = append([]job.Option{
+		job.WithPollInterval(10 * time.Millisecond),
+		job.WithBackoff(10*time.Millisecond, 50*time.Millisecond),
+	}, opts...)
+	return job.New(repo, opts...), context.Background()
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatal("condition not met within timeout")
+}
+
+func TestQueue(t *testing.T) {
+	t.Run("runs enqueued job and removes it", func(t *testing.T) {
+		repo := repository.NewMemory()
+		q := job.New(repo, job.WithPollInterval(10*time.Millisecond))
+		ctx := context.Background()
+
+		received := make(chan string, 1)
+		q.Register("echo", func(ctx context.Context, payload []byte) error {
+			received <- string(payload)
+			return nil
+		})
+		q.Start(ctx)
+		defer func() { gt.NoError(t, q.Shutdown(ctx)) }()
+
+		enqueued, err := q.Enqueue(ctx, "echo", []byte("hello"))
+		gt.NoError(t, err).Required()
+
+		select {
+		case got := <-received:
+			gt.Equal(t, "hello", got)
+		case <-time.After(2 * time.Second):
+			t.Fatal("job did not run")
+		}
+
+		waitFor(t, func() bool {
+			_, err := repo.GetJob(ctx, enqueued.ID)
+			return errors.Is(err, model.ErrJobNotFound)
+		})
+	})
+
+	t.Run("retries failed job with backoff until success", func(t *testing.T) {
+		q, ctx := newTestQueue(t)
+
+		var calls atomic.Int32
+		q.Register("flaky", func(ctx context.Context, payload []byte) error {
+			if calls.Add(1) < 3 {
+				return errors.New("temporary failure")
+			}
+			return nil
+		})
+		q.Start(ctx)
+		defer func() { gt.NoError(t, q.Shutdown(ctx)) }()
+
+		_, err := q.Enqueue(ctx, "flaky", nil)
+		gt.NoError(t, err).Required()
+
+		waitFor(t, func() bool { return calls.Load() == 3 })
+	})
+
+	t.Run("dead-letters job after max attempts and retries on request", func(t *testing.T) {
+		q, ctx := newTestQueue(t, job.WithMaxAttempts(2))
+
+		var calls atomic.Int32
+		var fail atomic.Bool
+		fail.Store(true)
+		q.Register("broken", func(ctx context.Context, payload []byte) error {
+			calls.Add(1)
+			if fail.Load() {
+				return errors.New("permanent failure")
+			}
+			return nil
+		})
+		q.Start(ctx)
+		defer func() { gt.NoError(t, q.Shutdown(ctx)) }()
+
+		enqueued, err := q.Enqueue(ctx, "broken", nil)
+		gt.NoError(t, err).Required()
+
+		var dead []*model.Job
+		waitFor(t, func() bool {
+			dead, err = q.ListDeadLetters(ctx, 10)
+			gt.NoError(t, err)
+			return len(dead) == 1
+		})
+		gt.Equal(t, enqueued.ID, dead[0].ID)
+		gt.Equal(t, 2, dead[0].Attempts)
+		gt.Equal(t, "permanent failure", dead[0].LastError)
+		gt.Equal(t, int32(2), calls.Load())
+
+		fail.Store(false)
+		gt.NoError(t, q.Retry(ctx, enqueued.ID)).Required()
+		waitFor(t, func() bool { return calls.Load() == 3 })
+		waitFor(t, func() bool {
+			dead, err = q.ListDeadLetters(ctx, 10)
+			gt.NoError(t, err)
+			return len(dead) == 0
+		})
+	})
+
+	t.Run("per-kind max attempts overrides default", func(t *testing.T) {
+		q, ctx := newTestQueue(t, job.WithMaxAttempts(5))
+
+		var calls atomic.Int32
+		q.Register("once", func(ctx context.Context, payload []byte) error {
+			calls.Add(1)
+			return errors.New("failure")
+		}, job.WithKindMaxAttempts(1))
+		q.Start(ctx)
+		defer func() { gt.NoError(t, q.Shutdown(ctx)) }()
+
+		_, err := q.Enqueue(ctx, "once", nil)
+		gt.NoError(t, err).Required()
+
+		waitFor(t, func() bool {
+			dead, err := q.ListDeadLetters(ctx, 10)
+			gt.NoError(t, err)
+			return len(dead) == 1
+		})
+		gt.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("panic in handler is treated as failure", func(t *testing.T) {
+		q, ctx := newTestQueue(t, job.WithMaxAttempts(1))
+
+		q.Register("panics", func(ctx context.Context, payload []byte) error {
+			panic("boom")
+		})
+		q.Start(ctx)
+		defer func() { gt.NoError(t, q.Shutdown(ctx)) }()
+
+		_, err := q.Enqueue(ctx, "panics", nil)
+		gt.NoError(t, err).Required()
+
+		waitFor(t, func() bool {
+			dead, err := q.ListDeadLetters(ctx, 10)
+			gt.NoError(t, err)
+			return len(dead) == 1
+		})
+	})
+
+	t.Run("bounded concurrency", func(t *testing.T) {
+		q, ctx := newTestQueue(t, job.WithConcurrency(2))
+
+		var running, peak, done atomic.Int32
+		q.Register("slow", func(ctx context.Context, payload []byte) error {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(30 * time.Millisecond)
+			running.Add(-1)
+			done.Add(1)
+			return nil
+		})
+		q.Start(ctx)
+		defer func() { gt.NoError(t, q.Shutdown(ctx)) }()
+
+		for range 6 {
+			_, err := q.Enqueue(ctx, "slow", nil)
+			gt.NoError(t, err).Required()
+		}
+
+		waitFor(t, func() bool { return done.Load() == 6 })
+		gt.True(t, peak.Load() <= 2)
+	})
+
+	t.Run("shutdown drains running jobs", func(t *testing.T) {
+		q, ctx := newTestQueue(t)
+
+		started := make(chan struct{})
+		var finished atomic.Bool
+		q.Register("drain", func(ctx context.Context, payload []byte) error {
+			close(started)
+			time.Sleep(100 * time.Millisecond)
+			finished.Store(true)
+			return nil
+		})
+		q.Start(ctx)
+
+		_, err := q.Enqueue(ctx, "drain", nil)
+		gt.NoError(t, err).Required()
+		<-started
+
+		shutdownCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+		defer cancel()
+		gt.NoError(t, q.Shutdown(shutdownCtx))
+		gt.True(t, finished.Load())
+	})
+
+	t.Run("lease is renewed while the handler runs", func(t *testing.T) {
+		repo := repository.NewMemory()
+		q := job.New(repo, job.WithPollInterval(10*time.Millisecond), job.WithLease(60*time.Millisecond))
+		ctx := context.Background()
+
+		var runs atomic.Int32
+		q.Register("slow", func(ctx context.Context, payload []byte) error {
+			runs.Add(1)
+			time.Sleep(300 * time.Millisecond)
+			return nil
+		})
+		q.Start(ctx)
+		defer func() { gt.NoError(t, q.Shutdown(ctx)) }()
+
+		enqueued, err := q.Enqueue(ctx, "slow", nil)
+		gt.NoError(t, err).Required()
+
+		waitFor(t, func() bool {
+			_, err := repo.GetJob(ctx, enqueued.ID)
+			return errors.Is(err, model.ErrJobNotFound)
+		})
+		// The job outlived its lease several times but was never reclaimed
+		gt.Equal(t, int32(1), runs.Load())
+	})
+
+	t.Run("pending jobs survive restart", func(t *testing.T) {
+		repo := repository.NewMemory()
+		ctx := context.Background()
+
+		// First process accepts the job but never starts workers
+		first := job.New(repo)
+		first.Register("durable", func(ctx context.Context, payload []byte) error { return nil })
+		_, err := first.Enqueue(ctx, "durable", []byte("persisted"))
+		gt.NoError(t, err).Required()
+		gt.NoError(t, first.Shutdown(ctx))
+
+		// Second process picks it up
+		received := make(chan string, 1)
+		second := job.New(repo, job.WithPollInterval(10*time.Millisecond))
+		second.Register("durable", func(ctx context.Context, payload []byte) error {
+			received <- string(payload)
+			return nil
+		})
+		second.Start(ctx)
+		defer func() { gt.NoError(t, second.Shutdown(ctx)) }()
+
+		select {
+		case got := <-received:
+			gt.Equal(t, "persisted", got)
+		case <-time.After(2 * time.Second):
+			t.Fatal("persisted job did not run after restart")
+		}
+	})
+
+	t.Run("enqueue unknown kind fails", func(t *testing.T) {
+		q, ctx := newTestQueue(t)
+		_, err := q.Enqueue(ctx, "unknown", nil)
+		gt.Error(t, err)
+	})
+
+	t.Run("retry rejects non dead-lettered job", func(t *testing.T) {
+		q, ctx := newTestQueue(t)
+		q.Register("noop", func(ctx context.Context, payload []byte) error { return nil })
+
+		enqueued, err := q.Enqueue(ctx, "noop", nil)
+		gt.NoError(t, err).Required()
+		gt.Error(t, q.Retry(ctx, enqueued.ID))
+		gt.Error(t, q.Retry(ctx, types.JobID("missing")))
+	})
+
+	t.Run("job continues the trace of the enqueuer", func(t *testing.T) {
+		q, ctx := newTestQueue(t)
+		traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+		gt.NoError(t, err).Required()
+		spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+		gt.NoError(t, err).Required()
+		enqueueCtx := trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		}))
+
+		received := make(chan trace.TraceID, 1)
+		q.Register("traced", func(ctx context.Context, payload []byte) error {
+			received <- trace.SpanContextFromContext(ctx).TraceID()
+			return nil
+		})
+		q.Start(ctx)
+		defer func() { gt.NoError(t, q.Shutdown(ctx)) }()
+
+		enqueued, err := q.Enqueue(enqueueCtx, "traced", nil)
+		gt.NoError(t, err).Required()
+		gt.S(t, enqueued.TraceParent).HasPrefix("00-4bf92f3577b34da6a3ce929d0e0e4736-")
+
+		select {
+		case got := <-received:
+			gt.Equal(t, traceID, got)
+		case <-time.After(2 * time.Second):
+			t.Fatal("job did not run")
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package llm
+
+import (
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/utils/metrics"
+)
+
+var (
+	analysisDuration = metrics.NewHistogramVec("lycaon_llm_analysis_duration_seconds",
+		"Latency of incident analyses by the LLM by result.",
+		[]float64{0.5, 1, 2.5, 5, 10, 20, 30, 60, 120}, "result")
+	analysisFailures = metrics.NewCounterVec("lycaon_llm_analysis_failures_total",
+		"Failed incident analyses by reason.", "reason")
+	llmTokens = metrics.NewCounterVec("lycaon_llm_tokens_total",
+		"Tokens used by incident analyses by direction (input or output).", "direction")
+)
+
+// recordAnalysis records the latency and outcome of an analysis started at started
+func recordAnalysis(started time.Time, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+		analysisFailures.Inc(failureReason(err))
+	}
+	analysisDuration.Observe(time.Since(started).Seconds(), result)
+}
+
+// failureReason returns the error tag of a failed analysis, or "generation_failure"
+// for errors of the LLM itself
+func failureReason(err error) string {
+	switch {
+	case goerr.HasTag(err, ErrTagTemplateFailure):
+		return ErrTagTemplateFailure.String()
+	case goerr.HasTag(err, ErrTagEmptyResponse):
+		return ErrTagEmptyResponse.String()
+	case goerr.HasTag(err, ErrTagInvalidJSON):
+		return ErrTagInvalidJSON.String()
+	case goerr.HasTag(err, ErrTagMissingField):
+		return ErrTagMissingField.String()
+	default:
+		return "generation_failure"
+	}
+}
@@ -13,7 +13,10 @@ import (
 	"github.com/m-mizutani/gollem"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/utils/tracing"
 	"github.com/slack-go/slack"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Error tags for categorization
@@ -118,7 +121,14 @@ func (s *LLMService) AnalyzeIncidentWithContext(ctx context.Context, messages []
 
 // analyze performs the common LLM analysis logic
 // This is the shared implementation used by both AnalyzeIncident and AnalyzeIncidentWithContext
-func (s *LLMService) analyze(ctx context.Context, templateData IncidentAnalysisTemplateData, config *model.Config) (*IncidentSummary, error) {
+func (s *LLMService) analyze(ctx context.Context, templateData IncidentAnalysisTemplateData, config *model.Config) (_ *IncidentSummary, err error) {
+	started := time.Now()
+	ctx, span := tracing.Start(ctx, "llm.analyze", trace.WithSpanKind(trace.SpanKindClient))
+	defer func() {
+		recordAnalysis(started, err)
+		tracing.End(span, err)
+	}()
+
 	// Generate prompt using the unified template
 	prompt, err := s.renderIncidentAnalysisTemplate(templateData)
 	if err != nil {
@@ -137,6 +147,12 @@ func (s *LLMService) analyze(ctx context.Context, templateData IncidentAnalysisT
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to generate LLM response")
 	}
+	llmTokens.Add(float64(response.InputToken), "input")
+	llmTokens.Add(float64(response.OutputToken), "output")
+	span.SetAttributes(
+		attribute.Int("gen_ai.usage.input_tokens", response.InputToken),
+		attribute.Int("gen_ai.usage.output_tokens", response.OutputToken),
+	)
 
 	// Check if response has content
 	if len(response.Texts) == 0 || response.Texts[0] == "" {
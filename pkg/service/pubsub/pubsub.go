@@ -0,0 +1,262 @@
+package pubsub
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/service/audit"
+)
+
+// Kind is the kind of a change event
+type Kind string
+
+const (
+	// KindIncidentUpdated carries an incident that was created or changed
+	KindIncidentUpdated Kind = "incident_updated"
+	// KindTaskUpdated carries a task that was created or changed
+	KindTaskUpdated Kind = "task_updated"
+	// KindTaskDeleted carries the last state of a task that was deleted
+	KindTaskDeleted Kind = "task_deleted"
+	// KindStatusChanged carries the status history entry of a status change
+	KindStatusChanged Kind = "status_changed"
+	// KindTimelineEventAdded carries a timeline event of an incident
+	KindTimelineEventAdded Kind = "timeline_event_added"
+	// KindStakeholderUpdatePosted carries a stakeholder update of an incident
+	KindStakeholderUpdatePosted Kind = "stakeholder_update_posted"
+	// KindMessageSaved carries a Slack message that was stored. Its IncidentID is
+	// zero as messages are not resolved to incidents when saved.
+	KindMessageSaved Kind = "message_saved"
+)
+
+// Event is a change of an incident or one of its tasks. Exactly one of the
+// payload fields matching Kind is set.
+type Event struct {
+	Kind       Kind
+	IncidentID types.IncidentID
+
+	Incident *model.Incident
+	Task     *model.Task
+	Status   *model.StatusHistory
+	Timeline *model.TimelineEvent
+	Message  *model.Message
+	Update   *model.StakeholderUpdate
+}
+
+const defaultBufferSize = 64
+
+// Broker fans out change events to subscribers within this process. A nil
+// Broker is valid and drops every event, so publishers need no nil checks.
+type Broker struct {
+	mu         sync.RWMutex
+	subs       map[*subscription]struct{}
+	bufferSize int
+}
+
+type subscription struct {
+	ch    chan Event
+	match func(Event) bool
+}
+
+// Option configures a Broker
+type Option func(*Broker)
+
+// WithBufferSize sets how many events may queue up per subscriber. Events for
+// a subscriber whose buffer is full are dropped rather than blocking publishers.
+func WithBufferSize(n int) Option {
+	return func(b *Broker) {
+		if n > 0 {
+			b.bufferSize = n
+		}
+	}
+}
+
+// New creates a new Broker
+func New(opts ...Option) *Broker {
+	b := &Broker{
+		subs:       make(map[*subscription]struct{}),
+		bufferSize: defaultBufferSize,
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// Subscribe returns a channel receiving the events for which match returns true,
+// or all events if match is nil. The channel is closed when ctx is done.
+func (b *Broker) Subscribe(ctx context.Context, match func(Event) bool) <-chan Event {
+	sub := &subscription{
+		ch:    make(chan Event, b.bufferSize),
+		match: match,
+	}
+
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subs, sub)
+		close(sub.ch)
+		b.mu.Unlock()
+	}()
+
+	return sub.ch
+}
+
+// Publish delivers ev to all matching subscribers without blocking
+func (b *Broker) Publish(ctx context.Context, ev Event) {
+	if b == nil {
+		return
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for sub := range b.subs {
+		if sub.match != nil && !sub.match(ev) {
+			continue
+		}
+		select {
+		case sub.ch <- ev:
+		default:
+			ctxlog.From(ctx).Warn("Subscriber buffer is full, dropping event",
+				"kind", ev.Kind,
+				"incidentID", ev.IncidentID,
+			)
+		}
+	}
+}
+
+// PublishIncident publishes a change of incident and adds a timeline event of kind.
+// An empty kind publishes bookkeeping changes such as channel members without a
+// timeline event. actorID may be empty when the actor is taken from the audit actor of ctx.
+func (b *Broker) PublishIncident(ctx context.Context, kind types.TimelineEventKind, incident *model.Incident, actorID string) {
+	if b == nil || incident == nil {
+		return
+	}
+
+	// Publish a copy so that later changes by the caller are not seen by subscribers
+	snapshot := *incident
+	b.Publish(ctx, Event{Kind: KindIncidentUpdated, IncidentID: incident.ID, Incident: &snapshot})
+	if kind == "" {
+		return
+	}
+
+	summary := "Incident updated"
+	if kind == types.TimelineEventIncidentCreated {
+		summary = fmt.Sprintf("Incident declared: %s", incident.Title)
+	}
+	b.publishTimeline(ctx, incident.ID, kind, actorID, summary)
+}
+
+// PublishRelation publishes a change of the relationships of incident and adds a
+// timeline event of kind with summary, which names the related incident
+func (b *Broker) PublishRelation(ctx context.Context, kind types.TimelineEventKind, incident *model.Incident, actorID, summary string) {
+	if b == nil || incident == nil {
+		return
+	}
+
+	snapshot := *incident
+	b.Publish(ctx, Event{Kind: KindIncidentUpdated, IncidentID: incident.ID, Incident: &snapshot})
+	b.publishTimeline(ctx, incident.ID, kind, actorID, summary)
+}
+
+// PublishTask publishes a change of task and adds a timeline event of kind
+func (b *Broker) PublishTask(ctx context.Context, kind types.TimelineEventKind, task *model.Task, actorID string) {
+	if b == nil || task == nil {
+		return
+	}
+
+	var summary string
+	switch kind {
+	case types.TimelineEventTaskCreated:
+		summary = fmt.Sprintf("Task created: %s", task.Title)
+	case types.TimelineEventTaskDeleted:
+		summary = fmt.Sprintf("Task deleted: %s", task.Title)
+	default:
+		summary = fmt.Sprintf("Task updated: %s (%s)", task.Title, task.Status)
+	}
+
+	snapshot := *task
+	if kind == types.TimelineEventTaskDeleted {
+		b.Publish(ctx, Event{Kind: KindTaskDeleted, IncidentID: task.IncidentID, Task: &snapshot})
+	} else {
+		b.Publish(ctx, Event{Kind: KindTaskUpdated, IncidentID: task.IncidentID, Task: &snapshot})
+	}
+	b.publishTimeline(ctx, task.IncidentID, kind, actorID, summary)
+}
+
+// PublishStatus publishes a status change of incident, which already has the new status
+func (b *Broker) PublishStatus(ctx context.Context, incident *model.Incident, history *model.StatusHistory) {
+	if b == nil || incident == nil || history == nil {
+		return
+	}
+
+	snapshot := *incident
+	entry := *history
+	b.Publish(ctx, Event{Kind: KindStatusChanged, IncidentID: incident.ID, Status: &entry})
+	b.Publish(ctx, Event{Kind: KindIncidentUpdated, IncidentID: incident.ID, Incident: &snapshot})
+
+	kind, summary := types.TimelineEventStatusChanged, fmt.Sprintf("Status changed to %s", history.Status)
+	if history.Reopened {
+		kind, summary = types.TimelineEventIncidentReopened, fmt.Sprintf("Reopened as %s", history.Status)
+	}
+	if history.Note != "" {
+		summary += ": " + history.Note
+	}
+	b.publishTimeline(ctx, incident.ID, kind, history.ChangedBy.String(), summary)
+}
+
+// PublishMessage publishes a Slack message that was stored
+func (b *Broker) PublishMessage(ctx context.Context, message *model.Message) {
+	if b == nil || message == nil {
+		return
+	}
+
+	snapshot := *message
+	b.Publish(ctx, Event{Kind: KindMessageSaved, Message: &snapshot})
+}
+
+// PublishStakeholderUpdate publishes a stakeholder update that was posted
+func (b *Broker) PublishStakeholderUpdate(ctx context.Context, update *model.StakeholderUpdate) {
+	if b == nil || update == nil {
+		return
+	}
+
+	snapshot := *update
+	b.Publish(ctx, Event{Kind: KindStakeholderUpdatePosted, IncidentID: update.IncidentID, Update: &snapshot})
+	b.publishTimeline(ctx, update.IncidentID, types.TimelineEventStakeholderUpdate, update.PostedBy.String(),
+		"Stakeholder update: "+update.Text)
+}
+
+func (b *Broker) publishTimeline(ctx context.Context, incidentID types.IncidentID, kind types.TimelineEventKind, actorID, summary string) {
+	if actorID == "" {
+		if actor, ok := model.GetAuditActor(ctx); ok {
+			actorID = actor.ID
+		}
+	}
+	if actorID == "" {
+		if authCtx, ok := model.GetAuthContext(ctx); ok && authCtx != nil {
+			actorID = audit.ActorIDFromAuthContext(authCtx)
+		}
+	}
+
+	b.Publish(ctx, Event{
+		Kind:       KindTimelineEventAdded,
+		IncidentID: incidentID,
+		Timeline: &model.TimelineEvent{
+			IncidentID: incidentID,
+			Kind:       kind,
+			ActorID:    actorID,
+			Summary:    summary,
+			Timestamp:  time.Now(),
+		},
+	})
+}
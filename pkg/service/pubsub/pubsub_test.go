@@ -0,0 +1,163 @@
+package pubsub_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/service/pubsub"
+)
+
+func receive(t *testing.T, ch <-chan pubsub.Event) pubsub.Event {
+	t.Helper()
+	select {
+	case ev, ok := <-ch:
+		gt.True(t, ok)
+		return ev
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+		return pubsub.Event{}
+	}
+}
+
+func TestBroker(t *testing.T) {
+	t.Run("delivers matching events", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		broker := pubsub.New()
+		tasks := broker.Subscribe(ctx, func(ev pubsub.Event) bool {
+			return ev.Kind == pubsub.KindTaskUpdated
+		})
+
+		broker.PublishTask(ctx, types.TimelineEventTaskCreated, &model.Task{
+			ID:         "task-1",
+			IncidentID: 7,
+			Title:      "Rotate keys",
+		}, "U-ALICE")
+
+		ev := receive(t, tasks)
+		gt.Equal(t, ev.IncidentID, types.IncidentID(7))
+		gt.Equal(t, ev.Task.Title, "Rotate keys")
+
+		select {
+		case ev := <-tasks:
+			t.Fatalf("unexpected event %s", ev.Kind)
+		default:
+		}
+	})
+
+	t.Run("adds timeline events", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		broker := pubsub.New()
+		timeline := broker.Subscribe(ctx, func(ev pubsub.Event) bool {
+			return ev.Kind == pubsub.KindTimelineEventAdded
+		})
+
+		actorCtx := model.WithAuditActor(ctx, model.AuditActor{ID: "U-BOB", Source: types.AuditSourceGraphQL})
+		broker.PublishStatus(actorCtx, &model.Incident{ID: 7, Status: types.IncidentStatusHandling}, &model.StatusHistory{
+			IncidentID: 7,
+			Status:     types.IncidentStatusHandling,
+			ChangedBy:  "U-ALICE",
+			Note:       "Root cause found",
+		})
+		broker.PublishTask(actorCtx, types.TimelineEventTaskDeleted, &model.Task{IncidentID: 7, Title: "Obsolete"}, "")
+
+		ev := receive(t, timeline)
+		gt.Equal(t, ev.Timeline.Kind, types.TimelineEventStatusChanged)
+		gt.Equal(t, ev.Timeline.ActorID, "U-ALICE")
+		gt.Equal(t, ev.Timeline.Summary, "Status changed to handling: Root cause found")
+
+		ev = receive(t, timeline)
+		gt.Equal(t, ev.Timeline.Kind, types.TimelineEventTaskDeleted)
+		gt.Equal(t, ev.Timeline.ActorID, "U-BOB")
+	})
+
+	t.Run("publishes deleted tasks and saved messages", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		broker := pubsub.New()
+		events := broker.Subscribe(ctx, func(ev pubsub.Event) bool {
+			return ev.Kind == pubsub.KindTaskDeleted || ev.Kind == pubsub.KindMessageSaved
+		})
+
+		broker.PublishTask(ctx, types.TimelineEventTaskDeleted, &model.Task{ID: "task-1", IncidentID: 7}, "U-ALICE")
+		broker.PublishMessage(ctx, &model.Message{ID: "msg-1", ChannelID: "C-INC", Text: "rolled back"})
+
+		ev := receive(t, events)
+		gt.Equal(t, ev.Kind, pubsub.KindTaskDeleted)
+		gt.Equal(t, ev.Task.ID, types.TaskID("task-1"))
+
+		ev = receive(t, events)
+		gt.Equal(t, ev.Message.Text, "rolled back")
+	})
+
+	t.Run("publishes snapshots", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		broker := pubsub.New()
+		events := broker.Subscribe(ctx, nil)
+
+		incident := &model.Incident{ID: 7, Title: "Before"}
+		broker.PublishIncident(ctx, "", incident, "")
+		incident.Title = "After"
+
+		ev := receive(t, events)
+		gt.Equal(t, ev.Kind, pubsub.KindIncidentUpdated)
+		gt.Equal(t, ev.Incident.Title, "Before")
+
+		// An empty timeline kind only publishes the incident
+		select {
+		case ev := <-events:
+			t.Fatalf("unexpected event %s", ev.Kind)
+		default:
+		}
+	})
+
+	t.Run("drops events for full subscribers", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		broker := pubsub.New(pubsub.WithBufferSize(1))
+		events := broker.Subscribe(ctx, nil)
+
+		broker.Publish(ctx, pubsub.Event{Kind: pubsub.KindIncidentUpdated, IncidentID: 1})
+		broker.Publish(ctx, pubsub.Event{Kind: pubsub.KindIncidentUpdated, IncidentID: 2})
+
+		gt.Equal(t, receive(t, events).IncidentID, types.IncidentID(1))
+		select {
+		case ev := <-events:
+			t.Fatalf("unexpected event for incident %d", ev.IncidentID)
+		default:
+		}
+	})
+
+	t.Run("closes the channel when the subscriber leaves", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		broker := pubsub.New()
+		events := broker.Subscribe(ctx, nil)
+		cancel()
+
+		select {
+		case _, ok := <-events:
+			gt.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("channel not closed")
+		}
+
+		// Publishing after the subscriber left must not panic
+		broker.Publish(context.Background(), pubsub.Event{Kind: pubsub.KindIncidentUpdated})
+	})
+
+	t.Run("nil broker drops events", func(t *testing.T) {
+		var broker *pubsub.Broker
+		broker.PublishIncident(context.Background(), types.TimelineEventIncidentUpdated, &model.Incident{ID: 1}, "")
+	})
+}
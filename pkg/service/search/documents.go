@@ -0,0 +1,110 @@
+package search
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+// IncidentDocument converts an incident into its search document
+func IncidentDocument(incident *model.Incident) model.SearchDocument {
+	return model.SearchDocument{
+		ID:         IncidentDocumentID(incident.ID),
+		Kind:       types.SearchKindIncident,
+		IncidentID: incident.ID,
+		ChannelID:  incident.ChannelID,
+		MessageTS:  incident.WelcomeMessageTS,
+		Title:      incident.Title,
+		Text:       incident.Description,
+		Timestamp:  incident.CreatedAt,
+	}
+}
+
+// IncidentDocumentID returns the ID of the search document of an incident
+func IncidentDocumentID(id types.IncidentID) string {
+	return fmt.Sprintf("incident:%d", id)
+}
+
+// TaskDocument converts a task into its search document
+func TaskDocument(task *model.Task) model.SearchDocument {
+	return model.SearchDocument{
+		ID:         TaskDocumentID(task.ID),
+		Kind:       types.SearchKindTask,
+		IncidentID: task.IncidentID,
+		ChannelID:  task.ChannelID,
+		MessageTS:  task.MessageTS,
+		Title:      task.Title,
+		Text:       task.Description,
+		Timestamp:  task.CreatedAt,
+	}
+}
+
+// TaskDocumentID returns the ID of the search document of a task
+func TaskDocumentID(id types.TaskID) string {
+	return "task:" + id.String()
+}
+
+// StatusDocument converts a status change into a timeline search document.
+// channelID is the channel of the incident.
+func StatusDocument(history *model.StatusHistory, channelID types.ChannelID) model.SearchDocument {
+	title := fmt.Sprintf("Status changed to %s", history.Status)
+	if history.Reopened {
+		title = fmt.Sprintf("Reopened as %s", history.Status)
+	}
+	return model.SearchDocument{
+		ID:         "status:" + history.ID.String(),
+		Kind:       types.SearchKindTimeline,
+		IncidentID: history.IncidentID,
+		ChannelID:  channelID,
+		Title:      title,
+		Text:       history.Note,
+		Timestamp:  history.ChangedAt,
+	}
+}
+
+// StakeholderUpdateDocument converts a stakeholder update into a timeline search
+// document. channelID is the channel of the incident.
+func StakeholderUpdateDocument(update *model.StakeholderUpdate, channelID types.ChannelID) model.SearchDocument {
+	return model.SearchDocument{
+		ID:         "update:" + update.ID.String(),
+		Kind:       types.SearchKindTimeline,
+		IncidentID: update.IncidentID,
+		ChannelID:  channelID,
+		Title:      "Stakeholder update",
+		Text:       update.Text,
+		Timestamp:  update.PostedAt,
+	}
+}
+
+// MessageDocument converts a stored Slack message into its search document. The
+// incident is resolved from the channel when searching.
+func MessageDocument(message *model.Message) model.SearchDocument {
+	timestamp := message.Timestamp
+	if timestamp.IsZero() {
+		timestamp = slackTime(string(message.EventTS))
+	}
+
+	return model.SearchDocument{
+		ID:        "message:" + string(message.ChannelID) + ":" + string(message.ID),
+		Kind:      types.SearchKindMessage,
+		ChannelID: message.ChannelID,
+		MessageTS: string(message.EventTS),
+		Title:     message.UserName,
+		Text:      message.Text,
+		Timestamp: timestamp,
+	}
+}
+
+// slackTime parses a Slack timestamp such as "1234567890.123456"
+func slackTime(ts string) time.Time {
+	sec, _, _ := strings.Cut(ts, ".")
+	n, err := strconv.ParseInt(sec, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(n, 0)
+}
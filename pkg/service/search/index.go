@@ -0,0 +1,417 @@
+package search
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+const (
+	// titleBoost weighs terms in titles over terms in the text
+	titleBoost = 2
+	// fragmentLength is the number of runes shown around the first match of a field
+	fragmentLength = 160
+	// defaultRefreshInterval is how often Run reads changes made through other replicas
+	defaultRefreshInterval = time.Minute
+	// defaultRebuildInterval is how often Run rebuilds the whole index
+	defaultRebuildInterval = time.Hour
+	// defaultMessageRetention is how long Slack messages stay searchable after they were posted
+	defaultMessageRetention = 90 * 24 * time.Hour
+)
+
+// Index is an in-memory full-text index of incident content. Query terms match
+// words they are a prefix of, and a document must match all terms of a query.
+//
+// Every process keeps its own index, backfilled from the shared repository on
+// start and then updated from the changes it publishes itself. Changes made by
+// other replicas are read from the repository every refresh interval, and tasks
+// they deleted are dropped when the index is rebuilt every rebuild interval.
+type Index struct {
+	refreshInterval  time.Duration
+	rebuildInterval  time.Duration
+	messageRetention time.Duration
+
+	mu   sync.RWMutex
+	docs map[string]*entry
+	// postings maps a word to the weighted frequency of it per document ID
+	postings map[string]map[string]int
+	// channels resolves messages to the incident of their channel
+	channels map[types.ChannelID]types.IncidentID
+}
+
+type entry struct {
+	doc   model.SearchDocument
+	words map[string]int
+}
+
+// Option configures an Index
+type Option func(*Index)
+
+// WithRefreshInterval sets how often Run reads changes made through other replicas
+func WithRefreshInterval(d time.Duration) Option {
+	return func(x *Index) {
+		if d > 0 {
+			x.refreshInterval = d
+		}
+	}
+}
+
+// WithRebuildInterval sets how often Run rebuilds the whole index
+func WithRebuildInterval(d time.Duration) Option {
+	return func(x *Index) {
+		if d > 0 {
+			x.rebuildInterval = d
+		}
+	}
+}
+
+// WithMessageRetention sets how long Slack messages stay searchable after they were posted
+func WithMessageRetention(d time.Duration) Option {
+	return func(x *Index) {
+		if d > 0 {
+			x.messageRetention = d
+		}
+	}
+}
+
+// New creates an empty Index
+func New(opts ...Option) *Index {
+	x := &Index{
+		refreshInterval:  defaultRefreshInterval,
+		rebuildInterval:  defaultRebuildInterval,
+		messageRetention: defaultMessageRetention,
+		docs:             make(map[string]*entry),
+		postings:         make(map[string]map[string]int),
+		channels:         make(map[types.ChannelID]types.IncidentID),
+	}
+	for _, opt := range opts {
+		opt(x)
+	}
+	return x
+}
+
+// replace swaps the content of the index with the content of other
+func (x *Index) replace(other *Index) {
+	other.mu.RLock()
+	defer other.mu.RUnlock()
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.docs = other.docs
+	x.postings = other.postings
+	x.channels = other.channels
+}
+
+// hasChannel reports whether channelID belongs to an indexed incident
+func (x *Index) hasChannel(channelID types.ChannelID) bool {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	_, ok := x.channels[channelID]
+	return ok
+}
+
+// channelOf returns the channel of an indexed incident, or "" if it is not indexed
+func (x *Index) channelOf(incidentID types.IncidentID) types.ChannelID {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	if e, ok := x.docs[IncidentDocumentID(incidentID)]; ok {
+		return e.doc.ChannelID
+	}
+	return ""
+}
+
+// Put adds doc to the index, replacing a document with the same ID
+func (x *Index) Put(doc model.SearchDocument) {
+	words := make(map[string]int)
+	for _, w := range tokenize(doc.Title) {
+		words[w] += titleBoost
+	}
+	for _, w := range tokenize(doc.Text) {
+		words[w]++
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.remove(doc.ID)
+	x.docs[doc.ID] = &entry{doc: doc, words: words}
+	for w, n := range words {
+		if x.postings[w] == nil {
+			x.postings[w] = make(map[string]int)
+		}
+		x.postings[w][doc.ID] = n
+	}
+	if doc.Kind == types.SearchKindIncident && doc.ChannelID != "" {
+		x.channels[doc.ChannelID] = doc.IncidentID
+	}
+}
+
+// Delete removes the document with id from the index
+func (x *Index) Delete(id string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.remove(id)
+}
+
+func (x *Index) remove(id string) {
+	e, ok := x.docs[id]
+	if !ok {
+		return
+	}
+	for w := range e.words {
+		delete(x.postings[w], id)
+		if len(x.postings[w]) == 0 {
+			delete(x.postings, w)
+		}
+	}
+	delete(x.docs, id)
+}
+
+// Len returns the number of indexed documents
+func (x *Index) Len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return len(x.docs)
+}
+
+// Search returns up to limit hits for query ordered by relevance, and the number
+// of all matching documents. Documents that cannot be linked to an incident, such
+// as messages in other channels, are never returned.
+func (x *Index) Search(query string, filter model.SearchFilter, limit int) ([]model.SearchHit, int) {
+	terms := tokenize(query)
+	if len(terms) == 0 {
+		return nil, 0
+	}
+
+	// Include may be slow, so it is applied after the index is released
+	candidates := x.match(terms, filter)
+
+	hits := candidates
+	if filter.Include != nil {
+		var ids []types.IncidentID
+		for _, hit := range candidates {
+			if !slices.Contains(ids, hit.IncidentID) {
+				ids = append(ids, hit.IncidentID)
+			}
+		}
+		included := filter.Include(ids)
+		hits = slices.DeleteFunc(candidates, func(hit model.SearchHit) bool {
+			return !included[hit.IncidentID]
+		})
+	}
+
+	slices.SortFunc(hits, func(a, b model.SearchHit) int {
+		if a.Score != b.Score {
+			if a.Score > b.Score {
+				return -1
+			}
+			return 1
+		}
+		if c := b.Timestamp.Compare(a.Timestamp); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	total := len(hits)
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	for i := range hits {
+		hits[i].Highlights = highlight(&hits[i].SearchDocument, terms)
+	}
+	return hits, total
+}
+
+// match returns the documents containing all terms that pass filter, scored by
+// tf-idf of the words matched
+func (x *Index) match(terms []string, filter model.SearchFilter) []model.SearchHit {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	var scores map[string]float64
+	for _, term := range terms {
+		matched := make(map[string]float64)
+		for w, docs := range x.postings {
+			if !strings.HasPrefix(w, term) {
+				continue
+			}
+			idf := math.Log(1 + float64(len(x.docs))/float64(len(docs)))
+			for id, n := range docs {
+				if scores != nil {
+					if _, ok := scores[id]; !ok {
+						continue
+					}
+				}
+				matched[id] += float64(n) * idf
+			}
+		}
+		for id, score := range scores {
+			if _, ok := matched[id]; ok {
+				matched[id] += score
+			}
+		}
+		scores = matched
+		if len(scores) == 0 {
+			return nil
+		}
+	}
+
+	var hits []model.SearchHit
+	for id, score := range scores {
+		doc := x.docs[id].doc
+		if doc.IncidentID == 0 {
+			doc.IncidentID = x.channels[doc.ChannelID]
+		}
+		if doc.IncidentID == 0 || !matchFilter(&doc, filter) {
+			continue
+		}
+		hits = append(hits, model.SearchHit{SearchDocument: doc, Score: score})
+	}
+	return hits
+}
+
+func matchFilter(doc *model.SearchDocument, filter model.SearchFilter) bool {
+	if len(filter.Kinds) > 0 && !slices.Contains(filter.Kinds, doc.Kind) {
+		return false
+	}
+	if filter.IncidentID != 0 && doc.IncidentID != filter.IncidentID {
+		return false
+	}
+	if !filter.Since.IsZero() && doc.Timestamp.Before(filter.Since) {
+		return false
+	}
+	if !filter.Until.IsZero() && !doc.Timestamp.Before(filter.Until) {
+		return false
+	}
+	return true
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// isCJKRune reports whether r belongs to a script written without spaces between words
+func isCJKRune(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) || r == 'ー'
+}
+
+// token is a word of a text and its range of runes
+type token struct {
+	word       string
+	start, end int
+}
+
+// tokens splits runes into lower case words. Runs of CJK characters, which have
+// no word boundaries, are split into overlapping bigrams instead, so that queries
+// match any part of them of two or more characters.
+func tokens(runes []rune) []token {
+	var result []token
+	for start := 0; start < len(runes); {
+		if !isWordRune(runes[start]) {
+			start++
+			continue
+		}
+		cjk := isCJKRune(runes[start])
+		end := start
+		for end < len(runes) && isWordRune(runes[end]) && isCJKRune(runes[end]) == cjk {
+			end++
+		}
+
+		switch {
+		case !cjk || end-start == 1:
+			result = append(result, token{word: strings.ToLower(string(runes[start:end])), start: start, end: end})
+		default:
+			for i := start; i+1 < end; i++ {
+				result = append(result, token{word: string(runes[i : i+2]), start: i, end: i + 2})
+			}
+		}
+		start = end
+	}
+	return result
+}
+
+// tokenize splits text into lower case words and bigrams of CJK characters
+func tokenize(text string) []string {
+	toks := tokens([]rune(text))
+	words := make([]string, len(toks))
+	for i, t := range toks {
+		words[i] = t.word
+	}
+	return words
+}
+
+// highlight returns fragments of the title and text of doc with words starting
+// with one of terms marked
+func highlight(doc *model.SearchDocument, terms []string) []model.SearchHighlight {
+	var highlights []model.SearchHighlight
+	for _, field := range []struct {
+		name string
+		text string
+	}{
+		{name: "title", text: doc.Title},
+		{name: "text", text: doc.Text},
+	} {
+		if h, ok := highlightField(field.name, field.text, terms); ok {
+			highlights = append(highlights, h)
+		}
+	}
+	return highlights
+}
+
+func highlightField(name, text string, terms []string) (model.SearchHighlight, bool) {
+	runes := []rune(text)
+
+	var ranges []model.TextRange
+	for _, t := range tokens(runes) {
+		if !slices.ContainsFunc(terms, func(term string) bool { return strings.HasPrefix(t.word, term) }) {
+			continue
+		}
+		// Bigrams of CJK text overlap, so adjacent matches are marked as one range
+		if n := len(ranges); n > 0 && t.start <= ranges[n-1].End {
+			ranges[n-1].End = max(ranges[n-1].End, t.end)
+			continue
+		}
+		ranges = append(ranges, model.TextRange{Start: t.start, End: t.end})
+	}
+	if len(ranges) == 0 {
+		return model.SearchHighlight{}, false
+	}
+
+	// Cut a window starting a little before the first match
+	from := 0
+	if len(runes) > fragmentLength {
+		from = max(0, min(ranges[0].Start-fragmentLength/4, len(runes)-fragmentLength))
+	}
+	to := min(len(runes), from+fragmentLength)
+
+	prefix := ""
+	if from > 0 {
+		prefix = "…"
+	}
+	suffix := ""
+	if to < len(runes) {
+		suffix = "…"
+	}
+	shift := len([]rune(prefix)) - from
+
+	var visible []model.TextRange
+	for _, r := range ranges {
+		if r.Start < from || r.End > to {
+			continue
+		}
+		visible = append(visible, model.TextRange{Start: r.Start + shift, End: r.End + shift})
+	}
+
+	return model.SearchHighlight{
+		Field:    name,
+		Fragment: prefix + string(runes[from:to]) + suffix,
+		Ranges:   visible,
+	}, true
+}
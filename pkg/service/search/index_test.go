@@ -0,0 +1,313 @@
+package search_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	"github.com/secmon-lab/lycaon/pkg/service/pubsub"
+	"github.com/secmon-lab/lycaon/pkg/service/search"
+)
+
+func hitIDs(hits []model.SearchHit) []string {
+	ids := make([]string, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.ID
+	}
+	return ids
+}
+
+func TestIndexSearch(t *testing.T) {
+	now := time.Now()
+	index := search.New()
+	index.Put(search.IncidentDocument(&model.Incident{
+		ID:          1,
+		Title:       "Database outage",
+		Description: "Primary replica stopped responding",
+		ChannelID:   "C-INC-1",
+		CreatedAt:   now.Add(-2 * time.Hour),
+	}))
+	index.Put(search.IncidentDocument(&model.Incident{
+		ID:          2,
+		Title:       "Login errors",
+		Description: "Users see errors after the database migration",
+		ChannelID:   "C-INC-2",
+		CreatedAt:   now.Add(-time.Hour),
+	}))
+	index.Put(search.TaskDocument(&model.Task{
+		ID:         "task-1",
+		IncidentID: 1,
+		Title:      "Fail over the database",
+		ChannelID:  "C-INC-1",
+		MessageTS:  "1700000000.000100",
+		CreatedAt:  now,
+	}))
+	index.Put(search.MessageDocument(&model.Message{
+		ID:        "msg-1",
+		ChannelID: "C-INC-1",
+		Text:      "Replica lag is back to normal",
+		EventTS:   "1700000100.000200",
+	}))
+	index.Put(search.MessageDocument(&model.Message{
+		ID:        "msg-2",
+		ChannelID: "C-RANDOM",
+		Text:      "Anyone seen the replica dashboard?",
+		EventTS:   "1700000200.000300",
+	}))
+
+	t.Run("ranks title matches first", func(t *testing.T) {
+		hits, total := index.Search("database", model.SearchFilter{}, 10)
+		gt.Equal(t, total, 3)
+		gt.Equal(t, hitIDs(hits), []string{"task:task-1", "incident:1", "incident:2"})
+	})
+
+	t.Run("requires all terms and matches prefixes", func(t *testing.T) {
+		hits, _ := index.Search("datab migr", model.SearchFilter{}, 10)
+		gt.Equal(t, hitIDs(hits), []string{"incident:2"})
+	})
+
+	t.Run("resolves messages to the incident of their channel", func(t *testing.T) {
+		hits, total := index.Search("replica", model.SearchFilter{Kinds: []types.SearchDocumentKind{types.SearchKindMessage}}, 10)
+		gt.Equal(t, total, 1)
+		gt.Equal(t, hits[0].IncidentID, types.IncidentID(1))
+		gt.Equal(t, hits[0].Permalink(), "https://slack.com/archives/C-INC-1/p1700000100000200")
+	})
+
+	t.Run("filters by incident and time", func(t *testing.T) {
+		hits, _ := index.Search("database", model.SearchFilter{IncidentID: 1, Since: now.Add(-time.Hour)}, 10)
+		gt.Equal(t, hitIDs(hits), []string{"task:task-1"})
+	})
+
+	t.Run("excludes incidents rejected by Include", func(t *testing.T) {
+		var calls [][]types.IncidentID
+		hits, total := index.Search("database", model.SearchFilter{
+			Include: func(ids []types.IncidentID) map[types.IncidentID]bool {
+				calls = append(calls, ids)
+				return map[types.IncidentID]bool{2: true}
+			},
+		}, 10)
+		gt.Equal(t, total, 1)
+		gt.Equal(t, hitIDs(hits), []string{"incident:2"})
+
+		// Incidents are looked up once for all hits
+		gt.A(t, calls).Length(1).At(0, func(t testing.TB, ids []types.IncidentID) {
+			slices.Sort(ids)
+			gt.Equal(t, ids, []types.IncidentID{1, 2})
+		})
+	})
+
+	t.Run("limits hits but counts all", func(t *testing.T) {
+		hits, total := index.Search("database", model.SearchFilter{}, 1)
+		gt.Equal(t, len(hits), 1)
+		gt.Equal(t, total, 3)
+	})
+
+	t.Run("highlights matched words", func(t *testing.T) {
+		hits, _ := index.Search("errors", model.SearchFilter{}, 10)
+		gt.Equal(t, len(hits), 1)
+		gt.Equal(t, hits[0].Highlights, []model.SearchHighlight{
+			{Field: "title", Fragment: "Login errors", Ranges: []model.TextRange{{Start: 6, End: 12}}},
+			{Field: "text", Fragment: "Users see errors after the database migration", Ranges: []model.TextRange{{Start: 10, End: 16}}},
+		})
+	})
+
+	t.Run("cuts long text around the match", func(t *testing.T) {
+		index := search.New()
+		index.Put(search.IncidentDocument(&model.Incident{
+			ID:          3,
+			Description: strings.Repeat("filler ", 50) + "needle " + strings.Repeat("filler ", 50),
+		}))
+
+		hits, _ := index.Search("needle", model.SearchFilter{}, 10)
+		gt.Equal(t, len(hits), 1)
+		h := hits[0].Highlights[0]
+		gt.True(t, strings.HasPrefix(h.Fragment, "…"))
+		gt.True(t, strings.HasSuffix(h.Fragment, "…"))
+		gt.Equal(t, len(h.Ranges), 1)
+		gt.Equal(t, string([]rune(h.Fragment)[h.Ranges[0].Start:h.Ranges[0].End]), "needle")
+	})
+
+	t.Run("removes deleted documents", func(t *testing.T) {
+		index.Delete(search.TaskDocumentID("task-1"))
+		hits, _ := index.Search("fail", model.SearchFilter{}, 10)
+		gt.Equal(t, len(hits), 0)
+	})
+}
+
+func TestIndexRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := repository.NewMemory()
+	incident := &model.Incident{ID: 1, Title: "Certificate expired", ChannelID: "C-INC-1", CreatedAt: time.Now()}
+	gt.NoError(t, repo.PutIncident(ctx, incident))
+	gt.NoError(t, repo.SaveMessage(ctx, &model.Message{ID: "msg-1", ChannelID: "C-INC-1", Text: "Renewal is blocked", Timestamp: time.Now()}))
+
+	events := pubsub.New()
+	index := search.New()
+	go index.Run(ctx, repo, events)
+
+	waitFor := func(query string, want int) []model.SearchHit {
+		t.Helper()
+		deadline := time.Now().Add(time.Second)
+		for {
+			hits, total := index.Search(query, model.SearchFilter{}, 10)
+			if total == want {
+				return hits
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("expected %d hits for %q, got %d", want, query, total)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	// Existing content is indexed on start
+	waitFor("certificate", 1)
+	waitFor("renewal", 1)
+
+	task := &model.Task{ID: "task-1", IncidentID: 1, Title: "Renew the certificate"}
+	events.PublishTask(ctx, types.TimelineEventTaskCreated, task, "U-ALICE")
+	waitFor("certificate", 2)
+
+	events.PublishStatus(ctx, incident, &model.StatusHistory{
+		ID:         "history-1",
+		IncidentID: 1,
+		Status:     types.IncidentStatusHandling,
+		Note:       "Waiting for the CA",
+	})
+	hits := waitFor("ca", 1)
+	gt.Equal(t, hits[0].Kind, types.SearchKindTimeline)
+	gt.Equal(t, hits[0].ChannelID, types.ChannelID("C-INC-1"))
+
+	// Messages outside incident channels are not indexed at all
+	documents := index.Len()
+	events.PublishMessage(ctx, &model.Message{ID: "msg-random", ChannelID: "C-RANDOM", Text: "Lunch?"})
+	events.PublishMessage(ctx, &model.Message{ID: "msg-2", ChannelID: "C-INC-1", Text: "Renewal done"})
+	waitFor("renewal", 2)
+	gt.Equal(t, index.Len(), documents+1)
+
+	events.PublishTask(ctx, types.TimelineEventTaskDeleted, task, "U-ALICE")
+	waitFor("certificate", 1)
+}
+
+func TestIndexRunCatchUp(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := repository.NewMemory()
+	gt.NoError(t, repo.PutIncident(ctx, &model.Incident{ID: 1, Title: "Key leak", ChannelID: "C-INC-1", CreatedAt: time.Now()}))
+
+	index := search.New(search.WithRefreshInterval(20 * time.Millisecond))
+	go index.Run(ctx, repo, pubsub.New())
+
+	waitFor := func(query string, want int) {
+		t.Helper()
+		deadline := time.Now().Add(time.Second)
+		for {
+			if _, total := index.Search(query, model.SearchFilter{}, 10); total == want {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("expected %d hits for %q", want, query)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	waitFor("key", 1)
+
+	// Changes made through another replica are picked up without a rebuild
+	gt.NoError(t, repo.PutIncident(ctx, &model.Incident{ID: 2, Title: "Key expired", ChannelID: "C-INC-2", CreatedAt: time.Now()}))
+	waitFor("key", 2)
+
+	gt.NoError(t, repo.PutIncident(ctx, &model.Incident{ID: 1, Title: "Token leak", ChannelID: "C-INC-1", CreatedAt: time.Now()}))
+	waitFor("token", 1)
+	waitFor("key", 1)
+
+	gt.NoError(t, repo.CreateTask(ctx, &model.Task{ID: "task-1", IncidentID: 1, Title: "Rotate the token", UpdatedAt: time.Now()}))
+	gt.NoError(t, repo.SaveMessage(ctx, &model.Message{ID: "msg-1", ChannelID: "C-INC-2", Text: "Revoked by the vendor", Timestamp: time.Now()}))
+	waitFor("rotate", 1)
+	waitFor("vendor", 1)
+}
+
+func TestIndexRunRebuild(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := repository.NewMemory()
+	gt.NoError(t, repo.PutIncident(ctx, &model.Incident{ID: 1, Title: "Key leak", ChannelID: "C-INC-1", CreatedAt: time.Now()}))
+	gt.NoError(t, repo.CreateTask(ctx, &model.Task{ID: "task-1", IncidentID: 1, Title: "Rotate the key", UpdatedAt: time.Now()}))
+	gt.NoError(t, repo.SaveMessage(ctx, &model.Message{ID: "msg-old", ChannelID: "C-INC-1", Text: "Rotation scheduled", Timestamp: time.Now().Add(-48 * time.Hour)}))
+
+	index := search.New(search.WithRebuildInterval(20*time.Millisecond), search.WithMessageRetention(24*time.Hour))
+	go index.Run(ctx, repo, pubsub.New())
+
+	waitFor := func(query string, want int) {
+		t.Helper()
+		deadline := time.Now().Add(time.Second)
+		for {
+			if _, total := index.Search(query, model.SearchFilter{}, 10); total == want {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("expected %d hits for %q", want, query)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	waitFor("rotate", 1)
+	// Messages older than the retention are not loaded
+	waitFor("scheduled", 0)
+
+	// Tasks deleted through another replica are dropped by the rebuild
+	gt.NoError(t, repo.DeleteTask(ctx, 1, "task-1"))
+	waitFor("rotate", 0)
+}
+
+func TestIndexSearchCJK(t *testing.T) {
+	index := search.New()
+	index.Put(search.IncidentDocument(&model.Incident{
+		ID:          1,
+		Title:       "データベース障害",
+		Description: "本番DBの応答が停止しました",
+		ChannelID:   "C-INC-1",
+	}))
+	index.Put(search.IncidentDocument(&model.Incident{
+		ID:        2,
+		Title:     "ログイン障害",
+		ChannelID: "C-INC-2",
+	}))
+
+	t.Run("matches words inside CJK text", func(t *testing.T) {
+		hits, _ := index.Search("障害", model.SearchFilter{}, 10)
+		slices.SortFunc(hits, func(a, b model.SearchHit) int { return int(a.IncidentID - b.IncidentID) })
+		gt.Equal(t, hitIDs(hits), []string{"incident:1", "incident:2"})
+
+		hits, _ = index.Search("ベース", model.SearchFilter{}, 10)
+		gt.Equal(t, hitIDs(hits), []string{"incident:1"})
+	})
+
+	t.Run("matches CJK text next to latin words", func(t *testing.T) {
+		hits, _ := index.Search("db 応答", model.SearchFilter{}, 10)
+		gt.Equal(t, hitIDs(hits), []string{"incident:1"})
+	})
+
+	t.Run("requires all bigrams of a query", func(t *testing.T) {
+		hits, _ := index.Search("ログイン障害", model.SearchFilter{}, 10)
+		gt.Equal(t, hitIDs(hits), []string{"incident:2"})
+	})
+
+	t.Run("highlights the matched part", func(t *testing.T) {
+		hits, _ := index.Search("応答が停止", model.SearchFilter{}, 10)
+		gt.Equal(t, len(hits), 1)
+		gt.Equal(t, hits[0].Highlights, []model.SearchHighlight{
+			{Field: "text", Fragment: "本番DBの応答が停止しました", Ranges: []model.TextRange{{Start: 5, End: 10}}},
+		})
+	})
+}
@@ -0,0 +1,209 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/service/pubsub"
+	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
+)
+
+// Backfill replaces the index with all incidents with their tasks, status changes and
+// stakeholder updates, and the messages of their channels posted within the message
+// retention. Documents removed from the repository meanwhile are dropped. The index is
+// left unchanged if reading the repository fails.
+func (x *Index) Backfill(ctx context.Context, repo interfaces.Repository) error {
+	started := time.Now()
+	incidents, err := repo.ListIncidents(ctx)
+	if err != nil {
+		return goerr.Wrap(err, "failed to list incidents for search index")
+	}
+
+	fresh := New()
+	if err := fresh.load(ctx, repo, incidents, time.Time{}, started.Add(-x.messageRetention)); err != nil {
+		return err
+	}
+	x.replace(fresh)
+
+	ctxlog.From(ctx).Info("Search index backfilled",
+		"documents", x.Len(),
+		"duration", time.Since(started),
+	)
+	return nil
+}
+
+// load indexes incidents, then the tasks, status changes and stakeholder updates of
+// all incidents changed since since and the messages of incident channels posted since
+// messagesSince. Each kind of content is read with one query, whatever the number of
+// incidents.
+func (x *Index) load(ctx context.Context, repo interfaces.Repository, incidents []*model.Incident, since, messagesSince time.Time) error {
+	for _, incident := range incidents {
+		x.Put(IncidentDocument(incident))
+	}
+
+	tasks, err := repo.ListTasksUpdatedSince(ctx, since)
+	if err != nil {
+		return goerr.Wrap(err, "failed to list tasks for search index")
+	}
+	for _, task := range tasks {
+		x.Put(TaskDocument(task))
+	}
+
+	histories, err := repo.ListStatusHistoriesSince(ctx, since)
+	if err != nil {
+		return goerr.Wrap(err, "failed to list status histories for search index")
+	}
+	for _, history := range histories {
+		x.Put(StatusDocument(history, x.channelOf(history.IncidentID)))
+	}
+
+	updates, err := repo.ListStakeholderUpdatesSince(ctx, since)
+	if err != nil {
+		return goerr.Wrap(err, "failed to list stakeholder updates for search index")
+	}
+	for _, update := range updates {
+		x.Put(StakeholderUpdateDocument(update, x.channelOf(update.IncidentID)))
+	}
+
+	// Messages of other channels the bot is in are never searchable, so they are not indexed
+	messages, err := repo.ListMessagesSince(ctx, messagesSince)
+	if err != nil {
+		return goerr.Wrap(err, "failed to list messages for search index")
+	}
+	for _, message := range messages {
+		if x.hasChannel(message.ChannelID) {
+			x.Put(MessageDocument(message))
+		}
+	}
+
+	return nil
+}
+
+// Run backfills the index from repo once subscribed to events, so that no change
+// is missed, and then keeps it up to date with the changes published to events.
+// Changes made through other replicas are read from repo every refresh interval,
+// and the index is rebuilt every rebuild interval to drop documents they deleted.
+// It returns when ctx is done.
+func (x *Index) Run(ctx context.Context, repo interfaces.Repository, events *pubsub.Broker) {
+	var ch <-chan pubsub.Event
+	if events != nil {
+		ch = events.Subscribe(ctx, func(ev pubsub.Event) bool {
+			switch ev.Kind {
+			case pubsub.KindIncidentUpdated, pubsub.KindTaskUpdated, pubsub.KindTaskDeleted,
+				pubsub.KindStatusChanged, pubsub.KindStakeholderUpdatePosted, pubsub.KindMessageSaved:
+				return true
+			default:
+				return false
+			}
+		})
+	}
+
+	synced := x.rebuild(ctx, repo, time.Time{})
+
+	refresh := time.NewTicker(x.refreshInterval)
+	defer refresh.Stop()
+	rebuild := time.NewTicker(x.rebuildInterval)
+	defer rebuild.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-refresh.C:
+			synced = x.catchUp(ctx, repo, synced)
+		case <-rebuild.C:
+			synced = x.rebuild(ctx, repo, synced)
+		case ev, ok := <-ch:
+			if !ok {
+				return
+			}
+			x.apply(ctx, repo, ev)
+		}
+	}
+}
+
+// rebuild backfills the index and returns the time of this sync, or last if it failed
+func (x *Index) rebuild(ctx context.Context, repo interfaces.Repository, last time.Time) time.Time {
+	now := time.Now()
+	if err := x.Backfill(ctx, repo); err != nil {
+		apperr.Handle(ctx, err)
+		return last
+	}
+	return now
+}
+
+// catchUp indexes what changed in the repository since the last sync, which may have
+// been changed by another replica, and returns the time of this sync. Changes made
+// shortly before the last sync are read again to allow for clock skew between replicas.
+func (x *Index) catchUp(ctx context.Context, repo interfaces.Repository, last time.Time) time.Time {
+	now := time.Now()
+	since := last.Add(-x.refreshInterval)
+
+	incidents, err := repo.ListIncidentsUpdatedSince(ctx, since)
+	if err != nil {
+		apperr.Handle(ctx, goerr.Wrap(err, "failed to list updated incidents for search index"))
+		return last
+	}
+	if err := x.load(ctx, repo, incidents, since, since); err != nil {
+		apperr.Handle(ctx, err)
+		return last
+	}
+	return now
+}
+
+func (x *Index) apply(ctx context.Context, repo interfaces.Repository, ev pubsub.Event) {
+	switch ev.Kind {
+	case pubsub.KindIncidentUpdated:
+		x.Put(IncidentDocument(ev.Incident))
+	case pubsub.KindTaskUpdated:
+		x.Put(TaskDocument(ev.Task))
+	case pubsub.KindTaskDeleted:
+		x.Delete(TaskDocumentID(ev.Task.ID))
+	case pubsub.KindStatusChanged:
+		incident, err := repo.GetIncident(ctx, ev.IncidentID)
+		if err != nil {
+			apperr.Handle(ctx, goerr.Wrap(err, "failed to get incident for search index", goerr.V("incidentID", ev.IncidentID)))
+			incident = &model.Incident{}
+		}
+		x.Put(StatusDocument(ev.Status, incident.ChannelID))
+	case pubsub.KindStakeholderUpdatePosted:
+		incident, err := repo.GetIncident(ctx, ev.IncidentID)
+		if err != nil {
+			apperr.Handle(ctx, goerr.Wrap(err, "failed to get incident for search index", goerr.V("incidentID", ev.IncidentID)))
+			incident = &model.Incident{}
+		}
+		x.Put(StakeholderUpdateDocument(ev.Update, incident.ChannelID))
+	case pubsub.KindMessageSaved:
+		if x.isIncidentChannel(ctx, repo, ev.Message.ChannelID) {
+			x.Put(MessageDocument(ev.Message))
+		}
+	}
+}
+
+// isIncidentChannel reports whether messages of channelID belong to an incident.
+// Messages of other channels the bot is in are never searchable, so they are not indexed.
+func (x *Index) isIncidentChannel(ctx context.Context, repo interfaces.Repository, channelID types.ChannelID) bool {
+	if channelID == "" {
+		return false
+	}
+	if x.hasChannel(channelID) {
+		return true
+	}
+
+	// The incident may have been declared by another replica since the last sync
+	incident, err := repo.GetIncidentByChannelID(ctx, channelID)
+	if err != nil {
+		if !errors.Is(err, model.ErrIncidentNotFound) {
+			apperr.Handle(ctx, goerr.Wrap(err, "failed to get incident for search index", goerr.V("channelID", channelID)))
+		}
+		return false
+	}
+	x.Put(IncidentDocument(incident))
+	return true
+}
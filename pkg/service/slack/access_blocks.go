@@ -0,0 +1,123 @@
+package slack
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/slack-go/slack"
+)
+
+// AccessRequestActionPrefix prefixes the action IDs of access request buttons.
+// Format: access_request:{approve|deny}[:{hours}], with the value
+// {incidentID}:{requesterID}:{approverID}
+const AccessRequestActionPrefix = "access_request:"
+
+// accessRequestDurations are the grant lifetimes offered when approving a request
+var accessRequestDurations = []struct {
+	label string
+	hours int
+}{
+	{label: "Approve for 24h", hours: 24},
+	{label: "Approve for 7 days", hours: 7 * 24},
+}
+
+// AccessRequestAction is a parsed access request button click
+type AccessRequestAction struct {
+	IncidentID  types.IncidentID
+	RequesterID types.SlackUserID
+	ApproverID  types.SlackUserID // Empty for requests posted before approvers were recorded
+	Approved    bool
+	TTL         time.Duration
+}
+
+// ParseAccessRequestAction parses the action ID and value of an access request button
+func ParseAccessRequestAction(actionID, value string) (*AccessRequestAction, error) {
+	parts := strings.Split(strings.TrimPrefix(actionID, AccessRequestActionPrefix), ":")
+	values := strings.Split(value, ":")
+	if len(values) != 2 && len(values) != 3 {
+		return nil, goerr.New("invalid access request value", goerr.V("value", value))
+	}
+
+	incidentID, err := strconv.Atoi(values[0])
+	if err != nil {
+		return nil, goerr.Wrap(err, "invalid incident ID in access request", goerr.V("value", value))
+	}
+	action := &AccessRequestAction{
+		IncidentID:  types.IncidentID(incidentID),
+		RequesterID: types.SlackUserID(values[1]),
+	}
+	if len(values) == 3 {
+		action.ApproverID = types.SlackUserID(values[2])
+	}
+
+	switch {
+	case len(parts) == 2 && parts[0] == "approve":
+		hours, err := strconv.Atoi(parts[1])
+		if err != nil || hours <= 0 {
+			return nil, goerr.New("invalid grant duration in access request", goerr.V("actionID", actionID))
+		}
+		action.Approved = true
+		action.TTL = time.Duration(hours) * time.Hour
+	case len(parts) == 1 && parts[0] == "deny":
+	default:
+		return nil, goerr.New("invalid access request action", goerr.V("actionID", actionID))
+	}
+	return action, nil
+}
+
+// BuildAccessRequestBlocks creates the message asking an approver to grant a user
+// access to a private incident
+func BuildAccessRequestBlocks(incident *model.Incident, requesterID, approverID types.SlackUserID, reason string) []slack.Block {
+	text := fmt.Sprintf("🔐 <@%s> requests access to this private incident", requesterID)
+	if approverID != "" {
+		text = fmt.Sprintf("<@%s> %s", approverID, text)
+	}
+	if reason != "" {
+		text += fmt.Sprintf("\n>%s", reason)
+	}
+
+	value := fmt.Sprintf("%d:%s:%s", incident.ID, requesterID, approverID)
+	buttons := make([]slack.BlockElement, 0, len(accessRequestDurations)+1)
+	for i, d := range accessRequestDurations {
+		button := slack.NewButtonBlockElement(
+			fmt.Sprintf("%sapprove:%d", AccessRequestActionPrefix, d.hours),
+			value,
+			slack.NewTextBlockObject(slack.PlainTextType, d.label, false, false),
+		)
+		if i == 0 {
+			button = button.WithStyle(slack.StylePrimary)
+		}
+		buttons = append(buttons, button)
+	}
+	buttons = append(buttons, slack.NewButtonBlockElement(
+		AccessRequestActionPrefix+"deny",
+		value,
+		slack.NewTextBlockObject(slack.PlainTextType, "Deny", false, false),
+	).WithStyle(slack.StyleDanger))
+
+	return []slack.Block{
+		slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, text, false, false), nil, nil),
+		slack.NewActionBlock("access_request", buttons...),
+	}
+}
+
+// BuildAccessRequestDecidedBlocks replaces an access request once it was approved or denied
+func BuildAccessRequestDecidedBlocks(requesterID, decidedBy types.SlackUserID, grant *model.AccessGrant) []slack.Block {
+	text := fmt.Sprintf("🚫 <@%s> denied the access request of <@%s>", decidedBy, requesterID)
+	if grant != nil {
+		text = fmt.Sprintf("✅ <@%s> granted <@%s> access", decidedBy, requesterID)
+		if !grant.ExpiresAt.IsZero() {
+			text += fmt.Sprintf(" until <!date^%d^{date_short_pretty} {time}|%s>",
+				grant.ExpiresAt.Unix(), grant.ExpiresAt.UTC().Format(time.RFC3339))
+		}
+	}
+
+	return []slack.Block{
+		slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, text, false, false), nil, nil),
+	}
+}
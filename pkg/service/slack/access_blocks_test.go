@@ -0,0 +1,51 @@
+package slack_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	slackblocks "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/slack-go/slack"
+)
+
+func TestAccessRequestBlocks(t *testing.T) {
+	incident := &model.Incident{ID: 42, Private: true}
+	blocks := slackblocks.BuildAccessRequestBlocks(incident, "U-REQUESTER", "U-LEAD", "Reviewing IOCs")
+	gt.A(t, blocks).Length(2).Required()
+
+	section, ok := blocks[0].(*slack.SectionBlock)
+	gt.True(t, ok).Required()
+	gt.S(t, section.Text.Text).Contains("<@U-LEAD>").Contains("<@U-REQUESTER>").Contains("Reviewing IOCs")
+
+	actions, ok := blocks[1].(*slack.ActionBlock)
+	gt.True(t, ok).Required()
+	gt.A(t, actions.Elements.ElementSet).Length(3).Required()
+
+	parse := func(i int) *slackblocks.AccessRequestAction {
+		button, ok := actions.Elements.ElementSet[i].(*slack.ButtonBlockElement)
+		gt.True(t, ok).Required()
+		action, err := slackblocks.ParseAccessRequestAction(button.ActionID, button.Value)
+		gt.NoError(t, err).Required()
+		return action
+	}
+
+	approve := parse(0)
+	gt.True(t, approve.Approved)
+	gt.Equal(t, approve.IncidentID, types.IncidentID(42))
+	gt.Equal(t, approve.RequesterID, types.SlackUserID("U-REQUESTER"))
+	gt.Equal(t, approve.ApproverID, types.SlackUserID("U-LEAD"))
+	gt.Equal(t, approve.TTL, 24*time.Hour)
+	gt.Equal(t, parse(1).TTL, 7*24*time.Hour)
+
+	deny := parse(2)
+	gt.False(t, deny.Approved)
+	gt.Equal(t, deny.TTL, time.Duration(0))
+
+	_, err := slackblocks.ParseAccessRequestAction("access_request:approve:0", "42:U-REQUESTER")
+	gt.Error(t, err)
+	_, err = slackblocks.ParseAccessRequestAction("access_request:deny", "not-an-id")
+	gt.Error(t, err)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
@@ -729,11 +730,24 @@ func (b *BlockBuilder) BuildStatusMessageBlocks(incident *model.Incident, leadNa
 						Style: slack.StyleDefault,
 						Value: incident.ID.String(),
 					},
+					stakeholderUpdateButton(incident),
 				},
 			},
 		},
 	}
 
+	// Show the resolution of closed incidents above the actions
+	if incident.Resolution != "" {
+		resolution := &slack.SectionBlock{
+			Type: slack.MBTSection,
+			Text: &slack.TextBlockObject{
+				Type: slack.MarkdownType,
+				Text: "*Resolution:*\n" + strings.ReplaceAll(incident.Resolution, "\n", " "),
+			},
+		}
+		blocks = slices.Insert(blocks, len(blocks)-1, slack.Block(resolution))
+	}
+
 	return blocks
 }
 
@@ -764,6 +778,16 @@ func (b *BlockBuilder) BuildStatusSelectionModal(incident *model.Incident, chann
 		types.IncidentStatusClosed,
 	}
 
+	// A closed incident can only be reopened, which needs a reason
+	reopening := incident.Status == types.IncidentStatusClosed
+	prompt, title, submit := "*Select new status for incident:*", "Change Status", "Update"
+	noteLabel, notePlaceholder := "Note (optional)", "Add a note about this status change..."
+	if reopening {
+		statuses = statuses[:len(statuses)-1]
+		prompt, title, submit = "*This incident is closed. Select the status to reopen it with:*", "Reopen Incident", "Reopen"
+		noteLabel, notePlaceholder = "Reason for reopening", "Why does this incident need to be reopened?"
+	}
+
 	for _, status := range statuses {
 		emoji := getStatusEmoji(status)
 		statusOptions = append(statusOptions, &slack.OptionBlockObject{
@@ -780,7 +804,7 @@ func (b *BlockBuilder) BuildStatusSelectionModal(incident *model.Incident, chann
 			Type: slack.MBTSection,
 			Text: &slack.TextBlockObject{
 				Type: slack.MarkdownType,
-				Text: "*Select new status for incident:*",
+				Text: prompt,
 			},
 		},
 		&slack.InputBlock{
@@ -803,10 +827,10 @@ func (b *BlockBuilder) BuildStatusSelectionModal(incident *model.Incident, chann
 		&slack.InputBlock{
 			Type:     slack.MBTInput,
 			BlockID:  "note_block",
-			Optional: true,
+			Optional: !reopening,
 			Label: &slack.TextBlockObject{
 				Type: slack.PlainTextType,
-				Text: "Note (optional)",
+				Text: noteLabel,
 			},
 			Element: &slack.PlainTextInputBlockElement{
 				Type:      slack.METPlainTextInput,
@@ -814,7 +838,7 @@ func (b *BlockBuilder) BuildStatusSelectionModal(incident *model.Incident, chann
 				Multiline: true,
 				Placeholder: &slack.TextBlockObject{
 					Type: slack.PlainTextType,
-					Text: "Add a note about this status change...",
+					Text: notePlaceholder,
 				},
 			},
 		},
@@ -828,11 +852,11 @@ func (b *BlockBuilder) BuildStatusSelectionModal(incident *model.Incident, chann
 		CallbackID: "status_change_modal",
 		Title: &slack.TextBlockObject{
 			Type: slack.PlainTextType,
-			Text: "Change Status",
+			Text: title,
 		},
 		Submit: &slack.TextBlockObject{
 			Type: slack.PlainTextType,
-			Text: "Update",
+			Text: submit,
 		},
 		Close: &slack.TextBlockObject{
 			Type: slack.PlainTextType,
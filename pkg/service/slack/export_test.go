@@ -0,0 +1,15 @@
+package slack
+
+import "time"
+
+// SetLimiterIdleTTL sets how long a rate limiter may go unused before it is dropped
+func (s *Service) SetLimiterIdleTTL(ttl time.Duration) {
+	s.limiter.idleTTL = ttl
+}
+
+// LimiterCount returns the number of rate limiters currently kept
+func (s *Service) LimiterCount() int {
+	s.limiter.mu.Lock()
+	defer s.limiter.mu.Unlock()
+	return len(s.limiter.limiters)
+}
@@ -13,6 +13,10 @@ import (
 const (
 	// maxMessageLimit is the maximum number of messages that can be retrieved from Slack API
 	maxMessageLimit = 256
+
+	// historyPageSize and repliesPageSize are the page sizes used to read a whole channel
+	historyPageSize = 200
+	repliesPageSize = 1000
 )
 
 // MessageHistoryService handles retrieving message history from Slack
@@ -113,3 +117,67 @@ func (s *MessageHistoryService) getChannelMessages(ctx context.Context, opts Mes
 
 	return nonThreadMessages, nil
 }
+
+// GetAllMessages retrieves the whole history of a channel including thread replies.
+// Messages are returned oldest first, each thread parent followed by its replies.
+func (s *MessageHistoryService) GetAllMessages(ctx context.Context, channelID string) ([]slack.Message, error) {
+	if channelID == "" {
+		return nil, goerr.New("channel ID is required")
+	}
+
+	// Pages are read newest first, each ending before the oldest message of the previous one
+	var parents []slack.Message
+	latest := ""
+	for {
+		history, err := s.slackClient.GetConversationHistoryContext(ctx, &slack.GetConversationHistoryParameters{
+			ChannelID: channelID,
+			Latest:    latest,
+			Limit:     historyPageSize,
+		})
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to get channel messages",
+				goerr.V("channelID", channelID),
+				goerr.V("latest", latest),
+			)
+		}
+		for _, msg := range history.Messages {
+			// Replies also sent to the channel are read with their thread
+			if msg.ThreadTimestamp == "" || msg.ThreadTimestamp == msg.Timestamp {
+				parents = append(parents, msg)
+			}
+		}
+		if !history.HasMore || len(history.Messages) == 0 {
+			break
+		}
+		latest = history.Messages[len(history.Messages)-1].Timestamp
+	}
+
+	messages := make([]slack.Message, 0, len(parents))
+	for i := len(parents) - 1; i >= 0; i-- {
+		parent := parents[i]
+		messages = append(messages, parent)
+		if parent.ReplyCount == 0 {
+			continue
+		}
+
+		replies, _, _, err := s.slackClient.GetConversationRepliesContext(ctx, &slack.GetConversationRepliesParameters{
+			ChannelID: channelID,
+			Timestamp: parent.Timestamp,
+			Limit:     repliesPageSize,
+		})
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to get thread messages",
+				goerr.V("channelID", channelID),
+				goerr.V("threadTS", parent.Timestamp),
+			)
+		}
+		for _, reply := range replies {
+			// The first message of the replies is the parent itself
+			if reply.Timestamp != parent.Timestamp {
+				messages = append(messages, reply)
+			}
+		}
+	}
+
+	return messages, nil
+}
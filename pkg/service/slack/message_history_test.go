@@ -176,3 +176,42 @@ func TestMessageHistoryService_MessageOrdering(t *testing.T) {
 		gt.Equal(t, messages[2].Text, "Third reply")
 	})
 }
+
+func TestMessageHistoryService_GetAllMessages(t *testing.T) {
+	ctx := context.Background()
+	msg := func(ts, threadTS string, replies int) slack.Message {
+		return slack.Message{Msg: slack.Msg{Timestamp: ts, ThreadTimestamp: threadTS, ReplyCount: replies, Text: "m" + ts}}
+	}
+
+	// Two pages of history, newest first; 2.0 has a thread whose reply 2.5 was also sent to the channel
+	pages := map[string]*slack.GetConversationHistoryResponse{
+		"": {
+			Messages: []slack.Message{msg("4.0", "", 0), msg("2.5", "2.0", 0), msg("3.0", "", 0)},
+			HasMore:  true,
+		},
+		"3.0": {
+			Messages: []slack.Message{msg("2.0", "2.0", 2), msg("1.0", "", 0)},
+		},
+	}
+	mockClient := &mocks.SlackClientMock{
+		GetConversationHistoryContextFunc: func(ctx context.Context, params *slack.GetConversationHistoryParameters) (*slack.GetConversationHistoryResponse, error) {
+			return pages[params.Latest], nil
+		},
+		GetConversationRepliesContextFunc: func(ctx context.Context, params *slack.GetConversationRepliesParameters) ([]slack.Message, bool, bool, error) {
+			gt.Equal(t, params.Timestamp, "2.0")
+			return []slack.Message{msg("2.0", "2.0", 2), msg("2.1", "2.0", 0), msg("2.5", "2.0", 0)}, false, false, nil
+		},
+	}
+
+	messages, err := slackSvc.NewMessageHistoryService(mockClient).GetAllMessages(ctx, "C123")
+	gt.NoError(t, err).Required()
+
+	var timestamps []string
+	for _, m := range messages {
+		timestamps = append(timestamps, m.Timestamp)
+	}
+	gt.A(t, timestamps).Equal([]string{"1.0", "2.0", "2.1", "2.5", "3.0", "4.0"})
+
+	_, err = slackSvc.NewMessageHistoryService(mockClient).GetAllMessages(ctx, "")
+	gt.Error(t, err)
+}
@@ -2,6 +2,8 @@ package slack
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
@@ -27,13 +29,13 @@ func newMessageService(client interfaces.SlackClient, builder *BlockBuilder, con
 }
 
 // postStatusMessage sends a status message to the incident channel
-func (s *messageService) postStatusMessage(ctx context.Context, channelID types.ChannelID, incident *model.Incident, leadName string) error {
+func (s *messageService) postStatusMessage(ctx context.Context, channelID types.ChannelID, incident *model.Incident, leadName string, relations *model.IncidentRelations) error {
 	if channelID == "" {
 		return goerr.New("channel ID is required")
 	}
 
 	// Build status message blocks
-	blocks := s.builder.BuildStatusMessageBlocks(incident, leadName, s.config)
+	blocks := withIncidentRelationsBlock(s.builder.BuildStatusMessageBlocks(incident, leadName, s.config), relations)
 
 	// Post message to Slack
 	_, _, err := s.client.PostMessage(ctx, string(channelID), slack.MsgOptionBlocks(blocks...))
@@ -45,7 +47,7 @@ func (s *messageService) postStatusMessage(ctx context.Context, channelID types.
 }
 
 // updateStatusMessage updates an existing status message
-func (s *messageService) updateStatusMessage(ctx context.Context, channelID types.ChannelID, messageTS string, incident *model.Incident, leadName string) error {
+func (s *messageService) updateStatusMessage(ctx context.Context, channelID types.ChannelID, messageTS string, incident *model.Incident, leadName string, relations *model.IncidentRelations) error {
 	if channelID == "" || messageTS == "" {
 		return goerr.New("channelID and messageTS are required",
 			goerr.V("channelID", channelID),
@@ -53,7 +55,7 @@ func (s *messageService) updateStatusMessage(ctx context.Context, channelID type
 	}
 
 	// Build updated status message blocks
-	blocks := s.builder.BuildStatusMessageBlocks(incident, leadName, s.config)
+	blocks := withIncidentRelationsBlock(s.builder.BuildStatusMessageBlocks(incident, leadName, s.config), relations)
 
 	// Update the message
 	_, _, _, err := s.client.UpdateMessage(ctx, string(channelID), messageTS, slack.MsgOptionBlocks(blocks...))
@@ -85,6 +87,38 @@ func (s *messageService) postWelcomeMessage(ctx context.Context, channelID types
 	return messageTS, nil
 }
 
+// updateWelcomeMessage replaces the welcome message of the incident channel, e.g.
+// to show incidents related since it was posted
+func (s *messageService) updateWelcomeMessage(ctx context.Context, incident *model.Incident, leadName string, relations *model.IncidentRelations) error {
+	if incident.ChannelID == "" || incident.WelcomeMessageTS == "" {
+		return goerr.New("incident has no welcome message", goerr.V("incidentID", incident.ID))
+	}
+
+	blocks := withIncidentRelationsBlock(
+		s.builder.BuildIncidentChannelWelcomeBlocks(incident, incident.OriginChannelName.String(), leadName, s.config), relations)
+
+	_, _, _, err := s.client.UpdateMessage(ctx, incident.ChannelID.String(), incident.WelcomeMessageTS, slack.MsgOptionBlocks(blocks...))
+	if err != nil {
+		return goerr.Wrap(err, "failed to update welcome message",
+			goerr.V("incidentID", incident.ID),
+			goerr.V("channelID", incident.ChannelID))
+	}
+
+	return nil
+}
+
+// postRelationNotice posts a context message about a related incident in channelID
+func (s *messageService) postRelationNotice(ctx context.Context, channelID types.ChannelID, text string) error {
+	_, _, err := s.client.PostMessage(ctx, channelID.String(),
+		slack.MsgOptionBlocks(slack.NewContextBlock("", slack.NewTextBlockObject(slack.MarkdownType, text, false, false))),
+		slack.MsgOptionText(text, false),
+	)
+	if err != nil {
+		return goerr.Wrap(err, "failed to post relation notice", goerr.V("channelID", channelID))
+	}
+	return nil
+}
+
 // postIncidentCreatedNotification sends an incident creation notification
 func (s *messageService) postIncidentCreatedNotification(ctx context.Context, channelID types.ChannelID, messageTS types.MessageTS, originChannelName string, incidentChannelID types.ChannelID, title, categoryID, severityID string) error {
 	if channelID == "" {
@@ -94,14 +128,13 @@ func (s *messageService) postIncidentCreatedNotification(ctx context.Context, ch
 	// Build incident created notification blocks
 	blocks := s.builder.BuildIncidentCreatedBlocks(originChannelName, string(incidentChannelID), title, categoryID, severityID, s.config)
 
-	// Post message with broadcast option
-	_, _, err := s.client.PostMessage(
-		ctx,
-		string(channelID),
-		slack.MsgOptionBlocks(blocks...),
-		slack.MsgOptionTS(string(messageTS)),
-		slack.MsgOptionBroadcast(),
-	)
+	// Reply in the thread of the triggering message and broadcast it to the channel.
+	// Incidents declared outside of Slack have no such message.
+	options := []slack.MsgOption{slack.MsgOptionBlocks(blocks...)}
+	if messageTS != "" {
+		options = append(options, slack.MsgOptionTS(string(messageTS)), slack.MsgOptionBroadcast())
+	}
+	_, _, err := s.client.PostMessage(ctx, string(channelID), options...)
 	if err != nil {
 		return goerr.Wrap(err, "failed to post incident creation notification")
 	}
@@ -212,3 +245,157 @@ func (s *messageService) postIncidentPromptMessage(ctx context.Context, channelI
 
 	return botMessageTS, nil
 }
+
+// postAccessRequestMessage asks the approver in the incident channel to grant the requester access
+func (s *messageService) postAccessRequestMessage(ctx context.Context, channelID types.ChannelID, incident *model.Incident, requesterID, approverID types.SlackUserID, reason string) error {
+	if channelID == "" {
+		return goerr.New("channel ID is required")
+	}
+
+	blocks := BuildAccessRequestBlocks(incident, requesterID, approverID, reason)
+
+	_, _, err := s.client.PostMessage(ctx, string(channelID), slack.MsgOptionBlocks(blocks...))
+	if err != nil {
+		return goerr.Wrap(err, "failed to post access request message",
+			goerr.V("incidentID", incident.ID),
+			goerr.V("requesterID", requesterID))
+	}
+
+	return nil
+}
+
+// updateAccessRequestMessage replaces an access request with its outcome
+func (s *messageService) updateAccessRequestMessage(ctx context.Context, channelID types.ChannelID, messageTS string, requesterID, decidedBy types.SlackUserID, grant *model.AccessGrant) error {
+	if channelID == "" || messageTS == "" {
+		return goerr.New("channelID and messageTS are required",
+			goerr.V("channelID", channelID),
+			goerr.V("messageTS", messageTS))
+	}
+
+	blocks := BuildAccessRequestDecidedBlocks(requesterID, decidedBy, grant)
+
+	_, _, _, err := s.client.UpdateMessage(ctx, string(channelID), messageTS, slack.MsgOptionBlocks(blocks...))
+	if err != nil {
+		return goerr.Wrap(err, "failed to update access request message",
+			goerr.V("channelID", channelID),
+			goerr.V("messageTS", messageTS))
+	}
+
+	return nil
+}
+
+// postReminderMessage posts a reminder with snooze buttons in the incident channel
+func (s *messageService) postReminderMessage(ctx context.Context, incident *model.Incident, reminder *model.Reminder, task *model.Task, elapsed time.Duration) error {
+	if incident.ChannelID == "" {
+		return goerr.New("channel ID is required", goerr.V("incidentID", incident.ID))
+	}
+
+	blocks := BuildReminderBlocks(incident, reminder, task, elapsed)
+
+	_, _, err := s.client.PostMessage(ctx, string(incident.ChannelID), slack.MsgOptionBlocks(blocks...))
+	if err != nil {
+		return goerr.Wrap(err, "failed to post reminder message",
+			goerr.V("incidentID", incident.ID),
+			goerr.V("reminderID", reminder.ID))
+	}
+
+	return nil
+}
+
+// updateReminderMessage replaces a reminder once it was snoozed
+func (s *messageService) updateReminderMessage(ctx context.Context, channelID types.ChannelID, messageTS string, snoozedBy types.SlackUserID, until time.Time) error {
+	if channelID == "" || messageTS == "" {
+		return goerr.New("channelID and messageTS are required",
+			goerr.V("channelID", channelID),
+			goerr.V("messageTS", messageTS))
+	}
+
+	blocks := BuildReminderSnoozedBlocks(snoozedBy, until)
+
+	_, _, _, err := s.client.UpdateMessage(ctx, string(channelID), messageTS, slack.MsgOptionBlocks(blocks...))
+	if err != nil {
+		return goerr.Wrap(err, "failed to update reminder message",
+			goerr.V("channelID", channelID),
+			goerr.V("messageTS", messageTS))
+	}
+
+	return nil
+}
+
+// postIncidentChangeNotification posts a status or severity change of an incident
+// to channelID, in the thread of threadTS if set
+func (s *messageService) postIncidentChangeNotification(ctx context.Context, channelID types.ChannelID, threadTS types.MessageTS, before, after *model.Incident, changedBy types.SlackUserID) error {
+	if channelID == "" {
+		return goerr.New("channel ID is required")
+	}
+
+	var severities *model.SeveritiesConfig
+	if s.config != nil {
+		severities = s.config.GetSeveritiesConfig()
+	} else {
+		severities = &model.SeveritiesConfig{}
+	}
+	blocks := BuildIncidentChangeBlocks(before, after, severities, changedBy)
+	fallback := fmt.Sprintf("Incident #%d %s is %s", after.ID, after.Title, after.Status)
+
+	options := []slack.MsgOption{
+		slack.MsgOptionBlocks(blocks...),
+		slack.MsgOptionText(fallback, false),
+	}
+	if threadTS != "" {
+		options = append(options, slack.MsgOptionTS(threadTS.String()))
+	}
+	if _, _, err := s.client.PostMessage(ctx, string(channelID), options...); err != nil {
+		return goerr.Wrap(err, "failed to post incident change notification",
+			goerr.V("incidentID", after.ID),
+			goerr.V("channelID", channelID))
+	}
+
+	return nil
+}
+
+// postArchiveNotice tells an incident channel that its transcript was saved
+// and that it is about to be archived
+func (s *messageService) postArchiveNotice(ctx context.Context, channelID types.ChannelID, incident *model.Incident, messageCount int) error {
+	text := fmt.Sprintf("📦 A transcript of %d messages of this channel has been saved with incident #%d. This channel is now archived and is brought back if the incident is reopened.",
+		messageCount, incident.ID)
+
+	_, _, err := s.client.PostMessage(ctx, string(channelID),
+		slack.MsgOptionBlocks(slack.NewContextBlock("", slack.NewTextBlockObject(slack.MarkdownType, text, false, false))),
+		slack.MsgOptionText(text, false),
+	)
+	if err != nil {
+		return goerr.Wrap(err, "failed to post archive notice",
+			goerr.V("incidentID", incident.ID),
+			goerr.V("channelID", channelID))
+	}
+
+	return nil
+}
+
+// postStakeholderUpdate posts a stakeholder update to channelID. crossPost is
+// set for channels other than the incident channel.
+func (s *messageService) postStakeholderUpdate(ctx context.Context, channelID types.ChannelID, incident *model.Incident, update *model.StakeholderUpdate, crossPost bool) error {
+	if channelID == "" {
+		return goerr.New("channel ID is required")
+	}
+
+	var severity *model.Severity
+	if s.config != nil {
+		severity = s.config.FindSeverityByIDWithFallback(incident.SeverityID.String())
+	}
+	blocks := BuildStakeholderUpdateBlocks(incident, update, severity, crossPost)
+	fallback := fmt.Sprintf("Stakeholder update: Incident #%d %s", incident.ID, incident.Title)
+
+	_, _, err := s.client.PostMessage(ctx, string(channelID),
+		slack.MsgOptionBlocks(blocks...),
+		slack.MsgOptionText(fallback, false),
+	)
+	if err != nil {
+		return goerr.Wrap(err, "failed to post stakeholder update",
+			goerr.V("incidentID", incident.ID),
+			goerr.V("channelID", channelID))
+	}
+
+	return nil
+}
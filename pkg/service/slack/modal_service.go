@@ -124,3 +124,17 @@ func (s *modalService) openTaskEditModal(ctx context.Context, triggerID string,
 
 	return nil
 }
+
+// openStakeholderUpdateModal opens the modal for writing a stakeholder update
+func (s *modalService) openStakeholderUpdateModal(ctx context.Context, triggerID string, incident *model.Incident, previous *model.StakeholderUpdate) error {
+	if triggerID == "" {
+		return goerr.New("trigger ID is required")
+	}
+
+	_, err := s.client.OpenView(ctx, triggerID, BuildStakeholderUpdateModal(incident, previous))
+	if err != nil {
+		return goerr.Wrap(err, "failed to open stakeholder update modal", goerr.V("incidentID", incident.ID))
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package slack
+
+import (
+	"fmt"
+
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/slack-go/slack"
+)
+
+// BuildIncidentChangeBlocks creates a compact notification of a status or severity
+// change posted outside the incident channel. before and after are the incident
+// around the change, and severities resolve severity names.
+func BuildIncidentChangeBlocks(before, after *model.Incident, severities *model.SeveritiesConfig, changedBy types.SlackUserID) []slack.Block {
+	title := fmt.Sprintf("🔔 *Incident #%d %s*", after.ID, after.Title)
+	if after.ChannelID != "" {
+		title += fmt.Sprintf(" (<#%s>)", after.ChannelID)
+	}
+	if after.IsTest {
+		title = "🧪 [TEST] " + title
+	}
+
+	status := getStatusEmoji(after.Status) + " " + after.Status.String()
+	if before.Status != after.Status {
+		status = fmt.Sprintf("%s ~%s~ → %s", getStatusEmoji(after.Status), before.Status, after.Status)
+	}
+	severity := formatSeverityText(severities.FindSeverityByIDWithFallback(after.SeverityID.String()))
+	if before.SeverityID != after.SeverityID {
+		severity = fmt.Sprintf("~%s~ → %s",
+			formatSeverityText(severities.FindSeverityByIDWithFallback(before.SeverityID.String())), severity)
+	}
+
+	blocks := []slack.Block{
+		slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, title, false, false), []*slack.TextBlockObject{
+			slack.NewTextBlockObject(slack.MarkdownType, "*Status:*\n"+status, false, false),
+			slack.NewTextBlockObject(slack.MarkdownType, "*Severity:*\n"+severity, false, false),
+		}, nil),
+	}
+	if changedBy != "" {
+		blocks = append(blocks, slack.NewContextBlock("",
+			slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("Changed by <@%s>", changedBy), false, false)))
+	}
+	return blocks
+}
@@ -0,0 +1,45 @@
+package slack_test
+
+import (
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	slackblocks "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/slack-go/slack"
+)
+
+func TestIncidentChangeBlocks(t *testing.T) {
+	severities := &model.SeveritiesConfig{Severities: []model.Severity{
+		{ID: "critical", Name: "Critical", Level: 90},
+		{ID: "low", Name: "Low", Level: 20},
+	}}
+	before := &model.Incident{ID: 42, Title: "Login outage", ChannelID: "C-INCIDENT", Status: types.IncidentStatusHandling, SeverityID: "low"}
+
+	t.Run("status change", func(t *testing.T) {
+		after := *before
+		after.Status = types.IncidentStatusClosed
+
+		blocks := slackblocks.BuildIncidentChangeBlocks(before, &after, severities, "U-LEAD")
+		gt.A(t, blocks).Length(2).Required()
+		section, ok := blocks[0].(*slack.SectionBlock)
+		gt.True(t, ok).Required()
+		gt.S(t, section.Text.Text).Contains("Incident #42 Login outage").Contains("<#C-INCIDENT>")
+		gt.A(t, section.Fields).Length(2).Required()
+		gt.S(t, section.Fields[0].Text).Contains("~handling~ → closed")
+		gt.S(t, section.Fields[1].Text).Contains("Low").NotContains("→")
+	})
+
+	t.Run("severity change", func(t *testing.T) {
+		after := *before
+		after.SeverityID = "critical"
+
+		blocks := slackblocks.BuildIncidentChangeBlocks(before, &after, severities, "")
+		gt.A(t, blocks).Length(1).Required()
+		section, ok := blocks[0].(*slack.SectionBlock)
+		gt.True(t, ok).Required()
+		gt.S(t, section.Fields[0].Text).NotContains("→")
+		gt.S(t, section.Fields[1].Text).Contains("Low").Contains("→").Contains("Critical")
+	})
+}
@@ -0,0 +1,272 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/utils/metrics"
+	"github.com/secmon-lab/lycaon/pkg/utils/tracing"
+	"github.com/slack-go/slack"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultMaxRetries = 3
+	defaultQueueSize  = 1000
+	// limiterIdleTTL is how long a limiter may go unused before it is dropped. A
+	// limiter idle this long has refilled its bucket, so a new one behaves the same.
+	limiterIdleTTL = 10 * time.Minute
+)
+
+// ErrRequestQueueFull is returned when too many Slack API requests are already waiting
+var ErrRequestQueueFull = goerr.New("Slack API request queue is full")
+
+var (
+	apiCalls = metrics.NewCounterVec("lycaon_slack_api_calls_total",
+		"Slack Web API calls by method, counting each retry.", "method")
+	apiErrors = metrics.NewCounterVec("lycaon_slack_api_errors_total",
+		"Slack Web API calls by method that failed after retries or could not be queued.", "method")
+	apiRateLimited = metrics.NewCounterVec("lycaon_slack_api_rate_limited_total",
+		"Slack Web API responses by method asking to retry later.", "method")
+	apiDuration = metrics.NewHistogramVec("lycaon_slack_api_call_duration_seconds",
+		"Latency of Slack Web API calls by method, excluding time waiting for the rate limiter.",
+		metrics.DefaultBuckets, "method")
+)
+
+// tier is a Slack Web API rate limit tier
+// See https://api.slack.com/apis/rate-limits
+type tier struct {
+	perMinute int
+	burst     int
+}
+
+var (
+	tier2 = tier{perMinute: 20, burst: 5}
+	tier3 = tier{perMinute: 50, burst: 10}
+	tier4 = tier{perMinute: 100, burst: 20}
+	// tierPostMessage is the "special" tier of chat.postMessage: about one message
+	// per second per channel with short bursts allowed. It is applied per channel.
+	tierPostMessage = tier{perMinute: 60, burst: 5}
+)
+
+// methodTiers maps Slack API methods used by Service to their rate limit tier
+var methodTiers = map[string]tier{
+	"auth.test":                tier4,
+	"bookmarks.add":            tier2,
+	"chat.postEphemeral":       tier4,
+	"chat.postMessage":         tierPostMessage,
+	"chat.update":              tier3,
+	"conversations.archive":    tier2,
+	"conversations.create":     tier2,
+	"conversations.history":    tier3,
+	"conversations.info":       tier3,
+	"conversations.invite":     tier3,
+	"conversations.members":    tier4,
+	"conversations.open":       tier3,
+	"conversations.rename":     tier2,
+	"conversations.replies":    tier3,
+	"conversations.setPurpose": tier2,
+	"conversations.unarchive":  tier2,
+	"usergroups.list":          tier2,
+	"usergroups.users.list":    tier2,
+	"users.conversations":      tier3,
+	"users.info":               tier4,
+	"users.list":               tier2,
+	"views.open":               tier4,
+}
+
+// methodLimiter throttles calls of a single method (or method and channel)
+type methodLimiter struct {
+	limiter *rate.Limiter
+
+	// inUse and lastUsed are guarded by rateLimiter.mu
+	inUse    int
+	lastUsed time.Time
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+// rateLimiter applies tier-aware throttling, Retry-After handling and a bounded
+// wait queue to Slack API calls
+type rateLimiter struct {
+	maxRetries int
+	queue      chan struct{}
+	idleTTL    time.Duration
+
+	mu        sync.Mutex
+	limiters  map[string]*methodLimiter
+	lastSweep time.Time
+}
+
+func newRateLimiter(maxRetries, queueSize int) *rateLimiter {
+	return &rateLimiter{
+		maxRetries: maxRetries,
+		queue:      make(chan struct{}, queueSize),
+		idleTTL:    limiterIdleTTL,
+		limiters:   make(map[string]*methodLimiter),
+		lastSweep:  time.Now(),
+	}
+}
+
+// acquire returns the limiter for the method, keyed additionally by key
+// (e.g. channel ID) when the method is limited per channel. The limiter is
+// kept until the caller passes it to release.
+func (r *rateLimiter) acquire(method, key string) *methodLimiter {
+	t, ok := methodTiers[method]
+	if !ok {
+		t = tier3
+	}
+
+	id := method
+	if key != "" {
+		id = method + ":" + key
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(r.lastSweep) >= r.idleTTL {
+		r.sweep(now)
+	}
+
+	l, ok := r.limiters[id]
+	if !ok {
+		l = &methodLimiter{
+			limiter: rate.NewLimiter(rate.Every(time.Minute/time.Duration(t.perMinute)), t.burst),
+		}
+		r.limiters[id] = l
+	}
+	l.inUse++
+	l.lastUsed = now
+	return l
+}
+
+// release marks the end of a call that acquired l
+func (r *rateLimiter) release(l *methodLimiter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	l.inUse--
+	l.lastUsed = time.Now()
+}
+
+// sweep drops limiters that no call holds and that have not been used for
+// idleTTL, so per-channel limiters of channels no longer posted to do not
+// pile up. The caller must hold r.mu.
+func (r *rateLimiter) sweep(now time.Time) {
+	for id, l := range r.limiters {
+		if l.inUse == 0 && now.Sub(l.lastUsed) >= r.idleTTL && !l.paused(now) {
+			delete(r.limiters, id)
+		}
+	}
+	r.lastSweep = now
+}
+
+// do runs fn once the method's rate limit allows it. When Slack still responds
+// with a rate limit error, all callers of the method pause for Retry-After and
+// fn is retried up to maxRetries times.
+func (r *rateLimiter) do(ctx context.Context, method, key string, fn func(ctx context.Context) error) (err error) {
+	ctx, span := tracing.Start(ctx, "slack "+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("slack.method", method)),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	select {
+	case r.queue <- struct{}{}:
+		defer func() { <-r.queue }()
+	default:
+		apiErrors.Inc(method)
+		return goerr.Wrap(ErrRequestQueueFull, "too many pending Slack API requests",
+			goerr.V("method", method),
+			goerr.V("queueSize", cap(r.queue)))
+	}
+
+	l := r.acquire(method, key)
+	defer r.release(l)
+	logger := ctxlog.From(ctx)
+
+	for attempt := 0; ; attempt++ {
+		if err := l.waitPause(ctx); err != nil {
+			return goerr.Wrap(err, "cancelled while waiting for Slack rate limit", goerr.V("method", method))
+		}
+		if err := l.limiter.Wait(ctx); err != nil {
+			return goerr.Wrap(err, "cancelled while waiting for Slack rate limit", goerr.V("method", method))
+		}
+
+		started := time.Now()
+		err := fn(ctx)
+		apiCalls.Inc(method)
+		apiDuration.Observe(time.Since(started).Seconds(), method)
+
+		var rateLimited *slack.RateLimitedError
+		if !errors.As(err, &rateLimited) {
+			if err != nil {
+				apiErrors.Inc(method)
+			}
+			return err
+		}
+
+		apiRateLimited.Inc(method)
+		span.AddEvent("rate limited", trace.WithAttributes(attribute.String("slack.retry_after", rateLimited.RetryAfter.String())))
+		l.pause(rateLimited.RetryAfter)
+		if attempt >= r.maxRetries {
+			apiErrors.Inc(method)
+			return err
+		}
+
+		logger.Warn("Slack API rate limited, retrying",
+			"method", method,
+			"retryAfter", rateLimited.RetryAfter,
+			"attempt", attempt+1,
+			"maxRetries", r.maxRetries,
+		)
+	}
+}
+
+// pause blocks further calls until retryAfter has passed
+func (l *methodLimiter) pause(retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	until := time.Now().Add(retryAfter)
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// paused reports whether calls are blocked by a rate limit response at now
+func (l *methodLimiter) paused(now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return now.Before(l.pausedUntil)
+}
+
+// waitPause waits until a pause set by a rate limit response has passed
+func (l *methodLimiter) waitPause(ctx context.Context) error {
+	l.mu.Lock()
+	wait := time.Until(l.pausedUntil)
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
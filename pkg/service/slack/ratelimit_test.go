@@ -0,0 +1,130 @@
+package slack_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/slack-go/slack"
+)
+
+const authTestOK = `{"ok":true,"url":"https://example.slack.com/","team":"Example","user":"lycaon","team_id":"T123","user_id":"U123"}`
+
+func newTestService(t *testing.T, handler http.HandlerFunc, opts ...slackSvc.Option) *slackSvc.Service {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	opts = append(opts, slackSvc.WithSlackOptions(slack.OptionAPIURL(srv.URL+"/")))
+	svc, ok := slackSvc.New("xoxb-test", opts...).(*slackSvc.Service)
+	gt.True(t, ok)
+	return svc
+}
+
+func TestServiceRateLimit(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("retries after Retry-After when rate limited", func(t *testing.T) {
+		var calls atomic.Int32
+		svc := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) == 1 {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			_, _ = w.Write([]byte(authTestOK))
+		})
+
+		start := time.Now()
+		resp, err := svc.AuthTestContext(ctx)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, "T123", resp.TeamID)
+		gt.Equal(t, int32(2), calls.Load())
+		gt.True(t, time.Since(start) >= time.Second)
+	})
+
+	t.Run("returns rate limit error after max retries", func(t *testing.T) {
+		var calls atomic.Int32
+		svc := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}, slackSvc.WithMaxRetries(0))
+
+		_, err := svc.AuthTestContext(ctx)
+		gt.Error(t, err)
+
+		var rateLimited *slack.RateLimitedError
+		gt.True(t, errors.As(err, &rateLimited))
+		gt.Equal(t, time.Second, rateLimited.RetryAfter)
+		gt.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("rejects requests when queue is full", func(t *testing.T) {
+		release := make(chan struct{})
+		entered := make(chan struct{}, 1)
+		svc := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			entered <- struct{}{}
+			<-release
+			_, _ = w.Write([]byte(authTestOK))
+		}, slackSvc.WithQueueSize(1))
+
+		done := make(chan error, 1)
+		go func() {
+			_, err := svc.AuthTestContext(ctx)
+			done <- err
+		}()
+		<-entered
+
+		_, err := svc.AuthTestContext(ctx)
+		gt.True(t, errors.Is(err, slackSvc.ErrRequestQueueFull))
+
+		close(release)
+		gt.NoError(t, <-done)
+	})
+
+	t.Run("splits large invitations into batches", func(t *testing.T) {
+		var batches []int
+		svc := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			gt.NoError(t, r.ParseForm())
+			batches = append(batches, len(strings.Split(r.FormValue("users"), ",")))
+			_, _ = fmt.Fprint(w, `{"ok":true,"channel":{"id":"C123"}}`)
+		})
+
+		users := make([]string, 1500)
+		for i := range users {
+			users[i] = fmt.Sprintf("U%04d", i)
+		}
+
+		channel, err := svc.InviteUsersToConversation(ctx, "C123", users...)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, "C123", channel.ID)
+		gt.A(t, batches).Equal([]int{1000, 500})
+	})
+
+	t.Run("drops idle per-channel limiters", func(t *testing.T) {
+		svc := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, `{"ok":true,"channel":"C1","ts":"1.000001"}`)
+		})
+		svc.SetLimiterIdleTTL(50 * time.Millisecond)
+
+		for _, channelID := range []string{"C1", "C2"} {
+			_, _, err := svc.PostMessage(ctx, channelID, slack.MsgOptionText("hello", false))
+			gt.NoError(t, err).Required()
+		}
+		gt.Equal(t, 2, svc.LimiterCount())
+
+		time.Sleep(100 * time.Millisecond)
+		_, _, err := svc.PostMessage(ctx, "C3", slack.MsgOptionText("hello", false))
+		gt.NoError(t, err).Required()
+		gt.Equal(t, 1, svc.LimiterCount())
+	})
+}
@@ -0,0 +1,64 @@
+package slack
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/slack-go/slack"
+)
+
+// BuildIncidentRelationsBlock creates a section listing the incidents related to
+// an incident, or nil if there are none. Titles and channels of private incidents
+// are not shown, as the block is visible to everyone in the channel.
+func BuildIncidentRelationsBlock(relations *model.IncidentRelations) slack.Block {
+	if relations.IsEmpty() {
+		return nil
+	}
+
+	var lines []string
+	if relations.DuplicateOf != nil {
+		lines = append(lines, "*Duplicate of:* "+formatRelatedIncident(relations.DuplicateOf))
+	}
+	if relations.Parent != nil {
+		lines = append(lines, "*Part of major incident:* "+formatRelatedIncident(relations.Parent))
+	}
+	if len(relations.Children) > 0 {
+		lines = append(lines, "*Child incidents:*\n"+formatRelatedIncidents(relations.Children))
+	}
+	if len(relations.Duplicates) > 0 {
+		lines = append(lines, "*Merged duplicates:*\n"+formatRelatedIncidents(relations.Duplicates))
+	}
+
+	return slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, "🔗 "+strings.Join(lines, "\n"), false, false), nil, nil)
+}
+
+// withIncidentRelationsBlock inserts the relations block before the actions, which
+// are the last block of status messages
+func withIncidentRelationsBlock(blocks []slack.Block, relations *model.IncidentRelations) []slack.Block {
+	block := BuildIncidentRelationsBlock(relations)
+	if block == nil || len(blocks) == 0 {
+		return blocks
+	}
+	return slices.Insert(blocks, len(blocks)-1, block)
+}
+
+func formatRelatedIncidents(incidents []*model.Incident) string {
+	items := make([]string, 0, len(incidents))
+	for _, incident := range incidents {
+		items = append(items, "• "+getStatusEmoji(incident.Status)+" "+formatRelatedIncident(incident))
+	}
+	return strings.Join(items, "\n")
+}
+
+func formatRelatedIncident(incident *model.Incident) string {
+	if incident.Private {
+		return fmt.Sprintf("#%d (private)", incident.ID)
+	}
+	text := fmt.Sprintf("#%d %s", incident.ID, incident.Title)
+	if incident.ChannelID != "" {
+		text += fmt.Sprintf(" (<#%s>)", incident.ChannelID)
+	}
+	return text
+}
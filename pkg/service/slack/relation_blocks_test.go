@@ -0,0 +1,33 @@
+package slack_test
+
+import (
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	slackblocks "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/slack-go/slack"
+)
+
+func TestBuildIncidentRelationsBlock(t *testing.T) {
+	t.Run("no relations", func(t *testing.T) {
+		gt.Nil(t, slackblocks.BuildIncidentRelationsBlock(nil))
+		gt.Nil(t, slackblocks.BuildIncidentRelationsBlock(&model.IncidentRelations{}))
+	})
+
+	t.Run("lists related incidents and hides private ones", func(t *testing.T) {
+		block := slackblocks.BuildIncidentRelationsBlock(&model.IncidentRelations{
+			Parent: &model.Incident{ID: 1, Title: "Region outage", ChannelID: "C-MAJOR"},
+			Children: []*model.Incident{
+				{ID: 2, Title: "Checkout errors", Status: types.IncidentStatusHandling},
+				{ID: 3, Title: "Credential leak", Private: true},
+			},
+		})
+		text := gt.Cast[*slack.SectionBlock](t, block).Text.Text
+		gt.S(t, text).Contains("*Part of major incident:* #1 Region outage (<#C-MAJOR>)")
+		gt.S(t, text).Contains("#2 Checkout errors")
+		gt.S(t, text).Contains("#3 (private)")
+		gt.S(t, text).NotContains("Credential leak")
+	})
+}
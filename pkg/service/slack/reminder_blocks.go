@@ -0,0 +1,130 @@
+package slack
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/slack-go/slack"
+)
+
+// ReminderSnoozeActionPrefix prefixes the action IDs of reminder snooze buttons.
+// Format: reminder_snooze:{hours}, the value is the reminder ID.
+const ReminderSnoozeActionPrefix = "reminder_snooze:"
+
+// reminderSnoozeDurations are the snooze periods offered on a reminder
+var reminderSnoozeDurations = []struct {
+	label string
+	hours int
+}{
+	{label: "Snooze 4h", hours: 4},
+	{label: "Snooze 1 day", hours: 24},
+	{label: "Snooze 1 week", hours: 7 * 24},
+}
+
+// ReminderSnoozeAction is a parsed reminder snooze button click
+type ReminderSnoozeAction struct {
+	ReminderID string
+	IncidentID types.IncidentID
+	Duration   time.Duration
+}
+
+// ParseReminderSnoozeAction parses the action ID and value of a reminder snooze button
+func ParseReminderSnoozeAction(actionID, value string) (*ReminderSnoozeAction, error) {
+	hours, err := strconv.Atoi(strings.TrimPrefix(actionID, ReminderSnoozeActionPrefix))
+	if err != nil || hours <= 0 {
+		return nil, goerr.New("invalid snooze duration in reminder action", goerr.V("actionID", actionID))
+	}
+
+	incidentIDStr, _, _ := strings.Cut(value, ":")
+	incidentID, err := strconv.Atoi(incidentIDStr)
+	if err != nil || incidentID <= 0 {
+		return nil, goerr.New("invalid reminder ID", goerr.V("value", value))
+	}
+
+	return &ReminderSnoozeAction{
+		ReminderID: value,
+		IncidentID: types.IncidentID(incidentID),
+		Duration:   time.Duration(hours) * time.Hour,
+	}, nil
+}
+
+// BuildReminderBlocks creates a reminder posted in an incident channel. task is
+// only used by stale task reminders, and elapsed is how long the condition has held.
+func BuildReminderBlocks(incident *model.Incident, reminder *model.Reminder, task *model.Task, elapsed time.Duration) []slack.Block {
+	var text string
+	switch reminder.Kind {
+	case model.ReminderKindStatusUpdate:
+		text = fmt.Sprintf("⏰ %sNo status update for %s while the incident is *%s*. Please post an update or change the status.",
+			mention(incident.Lead), formatElapsed(elapsed), incident.Status)
+	case model.ReminderKindNoLead:
+		text = fmt.Sprintf("⏰ %sNo lead has been assigned for %s. Please assign an incident lead.",
+			mention(incident.CreatedBy), formatElapsed(elapsed))
+	case model.ReminderKindStaleTask:
+		assignee := incident.Lead
+		if task.AssigneeID != "" {
+			assignee = task.AssigneeID
+		}
+		text = fmt.Sprintf("⏰ %sTask *%s* (%s) has not been updated for %s.",
+			mention(assignee), task.Title, task.Status, formatElapsed(elapsed))
+	case model.ReminderKindStakeholderUpdate:
+		text = fmt.Sprintf("📣 %sNo stakeholder update for %s. Please post an update for people following this incident.",
+			mention(incident.Lead), formatElapsed(elapsed))
+	case model.ReminderKindMonitoring:
+		text = fmt.Sprintf("⏰ %sThis incident has been in *monitoring* for %s. Consider closing it if the issue is resolved.",
+			mention(incident.Lead), formatElapsed(elapsed))
+	default:
+		text = fmt.Sprintf("⏰ %sThis incident needs attention.", mention(incident.Lead))
+	}
+
+	buttons := make([]slack.BlockElement, 0, len(reminderSnoozeDurations)+1)
+	if reminder.Kind == model.ReminderKindStakeholderUpdate {
+		buttons = append(buttons, stakeholderUpdateButton(incident).WithStyle(slack.StylePrimary))
+	}
+	for _, d := range reminderSnoozeDurations {
+		buttons = append(buttons, slack.NewButtonBlockElement(
+			fmt.Sprintf("%s%d", ReminderSnoozeActionPrefix, d.hours),
+			reminder.ID,
+			slack.NewTextBlockObject(slack.PlainTextType, d.label, false, false),
+		))
+	}
+
+	return []slack.Block{
+		slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, text, false, false), nil, nil),
+		slack.NewActionBlock("reminder", buttons...),
+	}
+}
+
+// BuildReminderSnoozedBlocks replaces a reminder once it was snoozed
+func BuildReminderSnoozedBlocks(snoozedBy types.SlackUserID, until time.Time) []slack.Block {
+	text := fmt.Sprintf("💤 <@%s> snoozed this reminder until <!date^%d^{date_short_pretty} {time}|%s>",
+		snoozedBy, until.Unix(), until.UTC().Format(time.RFC3339))
+
+	return []slack.Block{
+		slack.NewContextBlock("", slack.NewTextBlockObject(slack.MarkdownType, text, false, false)),
+	}
+}
+
+// mention returns a mention of the user followed by a space, or nothing without a user
+func mention(userID types.SlackUserID) string {
+	if userID == "" {
+		return ""
+	}
+	return fmt.Sprintf("<@%s> ", userID)
+}
+
+// formatElapsed formats a duration in days, hours or minutes
+func formatElapsed(d time.Duration) string {
+	switch {
+	case d >= 48*time.Hour:
+		return fmt.Sprintf("%d days", int(d/(24*time.Hour)))
+	case d >= 2*time.Hour:
+		return fmt.Sprintf("%d hours", int(d/time.Hour))
+	default:
+		return fmt.Sprintf("%d minutes", int(d/time.Minute))
+	}
+}
@@ -0,0 +1,47 @@
+package slack_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	slackblocks "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/slack-go/slack"
+)
+
+func TestReminderBlocks(t *testing.T) {
+	incident := &model.Incident{ID: 42, Lead: "U-LEAD"}
+	task := &model.Task{ID: "task-1", Title: "Rotate keys", Status: model.TaskStatusFollowUp, AssigneeID: "U-ANALYST"}
+	reminder := model.NewReminder(incident.ID, model.ReminderKindStaleTask, task.ID)
+
+	blocks := slackblocks.BuildReminderBlocks(incident, reminder, task, 3*24*time.Hour)
+	gt.A(t, blocks).Length(2).Required()
+
+	section, ok := blocks[0].(*slack.SectionBlock)
+	gt.True(t, ok).Required()
+	gt.S(t, section.Text.Text).Contains("<@U-ANALYST>").Contains("Rotate keys").Contains("3 days")
+
+	actions, ok := blocks[1].(*slack.ActionBlock)
+	gt.True(t, ok).Required()
+	gt.A(t, actions.Elements.ElementSet).Length(3).Required()
+
+	button, ok := actions.Elements.ElementSet[1].(*slack.ButtonBlockElement)
+	gt.True(t, ok).Required()
+	action, err := slackblocks.ParseReminderSnoozeAction(button.ActionID, button.Value)
+	gt.NoError(t, err).Required()
+	gt.Equal(t, action.ReminderID, reminder.ID)
+	gt.Equal(t, action.IncidentID, types.IncidentID(42))
+	gt.Equal(t, action.Duration, 24*time.Hour)
+
+	monitoring := slackblocks.BuildReminderBlocks(incident, model.NewReminder(incident.ID, model.ReminderKindMonitoring, ""), nil, 5*time.Hour)
+	section, ok = monitoring[0].(*slack.SectionBlock)
+	gt.True(t, ok).Required()
+	gt.S(t, section.Text.Text).Contains("<@U-LEAD>").Contains("closing").Contains("5 hours")
+
+	_, err = slackblocks.ParseReminderSnoozeAction("reminder_snooze:0", reminder.ID)
+	gt.Error(t, err)
+	_, err = slackblocks.ParseReminderSnoozeAction("reminder_snooze:4", "not-an-id")
+	gt.Error(t, err)
+}
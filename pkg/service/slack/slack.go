@@ -10,21 +10,77 @@ import (
 	"github.com/slack-go/slack"
 )
 
-// Service provides Slack messaging capabilities
+const (
+	// maxInviteUsers is the maximum number of users conversations.invite accepts per call
+	maxInviteUsers = 1000
+)
+
+// Service provides Slack messaging capabilities.
+// All API calls are throttled per method according to Slack's rate limit tiers
+// and retried when Slack responds with a rate limit error.
 type Service struct {
-	client *slack.Client
+	client  *slack.Client
+	limiter *rateLimiter
+}
+
+type serviceOptions struct {
+	maxRetries int
+	queueSize  int
+	slackOpts  []slack.Option
+}
+
+// Option configures a Service
+type Option func(*serviceOptions)
+
+// WithMaxRetries sets how many times a rate limited request is retried
+func WithMaxRetries(n int) Option {
+	return func(o *serviceOptions) {
+		if n >= 0 {
+			o.maxRetries = n
+		}
+	}
+}
+
+// WithQueueSize sets the maximum number of requests waiting for the rate limiter.
+// Requests beyond this limit fail immediately with ErrRequestQueueFull.
+func WithQueueSize(n int) Option {
+	return func(o *serviceOptions) {
+		if n > 0 {
+			o.queueSize = n
+		}
+	}
+}
+
+// WithSlackOptions passes options to the underlying slack-go client
+func WithSlackOptions(opts ...slack.Option) Option {
+	return func(o *serviceOptions) {
+		o.slackOpts = append(o.slackOpts, opts...)
+	}
 }
 
 // New creates a new Slack service that implements interfaces.SlackClient
-func New(token string) interfaces.SlackClient {
+func New(token string, opts ...Option) interfaces.SlackClient {
+	o := &serviceOptions{
+		maxRetries: defaultMaxRetries,
+		queueSize:  defaultQueueSize,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	return &Service{
-		client: slack.New(token),
+		client:  slack.New(token, o.slackOpts...),
+		limiter: newRateLimiter(o.maxRetries, o.queueSize),
 	}
 }
 
 // PostMessage sends a message to a Slack channel
 func (s *Service) PostMessage(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
-	channel, timestamp, err := s.client.PostMessageContext(ctx, channelID, options...)
+	var channel, timestamp string
+	err := s.limiter.do(ctx, "chat.postMessage", channelID, func(ctx context.Context) (err error) {
+		channel, timestamp, err = s.client.PostMessageContext(ctx, channelID, options...)
+		return err
+	})
 	if err != nil {
 		return "", "", goerr.Wrap(err, "failed to post message to Slack")
 	}
@@ -33,25 +89,44 @@ func (s *Service) PostMessage(ctx context.Context, channelID string, options ...
 
 // CreateConversation creates a new Slack channel
 func (s *Service) CreateConversation(ctx context.Context, params slack.CreateConversationParams) (*slack.Channel, error) {
-	channel, err := s.client.CreateConversationContext(ctx, params)
+	var channel *slack.Channel
+	err := s.limiter.do(ctx, "conversations.create", "", func(ctx context.Context) (err error) {
+		channel, err = s.client.CreateConversationContext(ctx, params)
+		return err
+	})
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to create Slack conversation")
 	}
 	return channel, nil
 }
 
-// InviteUsersToConversation invites users to a Slack channel
+// InviteUsersToConversation invites users to a Slack channel.
+// Large user lists are split into batches accepted by conversations.invite.
 func (s *Service) InviteUsersToConversation(ctx context.Context, channelID string, users ...string) (*slack.Channel, error) {
-	channel, err := s.client.InviteUsersToConversationContext(ctx, channelID, users...)
-	if err != nil {
-		return nil, goerr.Wrap(err, "failed to invite users to conversation")
+	var channel *slack.Channel
+	for start := 0; start < len(users) || start == 0; start += maxInviteUsers {
+		batch := users[start:min(start+maxInviteUsers, len(users))]
+		err := s.limiter.do(ctx, "conversations.invite", "", func(ctx context.Context) (err error) {
+			channel, err = s.client.InviteUsersToConversationContext(ctx, channelID, batch...)
+			return err
+		})
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to invite users to conversation",
+				goerr.V("channelID", channelID),
+				goerr.V("batchStart", start),
+				goerr.V("batchSize", len(batch)))
+		}
 	}
 	return channel, nil
 }
 
 // PostEphemeral sends an ephemeral message visible only to the specified user
 func (s *Service) PostEphemeral(ctx context.Context, channelID, userID string, options ...slack.MsgOption) (string, error) {
-	timestamp, err := s.client.PostEphemeralContext(ctx, channelID, userID, options...)
+	var timestamp string
+	err := s.limiter.do(ctx, "chat.postEphemeral", "", func(ctx context.Context) (err error) {
+		timestamp, err = s.client.PostEphemeralContext(ctx, channelID, userID, options...)
+		return err
+	})
 	if err != nil {
 		return "", goerr.Wrap(err, "failed to post ephemeral message")
 	}
@@ -60,7 +135,11 @@ func (s *Service) PostEphemeral(ctx context.Context, channelID, userID string, o
 
 // UpdateMessage updates an existing Slack message
 func (s *Service) UpdateMessage(ctx context.Context, channelID, timestamp string, options ...slack.MsgOption) (string, string, string, error) {
-	channel, ts, text, err := s.client.UpdateMessageContext(ctx, channelID, timestamp, options...)
+	var channel, ts, text string
+	err := s.limiter.do(ctx, "chat.update", "", func(ctx context.Context) (err error) {
+		channel, ts, text, err = s.client.UpdateMessageContext(ctx, channelID, timestamp, options...)
+		return err
+	})
 	if err != nil {
 		return "", "", "", goerr.Wrap(err, "failed to update message")
 	}
@@ -73,7 +152,11 @@ func (s *Service) GetConversationInfo(ctx context.Context, channelID string, inc
 		ChannelID:     channelID,
 		IncludeLocale: includeLocale,
 	}
-	channel, err := s.client.GetConversationInfoContext(ctx, params)
+	var channel *slack.Channel
+	err := s.limiter.do(ctx, "conversations.info", "", func(ctx context.Context) (err error) {
+		channel, err = s.client.GetConversationInfoContext(ctx, params)
+		return err
+	})
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to get conversation info")
 	}
@@ -82,7 +165,12 @@ func (s *Service) GetConversationInfo(ctx context.Context, channelID string, inc
 
 // OpenConversation opens a conversation with a user
 func (s *Service) OpenConversation(ctx context.Context, params *slack.OpenConversationParameters) (*slack.Channel, bool, bool, error) {
-	channel, wasAlreadyOpen, noOp, err := s.client.OpenConversationContext(ctx, params)
+	var channel *slack.Channel
+	var wasAlreadyOpen, noOp bool
+	err := s.limiter.do(ctx, "conversations.open", "", func(ctx context.Context) (err error) {
+		channel, wasAlreadyOpen, noOp, err = s.client.OpenConversationContext(ctx, params)
+		return err
+	})
 	if err != nil {
 		return nil, false, false, goerr.Wrap(err, "failed to open conversation")
 	}
@@ -91,7 +179,11 @@ func (s *Service) OpenConversation(ctx context.Context, params *slack.OpenConver
 
 // AuthTestContext tests authentication and returns basic information about the team and bot
 func (s *Service) AuthTestContext(ctx context.Context) (*slack.AuthTestResponse, error) {
-	resp, err := s.client.AuthTestContext(ctx)
+	var resp *slack.AuthTestResponse
+	err := s.limiter.do(ctx, "auth.test", "", func(ctx context.Context) (err error) {
+		resp, err = s.client.AuthTestContext(ctx)
+		return err
+	})
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to authenticate with Slack")
 	}
@@ -100,13 +192,52 @@ func (s *Service) AuthTestContext(ctx context.Context) (*slack.AuthTestResponse,
 
 // SetPurposeOfConversationContext sets the purpose (description) of a Slack channel
 func (s *Service) SetPurposeOfConversationContext(ctx context.Context, channelID, purpose string) (*slack.Channel, error) {
-	channel, err := s.client.SetPurposeOfConversationContext(ctx, channelID, purpose)
+	var channel *slack.Channel
+	err := s.limiter.do(ctx, "conversations.setPurpose", "", func(ctx context.Context) (err error) {
+		channel, err = s.client.SetPurposeOfConversationContext(ctx, channelID, purpose)
+		return err
+	})
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to set channel purpose")
 	}
 	return channel, nil
 }
 
+// RenameConversationContext renames a Slack channel
+func (s *Service) RenameConversationContext(ctx context.Context, channelID, name string) (*slack.Channel, error) {
+	var channel *slack.Channel
+	err := s.limiter.do(ctx, "conversations.rename", "", func(ctx context.Context) (err error) {
+		channel, err = s.client.RenameConversationContext(ctx, channelID, name)
+		return err
+	})
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to rename channel", goerr.V("channelID", channelID), goerr.V("name", name))
+	}
+	return channel, nil
+}
+
+// ArchiveConversationContext archives a Slack channel
+func (s *Service) ArchiveConversationContext(ctx context.Context, channelID string) error {
+	err := s.limiter.do(ctx, "conversations.archive", "", func(ctx context.Context) error {
+		return s.client.ArchiveConversationContext(ctx, channelID)
+	})
+	if err != nil {
+		return goerr.Wrap(err, "failed to archive channel", goerr.V("channelID", channelID))
+	}
+	return nil
+}
+
+// UnArchiveConversationContext unarchives a Slack channel
+func (s *Service) UnArchiveConversationContext(ctx context.Context, channelID string) error {
+	err := s.limiter.do(ctx, "conversations.unarchive", "", func(ctx context.Context) error {
+		return s.client.UnArchiveConversationContext(ctx, channelID)
+	})
+	if err != nil {
+		return goerr.Wrap(err, "failed to unarchive channel", goerr.V("channelID", channelID))
+	}
+	return nil
+}
+
 // GetClient returns the underlying Slack client for advanced operations
 func (s *Service) GetClient() *slack.Client {
 	return s.client
@@ -114,7 +245,11 @@ func (s *Service) GetClient() *slack.Client {
 
 // OpenView opens a modal view in Slack
 func (s *Service) OpenView(ctx context.Context, triggerID string, view slack.ModalViewRequest) (*slack.ViewResponse, error) {
-	resp, err := s.client.OpenView(triggerID, view)
+	var resp *slack.ViewResponse
+	err := s.limiter.do(ctx, "views.open", "", func(ctx context.Context) (err error) {
+		resp, err = s.client.OpenViewContext(ctx, triggerID, view)
+		return err
+	})
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to open modal view")
 	}
@@ -123,7 +258,11 @@ func (s *Service) OpenView(ctx context.Context, triggerID string, view slack.Mod
 
 // GetConversationHistoryContext retrieves conversation history
 func (s *Service) GetConversationHistoryContext(ctx context.Context, params *slack.GetConversationHistoryParameters) (*slack.GetConversationHistoryResponse, error) {
-	resp, err := s.client.GetConversationHistoryContext(ctx, params)
+	var resp *slack.GetConversationHistoryResponse
+	err := s.limiter.do(ctx, "conversations.history", "", func(ctx context.Context) (err error) {
+		resp, err = s.client.GetConversationHistoryContext(ctx, params)
+		return err
+	})
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to get conversation history")
 	}
@@ -132,7 +271,13 @@ func (s *Service) GetConversationHistoryContext(ctx context.Context, params *sla
 
 // GetConversationRepliesContext retrieves conversation replies (thread messages)
 func (s *Service) GetConversationRepliesContext(ctx context.Context, params *slack.GetConversationRepliesParameters) ([]slack.Message, bool, bool, error) {
-	messages, hasMore, nextCursor, err := s.client.GetConversationRepliesContext(ctx, params)
+	var messages []slack.Message
+	var hasMore bool
+	var nextCursor string
+	err := s.limiter.do(ctx, "conversations.replies", "", func(ctx context.Context) (err error) {
+		messages, hasMore, nextCursor, err = s.client.GetConversationRepliesContext(ctx, params)
+		return err
+	})
 	if err != nil {
 		return nil, false, false, goerr.Wrap(err, "failed to get conversation replies")
 	}
@@ -172,12 +317,16 @@ func (s *Service) SendContextMessage(ctx context.Context, channelID, messageTS,
 		"channelID", channelID,
 	)
 
-	channelResp, contextMsgTS, err := s.client.PostMessageContext(
-		ctx,
-		channelID,
-		slack.MsgOptionBlocks(contextBlocks...),
-		slack.MsgOptionTS(messageTS), // Reply in thread
-	)
+	var channelResp, contextMsgTS string
+	err := s.limiter.do(ctx, "chat.postMessage", channelID, func(ctx context.Context) (err error) {
+		channelResp, contextMsgTS, err = s.client.PostMessageContext(
+			ctx,
+			channelID,
+			slack.MsgOptionBlocks(contextBlocks...),
+			slack.MsgOptionTS(messageTS), // Reply in thread
+		)
+		return err
+	})
 	if err != nil {
 		// Log error but don't fail - context message is not critical
 		ctxlog.From(ctx).Error("Failed to send context message",
@@ -201,7 +350,11 @@ func (s *Service) SendContextMessage(ctx context.Context, channelID, messageTS,
 
 // GetUsersContext retrieves the list of users (including bots) from the workspace
 func (s *Service) GetUsersContext(ctx context.Context) ([]slack.User, error) {
-	users, err := s.client.GetUsersContext(ctx)
+	var users []slack.User
+	err := s.limiter.do(ctx, "users.list", "", func(ctx context.Context) (err error) {
+		users, err = s.client.GetUsersContext(ctx)
+		return err
+	})
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to get users")
 	}
@@ -210,7 +363,11 @@ func (s *Service) GetUsersContext(ctx context.Context) ([]slack.User, error) {
 
 // GetUserInfoContext retrieves information about a specific user
 func (s *Service) GetUserInfoContext(ctx context.Context, userID string) (*slack.User, error) {
-	user, err := s.client.GetUserInfoContext(ctx, userID)
+	var user *slack.User
+	err := s.limiter.do(ctx, "users.info", "", func(ctx context.Context) (err error) {
+		user, err = s.client.GetUserInfoContext(ctx, userID)
+		return err
+	})
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to get user info", goerr.V("userID", userID))
 	}
@@ -219,7 +376,11 @@ func (s *Service) GetUserInfoContext(ctx context.Context, userID string) (*slack
 
 // GetUserGroupsContext retrieves the list of user groups from the workspace
 func (s *Service) GetUserGroupsContext(ctx context.Context) ([]slack.UserGroup, error) {
-	groups, err := s.client.GetUserGroupsContext(ctx)
+	var groups []slack.UserGroup
+	err := s.limiter.do(ctx, "usergroups.list", "", func(ctx context.Context) (err error) {
+		groups, err = s.client.GetUserGroupsContext(ctx)
+		return err
+	})
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to get user groups")
 	}
@@ -228,7 +389,11 @@ func (s *Service) GetUserGroupsContext(ctx context.Context) ([]slack.UserGroup,
 
 // GetUserGroupMembersContext retrieves the member IDs of a user group
 func (s *Service) GetUserGroupMembersContext(ctx context.Context, groupID string) ([]string, error) {
-	members, err := s.client.GetUserGroupMembersContext(ctx, groupID)
+	var members []string
+	err := s.limiter.do(ctx, "usergroups.users.list", "", func(ctx context.Context) (err error) {
+		members, err = s.client.GetUserGroupMembersContext(ctx, groupID)
+		return err
+	})
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to get user group members", goerr.V("groupID", groupID))
 	}
@@ -237,13 +402,33 @@ func (s *Service) GetUserGroupMembersContext(ctx context.Context, groupID string
 
 // GetUsersInConversationContext retrieves the member IDs of a conversation (channel)
 func (s *Service) GetUsersInConversationContext(ctx context.Context, params *slack.GetUsersInConversationParameters) ([]string, string, error) {
-	users, nextCursor, err := s.client.GetUsersInConversationContext(ctx, params)
+	var users []string
+	var nextCursor string
+	err := s.limiter.do(ctx, "conversations.members", "", func(ctx context.Context) (err error) {
+		users, nextCursor, err = s.client.GetUsersInConversationContext(ctx, params)
+		return err
+	})
 	if err != nil {
 		return nil, "", goerr.Wrap(err, "failed to get users in conversation", goerr.V("channelID", params.ChannelID))
 	}
 	return users, nextCursor, nil
 }
 
+// GetConversationsForUserContext retrieves the conversations a user is a member of,
+// or the bot itself when params.UserID is empty
+func (s *Service) GetConversationsForUserContext(ctx context.Context, params *slack.GetConversationsForUserParameters) ([]slack.Channel, string, error) {
+	var channels []slack.Channel
+	var nextCursor string
+	err := s.limiter.do(ctx, "users.conversations", "", func(ctx context.Context) (err error) {
+		channels, nextCursor, err = s.client.GetConversationsForUserContext(ctx, params)
+		return err
+	})
+	if err != nil {
+		return nil, "", goerr.Wrap(err, "failed to get conversations for user", goerr.V("userID", params.UserID))
+	}
+	return channels, nextCursor, nil
+}
+
 // AddBookmark adds a bookmark to a Slack channel
 func (s *Service) AddBookmark(ctx context.Context, channelID, title, link string) error {
 	logger := ctxlog.From(ctx)
@@ -254,10 +439,13 @@ func (s *Service) AddBookmark(ctx context.Context, channelID, title, link string
 		"link", link)
 
 	// Use the bookmarks.add API endpoint
-	_, err := s.client.AddBookmarkContext(ctx, channelID, slack.AddBookmarkParameters{
-		Title: title,
-		Link:  link,
-		Type:  "link",
+	err := s.limiter.do(ctx, "bookmarks.add", "", func(ctx context.Context) error {
+		_, err := s.client.AddBookmarkContext(ctx, channelID, slack.AddBookmarkParameters{
+			Title: title,
+			Link:  link,
+			Type:  "link",
+		})
+		return err
 	})
 	if err != nil {
 		return goerr.Wrap(err, "failed to add bookmark",
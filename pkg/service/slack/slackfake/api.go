@@ -0,0 +1,636 @@
+package slackfake
+
+import (
+	"cmp"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// apiHandler implements a single Slack Web API method. It runs with s.mu held
+// and returns the response body fields, or a Slack error code.
+type apiHandler func(s *Server, r *apiRequest) (map[string]any, string)
+
+// apiRequest holds the parameters of a Web API call, sent either as a form or as JSON
+type apiRequest struct {
+	form url.Values
+	body []byte
+}
+
+var apiHandlers = map[string]apiHandler{
+	"auth.test":                (*Server).authTest,
+	"bookmarks.add":            (*Server).bookmarksAdd,
+	"chat.postEphemeral":       (*Server).chatPostEphemeral,
+	"chat.postMessage":         (*Server).chatPostMessage,
+	"chat.update":              (*Server).chatUpdate,
+	"conversations.archive":    (*Server).conversationsArchive,
+	"conversations.create":     (*Server).conversationsCreate,
+	"conversations.history":    (*Server).conversationsHistory,
+	"conversations.info":       (*Server).conversationsInfo,
+	"conversations.invite":     (*Server).conversationsInvite,
+	"conversations.members":    (*Server).conversationsMembers,
+	"conversations.open":       (*Server).conversationsOpen,
+	"conversations.rename":     (*Server).conversationsRename,
+	"conversations.replies":    (*Server).conversationsReplies,
+	"conversations.setPurpose": (*Server).conversationsSetPurpose,
+	"conversations.unarchive":  (*Server).conversationsUnarchive,
+	"usergroups.list":          (*Server).usergroupsList,
+	"usergroups.users.list":    (*Server).usergroupsUsersList,
+	"users.conversations":      (*Server).usersConversations,
+	"users.info":               (*Server).usersInfo,
+	"users.list":               (*Server).usersList,
+	"views.open":               (*Server).viewsOpen,
+}
+
+// channelNamePattern matches names accepted by conversations.create
+var channelNamePattern = regexp.MustCompile(`^[a-z0-9_-]{1,80}$`)
+
+func (s *Server) handleAPI(w http.ResponseWriter, r *http.Request) {
+	method := r.PathValue("method")
+	handler, ok := apiHandlers[method]
+	if !ok {
+		writeJSON(w, map[string]any{"ok": false, "error": "unknown_method"})
+		return
+	}
+
+	req := &apiRequest{}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeJSON(w, map[string]any{"ok": false, "error": "invalid_json"})
+			return
+		}
+		req.body = body
+	} else {
+		if err := r.ParseForm(); err != nil {
+			writeJSON(w, map[string]any{"ok": false, "error": "invalid_form_data"})
+			return
+		}
+		req.form = r.Form
+	}
+
+	s.mu.Lock()
+	resp, errCode := handler(s, req)
+	s.mu.Unlock()
+
+	if errCode != "" {
+		writeJSON(w, map[string]any{"ok": false, "error": errCode})
+		return
+	}
+	if resp == nil {
+		resp = map[string]any{}
+	}
+	resp["ok"] = true
+	writeJSON(w, resp)
+}
+
+func (s *Server) authTest(_ *apiRequest) (map[string]any, string) {
+	return map[string]any{
+		"url":     "https://lycaon-dev.slack.com/",
+		"team":    "lycaon-dev",
+		"user":    "lycaon",
+		"team_id": DefaultTeamID,
+		"user_id": DefaultBotUserID,
+		"bot_id":  DefaultBotID,
+	}, ""
+}
+
+func (s *Server) conversationsCreate(r *apiRequest) (map[string]any, string) {
+	name := r.form.Get("name")
+	if !channelNamePattern.MatchString(name) {
+		return nil, "invalid_name_specials"
+	}
+	for _, ch := range s.channels {
+		if ch.Name == name {
+			return nil, "name_taken"
+		}
+	}
+
+	ch := &Channel{
+		ID:        s.nextID("C"),
+		Name:      name,
+		IsPrivate: r.form.Get("is_private") == "true",
+		Creator:   DefaultBotUserID,
+		Created:   time.Now(),
+		Members:   []string{DefaultBotUserID},
+	}
+	s.addChannel(ch)
+
+	return map[string]any{"channel": channelJSON(ch)}, ""
+}
+
+func (s *Server) conversationsInfo(r *apiRequest) (map[string]any, string) {
+	ch, ok := s.channels[r.form.Get("channel")]
+	if !ok {
+		return nil, "channel_not_found"
+	}
+	return map[string]any{"channel": channelJSON(ch)}, ""
+}
+
+func (s *Server) conversationsInvite(r *apiRequest) (map[string]any, string) {
+	ch, ok := s.channels[r.form.Get("channel")]
+	if !ok {
+		return nil, "channel_not_found"
+	}
+
+	users := splitList(r.form.Get("users"))
+	if len(users) == 0 {
+		return nil, "no_user"
+	}
+	for _, id := range users {
+		if _, ok := s.users[id]; !ok {
+			return nil, "user_not_found"
+		}
+	}
+
+	added := false
+	for _, id := range users {
+		if !slices.Contains(ch.Members, id) {
+			ch.Members = append(ch.Members, id)
+			added = true
+		}
+	}
+	if !added {
+		return nil, "already_in_channel"
+	}
+
+	return map[string]any{"channel": channelJSON(ch)}, ""
+}
+
+func (s *Server) conversationsMembers(r *apiRequest) (map[string]any, string) {
+	ch, ok := s.channels[r.form.Get("channel")]
+	if !ok {
+		return nil, "channel_not_found"
+	}
+	return map[string]any{
+		"members":           slices.Clone(ch.Members),
+		"response_metadata": map[string]any{"next_cursor": ""},
+	}, ""
+}
+
+func (s *Server) conversationsOpen(r *apiRequest) (map[string]any, string) {
+	users := splitList(r.form.Get("users"))
+	if len(users) == 0 {
+		return nil, "users_list_not_supplied"
+	}
+
+	// Direct messages are modelled as private channels named after their members
+	name := "dm-" + strings.ToLower(strings.Join(users, "-"))
+	for _, ch := range s.channels {
+		if ch.Name == name {
+			return map[string]any{"channel": channelJSON(ch), "already_open": true}, ""
+		}
+	}
+
+	ch := &Channel{
+		ID:        s.nextID("D"),
+		Name:      name,
+		IsPrivate: true,
+		Created:   time.Now(),
+		Members:   append([]string{DefaultBotUserID}, users...),
+	}
+	s.addChannel(ch)
+	return map[string]any{"channel": channelJSON(ch)}, ""
+}
+
+func (s *Server) conversationsSetPurpose(r *apiRequest) (map[string]any, string) {
+	ch, ok := s.channels[r.form.Get("channel")]
+	if !ok {
+		return nil, "channel_not_found"
+	}
+	ch.Purpose = r.form.Get("purpose")
+	return map[string]any{"channel": channelJSON(ch)}, ""
+}
+
+func (s *Server) conversationsRename(r *apiRequest) (map[string]any, string) {
+	ch, ok := s.channels[r.form.Get("channel")]
+	if !ok {
+		return nil, "channel_not_found"
+	}
+	name := r.form.Get("name")
+	if !channelNamePattern.MatchString(name) {
+		return nil, "invalid_name_specials"
+	}
+	for _, other := range s.channels {
+		if other.Name == name && other.ID != ch.ID {
+			return nil, "name_taken"
+		}
+	}
+	ch.Name = name
+	return map[string]any{"channel": channelJSON(ch)}, ""
+}
+
+func (s *Server) conversationsArchive(r *apiRequest) (map[string]any, string) {
+	ch, ok := s.channels[r.form.Get("channel")]
+	if !ok {
+		return nil, "channel_not_found"
+	}
+	if ch.IsArchived {
+		return nil, "already_archived"
+	}
+	ch.IsArchived = true
+	return nil, ""
+}
+
+func (s *Server) conversationsUnarchive(r *apiRequest) (map[string]any, string) {
+	ch, ok := s.channels[r.form.Get("channel")]
+	if !ok {
+		return nil, "channel_not_found"
+	}
+	if !ch.IsArchived {
+		return nil, "not_archived"
+	}
+	ch.IsArchived = false
+	return nil, ""
+}
+
+func (s *Server) conversationsHistory(r *apiRequest) (map[string]any, string) {
+	ch, ok := s.channels[r.form.Get("channel")]
+	if !ok {
+		return nil, "channel_not_found"
+	}
+
+	// Thread parents carry their own ts as thread_ts and a reply count, as in Slack
+	replies := map[string]int{}
+	for _, msg := range ch.Messages {
+		if msg.EphemeralTo == "" && msg.ThreadTS != "" && msg.ThreadTS != msg.TS {
+			replies[msg.ThreadTS]++
+		}
+	}
+
+	oldest := r.form.Get("oldest")
+	latest := r.form.Get("latest")
+	var messages []map[string]any
+	for i := len(ch.Messages) - 1; i >= 0; i-- {
+		msg := ch.Messages[i]
+		if msg.EphemeralTo != "" || (msg.ThreadTS != "" && msg.ThreadTS != msg.TS) {
+			continue
+		}
+		if oldest != "" && compareTS(msg.TS, oldest) <= 0 {
+			continue
+		}
+		if latest != "" && compareTS(msg.TS, latest) >= 0 {
+			continue
+		}
+		m := messageJSON(msg)
+		if n := replies[msg.TS]; n > 0 {
+			m["thread_ts"] = msg.TS
+			m["reply_count"] = n
+		}
+		messages = append(messages, m)
+	}
+
+	return paginateMessages(messages, r.form.Get("limit")), ""
+}
+
+func (s *Server) conversationsReplies(r *apiRequest) (map[string]any, string) {
+	ch, ok := s.channels[r.form.Get("channel")]
+	if !ok {
+		return nil, "channel_not_found"
+	}
+
+	ts := r.form.Get("ts")
+	var messages []map[string]any
+	for _, msg := range ch.Messages {
+		if msg.EphemeralTo != "" {
+			continue
+		}
+		if msg.TS == ts || msg.ThreadTS == ts {
+			messages = append(messages, messageJSON(msg))
+		}
+	}
+	if len(messages) == 0 {
+		return nil, "thread_not_found"
+	}
+
+	return paginateMessages(messages, r.form.Get("limit")), ""
+}
+
+func (s *Server) chatPostMessage(r *apiRequest) (map[string]any, string) {
+	ch, ok := s.channels[r.form.Get("channel")]
+	if !ok {
+		return nil, "channel_not_found"
+	}
+	if ch.IsArchived {
+		return nil, "is_archived"
+	}
+
+	msg := Message{
+		Channel:  ch.ID,
+		TS:       s.nextTS(),
+		ThreadTS: r.form.Get("thread_ts"),
+		User:     DefaultBotUserID,
+		BotID:    DefaultBotID,
+		Text:     r.form.Get("text"),
+		Blocks:   rawJSON(r.form.Get("blocks")),
+	}
+	if msg.Text == "" && len(msg.Blocks) == 0 {
+		return nil, "no_text"
+	}
+	ch.Messages = append(ch.Messages, msg)
+
+	return map[string]any{
+		"channel": ch.ID,
+		"ts":      msg.TS,
+		"message": messageJSON(msg),
+	}, ""
+}
+
+func (s *Server) chatPostEphemeral(r *apiRequest) (map[string]any, string) {
+	ch, ok := s.channels[r.form.Get("channel")]
+	if !ok {
+		return nil, "channel_not_found"
+	}
+	user := r.form.Get("user")
+	if _, ok := s.users[user]; !ok {
+		return nil, "user_not_found"
+	}
+
+	msg := Message{
+		Channel:     ch.ID,
+		TS:          s.nextTS(),
+		ThreadTS:    r.form.Get("thread_ts"),
+		User:        DefaultBotUserID,
+		BotID:       DefaultBotID,
+		Text:        r.form.Get("text"),
+		Blocks:      rawJSON(r.form.Get("blocks")),
+		EphemeralTo: user,
+	}
+	ch.Messages = append(ch.Messages, msg)
+
+	return map[string]any{"message_ts": msg.TS}, ""
+}
+
+func (s *Server) chatUpdate(r *apiRequest) (map[string]any, string) {
+	ch, ok := s.channels[r.form.Get("channel")]
+	if !ok {
+		return nil, "channel_not_found"
+	}
+
+	ts := r.form.Get("ts")
+	idx := slices.IndexFunc(ch.Messages, func(m Message) bool { return m.TS == ts })
+	if idx < 0 {
+		return nil, "message_not_found"
+	}
+
+	msg := &ch.Messages[idx]
+	if text := r.form.Get("text"); text != "" {
+		msg.Text = text
+	}
+	if blocks := rawJSON(r.form.Get("blocks")); blocks != nil {
+		msg.Blocks = blocks
+	}
+	msg.Edited = true
+
+	return map[string]any{
+		"channel": ch.ID,
+		"ts":      msg.TS,
+		"text":    msg.Text,
+	}, ""
+}
+
+func (s *Server) viewsOpen(r *apiRequest) (map[string]any, string) {
+	var req struct {
+		TriggerID string          `json:"trigger_id"`
+		View      json.RawMessage `json:"view"`
+	}
+	if err := json.Unmarshal(r.body, &req); err != nil {
+		return nil, "invalid_json"
+	}
+	if req.TriggerID == "" {
+		return nil, "invalid_trigger_id"
+	}
+
+	view := View{
+		ID:        s.nextID("V"),
+		TriggerID: req.TriggerID,
+		View:      req.View,
+	}
+	s.views = append(s.views, view)
+
+	resp := map[string]any{}
+	_ = json.Unmarshal(req.View, &resp)
+	resp["id"] = view.ID
+	resp["team_id"] = DefaultTeamID
+	return map[string]any{"view": resp}, ""
+}
+
+func (s *Server) bookmarksAdd(r *apiRequest) (map[string]any, string) {
+	ch, ok := s.channels[r.form.Get("channel_id")]
+	if !ok {
+		return nil, "channel_not_found"
+	}
+
+	bookmark := Bookmark{
+		ID:    s.nextID("Bk"),
+		Title: r.form.Get("title"),
+		Link:  r.form.Get("link"),
+	}
+	ch.Bookmarks = append(ch.Bookmarks, bookmark)
+
+	return map[string]any{"bookmark": map[string]any{
+		"id":         bookmark.ID,
+		"channel_id": ch.ID,
+		"title":      bookmark.Title,
+		"link":       bookmark.Link,
+		"type":       "link",
+	}}, ""
+}
+
+func (s *Server) usersList(_ *apiRequest) (map[string]any, string) {
+	members := make([]map[string]any, 0, len(s.users))
+	for _, u := range s.users {
+		members = append(members, userJSON(u))
+	}
+	slices.SortFunc(members, func(a, b map[string]any) int {
+		return strings.Compare(a["id"].(string), b["id"].(string))
+	})
+
+	return map[string]any{
+		"members":           members,
+		"response_metadata": map[string]any{"next_cursor": ""},
+	}, ""
+}
+
+func (s *Server) usersConversations(r *apiRequest) (map[string]any, string) {
+	user := r.form.Get("user")
+	if user == "" {
+		user = DefaultBotUserID
+	}
+	types := splitList(r.form.Get("types"))
+	if len(types) == 0 {
+		types = []string{"public_channel"}
+	}
+
+	channels := make([]map[string]any, 0)
+	for _, ch := range s.channels {
+		if !slices.Contains(ch.Members, user) {
+			continue
+		}
+		kind := "public_channel"
+		switch {
+		case strings.HasPrefix(ch.ID, "D"):
+			kind = "im"
+		case ch.IsPrivate:
+			kind = "private_channel"
+		}
+		if slices.Contains(types, kind) {
+			channels = append(channels, channelJSON(ch))
+		}
+	}
+	slices.SortFunc(channels, func(a, b map[string]any) int {
+		return strings.Compare(a["id"].(string), b["id"].(string))
+	})
+
+	return map[string]any{
+		"channels":          channels,
+		"response_metadata": map[string]any{"next_cursor": ""},
+	}, ""
+}
+
+func (s *Server) usersInfo(r *apiRequest) (map[string]any, string) {
+	u, ok := s.users[r.form.Get("user")]
+	if !ok {
+		return nil, "user_not_found"
+	}
+	return map[string]any{"user": userJSON(u)}, ""
+}
+
+func (s *Server) usergroupsList(_ *apiRequest) (map[string]any, string) {
+	groups := make([]map[string]any, 0, len(s.userGroups))
+	for _, g := range s.userGroups {
+		groups = append(groups, map[string]any{
+			"id":         g.ID,
+			"team_id":    DefaultTeamID,
+			"handle":     g.Handle,
+			"name":       g.Name,
+			"users":      slices.Clone(g.Users),
+			"user_count": len(g.Users),
+		})
+	}
+	slices.SortFunc(groups, func(a, b map[string]any) int {
+		return strings.Compare(a["id"].(string), b["id"].(string))
+	})
+
+	return map[string]any{"usergroups": groups}, ""
+}
+
+func (s *Server) usergroupsUsersList(r *apiRequest) (map[string]any, string) {
+	g, ok := s.userGroups[r.form.Get("usergroup")]
+	if !ok {
+		return nil, "no_such_subteam"
+	}
+	return map[string]any{"users": slices.Clone(g.Users)}, ""
+}
+
+func channelJSON(ch *Channel) map[string]any {
+	return map[string]any{
+		"id":          ch.ID,
+		"name":        ch.Name,
+		"is_channel":  !strings.HasPrefix(ch.ID, "D"),
+		"is_im":       strings.HasPrefix(ch.ID, "D"),
+		"is_private":  ch.IsPrivate,
+		"is_archived": ch.IsArchived,
+		"is_member":   slices.Contains(ch.Members, DefaultBotUserID),
+		"created":     ch.Created.Unix(),
+		"creator":     ch.Creator,
+		"num_members": len(ch.Members),
+		"purpose":     map[string]any{"value": ch.Purpose},
+		"topic":       map[string]any{"value": ""},
+	}
+}
+
+func messageJSON(msg Message) map[string]any {
+	m := map[string]any{
+		"type": "message",
+		"ts":   msg.TS,
+		"user": msg.User,
+		"text": msg.Text,
+	}
+	if msg.ThreadTS != "" {
+		m["thread_ts"] = msg.ThreadTS
+	}
+	if msg.BotID != "" {
+		m["bot_id"] = msg.BotID
+	}
+	if len(msg.Blocks) > 0 {
+		m["blocks"] = msg.Blocks
+	}
+	return m
+}
+
+func userJSON(u *User) map[string]any {
+	return map[string]any{
+		"id":        u.ID,
+		"team_id":   DefaultTeamID,
+		"name":      u.Name,
+		"real_name": u.RealName,
+		"is_bot":    u.IsBot,
+		"deleted":   false,
+		"profile": map[string]any{
+			"real_name":    u.RealName,
+			"display_name": u.DisplayName,
+			"email":        u.Email,
+		},
+	}
+}
+
+// paginateMessages applies the limit parameter; cursors are not supported
+func paginateMessages(messages []map[string]any, limitParam string) map[string]any {
+	hasMore := false
+	if limit, err := strconv.Atoi(limitParam); err == nil && limit > 0 && len(messages) > limit {
+		messages = messages[:limit]
+		hasMore = true
+	}
+	if messages == nil {
+		messages = []map[string]any{}
+	}
+
+	return map[string]any{
+		"messages":          messages,
+		"has_more":          hasMore,
+		"response_metadata": map[string]any{"next_cursor": ""},
+	}
+}
+
+// compareTS compares Slack timestamps ("seconds.micros") numerically
+func compareTS(a, b string) int {
+	as, au, _ := strings.Cut(a, ".")
+	bs, bu, _ := strings.Cut(b, ".")
+	ai, _ := strconv.ParseInt(as, 10, 64)
+	bi, _ := strconv.ParseInt(bs, 10, 64)
+	if ai != bi {
+		return cmp.Compare(ai, bi)
+	}
+	af, _ := strconv.ParseInt((au + "000000")[:6], 10, 64)
+	bf, _ := strconv.ParseInt((bu + "000000")[:6], 10, 64)
+	return cmp.Compare(af, bf)
+}
+
+func splitList(v string) []string {
+	var items []string
+	for item := range strings.SplitSeq(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func rawJSON(v string) json.RawMessage {
+	if v == "" {
+		return nil
+	}
+	return json.RawMessage(v)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
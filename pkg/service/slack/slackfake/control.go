@@ -0,0 +1,88 @@
+package slackfake
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/m-mizutani/goerr/v2"
+)
+
+// registerControl adds endpoints to inspect the fake workspace and to act as a
+// Slack user, so flows can be driven with curl during local development:
+//
+//	GET  /_fake/state         snapshot of channels, messages, views, users
+//	POST /_fake/messages      {"channel","user","text","thread_ts"} post as a user
+//	POST /_fake/interactions  raw interaction payload JSON (e.g. block_actions)
+func (s *Server) registerControl(mux *http.ServeMux) {
+	mux.HandleFunc("GET /_fake/state", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, s.State())
+	})
+
+	mux.HandleFunc("POST /_fake/messages", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Channel  string `json:"channel"`
+			User     string `json:"user"`
+			Text     string `json:"text"`
+			ThreadTS string `json:"thread_ts"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if req.Channel == "" {
+			req.Channel = DefaultChannelID
+		}
+
+		msg, err := s.Say(r.Context(), req.Channel, req.User, req.Text, req.ThreadTS)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		writeJSON(w, msg)
+	})
+
+	mux.HandleFunc("POST /_fake/interactions", func(w http.ResponseWriter, r *http.Request) {
+		payload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		hook := s.getHook()
+		if hook == nil {
+			http.Error(w, "no hook configured", http.StatusServiceUnavailable)
+			return
+		}
+		if err := hook.SendInteraction(r.Context(), payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// Say records a message from a user and delivers the corresponding events
+// through the configured Hook
+func (s *Server) Say(ctx context.Context, channelID, userID, text, threadTS string) (Message, error) {
+	hook := s.getHook()
+	if hook == nil {
+		return Message{}, goerr.New("no hook configured")
+	}
+
+	msg, err := s.PostUserMessage(channelID, userID, text, threadTS)
+	if err != nil {
+		return Message{}, err
+	}
+	if err := hook.SendMessage(ctx, msg); err != nil {
+		return Message{}, goerr.Wrap(err, "failed to deliver message event")
+	}
+	return msg, nil
+}
+
+func (s *Server) getHook() *Hook {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.hook
+}
@@ -0,0 +1,137 @@
+package slackfake
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+)
+
+// Hook posts signed synthetic Events API callbacks and interactions to
+// lycaon's /hooks/slack/event and /hooks/slack/interaction endpoints
+type Hook struct {
+	baseURL       string
+	signingSecret string
+	client        *http.Client
+	seq           atomic.Int64
+}
+
+// NewHook creates a Hook for the lycaon server at baseURL (e.g. http://localhost:8080)
+func NewHook(baseURL, signingSecret string) *Hook {
+	return &Hook{
+		baseURL:       strings.TrimRight(baseURL, "/"),
+		signingSecret: signingSecret,
+		client:        &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// SendEvent wraps an inner event (e.g. {"type":"message",...}) in an
+// event_callback envelope and delivers it
+func (h *Hook) SendEvent(ctx context.Context, event map[string]any) error {
+	envelope := map[string]any{
+		"token":      "fake-verification-token",
+		"team_id":    DefaultTeamID,
+		"api_app_id": "A0LYCAONDEV",
+		"type":       "event_callback",
+		"event_id":   fmt.Sprintf("Ev%012d", h.seq.Add(1)),
+		"event_time": time.Now().Unix(),
+		"event":      event,
+	}
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		return goerr.Wrap(err, "failed to marshal event")
+	}
+
+	return h.post(ctx, "/hooks/slack/event", "application/json", body)
+}
+
+// SendMessage delivers a message event for msg, followed by an app_mention
+// event when the bot is mentioned, as Slack does
+func (h *Hook) SendMessage(ctx context.Context, msg Message) error {
+	event := map[string]any{
+		"type":         "message",
+		"channel":      msg.Channel,
+		"user":         msg.User,
+		"text":         msg.Text,
+		"ts":           msg.TS,
+		"event_ts":     msg.TS,
+		"channel_type": "channel",
+	}
+	if msg.ThreadTS != "" {
+		event["thread_ts"] = msg.ThreadTS
+	}
+	if err := h.SendEvent(ctx, event); err != nil {
+		return err
+	}
+
+	if !strings.Contains(msg.Text, "<@"+DefaultBotUserID+">") {
+		return nil
+	}
+
+	mention := map[string]any{
+		"type":     "app_mention",
+		"channel":  msg.Channel,
+		"user":     msg.User,
+		"text":     msg.Text,
+		"ts":       msg.TS,
+		"event_ts": msg.TS,
+	}
+	if msg.ThreadTS != "" {
+		mention["thread_ts"] = msg.ThreadTS
+	}
+	return h.SendEvent(ctx, mention)
+}
+
+// SendInteraction delivers an interaction payload (block_actions, view_submission, ...)
+func (h *Hook) SendInteraction(ctx context.Context, payload []byte) error {
+	form := url.Values{"payload": {string(payload)}}
+	return h.post(ctx, "/hooks/slack/interaction", "application/x-www-form-urlencoded", []byte(form.Encode()))
+}
+
+// post sends a request signed the same way Slack signs webhook requests
+func (h *Hook) post(ctx context.Context, path, contentType string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return goerr.Wrap(err, "failed to create hook request")
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Slack-Request-Timestamp", timestamp)
+	req.Header.Set("X-Slack-Signature", Sign(h.signingSecret, timestamp, body))
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return goerr.Wrap(err, "failed to deliver hook request", goerr.V("path", path))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return goerr.New("hook request rejected",
+			goerr.V("path", path),
+			goerr.V("status", resp.StatusCode),
+			goerr.V("body", string(respBody)))
+	}
+	return nil
+}
+
+// Sign returns the X-Slack-Signature header value for a request body
+func Sign(signingSecret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+	return "v0=" + hex.EncodeToString(mac.Sum(nil))
+}
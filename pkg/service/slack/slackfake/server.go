@@ -0,0 +1,329 @@
+// Package slackfake provides an in-process fake of the Slack Web API endpoints
+// used by lycaon, with inspectable state and a client for posting signed
+// synthetic events to lycaon's webhook endpoints. It is used by `lycaon dev`
+// and by end-to-end tests that run without a Slack workspace.
+package slackfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+)
+
+const (
+	// DefaultBotUserID is the user ID of the bot returned by auth.test
+	DefaultBotUserID = "U0LYCAONBOT"
+	// DefaultBotID is the bot ID attached to messages posted by the bot
+	DefaultBotID = "B0LYCAONBOT"
+	// DefaultTeamID is the team ID of the fake workspace
+	DefaultTeamID = "T0LYCAONDEV"
+	// DefaultChannelID is the ID of the #general channel created on startup
+	DefaultChannelID = "C0GENERAL00"
+)
+
+// Channel is a conversation in the fake workspace
+type Channel struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	IsPrivate  bool       `json:"is_private"`
+	IsArchived bool       `json:"is_archived"`
+	Purpose    string     `json:"purpose"`
+	Creator    string     `json:"creator"`
+	Created    time.Time  `json:"created"`
+	Members    []string   `json:"members"`
+	Messages   []Message  `json:"messages"`
+	Bookmarks  []Bookmark `json:"bookmarks"`
+}
+
+// Message is a message posted to a channel
+type Message struct {
+	Channel  string          `json:"channel"`
+	TS       string          `json:"ts"`
+	ThreadTS string          `json:"thread_ts,omitempty"`
+	User     string          `json:"user,omitempty"`
+	BotID    string          `json:"bot_id,omitempty"`
+	Text     string          `json:"text"`
+	Blocks   json.RawMessage `json:"blocks,omitempty"`
+	// EphemeralTo is set for ephemeral messages and holds the recipient user ID
+	EphemeralTo string `json:"ephemeral_to,omitempty"`
+	Edited      bool   `json:"edited,omitempty"`
+}
+
+// Bookmark is a channel bookmark
+type Bookmark struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Link  string `json:"link"`
+}
+
+// View is a modal opened with views.open
+type View struct {
+	ID        string          `json:"id"`
+	TriggerID string          `json:"trigger_id"`
+	View      json.RawMessage `json:"view"`
+}
+
+// User is a member of the fake workspace
+type User struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	RealName    string `json:"real_name"`
+	DisplayName string `json:"display_name"`
+	Email       string `json:"email"`
+	IsBot       bool   `json:"is_bot"`
+}
+
+// UserGroup is a user group (e.g. @oncall)
+type UserGroup struct {
+	ID     string   `json:"id"`
+	Handle string   `json:"handle"`
+	Name   string   `json:"name"`
+	Users  []string `json:"users"`
+}
+
+// State is a snapshot of the fake workspace
+type State struct {
+	Channels   []Channel   `json:"channels"`
+	Views      []View      `json:"views"`
+	Users      []User      `json:"users"`
+	UserGroups []UserGroup `json:"user_groups"`
+}
+
+// Server is a fake Slack Web API. Mount it with httptest.NewServer or any
+// http.Server and point the Slack client at "<base URL>/api/".
+type Server struct {
+	mu         sync.Mutex
+	channels   map[string]*Channel
+	order      []string
+	views      []View
+	users      map[string]*User
+	userGroups map[string]*UserGroup
+	seq        int
+	lastTS     int64
+	hook       *Hook
+
+	mux *http.ServeMux
+}
+
+// Option configures a Server
+type Option func(*Server)
+
+// WithUser adds a user to the workspace
+func WithUser(user User) Option {
+	return func(s *Server) {
+		s.users[user.ID] = &user
+	}
+}
+
+// WithUserGroup adds a user group to the workspace
+func WithUserGroup(group UserGroup) Option {
+	return func(s *Server) {
+		s.userGroups[group.ID] = &group
+	}
+}
+
+// WithHook sets the client used by the control endpoints to deliver synthetic
+// events and interactions to lycaon
+func WithHook(hook *Hook) Option {
+	return func(s *Server) {
+		s.hook = hook
+	}
+}
+
+// New creates a fake Slack server with the bot user and a #general channel
+func New(opts ...Option) *Server {
+	s := &Server{
+		channels:   make(map[string]*Channel),
+		users:      make(map[string]*User),
+		userGroups: make(map[string]*UserGroup),
+	}
+
+	s.users[DefaultBotUserID] = &User{
+		ID:       DefaultBotUserID,
+		Name:     "lycaon",
+		RealName: "Lycaon",
+		IsBot:    true,
+	}
+	s.addChannel(&Channel{
+		ID:      DefaultChannelID,
+		Name:    "general",
+		Created: time.Now(),
+		Members: []string{DefaultBotUserID},
+	})
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.mux = http.NewServeMux()
+	s.mux.HandleFunc("POST /api/{method}", s.handleAPI)
+	s.mux.HandleFunc("GET /api/{method}", s.handleAPI)
+	s.registerControl(s.mux)
+
+	return s
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// SetHook sets the client used to deliver synthetic events to lycaon
+func (s *Server) SetHook(hook *Hook) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hook = hook
+}
+
+// AddUser adds or replaces a user in the workspace
+func (s *Server) AddUser(user User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users[user.ID] = &user
+}
+
+// AddUserGroup adds or replaces a user group in the workspace
+func (s *Server) AddUserGroup(group UserGroup) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.userGroups[group.ID] = &group
+}
+
+// AddChannel adds or replaces a channel in the workspace
+func (s *Server) AddChannel(ch Channel) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.channels[ch.ID]; ok {
+		s.channels[ch.ID] = &ch
+		return
+	}
+	s.addChannel(&ch)
+}
+
+// Channel returns a copy of the channel, or false if it does not exist
+func (s *Server) Channel(id string) (Channel, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch, ok := s.channels[id]
+	if !ok {
+		return Channel{}, false
+	}
+	return copyChannel(ch), true
+}
+
+// ChannelByName returns a copy of the channel with the given name
+func (s *Server) ChannelByName(name string) (Channel, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range s.order {
+		if s.channels[id].Name == name {
+			return copyChannel(s.channels[id]), true
+		}
+	}
+	return Channel{}, false
+}
+
+// Messages returns the messages posted to a channel in chronological order
+func (s *Server) Messages(channelID string) []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch, ok := s.channels[channelID]
+	if !ok {
+		return nil
+	}
+	return slices.Clone(ch.Messages)
+}
+
+// Views returns the modals opened so far
+func (s *Server) Views() []View {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.views)
+}
+
+// State returns a snapshot of the whole workspace
+func (s *Server) State() State {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := State{
+		Channels:   make([]Channel, 0, len(s.order)),
+		Views:      slices.Clone(s.views),
+		Users:      make([]User, 0, len(s.users)),
+		UserGroups: make([]UserGroup, 0, len(s.userGroups)),
+	}
+	for _, id := range s.order {
+		state.Channels = append(state.Channels, copyChannel(s.channels[id]))
+	}
+	for _, u := range s.users {
+		state.Users = append(state.Users, *u)
+	}
+	for _, g := range s.userGroups {
+		state.UserGroups = append(state.UserGroups, *g)
+	}
+	slices.SortFunc(state.Users, func(a, b User) int { return strings.Compare(a.ID, b.ID) })
+	slices.SortFunc(state.UserGroups, func(a, b UserGroup) int { return strings.Compare(a.ID, b.ID) })
+
+	return state
+}
+
+// PostUserMessage records a message from a user as if it was typed in Slack
+// and returns it. It does not deliver an event; use Hook for that.
+func (s *Server) PostUserMessage(channelID, userID, text, threadTS string) (Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch, ok := s.channels[channelID]
+	if !ok {
+		return Message{}, goerr.New("channel not found", goerr.V("channelID", channelID))
+	}
+
+	msg := Message{
+		Channel:  channelID,
+		TS:       s.nextTS(),
+		ThreadTS: threadTS,
+		User:     userID,
+		Text:     text,
+	}
+	ch.Messages = append(ch.Messages, msg)
+	return msg, nil
+}
+
+// addChannel registers a channel; the caller must hold s.mu or be in New
+func (s *Server) addChannel(ch *Channel) {
+	s.channels[ch.ID] = ch
+	s.order = append(s.order, ch.ID)
+}
+
+// nextID returns a new Slack-like ID with the given prefix
+func (s *Server) nextID(prefix string) string {
+	s.seq++
+	return fmt.Sprintf("%s%08d", prefix, s.seq)
+}
+
+// nextTS returns a strictly increasing Slack message timestamp
+func (s *Server) nextTS() string {
+	now := time.Now().UnixMicro()
+	if now <= s.lastTS {
+		now = s.lastTS + 1
+	}
+	s.lastTS = now
+	return fmt.Sprintf("%d.%06d", now/1_000_000, now%1_000_000)
+}
+
+func copyChannel(ch *Channel) Channel {
+	c := *ch
+	c.Members = slices.Clone(ch.Members)
+	c.Messages = slices.Clone(ch.Messages)
+	c.Bookmarks = slices.Clone(ch.Bookmarks)
+	return c
+}
@@ -0,0 +1,187 @@
+package slackfake_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/service/slack/slackfake"
+	"github.com/slack-go/slack"
+	"github.com/slack-go/slack/slackevents"
+)
+
+func setupFake(t *testing.T, opts ...slackfake.Option) (*slackfake.Server, interfaces.SlackClient) {
+	t.Helper()
+	fake := slackfake.New(opts...)
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	client := slackSvc.New("xoxb-test", slackSvc.WithSlackOptions(slack.OptionAPIURL(srv.URL+"/api/")))
+	return fake, client
+}
+
+func TestServerWebAPI(t *testing.T) {
+	ctx := context.Background()
+	fake, client := setupFake(t,
+		slackfake.WithUser(slackfake.User{ID: "U1", Name: "alice", RealName: "Alice", Email: "alice@example.com"}),
+		slackfake.WithUser(slackfake.User{ID: "U2", Name: "bob"}),
+		slackfake.WithUserGroup(slackfake.UserGroup{ID: "S1", Handle: "oncall", Users: []string{"U1", "U2"}}),
+	)
+
+	t.Run("auth.test returns bot identity", func(t *testing.T) {
+		resp, err := client.AuthTestContext(ctx)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, slackfake.DefaultBotUserID, resp.UserID)
+		gt.Equal(t, slackfake.DefaultTeamID, resp.TeamID)
+	})
+
+	t.Run("channel lifecycle", func(t *testing.T) {
+		channel, err := client.CreateConversation(ctx, slack.CreateConversationParams{ChannelName: "inc-1-outage"})
+		gt.NoError(t, err).Required()
+		gt.Equal(t, "inc-1-outage", channel.Name)
+
+		_, err = client.CreateConversation(ctx, slack.CreateConversationParams{ChannelName: "inc-1-outage"})
+		gt.Error(t, err)
+
+		_, err = client.InviteUsersToConversation(ctx, channel.ID, "U1", "U2")
+		gt.NoError(t, err)
+		_, err = client.SetPurposeOfConversationContext(ctx, channel.ID, "Database outage")
+		gt.NoError(t, err)
+		gt.NoError(t, client.AddBookmark(ctx, channel.ID, "Web UI", "http://localhost:8080/incidents/1"))
+
+		info, err := client.GetConversationInfo(ctx, channel.ID, false)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, "Database outage", info.Purpose.Value)
+
+		members, _, err := client.GetUsersInConversationContext(ctx, &slack.GetUsersInConversationParameters{ChannelID: channel.ID})
+		gt.NoError(t, err).Required()
+		gt.A(t, members).Length(3)
+
+		state, ok := fake.ChannelByName("inc-1-outage")
+		gt.True(t, ok)
+		gt.A(t, state.Bookmarks).Length(1)
+		gt.Equal(t, "Web UI", state.Bookmarks[0].Title)
+	})
+
+	t.Run("messages, threads and updates", func(t *testing.T) {
+		_, ts, err := client.PostMessage(ctx, slackfake.DefaultChannelID, slack.MsgOptionText("hello", false))
+		gt.NoError(t, err).Required()
+		_, _, err = client.PostMessage(ctx, slackfake.DefaultChannelID, slack.MsgOptionText("reply", false), slack.MsgOptionTS(ts))
+		gt.NoError(t, err).Required()
+		_, _, _, err = client.UpdateMessage(ctx, slackfake.DefaultChannelID, ts, slack.MsgOptionText("hello, edited", false))
+		gt.NoError(t, err).Required()
+
+		history, err := client.GetConversationHistoryContext(ctx, &slack.GetConversationHistoryParameters{ChannelID: slackfake.DefaultChannelID})
+		gt.NoError(t, err).Required()
+		gt.A(t, history.Messages).Length(1)
+		gt.Equal(t, "hello, edited", history.Messages[0].Text)
+
+		replies, _, _, err := client.GetConversationRepliesContext(ctx, &slack.GetConversationRepliesParameters{ChannelID: slackfake.DefaultChannelID, Timestamp: ts})
+		gt.NoError(t, err).Required()
+		gt.A(t, replies).Length(2)
+
+		messages := fake.Messages(slackfake.DefaultChannelID)
+		gt.A(t, messages).Length(2)
+		gt.True(t, messages[0].Edited)
+		gt.Equal(t, ts, messages[1].ThreadTS)
+	})
+
+	t.Run("views are recorded", func(t *testing.T) {
+		view := slack.ModalViewRequest{
+			Type:            slack.VTModal,
+			CallbackID:      "status_change_modal",
+			PrivateMetadata: "incident-1",
+			Title:           slack.NewTextBlockObject(slack.PlainTextType, "Status", false, false),
+		}
+		resp, err := client.OpenView(ctx, "trigger-1", view)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, "status_change_modal", resp.View.CallbackID)
+
+		views := fake.Views()
+		gt.A(t, views).Length(1)
+		gt.Equal(t, "trigger-1", views[0].TriggerID)
+	})
+
+	t.Run("users and user groups", func(t *testing.T) {
+		users, err := client.GetUsersContext(ctx)
+		gt.NoError(t, err).Required()
+		gt.A(t, users).Length(3)
+
+		user, err := client.GetUserInfoContext(ctx, "U1")
+		gt.NoError(t, err).Required()
+		gt.Equal(t, "alice@example.com", user.Profile.Email)
+
+		groups, err := client.GetUserGroupsContext(ctx)
+		gt.NoError(t, err).Required()
+		gt.A(t, groups).Length(1)
+		gt.Equal(t, "oncall", groups[0].Handle)
+
+		groupMembers, err := client.GetUserGroupMembersContext(ctx, "S1")
+		gt.NoError(t, err).Required()
+		gt.A(t, groupMembers).Length(2)
+	})
+
+	t.Run("conversations of the bot", func(t *testing.T) {
+		channels, _, err := client.GetConversationsForUserContext(ctx, &slack.GetConversationsForUserParameters{
+			Types: []string{"public_channel"},
+		})
+		gt.NoError(t, err).Required()
+		ids := make([]string, 0, len(channels))
+		for _, ch := range channels {
+			ids = append(ids, ch.ID)
+		}
+		gt.A(t, ids).Has(slackfake.DefaultChannelID)
+	})
+}
+
+func TestHookSignsRequests(t *testing.T) {
+	ctx := context.Background()
+	const secret = "test-signing-secret"
+
+	var mu sync.Mutex
+	var eventTypes []string
+	var interactions []string
+	lycaon := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		verifier, err := slack.NewSecretsVerifier(r.Header, secret)
+		gt.NoError(t, err).Required()
+		body, err := io.ReadAll(r.Body)
+		gt.NoError(t, err).Required()
+		_, _ = verifier.Write(body)
+		gt.NoError(t, verifier.Ensure())
+
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case "/hooks/slack/event":
+			event, err := slackevents.ParseEvent(body, slackevents.OptionNoVerifyToken())
+			gt.NoError(t, err).Required()
+			eventTypes = append(eventTypes, event.InnerEvent.Type)
+		case "/hooks/slack/interaction":
+			interactions = append(interactions, string(body))
+		}
+	}))
+	defer lycaon.Close()
+
+	fake := slackfake.New(slackfake.WithHook(slackfake.NewHook(lycaon.URL, secret)))
+
+	msg, err := fake.Say(ctx, slackfake.DefaultChannelID, "U1", "<@"+slackfake.DefaultBotUserID+"> inc outage", "")
+	gt.NoError(t, err).Required()
+	gt.A(t, fake.Messages(slackfake.DefaultChannelID)).Length(1)
+	gt.Equal(t, msg.TS, fake.Messages(slackfake.DefaultChannelID)[0].TS)
+
+	payload, err := json.Marshal(map[string]any{"type": "block_actions", "trigger_id": "trigger-1"})
+	gt.NoError(t, err).Required()
+	gt.NoError(t, slackfake.NewHook(lycaon.URL, secret).SendInteraction(ctx, payload))
+
+	mu.Lock()
+	defer mu.Unlock()
+	gt.A(t, eventTypes).Equal([]string{"message", "app_mention"})
+	gt.A(t, interactions).Length(1)
+}
@@ -0,0 +1,100 @@
+package slack
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/slack-go/slack"
+)
+
+const (
+	// StakeholderUpdateActionID is the action ID of buttons opening the stakeholder update modal.
+	// The value is the incident ID.
+	StakeholderUpdateActionID = "post_stakeholder_update"
+	// StakeholderUpdateCallbackID is the callback ID of the stakeholder update modal.
+	// The private metadata is the incident ID.
+	StakeholderUpdateCallbackID = "stakeholder_update_modal"
+
+	stakeholderUpdateBlockID  = "stakeholder_update_block"
+	stakeholderUpdateActionID = "stakeholder_update_input"
+)
+
+// StakeholderUpdateText returns the update text submitted with the stakeholder update modal
+func StakeholderUpdateText(view slack.View) string {
+	if view.State == nil {
+		return ""
+	}
+	return view.State.Values[stakeholderUpdateBlockID][stakeholderUpdateActionID].Value
+}
+
+// BuildStakeholderUpdateModal creates the modal for writing a stakeholder update.
+// The previous update, if any, is prefilled so that it only needs to be revised.
+func BuildStakeholderUpdateModal(incident *model.Incident, previous *model.StakeholderUpdate) slack.ModalViewRequest {
+	input := slack.NewPlainTextInputBlockElement(
+		slack.NewTextBlockObject(slack.PlainTextType, "What is the impact, what is being done and when is the next update?", false, false),
+		stakeholderUpdateActionID,
+	)
+	input.Multiline = true
+	input.MaxLength = 3000
+	if previous != nil {
+		input.InitialValue = previous.Text
+	}
+
+	intro := fmt.Sprintf("Write an update on *%s* for people outside the response. It is posted in the incident channel", incident.Title)
+	if incident.Private {
+		intro += "."
+	} else {
+		intro += ", the channel it was declared in and the announcement channels."
+	}
+
+	return slack.ModalViewRequest{
+		Type:            slack.VTModal,
+		CallbackID:      StakeholderUpdateCallbackID,
+		Title:           slack.NewTextBlockObject(slack.PlainTextType, "Stakeholder Update", false, false),
+		Submit:          slack.NewTextBlockObject(slack.PlainTextType, "Post", false, false),
+		Close:           slack.NewTextBlockObject(slack.PlainTextType, "Cancel", false, false),
+		PrivateMetadata: incident.ID.String(),
+		Blocks: slack.Blocks{
+			BlockSet: []slack.Block{
+				slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, intro, false, false), nil, nil),
+				slack.NewInputBlock(stakeholderUpdateBlockID,
+					slack.NewTextBlockObject(slack.PlainTextType, "Update", false, false),
+					nil, input),
+			},
+		},
+	}
+}
+
+// BuildStakeholderUpdateBlocks creates a stakeholder update post. Posts outside the
+// incident channel link to it so that readers can follow up.
+func BuildStakeholderUpdateBlocks(incident *model.Incident, update *model.StakeholderUpdate, severity *model.Severity, crossPost bool) []slack.Block {
+	title := fmt.Sprintf("📣 *Stakeholder update: Incident #%d %s*", incident.ID, incident.Title)
+	if crossPost && incident.ChannelID != "" {
+		title += fmt.Sprintf(" (<#%s>)", incident.ChannelID)
+	}
+	if incident.IsTest {
+		title = "🧪 [TEST] " + title
+	}
+
+	return []slack.Block{
+		slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, title, false, false), []*slack.TextBlockObject{
+			slack.NewTextBlockObject(slack.MarkdownType, "*Status:*\n"+getStatusEmoji(update.Status)+" "+update.Status.String(), false, false),
+			slack.NewTextBlockObject(slack.MarkdownType, "*Severity:*\n"+formatSeverityText(severity), false, false),
+		}, nil),
+		slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, update.Text, false, false), nil, nil),
+		slack.NewContextBlock("", slack.NewTextBlockObject(slack.MarkdownType,
+			fmt.Sprintf("Posted by <@%s> at <!date^%d^{date_short_pretty} {time}|%s>",
+				update.PostedBy, update.PostedAt.Unix(), update.PostedAt.UTC().Format(time.RFC3339)),
+			false, false)),
+	}
+}
+
+// stakeholderUpdateButton opens the stakeholder update modal of incident
+func stakeholderUpdateButton(incident *model.Incident) *slack.ButtonBlockElement {
+	return slack.NewButtonBlockElement(
+		StakeholderUpdateActionID,
+		incident.ID.String(),
+		slack.NewTextBlockObject(slack.PlainTextType, "Post update", false, false),
+	)
+}
@@ -0,0 +1,57 @@
+package slack_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	slackblocks "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/slack-go/slack"
+)
+
+func TestStakeholderUpdateBlocks(t *testing.T) {
+	incident := &model.Incident{ID: 42, Title: "Login outage", ChannelID: "C-INCIDENT", Status: types.IncidentStatusHandling}
+	update := &model.StakeholderUpdate{
+		ID: "update-1", IncidentID: 42, Text: "Logins fail for some users",
+		Status: types.IncidentStatusHandling, PostedBy: "U-LEAD", PostedAt: time.Now(),
+	}
+
+	blocks := slackblocks.BuildStakeholderUpdateBlocks(incident, update, nil, false)
+	gt.A(t, blocks).Length(3).Required()
+	title, ok := blocks[0].(*slack.SectionBlock)
+	gt.True(t, ok).Required()
+	gt.S(t, title.Text.Text).Contains("Incident #42 Login outage").NotContains("<#C-INCIDENT>")
+	body, ok := blocks[1].(*slack.SectionBlock)
+	gt.True(t, ok).Required()
+	gt.Equal(t, body.Text.Text, update.Text)
+
+	// Cross-posts link to the incident channel
+	crossPost := slackblocks.BuildStakeholderUpdateBlocks(incident, update, nil, true)
+	title, ok = crossPost[0].(*slack.SectionBlock)
+	gt.True(t, ok).Required()
+	gt.S(t, title.Text.Text).Contains("<#C-INCIDENT>")
+}
+
+func TestStakeholderUpdateModal(t *testing.T) {
+	incident := &model.Incident{ID: 42, Title: "Login outage"}
+	previous := &model.StakeholderUpdate{Text: "Investigating"}
+
+	modal := slackblocks.BuildStakeholderUpdateModal(incident, previous)
+	gt.Equal(t, modal.CallbackID, slackblocks.StakeholderUpdateCallbackID)
+	gt.Equal(t, modal.PrivateMetadata, "42")
+	gt.A(t, modal.Blocks.BlockSet).Length(2).Required()
+
+	input, ok := modal.Blocks.BlockSet[1].(*slack.InputBlock)
+	gt.True(t, ok).Required()
+	element, ok := input.Element.(*slack.PlainTextInputBlockElement)
+	gt.True(t, ok).Required()
+	gt.Equal(t, element.InitialValue, "Investigating")
+
+	// The submitted text is read back from the view state
+	view := slack.View{State: &slack.ViewState{Values: map[string]map[string]slack.BlockAction{
+		input.BlockID: {element.ActionID: {Value: "Mitigated"}},
+	}}}
+	gt.Equal(t, slackblocks.StakeholderUpdateText(view), "Mitigated")
+}
@@ -0,0 +1,42 @@
+package slack_test
+
+import (
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	slackblocks "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/slack-go/slack"
+)
+
+func TestBuildStatusSelectionModal(t *testing.T) {
+	builder := slackblocks.NewBlockBuilder()
+
+	statusOptions := func(modal slack.ModalViewRequest) []string {
+		var values []string
+		for _, option := range modal.Blocks.BlockSet[1].(*slack.InputBlock).Element.(*slack.SelectBlockElement).Options {
+			values = append(values, option.Value)
+		}
+		return values
+	}
+	noteBlock := func(modal slack.ModalViewRequest) *slack.InputBlock {
+		return modal.Blocks.BlockSet[2].(*slack.InputBlock)
+	}
+
+	t.Run("open incident offers every status with an optional note", func(t *testing.T) {
+		modal := builder.BuildStatusSelectionModal(&model.Incident{ID: 1, Status: types.IncidentStatusHandling}, "C-INC", "1234.5678")
+		gt.Equal(t, modal.Title.Text, "Change Status")
+		gt.A(t, statusOptions(modal)).Length(4)
+		gt.True(t, noteBlock(modal).Optional)
+	})
+
+	t.Run("closed incident is reopened with a required reason", func(t *testing.T) {
+		modal := builder.BuildStatusSelectionModal(&model.Incident{ID: 1, Status: types.IncidentStatusClosed}, "C-INC", "1234.5678")
+		gt.Equal(t, modal.Title.Text, "Reopen Incident")
+		gt.Equal(t, modal.Submit.Text, "Reopen")
+		gt.Equal(t, statusOptions(modal), []string{"triage", "handling", "monitoring"})
+		gt.False(t, noteBlock(modal).Optional)
+		gt.Equal(t, noteBlock(modal).Label.Text, "Reason for reopening")
+	})
+}
@@ -2,7 +2,9 @@ package slack
 
 import (
 	"context"
+	"time"
 
+	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
@@ -30,14 +32,15 @@ func NewUIService(client interfaces.SlackClient, config *model.Config) *UIServic
 
 // Message operations - delegate to messageService
 
-// PostStatusMessage sends a status message to the incident channel
-func (s *UIService) PostStatusMessage(ctx context.Context, channelID types.ChannelID, incident *model.Incident, leadName string) error {
-	return s.msg.postStatusMessage(ctx, channelID, incident, leadName)
+// PostStatusMessage sends a status message to the incident channel. relations
+// may be nil for incidents without related incidents.
+func (s *UIService) PostStatusMessage(ctx context.Context, channelID types.ChannelID, incident *model.Incident, leadName string, relations *model.IncidentRelations) error {
+	return s.msg.postStatusMessage(ctx, channelID, incident, leadName, relations)
 }
 
 // UpdateStatusMessage updates an existing status message
-func (s *UIService) UpdateStatusMessage(ctx context.Context, channelID types.ChannelID, messageTS string, incident *model.Incident, leadName string) error {
-	return s.msg.updateStatusMessage(ctx, channelID, messageTS, incident, leadName)
+func (s *UIService) UpdateStatusMessage(ctx context.Context, channelID types.ChannelID, messageTS string, incident *model.Incident, leadName string, relations *model.IncidentRelations) error {
+	return s.msg.updateStatusMessage(ctx, channelID, messageTS, incident, leadName, relations)
 }
 
 // PostWelcomeMessage sends a welcome message to the incident channel
@@ -45,6 +48,16 @@ func (s *UIService) PostWelcomeMessage(ctx context.Context, channelID types.Chan
 	return s.msg.postWelcomeMessage(ctx, channelID, incident, originChannelName, leadName)
 }
 
+// UpdateWelcomeMessage replaces the welcome message of the incident channel with related incidents
+func (s *UIService) UpdateWelcomeMessage(ctx context.Context, incident *model.Incident, leadName string, relations *model.IncidentRelations) error {
+	return s.msg.updateWelcomeMessage(ctx, incident, leadName, relations)
+}
+
+// PostRelationNotice posts a short notice about a related incident in channelID
+func (s *UIService) PostRelationNotice(ctx context.Context, channelID types.ChannelID, text string) error {
+	return s.msg.postRelationNotice(ctx, channelID, text)
+}
+
 // PostIncidentCreatedNotification sends an incident creation notification
 func (s *UIService) PostIncidentCreatedNotification(ctx context.Context, channelID types.ChannelID, messageTS types.MessageTS, originChannelName string, incidentChannelID types.ChannelID, title, categoryID, severityID string) error {
 	return s.msg.postIncidentCreatedNotification(ctx, channelID, messageTS, originChannelName, incidentChannelID, title, categoryID, severityID)
@@ -75,6 +88,51 @@ func (s *UIService) PostIncidentPromptMessage(ctx context.Context, channelID str
 	return s.msg.postIncidentPromptMessage(ctx, channelID, messageTS, requestID, title, description, categoryID, severityID)
 }
 
+// PostAccessRequestMessage asks the approver in the incident channel to grant the requester access
+func (s *UIService) PostAccessRequestMessage(ctx context.Context, channelID types.ChannelID, incident *model.Incident, requesterID, approverID types.SlackUserID, reason string) error {
+	return s.msg.postAccessRequestMessage(ctx, channelID, incident, requesterID, approverID, reason)
+}
+
+// UpdateAccessRequestMessage replaces an access request with its outcome; grant is nil when denied
+func (s *UIService) UpdateAccessRequestMessage(ctx context.Context, channelID types.ChannelID, messageTS string, requesterID, decidedBy types.SlackUserID, grant *model.AccessGrant) error {
+	return s.msg.updateAccessRequestMessage(ctx, channelID, messageTS, requesterID, decidedBy, grant)
+}
+
+// PostStakeholderUpdate posts a stakeholder update to channelID. crossPost is set
+// for channels other than the incident channel, which then link to it.
+func (s *UIService) PostStakeholderUpdate(ctx context.Context, channelID types.ChannelID, incident *model.Incident, update *model.StakeholderUpdate, crossPost bool) error {
+	return s.msg.postStakeholderUpdate(ctx, channelID, incident, update, crossPost)
+}
+
+// PostIncidentChangeNotification posts a status or severity change of an incident
+// outside the incident channel, in the thread of threadTS if set
+func (s *UIService) PostIncidentChangeNotification(ctx context.Context, channelID types.ChannelID, threadTS types.MessageTS, before, after *model.Incident, changedBy types.SlackUserID) error {
+	return s.msg.postIncidentChangeNotification(ctx, channelID, threadTS, before, after, changedBy)
+}
+
+// PostArchiveNotice tells an incident channel that its transcript was saved before archiving it
+func (s *UIService) PostArchiveNotice(ctx context.Context, channelID types.ChannelID, incident *model.Incident, messageCount int) error {
+	return s.msg.postArchiveNotice(ctx, channelID, incident, messageCount)
+}
+
+// UnarchiveChannel brings back an archived incident channel
+func (s *UIService) UnarchiveChannel(ctx context.Context, channelID types.ChannelID) error {
+	if err := s.client.UnArchiveConversationContext(ctx, channelID.String()); err != nil {
+		return goerr.Wrap(err, "failed to unarchive channel", goerr.V("channelID", channelID))
+	}
+	return nil
+}
+
+// PostReminderMessage posts a reminder with snooze buttons in the incident channel
+func (s *UIService) PostReminderMessage(ctx context.Context, incident *model.Incident, reminder *model.Reminder, task *model.Task, elapsed time.Duration) error {
+	return s.msg.postReminderMessage(ctx, incident, reminder, task, elapsed)
+}
+
+// UpdateReminderMessage replaces a reminder once it was snoozed
+func (s *UIService) UpdateReminderMessage(ctx context.Context, channelID types.ChannelID, messageTS string, snoozedBy types.SlackUserID, until time.Time) error {
+	return s.msg.updateReminderMessage(ctx, channelID, messageTS, snoozedBy, until)
+}
+
 // Modal operations - delegate to modalService
 
 // OpenStatusChangeModal opens a status change modal
@@ -96,3 +154,8 @@ func (s *UIService) OpenIncidentDetailsEditModal(ctx context.Context, triggerID
 func (s *UIService) OpenTaskEditModal(ctx context.Context, triggerID string, task *model.Task, channelMembers []types.SlackUserID) error {
 	return s.modal.openTaskEditModal(ctx, triggerID, task, channelMembers)
 }
+
+// OpenStakeholderUpdateModal opens the modal for writing a stakeholder update, prefilled with the previous one
+func (s *UIService) OpenStakeholderUpdateModal(ctx context.Context, triggerID string, incident *model.Incident, previous *model.StakeholderUpdate) error {
+	return s.modal.openStakeholderUpdateModal(ctx, triggerID, incident, previous)
+}
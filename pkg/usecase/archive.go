@@ -0,0 +1,233 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
+	"github.com/slack-go/slack"
+)
+
+// archiveLockTTL is how long a replica owns the archival of an incident. It
+// covers exporting the history of a long channel.
+const archiveLockTTL = 30 * time.Minute
+
+// Archive exports the channel transcripts of closed incidents and archives
+// their channels once the configured grace period has passed
+type Archive struct {
+	repo        interfaces.Repository
+	slackClient interfaces.SlackClient
+	slackSvc    *slackSvc.UIService
+	history     *slackSvc.MessageHistoryService
+	config      *model.ArchiveConfig
+}
+
+// NewArchive creates a new Archive instance from the archive section of config.
+// Without one, Run never archives channels and only exports transcripts.
+func NewArchive(repo interfaces.Repository, slackClient interfaces.SlackClient, slackService *slackSvc.UIService, config *model.Config) *Archive {
+	a := &Archive{
+		repo:        repo,
+		slackClient: slackClient,
+		slackSvc:    slackService,
+		history:     slackSvc.NewMessageHistoryService(slackClient),
+	}
+	if config != nil {
+		a.config = config.Archive
+	}
+	if a.config == nil {
+		a.config = &model.ArchiveConfig{}
+	}
+	return a
+}
+
+// Run scans closed incidents every configured interval until ctx is cancelled
+func (a *Archive) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.config.ScanInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := a.Scan(ctx, time.Now()); err != nil {
+				apperr.Handle(ctx, err)
+			}
+		}
+	}
+}
+
+// Scan exports and archives every incident whose grace period after closure has
+// passed at now and whose transcript predates its last closure. Incidents closed
+// before closures were tracked are left alone.
+func (a *Archive) Scan(ctx context.Context, now time.Time) error {
+	incidents, err := a.repo.ListIncidents(ctx)
+	if err != nil {
+		return goerr.Wrap(err, "failed to list incidents for archival")
+	}
+
+	for _, incident := range incidents {
+		if incident.Status != types.IncidentStatusClosed || incident.ChannelID == "" || incident.ClosedAt.IsZero() {
+			continue
+		}
+		if now.Sub(incident.ClosedAt) < a.config.GracePeriod || incident.TranscriptExportedAt.After(incident.ClosedAt) {
+			continue
+		}
+		if err := a.archiveIncident(ctx, incident); err != nil {
+			apperr.Handle(ctx, goerr.Wrap(err, "failed to archive incident",
+				goerr.V("incidentID", incident.ID)))
+		}
+	}
+
+	return nil
+}
+
+// archiveIncident exports the transcript of a closed incident and archives its
+// channel if configured
+func (a *Archive) archiveIncident(ctx context.Context, incident *model.Incident) error {
+	// Another replica scanning at the same time may be archiving the same closure
+	key := "archive:" + incident.ID.String() + ":" + strconv.FormatInt(incident.ClosedAt.Unix(), 10)
+	first, err := a.repo.MarkEventProcessed(ctx, key, archiveLockTTL)
+	if err != nil {
+		return goerr.Wrap(err, "failed to lock incident for archival")
+	}
+	if !first {
+		return nil
+	}
+
+	transcript, err := a.ExportTranscript(ctx, incident.ID)
+	if err != nil {
+		return err
+	}
+
+	if a.config.ArchiveChannel {
+		// Reload as the incident may have been reopened while exporting
+		latest, err := a.repo.GetIncident(ctx, incident.ID)
+		if err != nil {
+			return goerr.Wrap(err, "failed to get incident")
+		}
+		if !closedAt(latest, incident.ClosedAt) || latest.ChannelArchived {
+			return nil
+		}
+
+		if err := a.slackSvc.PostArchiveNotice(ctx, latest.ChannelID, latest, len(transcript.Messages)); err != nil {
+			apperr.Handle(ctx, err)
+		}
+		if err := a.slackClient.ArchiveConversationContext(ctx, latest.ChannelID.String()); err != nil {
+			return goerr.Wrap(err, "failed to archive incident channel")
+		}
+
+		var reopened bool
+		err = a.repo.UpdateIncidentAtomic(ctx, incident.ID, func(current *model.Incident) error {
+			reopened = !closedAt(current, incident.ClosedAt)
+			if !reopened {
+				current.ChannelArchived = true
+			}
+			return nil
+		})
+		if err != nil {
+			return goerr.Wrap(err, "failed to save archived incident")
+		}
+		if reopened {
+			// The reopen did not see the channel as archived, so bring it back here
+			if err := a.slackSvc.UnarchiveChannel(ctx, latest.ChannelID); err != nil {
+				return goerr.Wrap(err, "failed to unarchive reopened incident channel")
+			}
+			return nil
+		}
+	}
+
+	ctxlog.From(ctx).Info("Incident archived",
+		"incidentID", incident.ID,
+		"messages", len(transcript.Messages),
+		"channelArchived", a.config.ArchiveChannel,
+	)
+	return nil
+}
+
+// closedAt reports whether the incident is still closed by the closure at closedAt
+func closedAt(incident *model.Incident, closedAt time.Time) bool {
+	return incident.Status == types.IncidentStatusClosed && incident.ClosedAt.Equal(closedAt)
+}
+
+// ExportTranscript exports the whole history of the incident channel including
+// threads and saves it with the incident, replacing any previous transcript
+func (a *Archive) ExportTranscript(ctx context.Context, incidentID types.IncidentID) (*model.Transcript, error) {
+	incident, err := a.repo.GetIncident(ctx, incidentID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get incident", goerr.V("incidentID", incidentID))
+	}
+	if incident.ChannelID == "" {
+		return nil, goerr.New("incident has no channel", goerr.V("incidentID", incidentID))
+	}
+
+	messages, err := a.history.GetAllMessages(ctx, incident.ChannelID.String())
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to read incident channel history", goerr.V("incidentID", incidentID))
+	}
+
+	transcript := &model.Transcript{
+		IncidentID: incidentID,
+		ChannelID:  incident.ChannelID,
+		ExportedAt: time.Now(),
+		Messages:   transcriptMessages(messages),
+	}
+	if err := a.repo.PutTranscript(ctx, transcript); err != nil {
+		return nil, goerr.Wrap(err, "failed to save transcript", goerr.V("incidentID", incidentID))
+	}
+
+	// Only the export time is written as the incident may have changed while reading the history
+	err = a.repo.UpdateIncidentAtomic(ctx, incidentID, func(current *model.Incident) error {
+		current.TranscriptExportedAt = transcript.ExportedAt
+		return nil
+	})
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to save transcript export time", goerr.V("incidentID", incidentID))
+	}
+
+	return transcript, nil
+}
+
+// GetTranscript returns the last exported transcript of an incident
+func (a *Archive) GetTranscript(ctx context.Context, incidentID types.IncidentID) (*model.Transcript, error) {
+	transcript, err := a.repo.GetTranscript(ctx, incidentID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get transcript", goerr.V("incidentID", incidentID))
+	}
+	return transcript, nil
+}
+
+// transcriptMessages converts Slack messages into transcript messages
+func transcriptMessages(messages []slack.Message) []model.TranscriptMessage {
+	result := make([]model.TranscriptMessage, 0, len(messages))
+	for _, msg := range messages {
+		result = append(result, model.TranscriptMessage{
+			TS:       msg.Timestamp,
+			ThreadTS: msg.ThreadTimestamp,
+			UserID:   types.SlackUserID(msg.User),
+			BotID:    msg.BotID,
+			Text:     msg.Text,
+			PostedAt: slackMessageTime(msg.Timestamp),
+		})
+	}
+	return result
+}
+
+// slackMessageTime parses a Slack timestamp such as "1234567890.123456"
+func slackMessageTime(ts string) time.Time {
+	sec, frac, _ := strings.Cut(ts, ".")
+	s, err := strconv.ParseInt(sec, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	us, _ := strconv.ParseInt((frac + "000000")[:6], 10, 64)
+	return time.Unix(s, us*int64(time.Microsecond))
+}
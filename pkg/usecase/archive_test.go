@@ -0,0 +1,97 @@
+package usecase_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/service/slack/slackfake"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+	"github.com/slack-go/slack"
+)
+
+func TestArchive(t *testing.T) {
+	ctx := context.Background()
+	fake := slackfake.New()
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	client := slackSvc.New("xoxb-test", slackSvc.WithSlackOptions(slack.OptionAPIURL(srv.URL+"/api/")))
+
+	repo := repository.NewMemory()
+	config := testConfig()
+	config.Archive = &model.ArchiveConfig{GracePeriod: time.Hour, ArchiveChannel: true}
+	ui := slackSvc.NewUIService(client, config)
+	uc := usecase.NewArchive(repo, client, ui, config)
+	statusUC := usecase.NewStatusUseCase(repo, ui, config)
+
+	parent, err := fake.PostUserMessage(slackfake.DefaultChannelID, "U-LEAD", "Looking into it", "")
+	gt.NoError(t, err).Required()
+	_, err = fake.PostUserMessage(slackfake.DefaultChannelID, "U-ANALYST", "Found the cause", parent.TS)
+	gt.NoError(t, err).Required()
+	_, err = fake.PostUserMessage(slackfake.DefaultChannelID, "U-LEAD", "Fixed", "")
+	gt.NoError(t, err).Required()
+
+	gt.NoError(t, repo.PutIncident(ctx, &model.Incident{
+		ID: 1, Title: "Database outage", ChannelID: slackfake.DefaultChannelID, ChannelName: "general",
+		Status: types.IncidentStatusHandling, CreatedAt: time.Now().Add(-time.Hour),
+	})).Required()
+	gt.NoError(t, statusUC.UpdateStatus(ctx, 1, types.IncidentStatusClosed, "U-LEAD", "resolved")).Required()
+
+	incident, err := repo.GetIncident(ctx, 1)
+	gt.NoError(t, err).Required()
+	gt.False(t, incident.ClosedAt.IsZero())
+
+	t.Run("nothing happens within the grace period", func(t *testing.T) {
+		gt.NoError(t, uc.Scan(ctx, incident.ClosedAt.Add(30*time.Minute)))
+		_, err := uc.GetTranscript(ctx, 1)
+		gt.Error(t, err)
+		ch, _ := fake.Channel(slackfake.DefaultChannelID)
+		gt.False(t, ch.IsArchived)
+	})
+
+	t.Run("exports the transcript and archives the channel after the grace period", func(t *testing.T) {
+		gt.NoError(t, uc.Scan(ctx, incident.ClosedAt.Add(2*time.Hour)))
+
+		transcript, err := uc.GetTranscript(ctx, 1)
+		gt.NoError(t, err).Required()
+		gt.A(t, transcript.Messages).Length(3).Required()
+		gt.Equal(t, transcript.Messages[0].Text, "Looking into it")
+		gt.Equal(t, transcript.Messages[1].Text, "Found the cause")
+		gt.True(t, transcript.Messages[1].IsReply())
+		gt.Equal(t, transcript.Messages[2].Text, "Fixed")
+		gt.False(t, transcript.Messages[0].PostedAt.IsZero())
+
+		ch, _ := fake.Channel(slackfake.DefaultChannelID)
+		gt.True(t, ch.IsArchived)
+
+		archived, err := repo.GetIncident(ctx, 1)
+		gt.NoError(t, err).Required()
+		gt.True(t, archived.ChannelArchived)
+		gt.False(t, archived.TranscriptExportedAt.IsZero())
+
+		// The same closure is not exported twice
+		gt.NoError(t, uc.Scan(ctx, incident.ClosedAt.Add(3*time.Hour)))
+		again, err := repo.GetIncident(ctx, 1)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, again.TranscriptExportedAt, archived.TranscriptExportedAt)
+	})
+
+	t.Run("reopening brings back the channel", func(t *testing.T) {
+		gt.NoError(t, statusUC.UpdateStatus(ctx, 1, types.IncidentStatusHandling, "U-LEAD", "regressed")).Required()
+
+		ch, _ := fake.Channel(slackfake.DefaultChannelID)
+		gt.False(t, ch.IsArchived)
+
+		reopened, err := repo.GetIncident(ctx, 1)
+		gt.NoError(t, err).Required()
+		gt.False(t, reopened.ChannelArchived)
+		gt.True(t, reopened.ClosedAt.IsZero())
+		gt.Equal(t, reopened.Status, types.IncidentStatusHandling)
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/service/audit"
 	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
 	"github.com/slack-go/slack"
 )
@@ -25,10 +26,31 @@ type Auth struct {
 	repo        interfaces.Repository
 	slackConfig *config.SlackConfig
 	userUC      *UserUseCase
+	audit       *audit.Recorder
+
+	sessionTTL         time.Duration
+	sessionMaxLifetime time.Duration
+}
+
+// AuthOption is a functional option for Auth
+type AuthOption func(*Auth)
+
+// WithSessionTTL sets how long a session stays valid after its last activity
+func WithSessionTTL(ttl time.Duration) AuthOption {
+	return func(a *Auth) {
+		a.sessionTTL = ttl
+	}
+}
+
+// WithSessionMaxLifetime sets the absolute lifetime of a session regardless of activity
+func WithSessionMaxLifetime(lifetime time.Duration) AuthOption {
+	return func(a *Auth) {
+		a.sessionMaxLifetime = lifetime
+	}
 }
 
 // NewAuth creates a new Auth use case
-func NewAuth(ctx context.Context, repo interfaces.Repository, slackConfig *config.SlackConfig) *Auth {
+func NewAuth(ctx context.Context, repo interfaces.Repository, slackConfig *config.SlackConfig, opts ...AuthOption) *Auth {
 	// Create Slack client for user usecase
 	var userUC *UserUseCase
 	if slackConfig.OAuthToken != "" {
@@ -38,11 +60,18 @@ func NewAuth(ctx context.Context, repo interfaces.Repository, slackConfig *confi
 		}
 	}
 
-	return &Auth{
-		repo:        repo,
-		slackConfig: slackConfig,
-		userUC:      userUC,
+	auth := &Auth{
+		repo:               repo,
+		slackConfig:        slackConfig,
+		userUC:             userUC,
+		audit:              audit.New(repo),
+		sessionTTL:         DefaultSessionTTL,
+		sessionMaxLifetime: DefaultSessionMaxLifetime,
+	}
+	for _, opt := range opts {
+		opt(auth)
 	}
+	return auth
 }
 
 // GenerateOAuthURL generates Slack OAuth URL with team ID from API
@@ -111,7 +140,7 @@ func (a *Auth) GenerateOAuthURL(ctx context.Context, config interfaces.OAuthConf
 }
 
 // CreateSession creates a new session for a user
-func (a *Auth) CreateSession(ctx context.Context, slackUserID, userName, userEmail string) (*model.Session, error) {
+func (a *Auth) CreateSession(ctx context.Context, slackUserID, userName, userEmail string, client model.SessionClient) (*model.Session, error) {
 	logger := ctxlog.From(ctx)
 
 	if slackUserID == "" {
@@ -137,11 +166,13 @@ func (a *Auth) CreateSession(ctx context.Context, slackUserID, userName, userEma
 		)
 	}
 
-	// Create new session (24 hours validity)
-	session, err := model.NewSession(user.ID, 24*time.Hour)
+	// Create new session; its expiry slides forward on activity
+	session, err := model.NewSession(user.ID, a.sessionTTL)
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to create session")
 	}
+	session.IPAddress = client.IPAddress
+	session.UserAgent = client.UserAgent
 
 	// Store session
 	if err := a.repo.SaveSession(ctx, session); err != nil {
@@ -151,14 +182,15 @@ func (a *Auth) CreateSession(ctx context.Context, slackUserID, userName, userEma
 	logger.Info("Created new session",
 		"sessionID", session.ID,
 		"userID", user.ID,
+		"ipAddress", session.IPAddress,
 		"expiresAt", session.ExpiresAt,
 	)
 
 	return session, nil
 }
 
-// ValidateSession validates a session by ID and secret
-func (a *Auth) ValidateSession(ctx context.Context, sessionID, sessionSecret string) (*model.Session, error) {
+// ValidateSession validates a session by ID and secret and records the activity
+func (a *Auth) ValidateSession(ctx context.Context, sessionID, sessionSecret string, client model.SessionClient) (*model.Session, error) {
 	if sessionID == "" || sessionSecret == "" {
 		return nil, goerr.New("session ID and secret are required")
 	}
@@ -179,6 +211,14 @@ func (a *Auth) ValidateSession(ctx context.Context, sessionID, sessionSecret str
 		return nil, goerr.New("session expired")
 	}
 
+	// Slide expiration on activity, throttled to avoid a write on every request
+	if now := time.Now(); now.Sub(session.LastSeenAt) > sessionTouchInterval {
+		session.Touch(now, client, a.sessionTTL, a.sessionMaxLifetime)
+		if err := a.repo.TouchSession(ctx, session); err != nil {
+			ctxlog.From(ctx).Warn("Failed to record session activity", "sessionID", session.ID, "error", err)
+		}
+	}
+
 	return session, nil
 }
 
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/service/audit"
+	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
+)
+
+const (
+	// DefaultSessionTTL is how long a session stays valid without activity
+	DefaultSessionTTL = 24 * time.Hour
+	// DefaultSessionMaxLifetime is how long a session can be kept alive by activity
+	DefaultSessionMaxLifetime = 30 * 24 * time.Hour
+
+	// sessionTouchInterval limits how often session activity is written back
+	sessionTouchInterval = time.Minute
+)
+
+// ListSessions lists active sessions of a user, most recently seen first
+func (a *Auth) ListSessions(ctx context.Context, userID types.UserID) ([]*model.Session, error) {
+	sessions, err := a.repo.ListSessionsByUser(ctx, userID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list sessions", goerr.V("userID", userID))
+	}
+
+	active := make([]*model.Session, 0, len(sessions))
+	for _, session := range sessions {
+		if !session.IsExpired() {
+			active = append(active, session)
+		}
+	}
+	return active, nil
+}
+
+// RevokeSession revokes one of the user's sessions. Sessions of other users are
+// reported as not found so that session IDs cannot be probed.
+func (a *Auth) RevokeSession(ctx context.Context, userID types.UserID, sessionID types.SessionID) error {
+	session, err := a.repo.GetSession(ctx, sessionID)
+	if err != nil || session.UserID != userID {
+		return goerr.Wrap(model.ErrSessionNotFound, "failed to revoke session",
+			goerr.V("userID", userID), goerr.V("sessionID", sessionID))
+	}
+
+	if err := a.repo.DeleteSession(ctx, sessionID); err != nil {
+		return goerr.Wrap(err, "failed to delete session", goerr.V("sessionID", sessionID))
+	}
+	a.audit.Record(ctx, types.AuditActionSessionRevoke, sessionAuditTarget(sessionID), "", nil, nil)
+
+	ctxlog.From(ctx).Info("Session revoked", "userID", userID, "sessionID", sessionID)
+	return nil
+}
+
+// RevokeAllSessions revokes every session of a user and returns how many were revoked
+func (a *Auth) RevokeAllSessions(ctx context.Context, userID types.UserID) (int, error) {
+	sessions, err := a.repo.ListSessionsByUser(ctx, userID)
+	if err != nil {
+		return 0, goerr.Wrap(err, "failed to list sessions", goerr.V("userID", userID))
+	}
+
+	revoked := 0
+	for _, session := range sessions {
+		if err := a.repo.DeleteSession(ctx, session.ID); err != nil {
+			return revoked, goerr.Wrap(err, "failed to delete session", goerr.V("sessionID", session.ID))
+		}
+		a.audit.Record(ctx, types.AuditActionSessionRevoke, sessionAuditTarget(session.ID), "", nil, nil)
+		revoked++
+	}
+
+	ctxlog.From(ctx).Info("All sessions revoked", "userID", userID, "count", revoked)
+	return revoked, nil
+}
+
+// CleanupExpiredSessions deletes expired sessions and returns how many were deleted
+func (a *Auth) CleanupExpiredSessions(ctx context.Context) (int, error) {
+	deleted, err := a.repo.DeleteExpiredSessions(ctx, time.Now())
+	if err != nil {
+		return deleted, goerr.Wrap(err, "failed to delete expired sessions")
+	}
+	if deleted > 0 {
+		ctxlog.From(ctx).Info("Expired sessions cleaned up", "count", deleted)
+	}
+	return deleted, nil
+}
+
+// RunSessionCleanup deletes expired sessions every interval until ctx is cancelled
+func (a *Auth) RunSessionCleanup(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := a.CleanupExpiredSessions(ctx); err != nil {
+				apperr.Handle(ctx, err)
+			}
+		}
+	}
+}
+
+// sessionAuditTarget identifies a session without recording its contents
+func sessionAuditTarget(id types.SessionID) audit.Target {
+	return audit.Target{Type: types.AuditTargetSession, ID: id.String()}
+}
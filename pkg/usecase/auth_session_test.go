@@ -0,0 +1,109 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/cli/config"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+)
+
+func TestAuthSessionManagement(t *testing.T) {
+	ctx := ctxlog.With(context.Background(), slog.New(slog.NewTextHandler(os.Stdout, nil)))
+	client := model.SessionClient{IPAddress: "192.0.2.10", UserAgent: "Mozilla/5.0"}
+
+	t.Run("records client and slides expiry on activity", func(t *testing.T) {
+		repo := repository.NewMemory()
+		auth := usecase.NewAuth(ctx, repo, &config.SlackConfig{},
+			usecase.WithSessionTTL(time.Hour),
+			usecase.WithSessionMaxLifetime(24*time.Hour),
+		)
+
+		session, err := auth.CreateSession(ctx, "U1", "User1", "u1@example.com", client)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, session.IPAddress, "192.0.2.10")
+		gt.Equal(t, session.UserAgent, "Mozilla/5.0")
+
+		// Pretend the session was last used a while ago
+		session.LastSeenAt = time.Now().Add(-30 * time.Minute)
+		session.ExpiresAt = time.Now().Add(30 * time.Minute)
+		gt.NoError(t, repo.SaveSession(ctx, session))
+
+		validated, err := auth.ValidateSession(ctx, session.ID.String(), session.Secret.String(),
+			model.SessionClient{IPAddress: "198.51.100.7", UserAgent: "Mozilla/5.0"})
+		gt.NoError(t, err).Required()
+		gt.True(t, validated.ExpiresAt.After(time.Now().Add(50*time.Minute)))
+		gt.Equal(t, validated.IPAddress, "198.51.100.7")
+
+		stored, err := repo.GetSession(ctx, session.ID)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, stored.ExpiresAt, validated.ExpiresAt)
+	})
+
+	t.Run("lists and revokes sessions", func(t *testing.T) {
+		repo := repository.NewMemory()
+		auth := usecase.NewAuth(ctx, repo, &config.SlackConfig{})
+
+		s1, err := auth.CreateSession(ctx, "U1", "User1", "u1@example.com", client)
+		gt.NoError(t, err).Required()
+		s2, err := auth.CreateSession(ctx, "U1", "User1", "u1@example.com", client)
+		gt.NoError(t, err).Required()
+		other, err := auth.CreateSession(ctx, "U2", "User2", "u2@example.com", client)
+		gt.NoError(t, err).Required()
+
+		sessions, err := auth.ListSessions(ctx, "U1")
+		gt.NoError(t, err)
+		gt.A(t, sessions).Length(2)
+
+		// Another user's session cannot be revoked
+		err = auth.RevokeSession(ctx, "U1", other.ID)
+		gt.True(t, errors.Is(err, model.ErrSessionNotFound))
+
+		gt.NoError(t, auth.RevokeSession(ctx, "U1", s1.ID))
+		_, err = auth.ValidateSession(ctx, s1.ID.String(), s1.Secret.String(), client)
+		gt.Error(t, err)
+		_, err = auth.ValidateSession(ctx, s2.ID.String(), s2.Secret.String(), client)
+		gt.NoError(t, err)
+
+		revoked, err := auth.RevokeAllSessions(ctx, "U1")
+		gt.NoError(t, err)
+		gt.Equal(t, revoked, 1)
+		_, err = auth.ValidateSession(ctx, s2.ID.String(), s2.Secret.String(), client)
+		gt.Error(t, err)
+		_, err = auth.ValidateSession(ctx, other.ID.String(), other.Secret.String(), client)
+		gt.NoError(t, err)
+	})
+
+	t.Run("cleans up expired sessions", func(t *testing.T) {
+		repo := repository.NewMemory()
+		auth := usecase.NewAuth(ctx, repo, &config.SlackConfig{})
+
+		expired, err := auth.CreateSession(ctx, "U1", "User1", "u1@example.com", client)
+		gt.NoError(t, err).Required()
+		expired.ExpiresAt = time.Now().Add(-time.Minute)
+		gt.NoError(t, repo.SaveSession(ctx, expired))
+		active, err := auth.CreateSession(ctx, "U1", "User1", "u1@example.com", client)
+		gt.NoError(t, err).Required()
+
+		sessions, err := auth.ListSessions(ctx, "U1")
+		gt.NoError(t, err)
+		gt.A(t, sessions).Length(1)
+
+		deleted, err := auth.CleanupExpiredSessions(ctx)
+		gt.NoError(t, err)
+		gt.Equal(t, deleted, 1)
+
+		_, err = repo.GetSession(ctx, expired.ID)
+		gt.Error(t, err)
+		_, err = repo.GetSession(ctx, active.ID)
+		gt.NoError(t, err)
+	})
+}
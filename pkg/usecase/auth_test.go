@@ -10,6 +10,7 @@ import (
 	"github.com/m-mizutani/ctxlog"
 	"github.com/m-mizutani/gt"
 	"github.com/secmon-lab/lycaon/pkg/cli/config"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
 	"github.com/secmon-lab/lycaon/pkg/repository"
 	"github.com/secmon-lab/lycaon/pkg/usecase"
@@ -23,7 +24,7 @@ func TestAuthCreateSession(t *testing.T) {
 	slackConfig := &config.SlackConfig{}
 	auth := usecase.NewAuth(ctx, repo, slackConfig)
 
-	session, err := auth.CreateSession(ctx, "U12345", "Test User", "test@example.com")
+	session, err := auth.CreateSession(ctx, "U12345", "Test User", "test@example.com", model.SessionClient{})
 	gt.NoError(t, err).Required()
 	gt.NotEqual(t, "", session.ID)
 	gt.NotEqual(t, "", session.Secret)
@@ -31,7 +32,7 @@ func TestAuthCreateSession(t *testing.T) {
 	gt.True(t, session.ExpiresAt.After(time.Now()))
 
 	// Create another session for the same user
-	session2, err := auth.CreateSession(ctx, "U12345", "Test User", "test@example.com")
+	session2, err := auth.CreateSession(ctx, "U12345", "Test User", "test@example.com", model.SessionClient{})
 	gt.NoError(t, err).Required()
 	gt.NotEqual(t, session.ID, session2.ID)      // Different session ID
 	gt.Equal(t, session.UserID, session2.UserID) // Same user ID
@@ -46,28 +47,28 @@ func TestAuthValidateSession(t *testing.T) {
 	auth := usecase.NewAuth(ctx, repo, slackConfig)
 
 	// Create a session
-	session, err := auth.CreateSession(ctx, "U12345", "Test User", "test@example.com")
+	session, err := auth.CreateSession(ctx, "U12345", "Test User", "test@example.com", model.SessionClient{})
 	gt.NoError(t, err).Required()
 
 	t.Run("Valid session", func(t *testing.T) {
-		validated, err := auth.ValidateSession(ctx, session.ID.String(), session.Secret.String())
+		validated, err := auth.ValidateSession(ctx, session.ID.String(), session.Secret.String(), model.SessionClient{})
 		gt.NoError(t, err).Required()
 		gt.Equal(t, session.ID, validated.ID)
 		gt.Equal(t, session.UserID, validated.UserID)
 	})
 
 	t.Run("Invalid secret", func(t *testing.T) {
-		_, err := auth.ValidateSession(ctx, session.ID.String(), "wrong-secret")
+		_, err := auth.ValidateSession(ctx, session.ID.String(), "wrong-secret", model.SessionClient{})
 		gt.Error(t, err)
 	})
 
 	t.Run("Non-existent session", func(t *testing.T) {
-		_, err := auth.ValidateSession(ctx, "non-existent", "secret")
+		_, err := auth.ValidateSession(ctx, "non-existent", "secret", model.SessionClient{})
 		gt.Error(t, err)
 	})
 
 	t.Run("Empty credentials", func(t *testing.T) {
-		_, err := auth.ValidateSession(ctx, "", "")
+		_, err := auth.ValidateSession(ctx, "", "", model.SessionClient{})
 		gt.Error(t, err)
 	})
 }
@@ -81,7 +82,7 @@ func TestAuthDeleteSession(t *testing.T) {
 	auth := usecase.NewAuth(ctx, repo, slackConfig)
 
 	// Create a session
-	session, err := auth.CreateSession(ctx, "U12345", "Test User", "test@example.com")
+	session, err := auth.CreateSession(ctx, "U12345", "Test User", "test@example.com", model.SessionClient{})
 	gt.NoError(t, err).Required()
 
 	// Delete the session
@@ -89,7 +90,7 @@ func TestAuthDeleteSession(t *testing.T) {
 	gt.NoError(t, err).Required()
 
 	// Try to validate deleted session
-	_, err = auth.ValidateSession(ctx, session.ID.String(), session.Secret.String())
+	_, err = auth.ValidateSession(ctx, session.ID.String(), session.Secret.String(), model.SessionClient{})
 	gt.Error(t, err)
 
 	// Try to delete non-existent session
@@ -106,7 +107,7 @@ func TestAuthGetUserFromSession(t *testing.T) {
 	auth := usecase.NewAuth(ctx, repo, slackConfig)
 
 	// Create a session
-	session, err := auth.CreateSession(ctx, "U12345", "Test User", "test@example.com")
+	session, err := auth.CreateSession(ctx, "U12345", "Test User", "test@example.com", model.SessionClient{})
 	gt.NoError(t, err).Required()
 
 	t.Run("Valid session", func(t *testing.T) {
@@ -140,17 +141,17 @@ func TestAuthCleanupExpiredSessions(t *testing.T) {
 	auth := usecase.NewAuth(ctx, repo, slackConfig)
 
 	// Create multiple sessions
-	session1, err := auth.CreateSession(ctx, "U1", "User1", "user1@example.com")
+	session1, err := auth.CreateSession(ctx, "U1", "User1", "user1@example.com", model.SessionClient{})
 	gt.NoError(t, err).Required()
 
-	session2, err := auth.CreateSession(ctx, "U2", "User2", "user2@example.com")
+	session2, err := auth.CreateSession(ctx, "U2", "User2", "user2@example.com", model.SessionClient{})
 	gt.NoError(t, err).Required()
 
 	// Both sessions should be valid
-	_, err = auth.ValidateSession(ctx, session1.ID.String(), session1.Secret.String())
+	_, err = auth.ValidateSession(ctx, session1.ID.String(), session1.Secret.String(), model.SessionClient{})
 	gt.NoError(t, err).Required()
 
-	_, err = auth.ValidateSession(ctx, session2.ID.String(), session2.Secret.String())
+	_, err = auth.ValidateSession(ctx, session2.ID.String(), session2.Secret.String(), model.SessionClient{})
 	gt.NoError(t, err).Required()
 
 	// Note: To properly test cleanup, we would need to:
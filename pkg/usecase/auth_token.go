@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/service/audit"
+)
+
+// apiTokenLastUsedInterval limits how often LastUsedAt is written back on validation
+const apiTokenLastUsedInterval = 5 * time.Minute
+
+// CreateAPIToken creates an API token and returns it with the plain token, which is not stored
+func (a *Auth) CreateAPIToken(ctx context.Context, req interfaces.CreateAPITokenRequest) (*model.APIToken, string, error) {
+	token, plain, err := model.NewAPIToken(req.Name, req.Kind, req.OwnerID, req.Scopes, req.TTL, req.CreatedBy)
+	if err != nil {
+		return nil, "", goerr.Wrap(err, "failed to create API token")
+	}
+
+	if err := a.repo.PutAPIToken(ctx, token); err != nil {
+		return nil, "", goerr.Wrap(err, "failed to save API token")
+	}
+	a.audit.Record(ctx, types.AuditActionAPITokenCreate, apiTokenAuditTarget(token), req.CreatedBy, nil, token)
+
+	ctxlog.From(ctx).Info("API token created",
+		"tokenID", token.ID,
+		"name", token.Name,
+		"kind", token.Kind,
+		"ownerID", token.OwnerID,
+		"scopes", token.Scopes,
+		"expiresAt", token.ExpiresAt,
+		"createdBy", token.CreatedBy,
+	)
+
+	return token, plain, nil
+}
+
+// ListAPITokens lists API tokens owned by ownerID, or all tokens if ownerID is empty
+func (a *Auth) ListAPITokens(ctx context.Context, ownerID types.SlackUserID) ([]*model.APIToken, error) {
+	tokens, err := a.repo.ListAPITokens(ctx)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list API tokens")
+	}
+	if ownerID == "" {
+		return tokens, nil
+	}
+
+	owned := make([]*model.APIToken, 0, len(tokens))
+	for _, token := range tokens {
+		if token.OwnerID == ownerID {
+			owned = append(owned, token)
+		}
+	}
+	return owned, nil
+}
+
+// GetAPIToken gets an API token by ID
+func (a *Auth) GetAPIToken(ctx context.Context, id types.APITokenID) (*model.APIToken, error) {
+	token, err := a.repo.GetAPIToken(ctx, id)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get API token")
+	}
+	return token, nil
+}
+
+// RevokeAPIToken revokes an API token so that it can no longer be used
+func (a *Auth) RevokeAPIToken(ctx context.Context, id types.APITokenID) (*model.APIToken, error) {
+	token, err := a.repo.GetAPIToken(ctx, id)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get API token")
+	}
+	if token.IsRevoked() {
+		return token, nil
+	}
+
+	before := *token
+	token.RevokedAt = time.Now()
+	if err := a.repo.PutAPIToken(ctx, token); err != nil {
+		return nil, goerr.Wrap(err, "failed to revoke API token", goerr.V("tokenID", id))
+	}
+	a.audit.Record(ctx, types.AuditActionAPITokenRevoke, apiTokenAuditTarget(token), "", &before, token)
+
+	ctxlog.From(ctx).Info("API token revoked", "tokenID", token.ID, "name", token.Name)
+	return token, nil
+}
+
+// ValidateAPIToken validates a plain token sent as a Bearer credential
+func (a *Auth) ValidateAPIToken(ctx context.Context, plain string) (*model.APIToken, error) {
+	id, secret, err := model.ParseAPIToken(plain)
+	if err != nil {
+		return nil, goerr.Wrap(err, "invalid API token")
+	}
+
+	token, err := a.repo.GetAPIToken(ctx, id)
+	if err != nil {
+		return nil, goerr.Wrap(err, "API token not found", goerr.V("tokenID", id))
+	}
+
+	if !token.VerifySecret(secret) {
+		return nil, goerr.New("invalid API token secret", goerr.V("tokenID", id))
+	}
+	if token.IsRevoked() {
+		return nil, goerr.New("API token revoked", goerr.V("tokenID", id))
+	}
+	if token.IsExpired() {
+		return nil, goerr.New("API token expired", goerr.V("tokenID", id), goerr.V("expiresAt", token.ExpiresAt))
+	}
+
+	// Record usage, throttled to avoid a write on every request
+	if now := time.Now(); now.Sub(token.LastUsedAt) > apiTokenLastUsedInterval {
+		token.LastUsedAt = now
+		if err := a.repo.UpdateAPITokenLastUsed(ctx, id, now); err != nil {
+			ctxlog.From(ctx).Warn("Failed to record API token usage", "tokenID", id, "error", err)
+		}
+	}
+
+	return token, nil
+}
+
+func apiTokenAuditTarget(token *model.APIToken) audit.Target {
+	return audit.Target{Type: types.AuditTargetAPIToken, ID: token.ID.String()}
+}
@@ -0,0 +1,101 @@
+package usecase_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/cli/config"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+)
+
+func TestAuthAPIToken(t *testing.T) {
+	ctx := ctxlog.With(context.Background(), slog.New(slog.NewTextHandler(os.Stdout, nil)))
+	repo := repository.NewMemory()
+	auth := usecase.NewAuth(ctx, repo, &config.SlackConfig{})
+
+	createToken := func(t *testing.T, ownerID types.SlackUserID, ttl time.Duration) (types.APITokenID, string) {
+		kind := types.APITokenKindPersonal
+		if ownerID == "" {
+			kind = types.APITokenKindService
+		}
+		token, plain, err := auth.CreateAPIToken(ctx, interfaces.CreateAPITokenRequest{
+			Name:      "automation",
+			Kind:      kind,
+			OwnerID:   ownerID,
+			Scopes:    []types.APITokenScope{types.APITokenScopeRead},
+			TTL:       ttl,
+			CreatedBy: "U-ADMIN",
+		})
+		gt.NoError(t, err).Required()
+		return token.ID, plain
+	}
+
+	t.Run("valid token is accepted and usage recorded", func(t *testing.T) {
+		id, plain := createToken(t, "U-OWNER", time.Hour)
+
+		token, err := auth.ValidateAPIToken(ctx, plain)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, token.ID, id)
+		gt.Equal(t, token.OwnerID, types.SlackUserID("U-OWNER"))
+
+		stored, err := auth.GetAPIToken(ctx, id)
+		gt.NoError(t, err).Required()
+		gt.False(t, stored.LastUsedAt.IsZero())
+	})
+
+	t.Run("wrong secret is rejected", func(t *testing.T) {
+		_, plain := createToken(t, "U-OWNER", time.Hour)
+		_, err := auth.ValidateAPIToken(ctx, plain+"x")
+		gt.Error(t, err)
+	})
+
+	t.Run("unknown or malformed token is rejected", func(t *testing.T) {
+		_, err := auth.ValidateAPIToken(ctx, "lyc_unknown_secret")
+		gt.Error(t, err)
+		_, err = auth.ValidateAPIToken(ctx, "not-a-token")
+		gt.Error(t, err)
+	})
+
+	t.Run("revoked token is rejected", func(t *testing.T) {
+		id, plain := createToken(t, "", 0)
+
+		revoked, err := auth.RevokeAPIToken(ctx, id)
+		gt.NoError(t, err).Required()
+		gt.True(t, revoked.IsRevoked())
+
+		_, err = auth.ValidateAPIToken(ctx, plain)
+		gt.Error(t, err)
+	})
+
+	t.Run("expired token is rejected", func(t *testing.T) {
+		id, plain := createToken(t, "U-OWNER", time.Hour)
+
+		token, err := repo.GetAPIToken(ctx, id)
+		gt.NoError(t, err).Required()
+		token.ExpiresAt = time.Now().Add(-time.Minute)
+		gt.NoError(t, repo.PutAPIToken(ctx, token))
+
+		_, err = auth.ValidateAPIToken(ctx, plain)
+		gt.Error(t, err)
+	})
+
+	t.Run("list filters by owner", func(t *testing.T) {
+		createToken(t, "U-LIST", time.Hour)
+
+		owned, err := auth.ListAPITokens(ctx, "U-LIST")
+		gt.NoError(t, err)
+		gt.A(t, owned).Length(1)
+
+		all, err := auth.ListAPITokens(ctx, "")
+		gt.NoError(t, err)
+		gt.True(t, len(all) > 1)
+	})
+}
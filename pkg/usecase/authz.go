@@ -0,0 +1,276 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/slack-go/slack"
+)
+
+const defaultRoleCacheTTL = 5 * time.Minute
+
+// Authorization resolves user roles from the roles configuration and checks
+// permissions for incident operations
+type Authorization struct {
+	roles       *model.RolesConfig
+	slackClient interfaces.SlackClient
+	cacheTTL    time.Duration
+
+	mu        sync.Mutex
+	members   *bindingMembers
+	fetchedAt time.Time
+}
+
+// bindingMembers holds the user IDs that binding entries resolve to. Users and
+// groups are kept apart so a user named like a group handle does not match it.
+type bindingMembers struct {
+	users  map[string][]string // @username -> user IDs
+	groups map[string][]string // group ID or @handle -> user IDs
+}
+
+// AuthorizationOption configures Authorization
+type AuthorizationOption func(*Authorization)
+
+// WithRoleCacheTTL sets how long resolved user group members are cached
+func WithRoleCacheTTL(ttl time.Duration) AuthorizationOption {
+	return func(a *Authorization) {
+		if ttl > 0 {
+			a.cacheTTL = ttl
+		}
+	}
+}
+
+// NewAuthorization creates a new Authorization use case. When roles is nil,
+// access control is disabled and every user is an admin.
+func NewAuthorization(roles *model.RolesConfig, slackClient interfaces.SlackClient, opts ...AuthorizationOption) *Authorization {
+	a := &Authorization{
+		roles:       roles,
+		slackClient: slackClient,
+		cacheTTL:    defaultRoleCacheTTL,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// Enabled reports whether role-based access control is configured
+func (a *Authorization) Enabled() bool {
+	return a.roles != nil
+}
+
+// GetRole returns the most privileged role bound to the user
+func (a *Authorization) GetRole(ctx context.Context, userID types.SlackUserID) (types.Role, error) {
+	if !a.Enabled() {
+		return types.RoleAdmin, nil
+	}
+
+	members, err := a.resolveMembers(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	role := a.roles.DefaultRole()
+	for _, b := range a.roles.Bindings {
+		if b.Role.AtLeast(role) && a.bound(b, userID, members) {
+			role = b.Role
+		}
+	}
+	return role, nil
+}
+
+// AuthorizeIncidentCreation checks if the user may declare a new incident
+func (a *Authorization) AuthorizeIncidentCreation(ctx context.Context, userID types.SlackUserID) error {
+	role, err := a.GetRole(ctx, userID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to get user role")
+	}
+	if !role.AtLeast(types.RoleResponder) {
+		return goerr.Wrap(model.ErrPermissionDenied, "creating incidents requires responder role",
+			goerr.V("userID", userID),
+			goerr.V("role", role))
+	}
+	return nil
+}
+
+// AuthorizeIncidentUpdate checks if the user may modify the incident, its
+// status and its tasks. Admins may modify any incident, responders only
+// incidents they take part in, and viewers none.
+func (a *Authorization) AuthorizeIncidentUpdate(ctx context.Context, userID types.SlackUserID, incident *model.Incident) error {
+	role, err := a.GetRole(ctx, userID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to get user role")
+	}
+
+	switch role {
+	case types.RoleAdmin:
+		return nil
+	case types.RoleResponder:
+		ok, err := a.isParticipant(ctx, userID, incident)
+		if err != nil {
+			return goerr.Wrap(err, "failed to check incident participation")
+		}
+		if ok {
+			return nil
+		}
+		return goerr.Wrap(model.ErrPermissionDenied, "responders can only modify incidents they take part in",
+			goerr.V("userID", userID),
+			goerr.V("incidentID", incident.ID))
+	default:
+		return goerr.Wrap(model.ErrPermissionDenied, "modifying incidents requires responder role",
+			goerr.V("userID", userID),
+			goerr.V("role", role),
+			goerr.V("incidentID", incident.ID))
+	}
+}
+
+// isParticipant checks if the user created, leads or is a member of the incident channel
+func (a *Authorization) isParticipant(ctx context.Context, userID types.SlackUserID, incident *model.Incident) (bool, error) {
+	if incident.CreatedBy == userID || incident.Lead == userID {
+		return true, nil
+	}
+	if slices.Contains(incident.JoinedMemberIDs, userID) {
+		return true, nil
+	}
+	if a.slackClient == nil || incident.ChannelID == "" {
+		return false, nil
+	}
+
+	params := &slack.GetUsersInConversationParameters{
+		ChannelID: incident.ChannelID.String(),
+		Limit:     1000,
+	}
+	for {
+		members, cursor, err := a.slackClient.GetUsersInConversationContext(ctx, params)
+		if err != nil {
+			return false, goerr.Wrap(err, "failed to get channel members",
+				goerr.V("channelID", incident.ChannelID))
+		}
+		if slices.Contains(members, userID.String()) {
+			return true, nil
+		}
+		if cursor == "" {
+			return false, nil
+		}
+		params.Cursor = cursor
+	}
+}
+
+// bound checks if the binding applies to the user
+func (a *Authorization) bound(b model.RoleBinding, userID types.SlackUserID, members *bindingMembers) bool {
+	for _, u := range b.Users {
+		if u == userID.String() || slices.Contains(members.users[u], userID.String()) {
+			return true
+		}
+	}
+	for _, g := range b.Groups {
+		if slices.Contains(members.groups[g], userID.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveMembers resolves @usernames and user groups of all bindings to user
+// IDs. Results are cached for cacheTTL; on refresh failure the stale cache is kept.
+func (a *Authorization) resolveMembers(ctx context.Context) (*bindingMembers, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.members != nil && time.Since(a.fetchedAt) < a.cacheTTL {
+		return a.members, nil
+	}
+
+	members, err := a.fetchMembers(ctx)
+	if err != nil {
+		if a.members != nil {
+			ctxlog.From(ctx).Warn("Failed to refresh role bindings, using cached members", "error", err)
+			return a.members, nil
+		}
+		return nil, goerr.Wrap(err, "failed to resolve role bindings")
+	}
+
+	a.members = members
+	a.fetchedAt = time.Now()
+	return members, nil
+}
+
+func (a *Authorization) fetchMembers(ctx context.Context) (*bindingMembers, error) {
+	members := &bindingMembers{
+		users:  make(map[string][]string),
+		groups: make(map[string][]string),
+	}
+
+	var needUsers, needGroups bool
+	for _, b := range a.roles.Bindings {
+		for _, u := range b.Users {
+			needUsers = needUsers || strings.HasPrefix(u, "@")
+		}
+		for _, g := range b.Groups {
+			needGroups = needGroups || strings.HasPrefix(g, "@")
+		}
+	}
+	hasGroups := slices.ContainsFunc(a.roles.Bindings, func(b model.RoleBinding) bool { return len(b.Groups) > 0 })
+	if (needUsers || hasGroups) && a.slackClient == nil {
+		return nil, goerr.New("Slack client is required to resolve role bindings")
+	}
+
+	if needUsers {
+		users, err := a.slackClient.GetUsersContext(ctx)
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to get users")
+		}
+		// Only the username is matched: display names can be changed by anyone to any value
+		for _, user := range users {
+			if user.Name != "" {
+				members.users["@"+user.Name] = append(members.users["@"+user.Name], user.ID)
+			}
+		}
+	}
+
+	groupIDs := make(map[string]string)
+	if needGroups {
+		groups, err := a.slackClient.GetUserGroupsContext(ctx)
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to get user groups")
+		}
+		for _, g := range groups {
+			groupIDs["@"+g.Handle] = g.ID
+			groupIDs["@"+g.Name] = g.ID
+		}
+	}
+
+	for _, b := range a.roles.Bindings {
+		for _, g := range b.Groups {
+			if _, ok := members.groups[g]; ok {
+				continue
+			}
+			groupID := g
+			if strings.HasPrefix(g, "@") {
+				id, ok := groupIDs[g]
+				if !ok {
+					ctxlog.From(ctx).Warn("User group in role binding not found", "group", g)
+					members.groups[g] = nil
+					continue
+				}
+				groupID = id
+			}
+
+			ids, err := a.slackClient.GetUserGroupMembersContext(ctx, groupID)
+			if err != nil {
+				return nil, goerr.Wrap(err, "failed to get user group members", goerr.V("group", g))
+			}
+			members.groups[g] = ids
+		}
+	}
+
+	return members, nil
+}
@@ -0,0 +1,177 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces/mocks"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+	"github.com/slack-go/slack"
+)
+
+func newAuthzSlackMock() *mocks.SlackClientMock {
+	return &mocks.SlackClientMock{
+		GetUsersContextFunc: func(ctx context.Context) ([]slack.User, error) {
+			return []slack.User{
+				{ID: "U-ALICE", Name: "alice"},
+				{ID: "U-BOB", Name: "bob"},
+				{ID: "U-MALLORY", Name: "mallory", Profile: slack.UserProfile{DisplayName: "alice"}},
+			}, nil
+		},
+		GetUserGroupsContextFunc: func(ctx context.Context) ([]slack.UserGroup, error) {
+			return []slack.UserGroup{
+				{ID: "S-ONCALL", Handle: "oncall", Name: "On-call"},
+			}, nil
+		},
+		GetUserGroupMembersContextFunc: func(ctx context.Context, groupID string) ([]string, error) {
+			if groupID == "S-ONCALL" {
+				return []string{"U-RESPONDER", "U-ALICE"}, nil
+			}
+			return nil, nil
+		},
+		GetUsersInConversationContextFunc: func(ctx context.Context, params *slack.GetUsersInConversationParameters) ([]string, string, error) {
+			if params.ChannelID == "C-INCIDENT" {
+				return []string{"U-MEMBER"}, "", nil
+			}
+			return nil, "", nil
+		},
+	}
+}
+
+func newAuthzRoles() *model.RolesConfig {
+	return &model.RolesConfig{
+		Bindings: []model.RoleBinding{
+			{Role: types.RoleAdmin, Users: []string{"@alice"}},
+			{Role: types.RoleResponder, Users: []string{"U-MEMBER", "U-OTHER"}, Groups: []string{"@oncall"}},
+		},
+	}
+}
+
+func TestAuthorizationGetRole(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("everyone is admin without roles configuration", func(t *testing.T) {
+		authz := usecase.NewAuthorization(nil, nil)
+		role, err := authz.GetRole(ctx, "U-ANYONE")
+		gt.NoError(t, err)
+		gt.Equal(t, types.RoleAdmin, role)
+	})
+
+	t.Run("resolves users, groups and the default role", func(t *testing.T) {
+		authz := usecase.NewAuthorization(newAuthzRoles(), newAuthzSlackMock())
+
+		testCases := map[types.SlackUserID]types.Role{
+			"U-ALICE":     types.RoleAdmin, // admin by name wins over responder via group
+			"U-RESPONDER": types.RoleResponder,
+			"U-MEMBER":    types.RoleResponder,
+			"U-BOB":       types.RoleViewer,
+			"U-MALLORY":   types.RoleViewer, // display name "alice" does not match "@alice"
+		}
+		for userID, expected := range testCases {
+			role, err := authz.GetRole(ctx, userID)
+			gt.NoError(t, err)
+			gt.Equal(t, expected, role)
+		}
+	})
+
+	t.Run("caches resolved members", func(t *testing.T) {
+		slackMock := newAuthzSlackMock()
+		authz := usecase.NewAuthorization(newAuthzRoles(), slackMock)
+
+		for range 3 {
+			_, err := authz.GetRole(ctx, "U-BOB")
+			gt.NoError(t, err)
+		}
+		gt.A(t, slackMock.GetUserGroupMembersContextCalls()).Length(1)
+	})
+
+	t.Run("user and group with the same name are resolved separately", func(t *testing.T) {
+		slackMock := &mocks.SlackClientMock{
+			GetUsersContextFunc: func(ctx context.Context) ([]slack.User, error) {
+				return []slack.User{
+					{ID: "U-SECURITY", Name: "security"},
+					{ID: "U-CAROL", Name: "carol"},
+				}, nil
+			},
+			GetUserGroupsContextFunc: func(ctx context.Context) ([]slack.UserGroup, error) {
+				return []slack.UserGroup{
+					{ID: "S-SECURITY", Handle: "security", Name: "Security"},
+				}, nil
+			},
+			GetUserGroupMembersContextFunc: func(ctx context.Context, groupID string) ([]string, error) {
+				if groupID == "S-SECURITY" {
+					return []string{"U-CAROL"}, nil
+				}
+				return nil, nil
+			},
+		}
+		authz := usecase.NewAuthorization(&model.RolesConfig{
+			Bindings: []model.RoleBinding{
+				{Role: types.RoleAdmin, Groups: []string{"@security"}},
+				{Role: types.RoleResponder, Users: []string{"@carol"}},
+			},
+		}, slackMock)
+
+		testCases := map[types.SlackUserID]types.Role{
+			"U-CAROL":    types.RoleAdmin,  // member of the @security group
+			"U-SECURITY": types.RoleViewer, // user named "security" is not the group
+		}
+		for userID, expected := range testCases {
+			role, err := authz.GetRole(ctx, userID)
+			gt.NoError(t, err)
+			gt.Equal(t, expected, role)
+		}
+	})
+
+	t.Run("custom default role", func(t *testing.T) {
+		authz := usecase.NewAuthorization(&model.RolesConfig{
+			Default: types.RoleResponder,
+			Bindings: []model.RoleBinding{
+				{Role: types.RoleAdmin, Users: []string{"U-ADMIN"}},
+			},
+		}, nil)
+		role, err := authz.GetRole(ctx, "U-BOB")
+		gt.NoError(t, err)
+		gt.Equal(t, types.RoleResponder, role)
+	})
+}
+
+func TestAuthorizationAuthorizeIncident(t *testing.T) {
+	ctx := context.Background()
+	authz := usecase.NewAuthorization(newAuthzRoles(), newAuthzSlackMock())
+
+	incident := &model.Incident{
+		ID:        1,
+		ChannelID: "C-INCIDENT",
+		CreatedBy: "U-RESPONDER",
+	}
+
+	t.Run("creation requires responder", func(t *testing.T) {
+		gt.NoError(t, authz.AuthorizeIncidentCreation(ctx, "U-RESPONDER"))
+		err := authz.AuthorizeIncidentCreation(ctx, "U-BOB")
+		gt.True(t, errors.Is(err, model.ErrPermissionDenied))
+	})
+
+	t.Run("admin may modify any incident", func(t *testing.T) {
+		gt.NoError(t, authz.AuthorizeIncidentUpdate(ctx, "U-ALICE", incident))
+	})
+
+	t.Run("responder may modify incidents they take part in", func(t *testing.T) {
+		gt.NoError(t, authz.AuthorizeIncidentUpdate(ctx, "U-RESPONDER", incident)) // creator
+		gt.NoError(t, authz.AuthorizeIncidentUpdate(ctx, "U-MEMBER", incident))    // channel member
+	})
+
+	t.Run("responder may not modify other incidents", func(t *testing.T) {
+		err := authz.AuthorizeIncidentUpdate(ctx, "U-OTHER", incident)
+		gt.True(t, errors.Is(err, model.ErrPermissionDenied))
+	})
+
+	t.Run("viewer may not modify incidents", func(t *testing.T) {
+		err := authz.AuthorizeIncidentUpdate(ctx, "U-BOB", &model.Incident{ID: 2, CreatedBy: "U-BOB"})
+		gt.True(t, errors.Is(err, model.ErrPermissionDenied))
+	})
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
+)
+
+const (
+	// DefaultEventDedupTTL covers Slack's retry schedule (immediately, after 1 minute and after 5 minutes)
+	// with a generous margin
+	DefaultEventDedupTTL = time.Hour
+
+	dedupKeyPrefixEvent       = "event:"
+	dedupKeyPrefixInteraction = "interaction:"
+)
+
+// DedupStats holds counters of dropped duplicate deliveries
+type DedupStats struct {
+	DroppedEvents       uint64
+	DroppedInteractions uint64
+}
+
+// EventDedup drops Slack deliveries that have already been accepted.
+// Records are stored in the repository so deduplication works across replicas.
+type EventDedup struct {
+	repo interfaces.Repository
+	ttl  time.Duration
+
+	droppedEvents       atomic.Uint64
+	droppedInteractions atomic.Uint64
+}
+
+// NewEventDedup creates a new EventDedup
+func NewEventDedup(repo interfaces.Repository, ttl time.Duration) *EventDedup {
+	if ttl <= 0 {
+		ttl = DefaultEventDedupTTL
+	}
+	return &EventDedup{
+		repo: repo,
+		ttl:  ttl,
+	}
+}
+
+// IsDuplicateEvent reports whether the Events API delivery with eventID was already accepted
+func (d *EventDedup) IsDuplicateEvent(ctx context.Context, eventID string) bool {
+	if d.isDuplicate(ctx, dedupKeyPrefixEvent+eventID, eventID) {
+		d.droppedEvents.Add(1)
+		return true
+	}
+	return false
+}
+
+// IsDuplicateInteraction reports whether the interaction with triggerID was already accepted
+func (d *EventDedup) IsDuplicateInteraction(ctx context.Context, triggerID string) bool {
+	if d.isDuplicate(ctx, dedupKeyPrefixInteraction+triggerID, triggerID) {
+		d.droppedInteractions.Add(1)
+		return true
+	}
+	return false
+}
+
+// ReleaseEvent forgets an accepted Events API delivery, e.g. when it could not be
+// persisted, so that Slack's retry of it is accepted again
+func (d *EventDedup) ReleaseEvent(ctx context.Context, eventID string) {
+	d.release(ctx, dedupKeyPrefixEvent+eventID, eventID)
+}
+
+// ReleaseInteraction forgets an accepted interaction, e.g. when it could not be persisted
+func (d *EventDedup) ReleaseInteraction(ctx context.Context, triggerID string) {
+	d.release(ctx, dedupKeyPrefixInteraction+triggerID, triggerID)
+}
+
+// Stats returns a snapshot of dropped duplicate counters
+func (d *EventDedup) Stats() DedupStats {
+	return DedupStats{
+		DroppedEvents:       d.droppedEvents.Load(),
+		DroppedInteractions: d.droppedInteractions.Load(),
+	}
+}
+
+// isDuplicate fails open: deliveries without an ID or with a repository error are processed
+func (d *EventDedup) isDuplicate(ctx context.Context, key, id string) bool {
+	if d == nil || d.repo == nil || id == "" {
+		return false
+	}
+
+	marked, err := d.repo.MarkEventProcessed(ctx, key, d.ttl)
+	if err != nil {
+		apperr.Handle(ctx, err)
+		return false
+	}
+
+	if !marked {
+		ctxlog.From(ctx).Info("Dropped duplicate Slack delivery", "key", key)
+		return true
+	}
+	return false
+}
+
+// release removes the record of an accepted delivery. Errors are only reported, as the
+// record expires after the TTL anyway.
+func (d *EventDedup) release(ctx context.Context, key, id string) {
+	if d == nil || d.repo == nil || id == "" {
+		return
+	}
+
+	if err := d.repo.UnmarkEventProcessed(ctx, key); err != nil {
+		apperr.Handle(ctx, err)
+	}
+}
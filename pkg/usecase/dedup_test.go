@@ -0,0 +1,57 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces/mocks"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+)
+
+func TestEventDedup(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("drops retried event and counts it", func(t *testing.T) {
+		dedup := usecase.NewEventDedup(repository.NewMemory(), time.Minute)
+
+		gt.False(t, dedup.IsDuplicateEvent(ctx, "Ev001"))
+		gt.True(t, dedup.IsDuplicateEvent(ctx, "Ev001"))
+		gt.False(t, dedup.IsDuplicateEvent(ctx, "Ev002"))
+
+		stats := dedup.Stats()
+		gt.Equal(t, uint64(1), stats.DroppedEvents)
+		gt.Equal(t, uint64(0), stats.DroppedInteractions)
+	})
+
+	t.Run("event and interaction keys do not collide", func(t *testing.T) {
+		dedup := usecase.NewEventDedup(repository.NewMemory(), time.Minute)
+
+		gt.False(t, dedup.IsDuplicateEvent(ctx, "same-id"))
+		gt.False(t, dedup.IsDuplicateInteraction(ctx, "same-id"))
+		gt.True(t, dedup.IsDuplicateInteraction(ctx, "same-id"))
+		gt.Equal(t, uint64(1), dedup.Stats().DroppedInteractions)
+	})
+
+	t.Run("empty ID is never treated as duplicate", func(t *testing.T) {
+		dedup := usecase.NewEventDedup(repository.NewMemory(), time.Minute)
+
+		gt.False(t, dedup.IsDuplicateEvent(ctx, ""))
+		gt.False(t, dedup.IsDuplicateEvent(ctx, ""))
+	})
+
+	t.Run("repository error fails open", func(t *testing.T) {
+		repo := &mocks.RepositoryMock{
+			MarkEventProcessedFunc: func(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+				return false, errors.New("unavailable")
+			},
+		}
+		dedup := usecase.NewEventDedup(repo, time.Minute)
+
+		gt.False(t, dedup.IsDuplicateEvent(ctx, "Ev001"))
+		gt.Equal(t, uint64(0), dedup.Stats().DroppedEvents)
+	})
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/utils/metrics"
+)
+
+// DefaultDomainMetricsTTL is how long domain metrics are reused between scrapes
+const DefaultDomainMetricsTTL = 30 * time.Second
+
+// incidentReopens counts reopens on this replica, so it is summed across replicas
+var incidentReopens = metrics.NewCounterVec("lycaon_incident_reopens_total",
+	"Closed incidents reopened by severity, excluding test incidents.", "severity")
+
+// DomainMetrics reports gauges of open incidents and tasks. Counting requires
+// scanning the repository, so a snapshot is shared by scrapes within the TTL.
+type DomainMetrics struct {
+	repo interfaces.Repository
+	ttl  time.Duration
+
+	mu       sync.Mutex
+	snapshot *domainSnapshot
+}
+
+type domainSnapshot struct {
+	takenAt   time.Time
+	incidents []metrics.Sample
+	tasks     []metrics.Sample
+}
+
+// NewDomainMetrics creates a new DomainMetrics
+func NewDomainMetrics(repo interfaces.Repository, ttl time.Duration) *DomainMetrics {
+	if ttl <= 0 {
+		ttl = DefaultDomainMetricsTTL
+	}
+	return &DomainMetrics{
+		repo: repo,
+		ttl:  ttl,
+	}
+}
+
+// Register exposes the gauges to reg
+func (m *DomainMetrics) Register(reg *metrics.Registry) error {
+	return reg.Register(
+		metrics.NewGaugeFunc("lycaon_open_incidents",
+			"Open incidents by severity and status, excluding test incidents.", []string{"severity", "status"},
+			func(ctx context.Context) ([]metrics.Sample, error) {
+				s, err := m.get(ctx)
+				if err != nil {
+					return nil, err
+				}
+				return s.incidents, nil
+			}),
+		metrics.NewGaugeFunc("lycaon_open_tasks",
+			"Uncompleted tasks of open incidents by status, excluding test incidents.", []string{"status"},
+			func(ctx context.Context) ([]metrics.Sample, error) {
+				s, err := m.get(ctx)
+				if err != nil {
+					return nil, err
+				}
+				return s.tasks, nil
+			}),
+	)
+}
+
+// get returns the cached snapshot, taking a new one when it has expired
+func (m *DomainMetrics) get(ctx context.Context) (*domainSnapshot, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.snapshot != nil && time.Since(m.snapshot.takenAt) < m.ttl {
+		return m.snapshot, nil
+	}
+
+	incidents, err := m.repo.ListIncidents(ctx)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list incidents for metrics")
+	}
+
+	type incidentKey struct {
+		severity types.SeverityID
+		status   types.IncidentStatus
+	}
+	incidentCounts := make(map[incidentKey]int)
+	taskCounts := map[model.TaskStatus]int{
+		model.TaskStatusTodo:     0,
+		model.TaskStatusFollowUp: 0,
+	}
+	for _, incident := range incidents {
+		if incident.IsTest || incident.Status == types.IncidentStatusClosed {
+			continue
+		}
+		incidentCounts[incidentKey{incident.SeverityID, incident.Status}]++
+
+		tasks, err := m.repo.ListTasksByIncident(ctx, incident.ID)
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to list tasks for metrics", goerr.V("incidentID", incident.ID))
+		}
+		for _, task := range tasks {
+			if task.Status != model.TaskStatusCompleted {
+				taskCounts[task.Status]++
+			}
+		}
+	}
+
+	s := &domainSnapshot{takenAt: time.Now()}
+	for key, count := range incidentCounts {
+		s.incidents = append(s.incidents, metrics.Sample{
+			LabelValues: []string{key.severity.String(), key.status.String()},
+			Value:       float64(count),
+		})
+	}
+	for status, count := range taskCounts {
+		s.tasks = append(s.tasks, metrics.Sample{
+			LabelValues: []string{string(status)},
+			Value:       float64(count),
+		})
+	}
+	m.snapshot = s
+	return s, nil
+}
@@ -0,0 +1,64 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+	"github.com/secmon-lab/lycaon/pkg/utils/metrics"
+)
+
+func TestDomainMetrics(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemory()
+
+	put := func(id types.IncidentID, severity types.SeverityID, status types.IncidentStatus, isTest bool, taskStatuses ...model.TaskStatus) {
+		gt.NoError(t, repo.PutIncident(ctx, &model.Incident{
+			ID:         id,
+			Title:      "Incident",
+			SeverityID: severity,
+			Status:     status,
+			IsTest:     isTest,
+			CreatedAt:  time.Now(),
+		})).Required()
+		for _, status := range taskStatuses {
+			task, err := model.NewTask(id, "Task", "U-LEAD")
+			gt.NoError(t, err).Required()
+			task.Status = status
+			gt.NoError(t, repo.CreateTask(ctx, task)).Required()
+		}
+	}
+	put(1, "high", types.IncidentStatusHandling, false, model.TaskStatusTodo, model.TaskStatusCompleted)
+	put(2, "high", types.IncidentStatusHandling, false, model.TaskStatusFollowUp)
+	put(3, "low", types.IncidentStatusTriage, false)
+	put(4, "high", types.IncidentStatusClosed, false, model.TaskStatusTodo)
+	put(5, "high", types.IncidentStatusHandling, true, model.TaskStatusTodo)
+
+	reg := metrics.NewRegistry()
+	dm := usecase.NewDomainMetrics(repo, time.Hour)
+	gt.NoError(t, dm.Register(reg)).Required()
+
+	scrape := func() string {
+		var buf bytes.Buffer
+		gt.NoError(t, reg.WriteTo(ctx, &buf)).Required()
+		return buf.String()
+	}
+
+	out := scrape()
+	gt.S(t, out).Contains(`lycaon_open_incidents{severity="high",status="handling"} 2`)
+	gt.S(t, out).Contains(`lycaon_open_incidents{severity="low",status="triage"} 1`)
+	gt.S(t, out).NotContains(`status="closed"`)
+	gt.S(t, out).Contains(`lycaon_open_tasks{status="follow_up"} 1`)
+	gt.S(t, out).Contains(`lycaon_open_tasks{status="todo"} 1`)
+
+	t.Run("snapshot is reused within the TTL", func(t *testing.T) {
+		put(6, "low", types.IncidentStatusTriage, false)
+		gt.S(t, scrape()).Contains(`lycaon_open_incidents{severity="low",status="triage"} 1`)
+	})
+}
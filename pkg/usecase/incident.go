@@ -12,6 +12,8 @@ import (
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/service/audit"
+	"github.com/secmon-lab/lycaon/pkg/service/pubsub"
 	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
 	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
 	"github.com/slack-go/slack"
@@ -19,8 +21,10 @@ import (
 
 // IncidentConfig holds configuration for Incident use case
 type IncidentConfig struct {
-	channelPrefix string
-	frontendURL   string
+	channelPrefix  string
+	frontendURL    string
+	events         *pubsub.Broker
+	renameChannels bool
 }
 
 // IncidentOption is a functional option for configuring Incident
@@ -33,6 +37,14 @@ func WithChannelPrefix(prefix string) IncidentOption {
 	}
 }
 
+// WithChannelRename sets whether incident channels are renamed, and their purpose
+// updated, when the incident title changes
+func WithChannelRename(enabled bool) IncidentOption {
+	return func(c *IncidentConfig) {
+		c.renameChannels = enabled
+	}
+}
+
 // WithFrontendURL sets the frontend URL for bookmark generation
 func WithFrontendURL(url string) IncidentOption {
 	return func(c *IncidentConfig) {
@@ -40,6 +52,13 @@ func WithFrontendURL(url string) IncidentOption {
 	}
 }
 
+// WithIncidentEvents sets the broker that incident changes are published to
+func WithIncidentEvents(events *pubsub.Broker) IncidentOption {
+	return func(c *IncidentConfig) {
+		c.events = events
+	}
+}
+
 // NewIncidentConfig creates a new IncidentConfig with default values and optional settings
 func NewIncidentConfig(opts ...IncidentOption) *IncidentConfig {
 	config := &IncidentConfig{
@@ -62,6 +81,9 @@ type Incident struct {
 	modelConfig *model.Config
 	invite      interfaces.Invite
 	config      *IncidentConfig
+	audit       *audit.Recorder
+	groups      *groupMembersCache
+	notifier    *changeNotifier
 }
 
 // NewIncident creates a new Incident instance with configuration
@@ -73,6 +95,9 @@ func NewIncident(repo interfaces.Repository, slackClient interfaces.SlackClient,
 		modelConfig: modelConfig,
 		invite:      invite,
 		config:      config,
+		audit:       audit.New(repo),
+		groups:      &groupMembersCache{entries: make(map[string]groupMembersEntry)},
+		notifier:    &changeNotifier{slackSvc: slackService, config: modelConfig},
 	}
 }
 
@@ -111,25 +136,11 @@ func (u *Incident) CreateIncident(ctx context.Context, req *model.CreateIncident
 		return nil, goerr.Wrap(err, "failed to create incident model")
 	}
 
-	// Create Slack channel with the generated channel name (includes title if provided)
+	// Create Slack channel with the generated channel name (includes title if provided).
+	// Test incidents get "-test" after the incident number (e.g., "inc-15-test-title").
 	channelName := incident.ChannelName
-
-	// Add TEST prefix to channel name if this is a test incident
 	if req.IsTest {
-		// Insert "test-" after the incident number (e.g., "inc-15" becomes "inc-15-test" or "inc-15-title" becomes "inc-15-test-title")
-		prefix := u.config.channelPrefix
-		if prefix == "" {
-			prefix = "inc"
-		}
-		basePrefix := fmt.Sprintf("%s-%d", prefix, incidentNumber)
-		if strings.HasPrefix(string(channelName), basePrefix) {
-			suffix := string(channelName)[len(basePrefix):]
-			if suffix == "" {
-				channelName = types.ChannelName(basePrefix + "-test")
-			} else {
-				channelName = types.ChannelName(basePrefix + "-test" + suffix)
-			}
-		}
+		channelName = model.IncidentChannelName(u.config.channelPrefix, incidentNumber, req.Title, true)
 	}
 
 	channel, err := u.slackClient.CreateConversation(ctx, slack.CreateConversationParams{
@@ -146,6 +157,9 @@ func (u *Incident) CreateIncident(ctx context.Context, req *model.CreateIncident
 	// Set test mode flag in incident
 	incident.IsTest = req.IsTest
 
+	// Remember the declaring message so that changes can be notified in its thread
+	incident.OriginMessageTS = types.MessageTS(req.OriginMessageTS)
+
 	// Set channel purpose/description if title is provided
 	if req.Title != "" {
 		_, err = u.slackClient.SetPurposeOfConversationContext(ctx, channel.ID, req.Title)
@@ -229,6 +243,7 @@ func (u *Incident) CreateIncident(ctx context.Context, req *model.CreateIncident
 	if err := u.repo.PutIncident(ctx, incident); err != nil {
 		return nil, goerr.Wrap(err, "failed to save incident")
 	}
+	u.audit.Record(ctx, types.AuditActionIncidentCreate, audit.IncidentTarget(incident.ID), incident.CreatedBy, nil, incident)
 
 	// Save initial status history
 	initialHistory := &model.StatusHistory{
@@ -244,6 +259,7 @@ func (u *Incident) CreateIncident(ctx context.Context, req *model.CreateIncident
 		apperr.Handle(ctx, err)
 		return nil, goerr.Wrap(err, "failed to save initial status history")
 	}
+	u.config.events.PublishIncident(ctx, types.TimelineEventIncidentCreated, incident, incident.CreatedBy.String())
 
 	// Category-based invitation process (serial execution)
 	// Note: This function assumes it's already dispatched asynchronously in the Controller layer
@@ -294,11 +310,6 @@ func (u *Incident) GetIncidentByChannelID(ctx context.Context, channelID types.C
 	return incident, nil
 }
 
-// HandleCreateIncidentAction handles the complete flow when a user clicks the create incident button
-func (u *Incident) HandleCreateIncidentAction(ctx context.Context, requestID, userID string) (*model.Incident, error) {
-	return u.handleCreateIncidentFromRequest(ctx, requestID, userID, nil)
-}
-
 // UpdateIncidentDetails updates incident title, description, and lead
 func (u *Incident) UpdateIncidentDetails(ctx context.Context, incidentID types.IncidentID, title, description string, lead types.SlackUserID, severityID string, updatedBy types.SlackUserID) (*model.Incident, error) {
 	// Validate incident ID
@@ -319,12 +330,14 @@ func (u *Incident) UpdateIncidentDetails(ctx context.Context, incidentID types.I
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to get incident")
 	}
+	before := *incident
 
 	// Track if any changes were made
 	hasChanges := false
 
 	// Update fields if they have changed
-	if title != incident.Title {
+	titleChanged := title != incident.Title
+	if titleChanged {
 		incident.Title = title
 		hasChanges = true
 	}
@@ -346,10 +359,17 @@ func (u *Incident) UpdateIncidentDetails(ctx context.Context, incidentID types.I
 		return incident, nil
 	}
 
+	if titleChanged {
+		u.syncChannelWithTitle(ctx, incident)
+	}
+
 	// Save updated incident using PutIncident
 	if err := u.repo.PutIncident(ctx, incident); err != nil {
 		return nil, goerr.Wrap(err, "failed to update incident")
 	}
+	u.audit.Record(ctx, types.AuditActionIncidentUpdate, audit.IncidentTarget(incidentID), updatedBy, &before, incident)
+	u.config.events.PublishIncident(ctx, types.TimelineEventIncidentUpdated, incident, updatedBy.String())
+	u.notifier.notify(ctx, &before, incident, updatedBy)
 
 	return incident, nil
 }
@@ -381,12 +401,14 @@ func (u *Incident) UpdateIncidentDetailsWithAssets(ctx context.Context, incident
 	if err != nil {
 		return nil, goerr.Wrap(err, "failed to get incident")
 	}
+	before := *incident
 
 	// Track if any changes were made
 	hasChanges := false
 
 	// Update fields if they have changed
-	if title != incident.Title {
+	titleChanged := title != incident.Title
+	if titleChanged {
 		incident.Title = title
 		hasChanges = true
 	}
@@ -430,16 +452,24 @@ func (u *Incident) UpdateIncidentDetailsWithAssets(ctx context.Context, incident
 		return incident, nil
 	}
 
+	if titleChanged {
+		u.syncChannelWithTitle(ctx, incident)
+	}
+
 	// Save updated incident using PutIncident
 	if err := u.repo.PutIncident(ctx, incident); err != nil {
 		return nil, goerr.Wrap(err, "failed to update incident")
 	}
+	u.audit.Record(ctx, types.AuditActionIncidentUpdate, audit.IncidentTarget(incidentID), updatedBy, &before, incident)
+	u.config.events.PublishIncident(ctx, types.TimelineEventIncidentUpdated, incident, updatedBy.String())
+	u.notifier.notify(ctx, &before, incident, updatedBy)
 
 	return incident, nil
 }
 
-// UpdateIncident updates incident with UpdateIncidentRequest
-func (u *Incident) UpdateIncident(ctx context.Context, incidentID types.IncidentID, req model.UpdateIncidentRequest) (*model.Incident, error) {
+// UpdateIncident updates the fields of an incident set in req. The incident is
+// updated atomically, and its channel is renamed when the title changes.
+func (u *Incident) UpdateIncident(ctx context.Context, incidentID types.IncidentID, req model.UpdateIncidentRequest, updatedBy types.SlackUserID) (*model.Incident, error) {
 	// Validate incident ID
 	if err := incidentID.Validate(); err != nil {
 		return nil, goerr.Wrap(err, "invalid incident ID")
@@ -457,48 +487,89 @@ func (u *Incident) UpdateIncident(ctx context.Context, incidentID types.Incident
 		}
 	}
 
-	// Get existing incident
-	incident, err := u.repo.GetIncident(ctx, incidentID)
+	var before, incident *model.Incident
+	var changes []string
+	err := u.repo.UpdateIncidentAtomic(ctx, incidentID, func(current *model.Incident) error {
+		snapshot := *current
+		changes = applyIncidentUpdate(current, req)
+		updated := *current
+		before, incident = &snapshot, &updated
+		return nil
+	})
 	if err != nil {
-		return nil, goerr.Wrap(err, "failed to get incident")
+		return nil, goerr.Wrap(err, "failed to update incident")
 	}
 
-	// Track if any changes were made
-	hasChanges := false
+	// Only notify if there are changes
+	if len(changes) == 0 {
+		return incident, nil
+	}
+
+	if incident.Title != before.Title {
+		u.syncChannelWithTitle(ctx, incident)
+		if incident.ChannelName != before.ChannelName {
+			channelName := incident.ChannelName
+			if err := u.repo.UpdateIncidentAtomic(ctx, incidentID, func(current *model.Incident) error {
+				current.ChannelName = channelName
+				return nil
+			}); err != nil {
+				return nil, goerr.Wrap(err, "failed to save renamed channel")
+			}
+		}
+	}
+
+	u.audit.Record(ctx, types.AuditActionIncidentUpdate, audit.IncidentTarget(incidentID), updatedBy, before, incident)
+	u.config.events.PublishIncident(ctx, types.TimelineEventIncidentUpdated, incident, updatedBy.String())
+	u.notifier.notify(ctx, before, incident, updatedBy)
+
+	// Post update notification to incident channel
+	if incident.ChannelID != "" {
+		// Build a simple notification message
+		message := fmt.Sprintf("📝 Incident details updated: %s", strings.Join(changes, ", "))
+		_, _, err = u.slackClient.PostMessage(
+			ctx,
+			string(incident.ChannelID),
+			slack.MsgOptionText(message, false),
+		)
+		if err != nil {
+			// Log error but don't fail - notification is nice to have but not critical
+			apperr.Handle(ctx, err)
+		}
+	}
+
+	return incident, nil
+}
+
+// applyIncidentUpdate sets the fields of req that differ from the incident and
+// returns the names of the changed fields
+func applyIncidentUpdate(incident *model.Incident, req model.UpdateIncidentRequest) []string {
 	var changes []string
 
-	// Update fields if they have changed
 	if req.Title != nil && *req.Title != incident.Title {
 		incident.Title = *req.Title
-		hasChanges = true
 		changes = append(changes, "title")
 	}
 	if req.Description != nil && *req.Description != incident.Description {
 		incident.Description = *req.Description
-		hasChanges = true
 		changes = append(changes, "description")
 	}
 	if req.Lead != nil && *req.Lead != incident.Lead {
 		incident.Lead = *req.Lead
-		hasChanges = true
 		changes = append(changes, "lead")
 	}
 	if req.Status != nil && *req.Status != incident.Status {
 		incident.Status = *req.Status
-		hasChanges = true
 		changes = append(changes, "status")
 	}
 	if req.SeverityID != nil && *req.SeverityID != incident.SeverityID {
 		incident.SeverityID = *req.SeverityID
-		hasChanges = true
 		changes = append(changes, "severity")
 	}
 
-	// Update assets if provided
+	// Update assets if provided and changed
 	if req.AssetIDs != nil {
 		assetIDs := *req.AssetIDs
 
-		// Check if asset IDs have changed
 		assetIDsChanged := false
 		if len(assetIDs) != len(incident.AssetIDs) {
 			assetIDsChanged = true
@@ -517,37 +588,11 @@ func (u *Incident) UpdateIncident(ctx context.Context, incidentID types.Incident
 
 		if assetIDsChanged {
 			incident.AssetIDs = assetIDs
-			hasChanges = true
 			changes = append(changes, "assets")
 		}
 	}
 
-	// Only update if there are changes
-	if !hasChanges {
-		return incident, nil
-	}
-
-	// Save updated incident using PutIncident
-	if err := u.repo.PutIncident(ctx, incident); err != nil {
-		return nil, goerr.Wrap(err, "failed to update incident")
-	}
-
-	// Post update notification to incident channel
-	if incident.ChannelID != "" {
-		// Build a simple notification message
-		message := fmt.Sprintf("📝 Incident details updated: %s", strings.Join(changes, ", "))
-		_, _, err = u.slackClient.PostMessage(
-			ctx,
-			string(incident.ChannelID),
-			slack.MsgOptionText(message, false),
-		)
-		if err != nil {
-			// Log error but don't fail - notification is nice to have but not critical
-			apperr.Handle(ctx, err)
-		}
-	}
-
-	return incident, nil
+	return changes
 }
 
 // GetRecentOpenIncidents gets recent open incidents grouped by date
@@ -741,12 +786,15 @@ func (u *Incident) SyncIncidentMemberWithEvent(ctx context.Context, incidentID t
 	}
 
 	// Update incident with new member list (complete replacement)
+	before := *incident
 	incident.JoinedMemberIDs = joinedMemberIDs
 
 	// Save to repository
 	if err := u.repo.PutIncident(ctx, incident); err != nil {
 		return goerr.Wrap(err, "failed to update incident members")
 	}
+	u.audit.Record(ctx, types.AuditActionIncidentMemberChange, audit.IncidentTarget(incidentID), eventUserID, &before, incident)
+	u.config.events.PublishIncident(ctx, "", incident, eventUserID.String())
 
 	ctxlog.From(ctx).Info("Synced incident members",
 		"incidentID", incidentID,
@@ -757,50 +805,56 @@ func (u *Incident) SyncIncidentMemberWithEvent(ctx context.Context, incidentID t
 	return nil
 }
 
-// CanUserAccessIncident checks if a user can access full incident information
-func (u *Incident) CanUserAccessIncident(ctx context.Context, incident *model.Incident, slackUserID types.SlackUserID) bool {
-	// Public incidents are accessible by everyone
-	if !incident.Private {
-		return true
+// FilterIncidentForUser filters incident information based on user access rights
+func (u *Incident) FilterIncidentForUser(ctx context.Context, incident *model.Incident, slackUserID types.SlackUserID) *model.Incident {
+	// If user can access, return as-is
+	if u.CanUserAccessIncident(ctx, incident, slackUserID) {
+		return incident
 	}
 
-	// Check if user is in joined members list
-	for _, joinedID := range incident.JoinedMemberIDs {
-		if joinedID == slackUserID {
-			return true
-		}
+	return incident.Redacted()
+}
+
+// ViewIncident filters incident like FilterIncidentForUser for a user opening its
+// details. Viewing the details of a private incident is audited like a change;
+// incidents merely listed, searched or pushed to subscribers are not.
+func (u *Incident) ViewIncident(ctx context.Context, incident *model.Incident, slackUserID types.SlackUserID) *model.Incident {
+	if !u.CanUserAccessIncident(ctx, incident, slackUserID) {
+		return incident.Redacted()
 	}
 
-	return false
+	if incident.Private {
+		u.audit.Record(ctx, types.AuditActionIncidentPrivateView, audit.IncidentTarget(incident.ID), slackUserID, nil, nil)
+	}
+	return incident
 }
 
-// FilterIncidentForUser filters incident information based on user access rights
-func (u *Incident) FilterIncidentForUser(ctx context.Context, incident *model.Incident, slackUserID types.SlackUserID) *model.Incident {
-	// If user can access, return as-is
-	if u.CanUserAccessIncident(ctx, incident, slackUserID) {
-		return incident
+// syncChannelWithTitle renames the incident channel and updates its purpose after
+// the title changed. Failures are not fatal; the channel keeps its current name.
+func (u *Incident) syncChannelWithTitle(ctx context.Context, incident *model.Incident) {
+	if !u.config.renameChannels || incident.ChannelID == "" || incident.ChannelArchived {
+		return
 	}
 
-	// Create filtered copy
-	filtered := &model.Incident{
-		ID:          incident.ID,
-		Title:       "Private Incident",  // Hide title
-		Description: "",                  // Hide description
-		CategoryID:  incident.CategoryID, // Keep category for display
-		SeverityID:  incident.SeverityID, // Keep severity
-		Status:      incident.Status,     // Keep status
-		CreatedAt:   incident.CreatedAt,  // Keep created at
-		Private:     true,
-		// Hide other sensitive fields
-		AssetIDs:          nil,
-		ChannelID:         "",
-		ChannelName:       "",
-		OriginChannelID:   "",
-		OriginChannelName: "",
-		TeamID:            "",
-		CreatedBy:         "",
-		Lead:              "",
-	}
-
-	return filtered
+	if name := incident.ChannelNameForTitle(u.config.channelPrefix); name != incident.ChannelName {
+		if _, err := u.slackClient.RenameConversationContext(ctx, incident.ChannelID.String(), name.String()); err != nil {
+			apperr.Handle(ctx, goerr.Wrap(err, "failed to rename incident channel",
+				goerr.V("incidentID", incident.ID),
+				goerr.V("name", name)))
+		} else {
+			ctxlog.From(ctx).Info("Renamed incident channel",
+				"incidentID", incident.ID,
+				"from", incident.ChannelName,
+				"to", name,
+			)
+			incident.ChannelName = name
+		}
+	}
+
+	if incident.Title != "" {
+		if _, err := u.slackClient.SetPurposeOfConversationContext(ctx, incident.ChannelID.String(), incident.Title); err != nil {
+			apperr.Handle(ctx, goerr.Wrap(err, "failed to update incident channel purpose",
+				goerr.V("incidentID", incident.ID)))
+		}
+	}
 }
@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/service/audit"
+	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
+	"github.com/slack-go/slack"
+)
+
+// groupMembersCacheTTL limits how often members of granted user groups are fetched
+const groupMembersCacheTTL = 5 * time.Minute
+
+// groupMembersCache caches Slack user group members for access checks
+type groupMembersCache struct {
+	mu      sync.Mutex
+	entries map[string]groupMembersEntry
+}
+
+type groupMembersEntry struct {
+	members   []string
+	fetchedAt time.Time
+}
+
+// CanUserAccessIncident checks if a user can access full incident information.
+// Private incidents are accessible by channel members and by users with an
+// active access grant, either directly or through a Slack user group.
+func (u *Incident) CanUserAccessIncident(ctx context.Context, incident *model.Incident, slackUserID types.SlackUserID) bool {
+	// Public incidents are accessible by everyone
+	if !incident.Private {
+		return true
+	}
+	if slackUserID == "" {
+		return false
+	}
+
+	// Check if user is in joined members list
+	if slices.Contains(incident.JoinedMemberIDs, slackUserID) {
+		return true
+	}
+
+	grants := incident.ActiveAccessGrants(time.Now())
+	for _, grant := range grants {
+		if grant.UserID == slackUserID {
+			return true
+		}
+	}
+	for _, grant := range grants {
+		if grant.GroupID == "" {
+			continue
+		}
+		members, err := u.userGroupMembers(ctx, grant.GroupID)
+		if err != nil {
+			apperr.Handle(ctx, goerr.Wrap(err, "failed to check user group access grant",
+				goerr.V("incidentID", incident.ID), goerr.V("groupID", grant.GroupID)))
+			continue
+		}
+		if slices.Contains(members, slackUserID.String()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GrantIncidentAccess grants a user or user group access to a private incident
+func (u *Incident) GrantIncidentAccess(ctx context.Context, incidentID types.IncidentID, req interfaces.GrantIncidentAccessRequest) (*model.AccessGrant, error) {
+	incident, err := u.repo.GetIncident(ctx, incidentID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get incident", goerr.V("incidentID", incidentID))
+	}
+	if !incident.Private {
+		return nil, goerr.New("access grants only apply to private incidents", goerr.V("incidentID", incidentID))
+	}
+
+	// Only users who can see the incident may share it, whatever their role
+	if !u.canGrantAccess(ctx, incident, req.GrantedBy) {
+		return nil, goerr.Wrap(model.ErrPermissionDenied, "only users with access to the incident can grant access",
+			goerr.V("incidentID", incidentID), goerr.V("grantedBy", req.GrantedBy))
+	}
+
+	groupID := req.GroupID
+	if strings.HasPrefix(groupID, "@") {
+		if groupID, err = u.resolveUserGroupHandle(ctx, groupID); err != nil {
+			return nil, err
+		}
+	}
+
+	grant, err := model.NewAccessGrant(req.UserID, groupID, req.Reason, req.TTL, req.GrantedBy)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to create access grant", goerr.V("incidentID", incidentID))
+	}
+
+	err = u.repo.UpdateIncidentAtomic(ctx, incidentID, func(current *model.Incident) error {
+		current.AddAccessGrant(*grant)
+		*incident = *current
+		return nil
+	})
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to save access grant", goerr.V("incidentID", incidentID))
+	}
+	u.audit.Record(ctx, types.AuditActionIncidentAccessGrant, audit.IncidentTarget(incidentID), req.GrantedBy, nil, grant)
+	u.config.events.PublishIncident(ctx, "", incident, "")
+
+	ctxlog.From(ctx).Info("Private incident access granted",
+		"incidentID", incidentID,
+		"grantID", grant.ID,
+		"userID", grant.UserID,
+		"groupID", grant.GroupID,
+		"expiresAt", grant.ExpiresAt,
+		"grantedBy", grant.GrantedBy,
+	)
+
+	// Let channel members know who else can see the incident
+	if incident.ChannelID != "" {
+		u.postAccessGrantNotice(ctx, incident, grant)
+	}
+
+	return grant, nil
+}
+
+// canGrantAccess checks if the user leads, created or can already access the incident
+func (u *Incident) canGrantAccess(ctx context.Context, incident *model.Incident, userID types.SlackUserID) bool {
+	if userID == "" {
+		return false
+	}
+	if incident.Lead == userID || incident.CreatedBy == userID {
+		return true
+	}
+	return u.CanUserAccessIncident(ctx, incident, userID)
+}
+
+// postAccessGrantNotice tells the incident channel who else can see the incident
+func (u *Incident) postAccessGrantNotice(ctx context.Context, incident *model.Incident, grant *model.AccessGrant) {
+	grantee := fmt.Sprintf("<@%s>", grant.UserID)
+	if grant.GroupID != "" {
+		grantee = fmt.Sprintf("<!subteam^%s>", grant.GroupID)
+	}
+	notice := fmt.Sprintf("🔓 %s was granted access to this incident", grantee)
+	if grant.GrantedBy != "" {
+		notice += fmt.Sprintf(" by <@%s>", grant.GrantedBy)
+	}
+	if _, _, err := u.slackClient.PostMessage(ctx, incident.ChannelID.String(), slack.MsgOptionText(notice, false)); err != nil {
+		apperr.Handle(ctx, goerr.Wrap(err, "failed to post access grant notice", goerr.V("incidentID", incident.ID)))
+	}
+}
+
+// RevokeIncidentAccess removes an access grant from a private incident
+func (u *Incident) RevokeIncidentAccess(ctx context.Context, incidentID types.IncidentID, grantID types.AccessGrantID) error {
+	var (
+		incident model.Incident
+		grant    *model.AccessGrant
+	)
+	err := u.repo.UpdateIncidentAtomic(ctx, incidentID, func(current *model.Incident) error {
+		removed, err := current.RemoveAccessGrant(grantID)
+		if err != nil {
+			return err
+		}
+		grant, incident = removed, *current
+		return nil
+	})
+	if err != nil {
+		return goerr.Wrap(err, "failed to revoke access grant", goerr.V("incidentID", incidentID))
+	}
+	u.audit.Record(ctx, types.AuditActionIncidentAccessRevoke, audit.IncidentTarget(incidentID), "", grant, nil)
+	u.config.events.PublishIncident(ctx, "", &incident, "")
+
+	ctxlog.From(ctx).Info("Private incident access revoked",
+		"incidentID", incidentID,
+		"grantID", grantID,
+	)
+	return nil
+}
+
+// RequestIncidentAccess asks the incident lead, or the creator if there is no
+// lead, to grant the requester access from the incident channel
+func (u *Incident) RequestIncidentAccess(ctx context.Context, incidentID types.IncidentID, requesterID types.SlackUserID, reason string) error {
+	if requesterID == "" {
+		return goerr.New("requester is required", goerr.V("incidentID", incidentID))
+	}
+
+	incident, err := u.repo.GetIncident(ctx, incidentID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to get incident", goerr.V("incidentID", incidentID))
+	}
+	if u.CanUserAccessIncident(ctx, incident, requesterID) {
+		return goerr.New("user already has access to the incident",
+			goerr.V("incidentID", incidentID), goerr.V("requesterID", requesterID))
+	}
+
+	approverID := incident.AccessApprover()
+
+	if err := u.slackSvc.PostAccessRequestMessage(ctx, incident.ChannelID, incident, requesterID, approverID, reason); err != nil {
+		return goerr.Wrap(err, "failed to request incident access", goerr.V("incidentID", incidentID))
+	}
+	u.audit.Record(ctx, types.AuditActionIncidentAccessRequest, audit.IncidentTarget(incidentID), requesterID, nil, nil)
+
+	ctxlog.From(ctx).Info("Private incident access requested",
+		"incidentID", incidentID,
+		"requesterID", requesterID,
+		"approverID", approverID,
+	)
+	return nil
+}
+
+// userGroupMembers returns the members of a Slack user group, cached for groupMembersCacheTTL
+func (u *Incident) userGroupMembers(ctx context.Context, groupID string) ([]string, error) {
+	u.groups.mu.Lock()
+	entry, cached := u.groups.entries[groupID]
+	u.groups.mu.Unlock()
+
+	if cached && time.Since(entry.fetchedAt) < groupMembersCacheTTL {
+		return entry.members, nil
+	}
+
+	// The lock is not held during the Slack call so access checks of other groups do not wait on it
+	members, err := u.slackClient.GetUserGroupMembersContext(ctx, groupID)
+	if err != nil {
+		if cached {
+			ctxlog.From(ctx).Warn("Failed to refresh user group members, using cached members",
+				"groupID", groupID, "error", err)
+			return entry.members, nil
+		}
+		return nil, goerr.Wrap(err, "failed to get user group members", goerr.V("groupID", groupID))
+	}
+
+	u.groups.mu.Lock()
+	u.groups.entries[groupID] = groupMembersEntry{members: members, fetchedAt: time.Now()}
+	u.groups.mu.Unlock()
+	return members, nil
+}
+
+// resolveUserGroupHandle resolves a user group @handle or @name to its ID
+func (u *Incident) resolveUserGroupHandle(ctx context.Context, handle string) (string, error) {
+	groups, err := u.slackClient.GetUserGroupsContext(ctx)
+	if err != nil {
+		return "", goerr.Wrap(err, "failed to get user groups")
+	}
+
+	name := strings.TrimPrefix(handle, "@")
+	for _, g := range groups {
+		if g.Handle == name || g.Name == name {
+			return g.ID, nil
+		}
+	}
+	return "", goerr.New("user group not found", goerr.V("group", handle))
+}
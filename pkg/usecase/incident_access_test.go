@@ -0,0 +1,174 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces/mocks"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+	"github.com/slack-go/slack"
+)
+
+func TestIncidentAccessGrants(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemory()
+	mockSlack := &mocks.SlackClientMock{
+		PostMessageFunc: func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+			return channelID, "1234.5678", nil
+		},
+		GetUserGroupsContextFunc: func(ctx context.Context) ([]slack.UserGroup, error) {
+			return []slack.UserGroup{{ID: "S-CISO", Handle: "ciso-staff"}}, nil
+		},
+		GetUserGroupMembersContextFunc: func(ctx context.Context, userGroup string) ([]string, error) {
+			if userGroup == "S-CISO" {
+				return []string{"U-CISO"}, nil
+			}
+			return nil, nil
+		},
+	}
+	uc := usecase.NewIncident(repo, mockSlack, slackSvc.NewUIService(mockSlack, testConfig()), testConfig(), nil, usecase.NewIncidentConfig())
+
+	incident := &model.Incident{
+		ID:              types.IncidentID(time.Now().UnixNano()),
+		Title:           "Credential leak",
+		ChannelID:       "C-PRIVATE",
+		CreatedBy:       "U-CREATOR",
+		Lead:            "U-LEAD",
+		Private:         true,
+		JoinedMemberIDs: []types.SlackUserID{"U-LEAD"},
+	}
+	gt.NoError(t, repo.PutIncident(ctx, incident))
+
+	reload := func() *model.Incident {
+		got, err := repo.GetIncident(ctx, incident.ID)
+		gt.NoError(t, err).Required()
+		return got
+	}
+
+	t.Run("user grant gives access until it expires", func(t *testing.T) {
+		gt.False(t, uc.CanUserAccessIncident(ctx, reload(), "U-ANALYST"))
+
+		grant, err := uc.GrantIncidentAccess(ctx, incident.ID, interfaces.GrantIncidentAccessRequest{
+			UserID:    "U-ANALYST",
+			TTL:       time.Hour,
+			GrantedBy: "U-LEAD",
+		})
+		gt.NoError(t, err).Required()
+		gt.True(t, uc.CanUserAccessIncident(ctx, reload(), "U-ANALYST"))
+
+		expired := reload()
+		expired.AccessGrants[0].ExpiresAt = time.Now().Add(-time.Minute)
+		gt.False(t, uc.CanUserAccessIncident(ctx, expired, "U-ANALYST"))
+
+		gt.NoError(t, uc.RevokeIncidentAccess(ctx, incident.ID, grant.ID))
+		gt.False(t, uc.CanUserAccessIncident(ctx, reload(), "U-ANALYST"))
+	})
+
+	t.Run("group grant by handle gives access to group members", func(t *testing.T) {
+		grant, err := uc.GrantIncidentAccess(ctx, incident.ID, interfaces.GrantIncidentAccessRequest{
+			GroupID:   "@ciso-staff",
+			GrantedBy: "U-LEAD",
+		})
+		gt.NoError(t, err).Required()
+		gt.Equal(t, grant.GroupID, "S-CISO")
+
+		gt.True(t, uc.CanUserAccessIncident(ctx, reload(), "U-CISO"))
+		gt.False(t, uc.CanUserAccessIncident(ctx, reload(), "U-OTHER"))
+
+		// Filtered views hide the grants of inaccessible incidents
+		gt.A(t, uc.FilterIncidentForUser(ctx, reload(), "U-OTHER").AccessGrants).Length(0)
+	})
+
+	t.Run("request pings the lead in the incident channel", func(t *testing.T) {
+		calls := len(mockSlack.PostMessageCalls())
+		gt.NoError(t, uc.RequestIncidentAccess(ctx, incident.ID, "U-OTHER", "Need to review IOCs"))
+		gt.A(t, mockSlack.PostMessageCalls()).Length(calls + 1)
+		gt.Equal(t, mockSlack.PostMessageCalls()[calls].ChannelID, "C-PRIVATE")
+
+		// Users with access have nothing to request
+		gt.Error(t, uc.RequestIncidentAccess(ctx, incident.ID, "U-LEAD", ""))
+	})
+
+	t.Run("only users with access can grant access", func(t *testing.T) {
+		for _, grantedBy := range []types.SlackUserID{"U-OUTSIDER", ""} {
+			_, err := uc.GrantIncidentAccess(ctx, incident.ID, interfaces.GrantIncidentAccessRequest{
+				UserID:    grantedBy,
+				GrantedBy: grantedBy,
+			})
+			gt.True(t, errors.Is(err, model.ErrPermissionDenied))
+		}
+		gt.False(t, uc.CanUserAccessIncident(ctx, reload(), "U-OUTSIDER"))
+
+		// The creator may grant access without being a channel member
+		grant, err := uc.GrantIncidentAccess(ctx, incident.ID, interfaces.GrantIncidentAccessRequest{
+			UserID:    "U-AUDITOR",
+			GrantedBy: "U-CREATOR",
+		})
+		gt.NoError(t, err).Required()
+		gt.NoError(t, uc.RevokeIncidentAccess(ctx, incident.ID, grant.ID))
+	})
+
+	t.Run("grants require a private incident", func(t *testing.T) {
+		public := &model.Incident{ID: incident.ID + 1, ChannelID: "C-PUBLIC", CreatedBy: "U-CREATOR"}
+		gt.NoError(t, repo.PutIncident(ctx, public))
+		_, err := uc.GrantIncidentAccess(ctx, public.ID, interfaces.GrantIncidentAccessRequest{UserID: "U-ANALYST"})
+		gt.Error(t, err)
+	})
+}
+
+func TestIncidentViewAudit(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemory()
+	mockSlack := &mocks.SlackClientMock{}
+	uc := usecase.NewIncident(repo, mockSlack, slackSvc.NewUIService(mockSlack, testConfig()), testConfig(), nil, usecase.NewIncidentConfig())
+
+	private := &model.Incident{
+		ID:              types.IncidentID(time.Now().UnixNano()),
+		Title:           "Credential leak",
+		ChannelID:       "C-PRIVATE",
+		Private:         true,
+		JoinedMemberIDs: []types.SlackUserID{"U-LEAD"},
+	}
+	public := &model.Incident{
+		ID:        private.ID + 1,
+		Title:     "Login errors",
+		ChannelID: "C-PUBLIC",
+	}
+
+	listViews := func(incidentID types.IncidentID) []*model.AuditEntry {
+		entries, err := repo.ListAuditEntries(ctx, model.AuditFilter{IncidentID: incidentID, Action: types.AuditActionIncidentPrivateView})
+		gt.NoError(t, err).Required()
+		return entries
+	}
+
+	t.Run("filtering for lists is not audited", func(t *testing.T) {
+		gt.Equal(t, uc.FilterIncidentForUser(ctx, private, "U-LEAD"), private)
+		gt.A(t, listViews(private.ID)).Length(0)
+	})
+
+	t.Run("members opening a private incident are audited", func(t *testing.T) {
+		gt.Equal(t, uc.ViewIncident(ctx, private, "U-LEAD"), private)
+		gt.A(t, listViews(private.ID)).Length(1).At(0, func(t testing.TB, v *model.AuditEntry) {
+			gt.Equal(t, v.ActorID, "U-LEAD")
+		})
+	})
+
+	t.Run("outsiders see a redacted incident without an audit entry", func(t *testing.T) {
+		viewed := uc.ViewIncident(ctx, private, "U-OTHER")
+		gt.Equal(t, viewed.Title, "Private Incident")
+		gt.A(t, listViews(private.ID)).Length(1)
+	})
+
+	t.Run("public incidents are not audited", func(t *testing.T) {
+		gt.Equal(t, uc.ViewIncident(ctx, public, "U-OTHER"), public)
+		gt.A(t, listViews(public.ID)).Length(0)
+	})
+}
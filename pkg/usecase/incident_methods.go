@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"github.com/m-mizutani/ctxlog"
 	"github.com/m-mizutani/goerr/v2"
@@ -92,6 +93,7 @@ func (u *Incident) handleCreateIncidentFromRequest(ctx context.Context, requestI
 			AssetIDs:          details.assetIDs,
 			OriginChannelID:   request.ChannelID.String(),
 			OriginChannelName: channelInfo.Name,
+			OriginMessageTS:   request.MessageTS.String(),
 			CreatedBy:         userID,
 			InitialTriage:     false, // TODO: Get from modal checkbox
 			Private:           details.private,
@@ -146,6 +148,7 @@ func (u *Incident) handleCreateIncidentFromRequest(ctx context.Context, requestI
 			SeverityID:        request.SeverityID,
 			OriginChannelID:   request.ChannelID.String(),
 			OriginChannelName: channelInfo.Name,
+			OriginMessageTS:   request.MessageTS.String(),
 			CreatedBy:         userID,
 		})
 		if err != nil {
@@ -187,6 +190,90 @@ func (u *Incident) handleCreateIncidentFromRequest(ctx context.Context, requestI
 	return incident, nil
 }
 
+// DeclareIncident creates an incident outside of Slack, e.g. from the web UI, and
+// announces it in the origin channel
+func (u *Incident) DeclareIncident(ctx context.Context, req *model.CreateIncidentRequest) (*model.Incident, error) {
+	if req.Title == "" {
+		return nil, goerr.New("incident title is required")
+	}
+	if req.OriginChannelID == "" {
+		return nil, goerr.New("origin channel is required")
+	}
+	if req.CreatedBy == "" {
+		return nil, goerr.New("creator user ID is required")
+	}
+	if req.CategoryID != "" && u.modelConfig != nil && u.modelConfig.FindCategoryByID(req.CategoryID) == nil {
+		return nil, goerr.New("invalid category ID", goerr.V("categoryID", req.CategoryID))
+	}
+
+	// The origin channel must be visible to the bot, as the incident is announced there
+	channelInfo, err := u.slackClient.GetConversationInfo(ctx, req.OriginChannelID, false)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get origin channel", goerr.V("channelID", req.OriginChannelID))
+	}
+
+	// The bot may be in private channels the user is not, which must not be announced to
+	if channelInfo.IsPrivate || channelInfo.IsIM || channelInfo.IsMpIM {
+		member, err := u.isChannelMember(ctx, req.OriginChannelID, types.SlackUserID(req.CreatedBy))
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to check origin channel membership", goerr.V("channelID", req.OriginChannelID))
+		}
+		if !member {
+			return nil, goerr.Wrap(model.ErrPermissionDenied, "user is not a member of the origin channel",
+				goerr.V("channelID", req.OriginChannelID),
+				goerr.V("userID", req.CreatedBy))
+		}
+	}
+
+	createReq := *req
+	createReq.OriginChannelName = channelInfo.Name
+	incident, err := u.CreateIncident(ctx, &createReq)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to create incident")
+	}
+
+	if err := u.slackSvc.PostIncidentCreatedNotification(
+		ctx,
+		types.ChannelID(req.OriginChannelID),
+		"",
+		channelInfo.Name,
+		incident.ChannelID,
+		incident.Title,
+		incident.CategoryID,
+		incident.SeverityID.String(),
+	); err != nil {
+		// Log error but don't fail - the incident was created successfully
+		ctxlog.From(ctx).Warn("Failed to post incident creation notification",
+			"error", err,
+			"channelID", req.OriginChannelID,
+			"incidentID", incident.ID,
+		)
+	}
+
+	return incident, nil
+}
+
+// isChannelMember checks if the user is a member of the channel
+func (u *Incident) isChannelMember(ctx context.Context, channelID string, userID types.SlackUserID) (bool, error) {
+	params := &slack.GetUsersInConversationParameters{
+		ChannelID: channelID,
+		Limit:     1000,
+	}
+	for {
+		members, cursor, err := u.slackClient.GetUsersInConversationContext(ctx, params)
+		if err != nil {
+			return false, goerr.Wrap(err, "failed to get channel members", goerr.V("channelID", channelID))
+		}
+		if slices.Contains(members, userID.String()) {
+			return true, nil
+		}
+		if cursor == "" {
+			return false, nil
+		}
+		params.Cursor = cursor
+	}
+}
+
 // updateOriginalMessageToDeclared updates the bot's prompt message to show incident was declared
 func (u *Incident) updateOriginalMessageToDeclared(ctx context.Context, request *model.IncidentRequest, title string) {
 	// Update the bot's message, not the original user message
@@ -283,10 +370,11 @@ func (u *Incident) HandleEditIncidentAction(ctx context.Context, requestID, user
 	return nil
 }
 
-// HandleCreateIncidentActionAsync handles the create incident button click with async processing and error messaging
-func (u *Incident) HandleCreateIncidentActionAsync(ctx context.Context, requestID, userID, channelID string) {
+// HandleCreateIncidentAction handles the complete flow when a user clicks the create incident button,
+// posting an error message to the channel if the incident cannot be created
+func (u *Incident) HandleCreateIncidentAction(ctx context.Context, requestID, userID, channelID string) {
 	// Process incident creation
-	incident, err := u.HandleCreateIncidentAction(ctx, requestID, userID)
+	incident, err := u.handleCreateIncidentFromRequest(ctx, requestID, userID, nil)
 	if err != nil {
 		ctxlog.From(ctx).Error("Failed to handle incident creation",
 			"error", err,
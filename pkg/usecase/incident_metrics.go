@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+// noneMetricsLabel labels the group of incidents without a value for the dimension
+const noneMetricsLabel = "None"
+
+// GetIncidentMetrics aggregates the response times of incidents created in
+// [from, to) by groupBy. Test incidents are excluded.
+func (u *Incident) GetIncidentMetrics(ctx context.Context, from, to time.Time, groupBy types.IncidentMetricsGroupBy) (*model.IncidentMetrics, error) {
+	if !groupBy.IsValid() {
+		return nil, goerr.New("invalid metrics group", goerr.V("groupBy", groupBy))
+	}
+	if !from.Before(to) {
+		return nil, goerr.New("metrics period must start before it ends", goerr.V("from", from), goerr.V("to", to))
+	}
+
+	incidents, err := u.repo.ListIncidentsSince(ctx, from)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list incidents for metrics")
+	}
+
+	now := time.Now()
+	var all []*model.IncidentDurations
+	grouped := make(map[string][]*model.IncidentDurations)
+	for _, incident := range incidents {
+		if incident.IsTest || !incident.CreatedAt.Before(to) {
+			continue
+		}
+
+		histories, err := u.repo.GetStatusHistories(ctx, incident.ID)
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to get status histories", goerr.V("incidentID", incident.ID))
+		}
+		d := model.NewIncidentDurations(incident, histories, now)
+		all = append(all, d)
+		for _, key := range metricsGroupKeys(incident, groupBy) {
+			grouped[key] = append(grouped[key], d)
+		}
+	}
+
+	// Every month of the period is reported so that trends have no gaps
+	if groupBy == types.IncidentMetricsGroupByMonth {
+		for month := monthStart(from); month.Before(to); month = month.AddDate(0, 1, 0) {
+			key := month.Format("2006-01")
+			if _, ok := grouped[key]; !ok {
+				grouped[key] = nil
+			}
+		}
+	}
+
+	groups := make([]*model.IncidentMetricsGroup, 0, len(grouped))
+	for key, durations := range grouped {
+		groups = append(groups, model.NewIncidentMetricsGroup(key, u.metricsGroupLabel(key, groupBy), durations))
+	}
+	slices.SortFunc(groups, func(a, b *model.IncidentMetricsGroup) int {
+		if groupBy != types.IncidentMetricsGroupByMonth {
+			// Months are listed in order, other groups largest first
+			if c := cmp.Compare(b.IncidentCount, a.IncidentCount); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(a.Key, b.Key)
+	})
+
+	return &model.IncidentMetrics{
+		From:    from,
+		To:      to,
+		GroupBy: groupBy,
+		Total:   model.NewIncidentMetricsGroup("", "Total", all),
+		Groups:  groups,
+	}, nil
+}
+
+// metricsGroupKeys returns the keys of the groups incident belongs to
+func metricsGroupKeys(incident *model.Incident, groupBy types.IncidentMetricsGroupBy) []string {
+	switch groupBy {
+	case types.IncidentMetricsGroupBySeverity:
+		return []string{incident.SeverityID.String()}
+	case types.IncidentMetricsGroupByCategory:
+		return []string{incident.CategoryID}
+	case types.IncidentMetricsGroupByAsset:
+		if len(incident.AssetIDs) == 0 {
+			return []string{""}
+		}
+		keys := make([]string, 0, len(incident.AssetIDs))
+		for _, id := range incident.AssetIDs {
+			keys = append(keys, string(id))
+		}
+		return keys
+	default:
+		return []string{incident.CreatedAt.UTC().Format("2006-01")}
+	}
+}
+
+// metricsGroupLabel returns the display name of a group
+func (u *Incident) metricsGroupLabel(key string, groupBy types.IncidentMetricsGroupBy) string {
+	if key == "" {
+		return noneMetricsLabel
+	}
+
+	switch groupBy {
+	case types.IncidentMetricsGroupBySeverity:
+		if u.modelConfig != nil {
+			if severity := u.modelConfig.FindSeverityByID(key); severity != nil {
+				return severity.Name
+			}
+		}
+	case types.IncidentMetricsGroupByCategory:
+		if u.modelConfig != nil {
+			if category := u.modelConfig.FindCategoryByID(key); category != nil {
+				return category.Name
+			}
+		}
+	case types.IncidentMetricsGroupByAsset:
+		if u.modelConfig != nil {
+			if asset := u.modelConfig.FindAssetByID(types.AssetID(key)); asset != nil {
+				return asset.Name
+			}
+		}
+	case types.IncidentMetricsGroupByMonth:
+		if month, err := time.Parse("2006-01", key); err == nil {
+			return month.Format("Jan 2006")
+		}
+	}
+	return key
+}
+
+// monthStart returns the first moment of the month of t in UTC
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,108 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces/mocks"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+)
+
+func TestIncident_GetIncidentMetrics(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemory()
+	config := testConfig()
+	config.Severities = []model.Severity{
+		{ID: "high", Name: "High", Level: 70},
+		{ID: "low", Name: "Low", Level: 10},
+	}
+	uc := usecase.NewIncident(repo, &mocks.SlackClientMock{}, nil, config, nil, usecase.NewIncidentConfig())
+
+	base := time.Date(2026, 1, 10, 9, 0, 0, 0, time.UTC)
+	put := func(id types.IncidentID, createdAt time.Time, severity types.SeverityID, isTest bool, changes ...*model.StatusHistory) {
+		incident := &model.Incident{
+			ID:         id,
+			Title:      "Incident",
+			CategoryID: "system_failure",
+			SeverityID: severity,
+			Status:     types.IncidentStatusTriage,
+			IsTest:     isTest,
+			CreatedAt:  createdAt,
+		}
+		for _, h := range changes {
+			h.ID = types.NewStatusHistoryID()
+			h.IncidentID = id
+			h.ChangedBy = "U-LEAD"
+			incident.Status = h.Status
+			gt.NoError(t, repo.AddStatusHistory(ctx, h))
+		}
+		gt.NoError(t, repo.PutIncident(ctx, incident))
+	}
+
+	put(1, base, "high", false,
+		&model.StatusHistory{Status: types.IncidentStatusTriage, ChangedAt: base},
+		&model.StatusHistory{Status: types.IncidentStatusHandling, ChangedAt: base.Add(10 * time.Minute)},
+		&model.StatusHistory{Status: types.IncidentStatusClosed, ChangedAt: base.Add(2 * time.Hour)},
+	)
+	march := base.AddDate(0, 2, 0)
+	put(2, march, "high", false,
+		&model.StatusHistory{Status: types.IncidentStatusTriage, ChangedAt: march},
+		&model.StatusHistory{Status: types.IncidentStatusHandling, ChangedAt: march.Add(30 * time.Minute)},
+		&model.StatusHistory{Status: types.IncidentStatusClosed, ChangedAt: march.Add(4 * time.Hour)},
+	)
+	put(3, march, "low", false,
+		&model.StatusHistory{Status: types.IncidentStatusTriage, ChangedAt: march},
+	)
+	// Test incidents and incidents outside of the period are left out
+	put(4, march, "high", true,
+		&model.StatusHistory{Status: types.IncidentStatusTriage, ChangedAt: march},
+		&model.StatusHistory{Status: types.IncidentStatusClosed, ChangedAt: march.Add(time.Minute)},
+	)
+	put(5, base.AddDate(1, 0, 0), "high", false)
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("by month", func(t *testing.T) {
+		metrics, err := uc.GetIncidentMetrics(ctx, from, to, types.IncidentMetricsGroupByMonth)
+		gt.NoError(t, err).Required()
+
+		gt.Equal(t, metrics.Total.IncidentCount, 3)
+		gt.Equal(t, metrics.Total.ClosedCount, 2)
+		gt.Equal(t, metrics.Total.TimeToAcknowledge.Count, 2)
+		gt.Equal(t, metrics.Total.TimeToAcknowledge.Mean, 20*time.Minute)
+		gt.Equal(t, metrics.Total.TimeToClose.Mean, 3*time.Hour)
+
+		// February has no incidents but is still reported
+		gt.A(t, metrics.Groups).Length(3)
+		gt.Equal(t, metrics.Groups[0].Key, "2026-01")
+		gt.Equal(t, metrics.Groups[0].Label, "Jan 2026")
+		gt.Equal(t, metrics.Groups[1].Key, "2026-02")
+		gt.Equal(t, metrics.Groups[1].IncidentCount, 0)
+		gt.Equal(t, metrics.Groups[2].IncidentCount, 2)
+	})
+
+	t.Run("by severity", func(t *testing.T) {
+		metrics, err := uc.GetIncidentMetrics(ctx, from, to, types.IncidentMetricsGroupBySeverity)
+		gt.NoError(t, err).Required()
+
+		gt.A(t, metrics.Groups).Length(2)
+		gt.Equal(t, metrics.Groups[0].Label, "High")
+		gt.Equal(t, metrics.Groups[0].IncidentCount, 2)
+		gt.Equal(t, metrics.Groups[0].TimeToClose.Median, 3*time.Hour)
+		gt.Equal(t, metrics.Groups[1].Label, "Low")
+		gt.Equal(t, metrics.Groups[1].ClosedCount, 0)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		_, err := uc.GetIncidentMetrics(ctx, from, to, "quarter")
+		gt.Error(t, err)
+		_, err = uc.GetIncidentMetrics(ctx, to, from, types.IncidentMetricsGroupByMonth)
+		gt.Error(t, err)
+	})
+}
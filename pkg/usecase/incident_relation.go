@@ -0,0 +1,498 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/service/audit"
+	"github.com/secmon-lab/lycaon/pkg/service/pubsub"
+	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
+)
+
+// relatedIncidentsPageSize is the page size used to list child and duplicate incidents
+const relatedIncidentsPageSize = 100
+
+// IncidentRelation links child incidents to a major incident and merges
+// duplicate incidents into a primary incident
+type IncidentRelation struct {
+	repo     interfaces.Repository
+	slackSvc *slackSvc.UIService
+	statusUC *StatusUseCase
+	audit    *audit.Recorder
+	events   *pubsub.Broker
+}
+
+// IncidentRelationOption configures IncidentRelation
+type IncidentRelationOption func(*IncidentRelation)
+
+// WithIncidentRelationEvents sets the broker that relationship changes are published to
+func WithIncidentRelationEvents(events *pubsub.Broker) IncidentRelationOption {
+	return func(uc *IncidentRelation) {
+		uc.events = events
+	}
+}
+
+// NewIncidentRelation creates a new IncidentRelation instance. statusUC closes
+// merged duplicates.
+func NewIncidentRelation(repo interfaces.Repository, slackSvc *slackSvc.UIService, statusUC *StatusUseCase, opts ...IncidentRelationOption) *IncidentRelation {
+	uc := &IncidentRelation{
+		repo:     repo,
+		slackSvc: slackSvc,
+		statusUC: statusUC,
+		audit:    audit.New(repo),
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
+}
+
+// relationAuditFields are the audited fields of a relationship change
+type relationAuditFields struct {
+	ParentID    types.IncidentID `json:"parentId,omitempty"`
+	DuplicateOf types.IncidentID `json:"duplicateOf,omitempty"`
+}
+
+// ListChildIncidents lists the child incidents of the major incident parentID, newest first
+func (uc *IncidentRelation) ListChildIncidents(ctx context.Context, parentID types.IncidentID) ([]*model.Incident, error) {
+	return listIncidentsByFilter(ctx, uc.repo, model.IncidentFilter{ParentID: parentID})
+}
+
+// ListDuplicateIncidents lists the incidents merged into primaryID, newest first
+func (uc *IncidentRelation) ListDuplicateIncidents(ctx context.Context, primaryID types.IncidentID) ([]*model.Incident, error) {
+	return listIncidentsByFilter(ctx, uc.repo, model.IncidentFilter{DuplicateOf: primaryID})
+}
+
+// LinkParent links the child incident to the major incident parentID. Major
+// incidents are a single level: a child cannot have children of its own.
+func (uc *IncidentRelation) LinkParent(ctx context.Context, childID, parentID types.IncidentID, userID types.SlackUserID) (*model.Incident, error) {
+	if childID == parentID {
+		return nil, goerr.New("an incident cannot be its own parent", goerr.V("incidentID", childID))
+	}
+
+	child, err := uc.repo.GetIncident(ctx, childID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get child incident", goerr.V("incidentID", childID))
+	}
+	parent, err := uc.repo.GetIncident(ctx, parentID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get parent incident", goerr.V("incidentID", parentID))
+	}
+
+	if child.DuplicateOf != 0 || parent.DuplicateOf != 0 {
+		return nil, goerr.New("merged duplicates cannot be linked",
+			goerr.V("childID", childID), goerr.V("parentID", parentID))
+	}
+	if parent.ParentID != 0 {
+		return nil, goerr.New("the parent incident is itself a child incident",
+			goerr.V("parentID", parentID), goerr.V("grandparentID", parent.ParentID))
+	}
+	if child.ParentID == parentID {
+		return child, nil
+	}
+	children, err := listIncidentsByFilter(ctx, uc.repo, model.IncidentFilter{ParentID: childID})
+	if err != nil {
+		return nil, err
+	}
+	if len(children) > 0 {
+		return nil, goerr.New("an incident with child incidents cannot become a child incident",
+			goerr.V("childID", childID), goerr.V("children", len(children)))
+	}
+
+	before := relationAuditFields{ParentID: child.ParentID}
+	err = uc.repo.UpdateIncidentAtomic(ctx, childID, func(current *model.Incident) error {
+		if current.DuplicateOf != 0 {
+			return goerr.New("merged duplicates cannot be linked", goerr.V("childID", childID))
+		}
+		current.ParentID = parentID
+		*child = *current
+		return nil
+	})
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to save incident", goerr.V("incidentID", childID))
+	}
+
+	uc.audit.Record(ctx, types.AuditActionIncidentLink, audit.IncidentTarget(childID), userID,
+		before, relationAuditFields{ParentID: parentID})
+	uc.events.PublishRelation(ctx, types.TimelineEventIncidentLinked, child, userID.String(),
+		fmt.Sprintf("Linked to major incident #%d", parentID))
+	uc.events.PublishRelation(ctx, types.TimelineEventIncidentLinked, parent, userID.String(),
+		fmt.Sprintf("Incident #%d linked as a child incident", childID))
+
+	uc.postNotice(ctx, child, fmt.Sprintf("🔗 This incident is now part of major incident %s, linked by <@%s>.", relatedIncidentRef(parent), userID))
+	uc.postNotice(ctx, parent, fmt.Sprintf("🔗 Incident %s is now a child of this incident, linked by <@%s>.", relatedIncidentRef(child), userID))
+	uc.refreshWelcomeMessages(ctx, child, parent)
+
+	ctxlog.From(ctx).Info("Incident linked to major incident",
+		"incidentID", childID,
+		"parentID", parentID,
+		"linkedBy", userID,
+	)
+	return child, nil
+}
+
+// UnlinkParent removes the child incident from its major incident
+func (uc *IncidentRelation) UnlinkParent(ctx context.Context, childID types.IncidentID, userID types.SlackUserID) (*model.Incident, error) {
+	child, err := uc.repo.GetIncident(ctx, childID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get child incident", goerr.V("incidentID", childID))
+	}
+	if child.ParentID == 0 {
+		return child, nil
+	}
+
+	parentID := child.ParentID
+	err = uc.repo.UpdateIncidentAtomic(ctx, childID, func(current *model.Incident) error {
+		current.ParentID = 0
+		*child = *current
+		return nil
+	})
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to save incident", goerr.V("incidentID", childID))
+	}
+
+	uc.audit.Record(ctx, types.AuditActionIncidentLink, audit.IncidentTarget(childID), userID,
+		relationAuditFields{ParentID: parentID}, relationAuditFields{})
+	uc.events.PublishRelation(ctx, types.TimelineEventIncidentLinked, child, userID.String(),
+		fmt.Sprintf("Unlinked from major incident #%d", parentID))
+
+	updated := []*model.Incident{child}
+	parent, err := uc.repo.GetIncident(ctx, parentID)
+	if err != nil {
+		// The parent may have been deleted, which leaves nothing else to update
+		apperr.Handle(ctx, goerr.Wrap(err, "failed to get parent incident", goerr.V("parentID", parentID)))
+	} else {
+		uc.events.PublishRelation(ctx, types.TimelineEventIncidentLinked, parent, userID.String(),
+			fmt.Sprintf("Incident #%d unlinked", childID))
+		updated = append(updated, parent)
+	}
+	uc.refreshWelcomeMessages(ctx, updated...)
+
+	ctxlog.From(ctx).Info("Incident unlinked from major incident",
+		"incidentID", childID,
+		"parentID", parentID,
+		"unlinkedBy", userID,
+	)
+	return child, nil
+}
+
+// MergeIncident merges the duplicate incident into the primary incident. Tasks
+// are moved and status changes and stakeholder updates are copied to the
+// timeline of the primary incident, child
+// incidents are re-linked, and the duplicate is closed with a pointer to the
+// primary incident in its channel. The primary incident is returned.
+func (uc *IncidentRelation) MergeIncident(ctx context.Context, duplicateID, primaryID types.IncidentID, userID types.SlackUserID) (*model.Incident, error) {
+	if duplicateID == primaryID {
+		return nil, goerr.New("an incident cannot be merged into itself", goerr.V("incidentID", duplicateID))
+	}
+
+	duplicate, err := uc.repo.GetIncident(ctx, duplicateID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get duplicate incident", goerr.V("incidentID", duplicateID))
+	}
+	primary, err := uc.repo.GetIncident(ctx, primaryID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get primary incident", goerr.V("incidentID", primaryID))
+	}
+
+	if duplicate.DuplicateOf != 0 {
+		return nil, goerr.New("the incident is already merged",
+			goerr.V("incidentID", duplicateID), goerr.V("duplicateOf", duplicate.DuplicateOf))
+	}
+	if primary.DuplicateOf != 0 {
+		return nil, goerr.New("the primary incident is itself a merged duplicate",
+			goerr.V("primaryID", primaryID), goerr.V("duplicateOf", primary.DuplicateOf))
+	}
+	if primary.Status == types.IncidentStatusClosed {
+		return nil, goerr.New("cannot merge into a closed incident", goerr.V("primaryID", primaryID))
+	}
+	// Merging copies tasks and updates, which must not leak out of a private incident
+	if duplicate.Private && !primary.Private {
+		return nil, goerr.New("a private incident cannot be merged into a public incident",
+			goerr.V("incidentID", duplicateID), goerr.V("primaryID", primaryID))
+	}
+
+	movedTasks, err := uc.moveTasks(ctx, duplicate, primary)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.copyStatusHistories(ctx, duplicate, primary); err != nil {
+		return nil, err
+	}
+	if err := uc.copyStakeholderUpdates(ctx, duplicate, primary); err != nil {
+		return nil, err
+	}
+	if err := uc.relinkRelated(ctx, duplicate, primary); err != nil {
+		return nil, err
+	}
+
+	// relinkRelated may have detached the primary incident from the duplicate
+	primary, err = uc.repo.GetIncident(ctx, primaryID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get primary incident", goerr.V("incidentID", primaryID))
+	}
+
+	var before relationAuditFields
+	err = uc.repo.UpdateIncidentAtomic(ctx, duplicateID, func(current *model.Incident) error {
+		before = relationAuditFields{ParentID: current.ParentID}
+		current.DuplicateOf = primaryID
+		current.ParentID = 0
+		*duplicate = *current
+		return nil
+	})
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to save duplicate incident", goerr.V("incidentID", duplicateID))
+	}
+
+	uc.audit.Record(ctx, types.AuditActionIncidentMerge, audit.IncidentTarget(duplicateID), userID,
+		before, relationAuditFields{DuplicateOf: primaryID})
+
+	if duplicate.Status != types.IncidentStatusClosed {
+		closed, err := uc.statusUC.CloseIncident(ctx, duplicateID, userID, fmt.Sprintf("Duplicate of incident #%d", primaryID))
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to close duplicate incident", goerr.V("incidentID", duplicateID))
+		}
+		duplicate = closed
+	}
+
+	uc.events.PublishRelation(ctx, types.TimelineEventIncidentMerged, duplicate, userID.String(),
+		fmt.Sprintf("Merged into incident #%d", primaryID))
+	uc.events.PublishRelation(ctx, types.TimelineEventIncidentMerged, primary, userID.String(),
+		fmt.Sprintf("Incident #%d merged as a duplicate (%d tasks moved)", duplicateID, movedTasks))
+
+	uc.postNotice(ctx, duplicate, fmt.Sprintf("🔀 This incident was merged into %s by <@%s> and closed as a duplicate. Please continue the response there.", relatedIncidentRef(primary), userID))
+	uc.postNotice(ctx, primary, fmt.Sprintf("🔀 Incident %s was merged into this incident by <@%s>. Moved tasks: %d", relatedIncidentRef(duplicate), userID, movedTasks))
+	uc.refreshWelcomeMessages(ctx, primary, duplicate)
+
+	ctxlog.From(ctx).Info("Incident merged as duplicate",
+		"incidentID", duplicateID,
+		"primaryID", primaryID,
+		"movedTasks", movedTasks,
+		"mergedBy", userID,
+	)
+	return primary, nil
+}
+
+// moveTasks moves the tasks of duplicate to primary and returns how many were moved
+func (uc *IncidentRelation) moveTasks(ctx context.Context, duplicate, primary *model.Incident) (int, error) {
+	tasks, err := uc.repo.ListTasksByIncident(ctx, duplicate.ID)
+	if err != nil {
+		return 0, goerr.Wrap(err, "failed to list tasks", goerr.V("incidentID", duplicate.ID))
+	}
+
+	for _, task := range tasks {
+		moved := *task
+		moved.IncidentID = primary.ID
+		if err := uc.repo.CreateTask(ctx, &moved); err != nil {
+			return 0, goerr.Wrap(err, "failed to move task",
+				goerr.V("taskID", task.ID), goerr.V("primaryID", primary.ID))
+		}
+		if err := uc.repo.DeleteTask(ctx, duplicate.ID, task.ID); err != nil {
+			return 0, goerr.Wrap(err, "failed to remove moved task",
+				goerr.V("taskID", task.ID), goerr.V("incidentID", duplicate.ID))
+		}
+	}
+	return len(tasks), nil
+}
+
+// copyStatusHistories copies the status changes of duplicate to the timeline of
+// primary, tagged with the incident they came from
+func (uc *IncidentRelation) copyStatusHistories(ctx context.Context, duplicate, primary *model.Incident) error {
+	histories, err := uc.repo.GetStatusHistories(ctx, duplicate.ID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to get status histories", goerr.V("incidentID", duplicate.ID))
+	}
+
+	for _, history := range histories {
+		copied := *history
+		copied.ID = types.NewStatusHistoryID()
+		copied.IncidentID = primary.ID
+		// Entries the duplicate got from its own duplicates keep their original source
+		if copied.SourceIncidentID == 0 {
+			copied.SourceIncidentID = duplicate.ID
+		}
+		if err := uc.repo.AddStatusHistory(ctx, &copied); err != nil {
+			return goerr.Wrap(err, "failed to copy status history",
+				goerr.V("historyID", history.ID), goerr.V("primaryID", primary.ID))
+		}
+	}
+	return nil
+}
+
+// copyStakeholderUpdates copies the stakeholder updates of duplicate to the
+// timeline of primary, marking where they came from
+func (uc *IncidentRelation) copyStakeholderUpdates(ctx context.Context, duplicate, primary *model.Incident) error {
+	updates, err := uc.repo.ListStakeholderUpdates(ctx, duplicate.ID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to list stakeholder updates", goerr.V("incidentID", duplicate.ID))
+	}
+
+	for _, update := range updates {
+		copied := *update
+		copied.ID = types.NewStakeholderUpdateID()
+		copied.IncidentID = primary.ID
+		copied.Text = fmt.Sprintf("From incident #%d: %s", duplicate.ID, update.Text)
+		if err := uc.repo.AddStakeholderUpdate(ctx, &copied); err != nil {
+			return goerr.Wrap(err, "failed to copy stakeholder update",
+				goerr.V("updateID", update.ID), goerr.V("primaryID", primary.ID))
+		}
+	}
+	return nil
+}
+
+// relinkRelated moves the children and earlier duplicates of duplicate to
+// primary. Children go to the major incident of primary if it has one, keeping
+// major incidents a single level.
+func (uc *IncidentRelation) relinkRelated(ctx context.Context, duplicate, primary *model.Incident) error {
+	children, err := listIncidentsByFilter(ctx, uc.repo, model.IncidentFilter{ParentID: duplicate.ID})
+	if err != nil {
+		return err
+	}
+	newParent := primary.ID
+	if primary.ParentID != 0 && primary.ParentID != duplicate.ID {
+		newParent = primary.ParentID
+	}
+	for _, child := range children {
+		parentID := newParent
+		if child.ID == primary.ID {
+			parentID = 0
+		}
+		err := uc.repo.UpdateIncidentAtomic(ctx, child.ID, func(current *model.Incident) error {
+			current.ParentID = parentID
+			return nil
+		})
+		if err != nil {
+			return goerr.Wrap(err, "failed to re-link child incident", goerr.V("incidentID", child.ID))
+		}
+	}
+
+	duplicates, err := listIncidentsByFilter(ctx, uc.repo, model.IncidentFilter{DuplicateOf: duplicate.ID})
+	if err != nil {
+		return err
+	}
+	for _, d := range duplicates {
+		err := uc.repo.UpdateIncidentAtomic(ctx, d.ID, func(current *model.Incident) error {
+			current.DuplicateOf = primary.ID
+			return nil
+		})
+		if err != nil {
+			return goerr.Wrap(err, "failed to re-link duplicate incident", goerr.V("incidentID", d.ID))
+		}
+	}
+	return nil
+}
+
+// postNotice posts text in the channel of incident. Failing to post is not
+// fatal as the relationship is already saved.
+func (uc *IncidentRelation) postNotice(ctx context.Context, incident *model.Incident, text string) {
+	if uc.slackSvc == nil || incident.ChannelID == "" || incident.ChannelArchived {
+		return
+	}
+	if err := uc.slackSvc.PostRelationNotice(ctx, incident.ChannelID, text); err != nil {
+		apperr.Handle(ctx, goerr.Wrap(err, "failed to post relation notice", goerr.V("incidentID", incident.ID)))
+	}
+}
+
+// refreshWelcomeMessages updates the welcome messages of incidents to show their
+// current relationships
+func (uc *IncidentRelation) refreshWelcomeMessages(ctx context.Context, incidents ...*model.Incident) {
+	if uc.slackSvc == nil {
+		return
+	}
+	for _, incident := range incidents {
+		if incident.ChannelID == "" || incident.WelcomeMessageTS == "" || incident.ChannelArchived {
+			continue
+		}
+		relations, err := loadIncidentRelations(ctx, uc.repo, incident)
+		if err != nil {
+			apperr.Handle(ctx, err)
+			continue
+		}
+		if err := uc.slackSvc.UpdateWelcomeMessage(ctx, incident, incidentLeadName(ctx, uc.repo, incident), relations); err != nil {
+			apperr.Handle(ctx, goerr.Wrap(err, "failed to refresh welcome message", goerr.V("incidentID", incident.ID)))
+		}
+	}
+}
+
+// relatedIncidentRef refers to incident in a notice, hiding the title of private incidents
+func relatedIncidentRef(incident *model.Incident) string {
+	if incident.Private {
+		return fmt.Sprintf("#%d (private)", incident.ID)
+	}
+	if incident.ChannelID != "" {
+		return fmt.Sprintf("#%d %s (<#%s>)", incident.ID, incident.Title, incident.ChannelID)
+	}
+	return fmt.Sprintf("#%d %s", incident.ID, incident.Title)
+}
+
+// loadIncidentRelations loads the incidents related to incident. Related
+// incidents that no longer exist are left out.
+func loadIncidentRelations(ctx context.Context, repo interfaces.Repository, incident *model.Incident) (*model.IncidentRelations, error) {
+	relations := &model.IncidentRelations{}
+
+	getRelated := func(id types.IncidentID) (*model.Incident, error) {
+		if id == 0 {
+			return nil, nil
+		}
+		related, err := repo.GetIncident(ctx, id)
+		if err != nil {
+			if errors.Is(err, model.ErrIncidentNotFound) {
+				return nil, nil
+			}
+			return nil, goerr.Wrap(err, "failed to get related incident", goerr.V("incidentID", id))
+		}
+		return related, nil
+	}
+
+	var err error
+	if relations.Parent, err = getRelated(incident.ParentID); err != nil {
+		return nil, err
+	}
+	if relations.DuplicateOf, err = getRelated(incident.DuplicateOf); err != nil {
+		return nil, err
+	}
+	if relations.Children, err = listIncidentsByFilter(ctx, repo, model.IncidentFilter{ParentID: incident.ID}); err != nil {
+		return nil, err
+	}
+	if relations.Duplicates, err = listIncidentsByFilter(ctx, repo, model.IncidentFilter{DuplicateOf: incident.ID}); err != nil {
+		return nil, err
+	}
+	return relations, nil
+}
+
+// listIncidentsByFilter lists every incident matching filter, newest first
+func listIncidentsByFilter(ctx context.Context, repo interfaces.Repository, filter model.IncidentFilter) ([]*model.Incident, error) {
+	var incidents []*model.Incident
+	opts := types.PaginationOptions{Limit: relatedIncidentsPageSize}
+	for {
+		page, result, err := repo.ListIncidentsPaginated(ctx, filter, opts)
+		if err != nil {
+			return nil, goerr.Wrap(err, "failed to list incidents")
+		}
+		incidents = append(incidents, page...)
+		if result == nil || !result.HasNextPage || len(page) == 0 {
+			return incidents, nil
+		}
+		last := page[len(page)-1].ID
+		opts.After = &last
+	}
+}
+
+// incidentLeadName returns the name of the incident lead shown in messages
+func incidentLeadName(ctx context.Context, repo interfaces.Repository, incident *model.Incident) string {
+	if incident.Lead == "" {
+		return "Not assigned"
+	}
+	leadUser, err := repo.GetUserBySlackID(ctx, incident.Lead)
+	if err == nil && leadUser != nil {
+		return leadUser.Name
+	}
+	return string(incident.Lead)
+}
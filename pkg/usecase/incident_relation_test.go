@@ -0,0 +1,157 @@
+package usecase_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/service/slack/slackfake"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+	"github.com/slack-go/slack"
+)
+
+func TestIncidentRelation(t *testing.T) {
+	ctx := context.Background()
+	fake := slackfake.New()
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	client := slackSvc.New("xoxb-test", slackSvc.WithSlackOptions(slack.OptionAPIURL(srv.URL+"/api/")))
+
+	repo := repository.NewMemory()
+	config := testConfig()
+	ui := slackSvc.NewUIService(client, config)
+	statusUC := usecase.NewStatusUseCase(repo, ui, config)
+	uc := usecase.NewIncidentRelation(repo, ui, statusUC)
+
+	newIncident := func(t *testing.T, id types.IncidentID, title string) *model.Incident {
+		ch, err := client.CreateConversation(ctx, slack.CreateConversationParams{ChannelName: strings.ToLower(strings.ReplaceAll(title, " ", "-"))})
+		gt.NoError(t, err).Required()
+		incident := &model.Incident{
+			ID: id, Title: title, ChannelID: types.ChannelID(ch.ID), ChannelName: types.ChannelName(ch.Name),
+			Status: types.IncidentStatusHandling, CreatedAt: time.Now(),
+		}
+		gt.NoError(t, repo.PutIncident(ctx, incident)).Required()
+		return incident
+	}
+	hasMessage := func(channelID types.ChannelID, substr string) bool {
+		for _, msg := range fake.Messages(channelID.String()) {
+			if strings.Contains(msg.Text, substr) {
+				return true
+			}
+		}
+		return false
+	}
+
+	major := newIncident(t, 1, "Region outage")
+	child := newIncident(t, 2, "Checkout errors")
+	grandchild := newIncident(t, 3, "Login errors")
+
+	t.Run("links a child incident to a major incident", func(t *testing.T) {
+		linked, err := uc.LinkParent(ctx, child.ID, major.ID, "U-LEAD")
+		gt.NoError(t, err).Required()
+		gt.Equal(t, linked.ParentID, major.ID)
+
+		children, err := uc.ListChildIncidents(ctx, major.ID)
+		gt.NoError(t, err).Required()
+		gt.A(t, children).Length(1)
+		gt.Equal(t, children[0].ID, child.ID)
+
+		gt.True(t, hasMessage(child.ChannelID, "part of major incident #1 Region outage"))
+		gt.True(t, hasMessage(major.ChannelID, "is now a child of this incident"))
+	})
+
+	t.Run("major incidents are a single level", func(t *testing.T) {
+		_, err := uc.LinkParent(ctx, grandchild.ID, child.ID, "U-LEAD")
+		gt.Error(t, err)
+		_, err = uc.LinkParent(ctx, major.ID, grandchild.ID, "U-LEAD")
+		gt.Error(t, err)
+		_, err = uc.LinkParent(ctx, major.ID, major.ID, "U-LEAD")
+		gt.Error(t, err)
+	})
+
+	t.Run("merges a duplicate into the primary incident", func(t *testing.T) {
+		duplicate := newIncident(t, 4, "Checkout down")
+		task, err := model.NewTask(duplicate.ID, "Roll back deploy", "U-LEAD")
+		gt.NoError(t, err).Required()
+		gt.NoError(t, repo.CreateTask(ctx, task)).Required()
+		gt.NoError(t, repo.AddStakeholderUpdate(ctx, &model.StakeholderUpdate{
+			ID: types.NewStakeholderUpdateID(), IncidentID: duplicate.ID, Text: "Payments are failing", PostedBy: "U-LEAD", PostedAt: time.Now(),
+		})).Required()
+		history, err := model.NewStatusHistory(duplicate.ID, types.IncidentStatusHandling, "U-LEAD", "Rolling back")
+		gt.NoError(t, err).Required()
+		gt.NoError(t, repo.AddStatusHistory(ctx, history)).Required()
+
+		primary, err := uc.MergeIncident(ctx, duplicate.ID, child.ID, "U-LEAD")
+		gt.NoError(t, err).Required()
+		gt.Equal(t, primary.ID, child.ID)
+
+		// Tasks are moved and updates are copied with their origin
+		tasks, err := repo.ListTasksByIncident(ctx, child.ID)
+		gt.NoError(t, err).Required()
+		gt.A(t, tasks).Length(1).Required()
+		gt.Equal(t, tasks[0].Title, "Roll back deploy")
+		left, err := repo.ListTasksByIncident(ctx, duplicate.ID)
+		gt.NoError(t, err).Required()
+		gt.A(t, left).Length(0)
+		updates, err := repo.ListStakeholderUpdates(ctx, child.ID)
+		gt.NoError(t, err).Required()
+		gt.A(t, updates).Length(1).Required()
+		gt.Equal(t, updates[0].Text, "From incident #4: Payments are failing")
+
+		// Status changes are copied to the timeline tagged with their source
+		histories, err := repo.GetStatusHistories(ctx, child.ID)
+		gt.NoError(t, err).Required()
+		gt.A(t, histories).Length(1).Required()
+		gt.Equal(t, histories[0].Note, "Rolling back")
+		gt.Equal(t, histories[0].SourceIncidentID, duplicate.ID)
+
+		merged, err := repo.GetIncident(ctx, duplicate.ID)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, merged.DuplicateOf, child.ID)
+		gt.Equal(t, merged.Status, types.IncidentStatusClosed)
+		gt.Equal(t, merged.Resolution, "Duplicate of incident #2")
+
+		duplicates, err := uc.ListDuplicateIncidents(ctx, child.ID)
+		gt.NoError(t, err).Required()
+		gt.A(t, duplicates).Length(1)
+
+		gt.True(t, hasMessage(duplicate.ChannelID, "merged into #2 Checkout errors"))
+		gt.True(t, hasMessage(child.ChannelID, "Moved tasks: 1"))
+
+		// A merged duplicate cannot be merged again
+		_, err = uc.MergeIncident(ctx, duplicate.ID, major.ID, "U-LEAD")
+		gt.Error(t, err)
+	})
+
+	t.Run("children of a merged incident move to the primary incident", func(t *testing.T) {
+		other := newIncident(t, 5, "Second region outage")
+		_, err := uc.MergeIncident(ctx, major.ID, other.ID, "U-LEAD")
+		gt.NoError(t, err).Required()
+
+		relinked, err := repo.GetIncident(ctx, child.ID)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, relinked.ParentID, other.ID)
+	})
+
+	t.Run("private incidents are not merged into public incidents", func(t *testing.T) {
+		private := newIncident(t, 6, "Credential leak")
+		private.Private = true
+		gt.NoError(t, repo.PutIncident(ctx, private)).Required()
+
+		_, err := uc.MergeIncident(ctx, private.ID, grandchild.ID, "U-LEAD")
+		gt.Error(t, err)
+	})
+
+	t.Run("unlinks a child incident", func(t *testing.T) {
+		unlinked, err := uc.UnlinkParent(ctx, child.ID, "U-LEAD")
+		gt.NoError(t, err).Required()
+		gt.Equal(t, unlinked.ParentID, types.IncidentID(0))
+	})
+}
@@ -2,12 +2,18 @@ package usecase_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/m-mizutani/gt"
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces/mocks"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/service/slack/slackfake"
 	"github.com/secmon-lab/lycaon/pkg/usecase"
 	"github.com/slack-go/slack"
 )
@@ -122,6 +128,9 @@ func TestSyncIncidentMemberWithEvent(t *testing.T) {
 			PutIncidentFunc: func(ctx context.Context, incident *model.Incident) error {
 				return nil
 			},
+			PutAuditEntryFunc: func(ctx context.Context, entry *model.AuditEntry) error {
+				return nil
+			},
 		}
 		slackClient := &mocks.SlackClientMock{
 			GetUsersInConversationContextFunc: func(ctx context.Context, params *slack.GetUsersInConversationParameters) ([]string, string, error) {
@@ -157,6 +166,9 @@ func TestSyncIncidentMemberWithEvent(t *testing.T) {
 			PutIncidentFunc: func(ctx context.Context, incident *model.Incident) error {
 				return nil
 			},
+			PutAuditEntryFunc: func(ctx context.Context, entry *model.AuditEntry) error {
+				return nil
+			},
 		}
 		slackClient := &mocks.SlackClientMock{
 			GetUsersInConversationContextFunc: func(ctx context.Context, params *slack.GetUsersInConversationParameters) ([]string, string, error) {
@@ -180,3 +192,139 @@ func TestSyncIncidentMemberWithEvent(t *testing.T) {
 		gt.A(t, putCall.Incident.JoinedMemberIDs).Length(1)
 	})
 }
+
+func TestIncident_DeclareIncident(t *testing.T) {
+	ctx := context.Background()
+	fake := slackfake.New()
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	client := slackSvc.New("xoxb-test", slackSvc.WithSlackOptions(slack.OptionAPIURL(srv.URL+"/api/")))
+
+	repo := repository.NewMemory()
+	config := testConfig()
+	uc := usecase.NewIncident(repo, client, slackSvc.NewUIService(client, config), config, nil, usecase.NewIncidentConfig())
+
+	t.Run("declares incident from the web UI", func(t *testing.T) {
+		incident, err := uc.DeclareIncident(ctx, &model.CreateIncidentRequest{
+			Title:           "Database outage",
+			CategoryID:      "system_failure",
+			OriginChannelID: slackfake.DefaultChannelID,
+			CreatedBy:       "U-WEB",
+		})
+		gt.NoError(t, err).Required()
+		gt.Equal(t, incident.OriginChannelName, types.ChannelName("general"))
+		gt.Equal(t, incident.CreatedBy, types.SlackUserID("U-WEB"))
+		gt.NotEqual(t, incident.ChannelID, types.ChannelID(""))
+
+		// The origin channel is notified without a thread to reply to
+		messages := fake.Messages(slackfake.DefaultChannelID)
+		gt.A(t, messages).Length(1)
+		gt.Equal(t, messages[0].ThreadTS, "")
+	})
+
+	t.Run("unknown category is rejected", func(t *testing.T) {
+		_, err := uc.DeclareIncident(ctx, &model.CreateIncidentRequest{
+			Title:           "Database outage",
+			CategoryID:      "no_such_category",
+			OriginChannelID: slackfake.DefaultChannelID,
+			CreatedBy:       "U-WEB",
+		})
+		gt.Error(t, err)
+	})
+
+	t.Run("unknown origin channel is rejected", func(t *testing.T) {
+		_, err := uc.DeclareIncident(ctx, &model.CreateIncidentRequest{
+			Title:           "Database outage",
+			CategoryID:      "system_failure",
+			OriginChannelID: "C-MISSING",
+			CreatedBy:       "U-WEB",
+		})
+		gt.Error(t, err)
+	})
+
+	t.Run("private origin channel requires membership", func(t *testing.T) {
+		fake.AddChannel(slackfake.Channel{
+			ID:        "C-SECRET",
+			Name:      "secret-project",
+			IsPrivate: true,
+			Members:   []string{slackfake.DefaultBotUserID, "U-MEMBER"},
+		})
+
+		_, err := uc.DeclareIncident(ctx, &model.CreateIncidentRequest{
+			Title:           "Database outage",
+			CategoryID:      "system_failure",
+			OriginChannelID: "C-SECRET",
+			CreatedBy:       "U-WEB",
+		})
+		gt.True(t, errors.Is(err, model.ErrPermissionDenied))
+		gt.A(t, fake.Messages("C-SECRET")).Length(0)
+
+		incident, err := uc.DeclareIncident(ctx, &model.CreateIncidentRequest{
+			Title:           "Database outage",
+			CategoryID:      "system_failure",
+			OriginChannelID: "C-SECRET",
+			CreatedBy:       "U-MEMBER",
+		})
+		gt.NoError(t, err).Required()
+		gt.Equal(t, incident.OriginChannelName, types.ChannelName("secret-project"))
+		gt.A(t, fake.Messages("C-SECRET")).Length(1)
+	})
+}
+
+func TestIncident_RenameChannelOnTitleChange(t *testing.T) {
+	ctx := context.Background()
+	fake := slackfake.New()
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	client := slackSvc.New("xoxb-test", slackSvc.WithSlackOptions(slack.OptionAPIURL(srv.URL+"/api/")))
+
+	repo := repository.NewMemory()
+	config := testConfig()
+	ui := slackSvc.NewUIService(client, config)
+
+	declare := func(t *testing.T, uc *usecase.Incident, isTest bool) *model.Incident {
+		incident, err := uc.CreateIncident(ctx, &model.CreateIncidentRequest{
+			Title:             "Slow API",
+			CategoryID:        "system_failure",
+			OriginChannelID:   slackfake.DefaultChannelID,
+			OriginChannelName: "general",
+			CreatedBy:         "U-LEAD",
+			IsTest:            isTest,
+		})
+		gt.NoError(t, err).Required()
+		return incident
+	}
+
+	t.Run("renames the channel and updates its purpose", func(t *testing.T) {
+		uc := usecase.NewIncident(repo, client, ui, config, nil, usecase.NewIncidentConfig(usecase.WithChannelRename(true)))
+		incident := declare(t, uc, true)
+		gt.Equal(t, incident.ChannelName, types.ChannelName(fmt.Sprintf("inc-%d-test-slow-api", incident.ID)))
+
+		updated, err := uc.UpdateIncidentDetails(ctx, incident.ID, "Database connection pool exhausted", "", "", "", "U-LEAD")
+		gt.NoError(t, err).Required()
+		want := types.ChannelName(fmt.Sprintf("inc-%d-test-database-connection-pool-exhausted", incident.ID))
+		gt.Equal(t, updated.ChannelName, want)
+
+		ch, ok := fake.Channel(incident.ChannelID.String())
+		gt.True(t, ok)
+		gt.Equal(t, ch.Name, want.String())
+		gt.Equal(t, ch.Purpose, "Database connection pool exhausted")
+
+		saved, err := repo.GetIncident(ctx, incident.ID)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, saved.ChannelName, want)
+	})
+
+	t.Run("keeps the channel name when disabled", func(t *testing.T) {
+		uc := usecase.NewIncident(repo, client, ui, config, nil, usecase.NewIncidentConfig())
+		incident := declare(t, uc, false)
+
+		updated, err := uc.UpdateIncidentDetails(ctx, incident.ID, "Database outage", "", "", "", "U-LEAD")
+		gt.NoError(t, err).Required()
+		gt.Equal(t, updated.ChannelName, incident.ChannelName)
+
+		ch, ok := fake.Channel(incident.ChannelID.String())
+		gt.True(t, ok)
+		gt.Equal(t, ch.Name, incident.ChannelName.String())
+	})
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
+)
+
+// changeNotifier notifies status and severity changes of incidents outside the
+// incident channel, so that people who reported a problem learn how it goes
+// without joining the incident channel
+type changeNotifier struct {
+	slackSvc *slackSvc.UIService
+	config   *model.Config
+}
+
+// notificationTarget is a channel, and optionally a thread in it, notified of changes
+type notificationTarget struct {
+	channelID types.ChannelID
+	threadTS  types.MessageTS
+}
+
+// notify posts the change from before to after. Nothing is posted unless the
+// status or severity changed. Failures are handled here as the change itself
+// has been saved.
+func (n *changeNotifier) notify(ctx context.Context, before, after *model.Incident, changedBy types.SlackUserID) {
+	if n.slackSvc == nil || (before.Status == after.Status && before.SeverityID == after.SeverityID) {
+		return
+	}
+
+	for _, target := range n.targets(before, after) {
+		if err := n.slackSvc.PostIncidentChangeNotification(ctx, target.channelID, target.threadTS, before, after, changedBy); err != nil {
+			apperr.Handle(ctx, goerr.Wrap(err, "failed to notify incident change",
+				goerr.V("incidentID", after.ID),
+				goerr.V("channelID", target.channelID)))
+		}
+	}
+}
+
+// targets returns where a change is notified. Private incidents are never
+// notified, and test incidents only in the thread they were declared from.
+// Severity changes are broadcast to the channels of both severities.
+func (n *changeNotifier) targets(before, after *model.Incident) []notificationTarget {
+	if n.config == nil || n.config.Notifications == nil || after.Private {
+		return nil
+	}
+	cfg := n.config.Notifications
+
+	var targets []notificationTarget
+	if cfg.OriginThread && after.OriginChannelID != "" && after.OriginMessageTS != "" {
+		targets = append(targets, notificationTarget{channelID: after.OriginChannelID, threadTS: after.OriginMessageTS})
+	}
+	if after.IsTest {
+		return targets
+	}
+
+	for _, ch := range cfg.BroadcastChannelsFor(before.SeverityID, after.SeverityID) {
+		if ch == after.ChannelID {
+			continue
+		}
+		targets = append(targets, notificationTarget{channelID: ch})
+	}
+	return targets
+}
@@ -0,0 +1,158 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces/mocks"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+	"github.com/slack-go/slack"
+)
+
+// notificationRecorder records the channels and threads messages are posted to
+type notificationRecorder struct {
+	mu    sync.Mutex
+	posts []string
+}
+
+func (r *notificationRecorder) client() *mocks.SlackClientMock {
+	return &mocks.SlackClientMock{
+		PostMessageFunc: func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+			_, values, err := slack.UnsafeApplyMsgOptions("", channelID, "", options...)
+			if err != nil {
+				return "", "", err
+			}
+			post := channelID
+			if ts := values.Get("thread_ts"); ts != "" {
+				post += "/" + ts
+			}
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.posts = append(r.posts, post)
+			return channelID, "1234.5678", nil
+		},
+	}
+}
+
+func (r *notificationRecorder) take() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	posts := r.posts
+	r.posts = nil
+	slices.Sort(posts)
+	return posts
+}
+
+func notificationConfig() *model.Config {
+	cfg := testConfig()
+	cfg.Severities = []model.Severity{
+		{ID: "critical", Name: "Critical", Level: 90},
+		{ID: "low", Name: "Low", Level: 20},
+	}
+	cfg.Notifications = &model.NotificationsConfig{
+		OriginThread: true,
+		BroadcastChannels: map[string][]types.ChannelID{
+			"critical": {"C-EXEC", "C-SECURITY"},
+			"low":      {"C-SECURITY"},
+		},
+	}
+	return cfg
+}
+
+func TestStatusUseCase_UpdateStatus_Notifications(t *testing.T) {
+	ctx := context.Background()
+
+	newIncident := func(id types.IncidentID) *model.Incident {
+		return &model.Incident{
+			ID: id, Title: "Login outage", ChannelID: "C-INCIDENT", SeverityID: "critical",
+			OriginChannelID: "C-ORIGIN", OriginMessageTS: "1111.2222",
+			Status: types.IncidentStatusHandling, CreatedAt: time.Now(),
+		}
+	}
+
+	t.Run("notifies the origin thread and broadcast channels", func(t *testing.T) {
+		repo := repository.NewMemory()
+		recorder := &notificationRecorder{}
+		cfg := notificationConfig()
+		statusUC := usecase.NewStatusUseCase(repo, slackSvc.NewUIService(recorder.client(), cfg), cfg)
+		gt.NoError(t, repo.PutIncident(ctx, newIncident(1))).Required()
+
+		gt.NoError(t, statusUC.UpdateStatus(ctx, 1, types.IncidentStatusMonitoring, "U-LEAD", "Fix deployed"))
+		gt.A(t, recorder.take()).Equal([]string{"C-EXEC", "C-ORIGIN/1111.2222", "C-SECURITY"})
+	})
+
+	t.Run("private incidents are not notified", func(t *testing.T) {
+		repo := repository.NewMemory()
+		recorder := &notificationRecorder{}
+		cfg := notificationConfig()
+		statusUC := usecase.NewStatusUseCase(repo, slackSvc.NewUIService(recorder.client(), cfg), cfg)
+		incident := newIncident(1)
+		incident.Private = true
+		gt.NoError(t, repo.PutIncident(ctx, incident)).Required()
+
+		gt.NoError(t, statusUC.UpdateStatus(ctx, 1, types.IncidentStatusMonitoring, "U-LEAD", ""))
+		gt.A(t, recorder.take()).Length(0)
+	})
+
+	t.Run("test incidents are only notified in the origin thread", func(t *testing.T) {
+		repo := repository.NewMemory()
+		recorder := &notificationRecorder{}
+		cfg := notificationConfig()
+		statusUC := usecase.NewStatusUseCase(repo, slackSvc.NewUIService(recorder.client(), cfg), cfg)
+		incident := newIncident(1)
+		incident.IsTest = true
+		gt.NoError(t, repo.PutIncident(ctx, incident)).Required()
+
+		gt.NoError(t, statusUC.UpdateStatus(ctx, 1, types.IncidentStatusMonitoring, "U-LEAD", ""))
+		gt.A(t, recorder.take()).Equal([]string{"C-ORIGIN/1111.2222"})
+	})
+
+	t.Run("nothing is notified without configuration", func(t *testing.T) {
+		repo := repository.NewMemory()
+		recorder := &notificationRecorder{}
+		cfg := testConfig()
+		statusUC := usecase.NewStatusUseCase(repo, slackSvc.NewUIService(recorder.client(), cfg), cfg)
+		gt.NoError(t, repo.PutIncident(ctx, newIncident(1))).Required()
+
+		gt.NoError(t, statusUC.UpdateStatus(ctx, 1, types.IncidentStatusMonitoring, "U-LEAD", ""))
+		gt.A(t, recorder.take()).Length(0)
+	})
+}
+
+func TestIncident_UpdateIncident_SeverityNotifications(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemory()
+	recorder := &notificationRecorder{}
+	cfg := notificationConfig()
+	client := recorder.client()
+	uc := usecase.NewIncident(repo, client, slackSvc.NewUIService(client, cfg), cfg, nil, usecase.NewIncidentConfig())
+
+	// Declared outside of Slack, so there is no origin thread to reply in
+	gt.NoError(t, repo.PutIncident(ctx, &model.Incident{
+		ID: 1, Title: "Login outage", ChannelID: "C-INCIDENT", SeverityID: "low",
+		OriginChannelID: "C-ORIGIN", Status: types.IncidentStatusHandling,
+	})).Required()
+
+	t.Run("severity changes are broadcast to the channels of both severities", func(t *testing.T) {
+		severity := types.SeverityID("critical")
+		_, err := uc.UpdateIncident(ctx, 1, model.UpdateIncidentRequest{SeverityID: &severity}, "U-LEAD")
+		gt.NoError(t, err)
+		// The incident channel is also told about the update
+		gt.A(t, recorder.take()).Equal([]string{"C-EXEC", "C-INCIDENT", "C-SECURITY"})
+	})
+
+	t.Run("other changes are not notified", func(t *testing.T) {
+		title := "Login outage in EU"
+		_, err := uc.UpdateIncident(ctx, 1, model.UpdateIncidentRequest{Title: &title}, "U-LEAD")
+		gt.NoError(t, err)
+		gt.A(t, recorder.take()).Equal([]string{"C-INCIDENT"})
+	})
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/m-mizutani/gollem"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+)
+
+const (
+	// DefaultReadinessTTL is how long a dependency check result is reused
+	DefaultReadinessTTL = 15 * time.Second
+	// DefaultReadinessTimeout bounds a single dependency check
+	DefaultReadinessTimeout = 5 * time.Second
+)
+
+// Dependency names reported by readiness checks
+const (
+	DependencyRepository = "repository"
+	DependencySlack      = "slack"
+	DependencyLLM        = "llm"
+	DependencyConfig     = "config"
+)
+
+// dependencyCheck checks whether a dependency can serve requests. A nil check
+// means the optional dependency is not configured and is reported as skipped.
+type dependencyCheck struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+// Readiness checks whether the dependencies of the server are usable. Results
+// are cached so that frequent probes from load balancers do not hit Slack or
+// the repository on every request.
+type Readiness struct {
+	checks  []dependencyCheck
+	ttl     time.Duration
+	timeout time.Duration
+
+	mu      sync.Mutex
+	results map[string]*model.DependencyStatus
+}
+
+// ReadinessOption configures optional Readiness settings
+type ReadinessOption func(*Readiness)
+
+// WithReadinessTTL sets how long check results are reused
+func WithReadinessTTL(ttl time.Duration) ReadinessOption {
+	return func(r *Readiness) {
+		r.ttl = ttl
+	}
+}
+
+// WithReadinessTimeout sets the timeout of a single dependency check
+func WithReadinessTimeout(timeout time.Duration) ReadinessOption {
+	return func(r *Readiness) {
+		r.timeout = timeout
+	}
+}
+
+// NewReadiness creates a Readiness checking the repository, the Slack token,
+// the LLM client and the configuration. The LLM client is optional; without
+// one its check is skipped.
+func NewReadiness(repo interfaces.Repository, slackClient interfaces.SlackClient, llmClient gollem.LLMClient, config *model.Config, opts ...ReadinessOption) *Readiness {
+	var llmCheck func(ctx context.Context) error
+	if llmClient != nil {
+		llmCheck = func(ctx context.Context) error {
+			// Opening a session does not call the model, so probes cost nothing
+			if _, err := llmClient.NewSession(ctx); err != nil {
+				return goerr.Wrap(err, "failed to open LLM session")
+			}
+			return nil
+		}
+	}
+
+	r := &Readiness{
+		ttl:     DefaultReadinessTTL,
+		timeout: DefaultReadinessTimeout,
+		results: make(map[string]*model.DependencyStatus),
+		checks: []dependencyCheck{
+			{name: DependencyRepository, check: func(ctx context.Context) error {
+				// Any cheap read proves connectivity and credentials
+				if _, err := repo.ListJobsByStatus(ctx, types.JobStatusPending, 1); err != nil {
+					return goerr.Wrap(err, "failed to read from repository")
+				}
+				return nil
+			}},
+			{name: DependencySlack, check: func(ctx context.Context) error {
+				// Fails when the token has been revoked or the app uninstalled
+				if _, err := slackClient.AuthTestContext(ctx); err != nil {
+					return goerr.Wrap(err, "Slack auth.test failed")
+				}
+				return nil
+			}},
+			{name: DependencyLLM, check: llmCheck},
+			{name: DependencyConfig, check: func(ctx context.Context) error {
+				if config == nil {
+					return goerr.New("configuration is not loaded")
+				}
+				// Validate a copy since validation rebuilds lookup tables shared with readers
+				copied := *config
+				if err := copied.Validate(); err != nil {
+					return goerr.Wrap(err, "invalid configuration")
+				}
+				return nil
+			}},
+		},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Check returns the readiness of all dependencies. Expired results are
+// refreshed concurrently; the server is ready only if every dependency is.
+func (r *Readiness) Check(ctx context.Context) *model.ReadinessReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	var wg sync.WaitGroup
+	fresh := make([]*model.DependencyStatus, len(r.checks))
+	for i, c := range r.checks {
+		if cached, ok := r.results[c.name]; ok && now.Sub(cached.CheckedAt) < r.ttl {
+			fresh[i] = cached
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fresh[i] = r.run(ctx, c)
+		}()
+	}
+	wg.Wait()
+
+	report := &model.ReadinessReport{Ready: true, Dependencies: fresh}
+	for _, status := range fresh {
+		r.results[status.Name] = status
+		if !status.OK {
+			report.Ready = false
+		}
+	}
+	return report
+}
+
+// run runs a single check within the timeout. The check is not cancelled with
+// the probe request since its result is cached for other probes.
+func (r *Readiness) run(ctx context.Context, c dependencyCheck) *model.DependencyStatus {
+	started := time.Now()
+	if c.check == nil {
+		return &model.DependencyStatus{Name: c.name, OK: true, Skipped: true, CheckedAt: started}
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), r.timeout)
+	defer cancel()
+
+	err := c.check(ctx)
+	status := &model.DependencyStatus{
+		Name:      c.name,
+		OK:        err == nil,
+		Latency:   time.Since(started),
+		CheckedAt: started,
+	}
+	if err != nil {
+		status.Error = err.Error()
+	}
+	return status
+}
@@ -0,0 +1,114 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gollem"
+	"github.com/m-mizutani/gollem/mock"
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces/mocks"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+	"github.com/slack-go/slack"
+)
+
+func TestReadiness(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemory()
+	llmClient := &mock.LLMClientMock{
+		NewSessionFunc: func(ctx context.Context, options ...gollem.SessionOption) (gollem.Session, error) {
+			return &mock.SessionMock{}, nil
+		},
+	}
+
+	var authCalls atomic.Int32
+	var authErr error
+	slackClient := &mocks.SlackClientMock{
+		AuthTestContextFunc: func(ctx context.Context) (*slack.AuthTestResponse, error) {
+			authCalls.Add(1)
+			return &slack.AuthTestResponse{UserID: "U-BOT"}, authErr
+		},
+	}
+
+	statusOf := func(report *model.ReadinessReport, name string) *model.DependencyStatus {
+		for _, dep := range report.Dependencies {
+			if dep.Name == name {
+				return dep
+			}
+		}
+		t.Fatalf("dependency %s not reported", name)
+		return nil
+	}
+
+	t.Run("ready when all dependencies are usable", func(t *testing.T) {
+		r := usecase.NewReadiness(repo, slackClient, llmClient, testConfig())
+		report := r.Check(ctx)
+		gt.True(t, report.Ready)
+		gt.A(t, report.Dependencies).Length(4)
+		for _, name := range []string{usecase.DependencyRepository, usecase.DependencySlack, usecase.DependencyLLM, usecase.DependencyConfig} {
+			gt.True(t, statusOf(report, name).OK)
+		}
+	})
+
+	t.Run("revoked Slack token makes the server not ready", func(t *testing.T) {
+		authErr = errors.New("token_revoked")
+		defer func() { authErr = nil }()
+
+		r := usecase.NewReadiness(repo, slackClient, llmClient, testConfig())
+		report := r.Check(ctx)
+		gt.False(t, report.Ready)
+		slackStatus := statusOf(report, usecase.DependencySlack)
+		gt.False(t, slackStatus.OK)
+		gt.S(t, slackStatus.Error).Contains("token_revoked")
+		gt.True(t, statusOf(report, usecase.DependencyRepository).OK)
+	})
+
+	t.Run("invalid config is reported", func(t *testing.T) {
+		r := usecase.NewReadiness(repo, slackClient, llmClient, &model.Config{})
+		report := r.Check(ctx)
+		gt.False(t, report.Ready)
+		gt.False(t, statusOf(report, usecase.DependencyConfig).OK)
+	})
+
+	t.Run("missing LLM client is skipped", func(t *testing.T) {
+		r := usecase.NewReadiness(repo, slackClient, nil, testConfig())
+		report := r.Check(ctx)
+		gt.True(t, report.Ready)
+		llmStatus := statusOf(report, usecase.DependencyLLM)
+		gt.True(t, llmStatus.OK)
+		gt.True(t, llmStatus.Skipped)
+	})
+
+	t.Run("results are cached within the TTL", func(t *testing.T) {
+		r := usecase.NewReadiness(repo, slackClient, llmClient, testConfig(), usecase.WithReadinessTTL(time.Hour))
+		before := authCalls.Load()
+		r.Check(ctx)
+		r.Check(ctx)
+		gt.Equal(t, before+1, authCalls.Load())
+
+		expired := usecase.NewReadiness(repo, slackClient, llmClient, testConfig(), usecase.WithReadinessTTL(time.Nanosecond))
+		before = authCalls.Load()
+		expired.Check(ctx)
+		time.Sleep(time.Millisecond)
+		expired.Check(ctx)
+		gt.Equal(t, before+2, authCalls.Load())
+	})
+
+	t.Run("slow dependencies time out", func(t *testing.T) {
+		slow := &mocks.SlackClientMock{
+			AuthTestContextFunc: func(ctx context.Context) (*slack.AuthTestResponse, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+		}
+		r := usecase.NewReadiness(repo, slow, llmClient, testConfig(), usecase.WithReadinessTimeout(10*time.Millisecond))
+		report := r.Check(ctx)
+		gt.False(t, report.Ready)
+		gt.False(t, statusOf(report, usecase.DependencySlack).OK)
+	})
+}
@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
+)
+
+// Reminder posts reminders in the channels of open incidents that look stale,
+// and prompts leads for stakeholder updates
+type Reminder struct {
+	repo     interfaces.Repository
+	slackSvc *slackSvc.UIService
+	config   *model.RemindersConfig
+	updates  *model.StakeholderUpdatesConfig
+}
+
+// NewReminder creates a new Reminder instance. Reminders and prompts of sections
+// omitted from config are never posted.
+func NewReminder(repo interfaces.Repository, slackSvc *slackSvc.UIService, config *model.Config) *Reminder {
+	r := &Reminder{
+		repo:     repo,
+		slackSvc: slackSvc,
+		config:   config.Reminders,
+		updates:  config.StakeholderUpdates,
+	}
+	if r.config == nil {
+		r.config = &model.RemindersConfig{}
+	}
+	if r.updates == nil {
+		r.updates = &model.StakeholderUpdatesConfig{}
+	}
+	return r
+}
+
+// Run scans open incidents every configured interval until ctx is cancelled
+func (r *Reminder) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.config.ScanInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Scan(ctx, time.Now()); err != nil {
+				apperr.Handle(ctx, err)
+			}
+		}
+	}
+}
+
+// Scan posts every reminder that is due at now. Test and closed incidents are skipped.
+func (r *Reminder) Scan(ctx context.Context, now time.Time) error {
+	incidents, err := r.repo.ListIncidents(ctx)
+	if err != nil {
+		return goerr.Wrap(err, "failed to list incidents for reminders")
+	}
+
+	for _, incident := range incidents {
+		if incident.IsTest || incident.Status == types.IncidentStatusClosed || incident.ChannelID == "" {
+			continue
+		}
+		if err := r.scanIncident(ctx, incident, now); err != nil {
+			apperr.Handle(ctx, goerr.Wrap(err, "failed to scan incident for reminders",
+				goerr.V("incidentID", incident.ID)))
+		}
+	}
+
+	return nil
+}
+
+// scanIncident posts the reminders of a single incident that are due at now
+func (r *Reminder) scanIncident(ctx context.Context, incident *model.Incident, now time.Time) error {
+	// The last status change; incidents without history count from creation
+	lastStatusAt := incident.CreatedAt
+	histories, err := r.repo.GetStatusHistories(ctx, incident.ID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to get status histories")
+	}
+	for _, h := range histories {
+		if h.SourceIncidentID == 0 && h.ChangedAt.After(lastStatusAt) {
+			lastStatusAt = h.ChangedAt
+		}
+	}
+
+	switch incident.Status {
+	case types.IncidentStatusTriage, types.IncidentStatusHandling:
+		threshold := r.config.StatusUpdateThreshold(incident.SeverityID)
+		if err := r.remind(ctx, incident, model.ReminderKindStatusUpdate, nil, lastStatusAt, threshold, now); err != nil {
+			return err
+		}
+	case types.IncidentStatusMonitoring:
+		if err := r.remind(ctx, incident, model.ReminderKindMonitoring, nil, lastStatusAt, r.config.Monitoring, now); err != nil {
+			return err
+		}
+	}
+
+	if incident.Status == types.IncidentStatusHandling {
+		if err := r.promptStakeholderUpdate(ctx, incident, lastStatusAt, now); err != nil {
+			return err
+		}
+	}
+
+	if incident.Lead == "" {
+		if err := r.remind(ctx, incident, model.ReminderKindNoLead, nil, incident.CreatedAt, r.config.NoLead, now); err != nil {
+			return err
+		}
+	}
+
+	if r.config.StaleTask <= 0 {
+		return nil
+	}
+	tasks, err := r.repo.ListTasksByIncident(ctx, incident.ID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to list tasks")
+	}
+	for _, task := range tasks {
+		stale := task.Status == model.TaskStatusFollowUp ||
+			(task.Status == model.TaskStatusTodo && task.AssigneeID != "")
+		if !stale {
+			continue
+		}
+		if err := r.remind(ctx, incident, model.ReminderKindStaleTask, task, task.UpdatedAt, r.config.StaleTask, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// promptStakeholderUpdate prompts for a stakeholder update when there was none
+// since the later of the last update and the last status change
+func (r *Reminder) promptStakeholderUpdate(ctx context.Context, incident *model.Incident, lastStatusAt, now time.Time) error {
+	threshold := r.updates.PromptInterval(incident.SeverityID)
+	if threshold <= 0 {
+		return nil
+	}
+
+	updates, err := r.repo.ListStakeholderUpdates(ctx, incident.ID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to list stakeholder updates")
+	}
+	since := lastStatusAt
+	if len(updates) > 0 && updates[len(updates)-1].PostedAt.After(since) {
+		since = updates[len(updates)-1].PostedAt
+	}
+
+	return r.remind(ctx, incident, model.ReminderKindStakeholderUpdate, nil, since, threshold, now)
+}
+
+// remind posts a reminder of kind if its condition has held since since for threshold
+func (r *Reminder) remind(ctx context.Context, incident *model.Incident, kind model.ReminderKind, task *model.Task, since time.Time, threshold time.Duration, now time.Time) error {
+	if threshold <= 0 || now.Sub(since) < threshold {
+		return nil
+	}
+
+	var taskID types.TaskID
+	if task != nil {
+		taskID = task.ID
+	}
+	reminder, err := r.repo.GetReminder(ctx, model.ReminderKey(incident.ID, kind, taskID))
+	if errors.Is(err, model.ErrReminderNotFound) {
+		reminder = model.NewReminder(incident.ID, kind, taskID)
+	} else if err != nil {
+		return goerr.Wrap(err, "failed to get reminder")
+	}
+
+	if !reminder.IsDue(since, threshold, now) {
+		return nil
+	}
+
+	// Another replica scanning at the same time may be about to post the same reminder
+	first, err := r.repo.MarkEventProcessed(ctx, "reminder:"+reminder.ID, r.config.ScanInterval())
+	if err != nil {
+		return goerr.Wrap(err, "failed to mark reminder as posted", goerr.V("reminderID", reminder.ID))
+	}
+	if !first {
+		return nil
+	}
+
+	if err := r.slackSvc.PostReminderMessage(ctx, incident, reminder, task, now.Sub(since)); err != nil {
+		return goerr.Wrap(err, "failed to post reminder", goerr.V("reminderID", reminder.ID))
+	}
+
+	reminder.LastRemindedAt = now
+	if err := r.repo.PutReminder(ctx, reminder); err != nil {
+		return goerr.Wrap(err, "failed to save reminder", goerr.V("reminderID", reminder.ID))
+	}
+
+	ctxlog.From(ctx).Info("Reminder posted",
+		"incidentID", incident.ID,
+		"kind", kind,
+		"taskID", taskID,
+	)
+	return nil
+}
+
+// Snooze suppresses the reminder for d
+func (r *Reminder) Snooze(ctx context.Context, reminderID string, d time.Duration, userID types.SlackUserID) (*model.Reminder, error) {
+	if d <= 0 {
+		return nil, goerr.New("snooze duration must be positive", goerr.V("duration", d))
+	}
+
+	reminder, err := r.repo.GetReminder(ctx, reminderID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get reminder", goerr.V("reminderID", reminderID))
+	}
+
+	reminder.SnoozedUntil = time.Now().Add(d)
+	reminder.SnoozedBy = userID
+	if err := r.repo.PutReminder(ctx, reminder); err != nil {
+		return nil, goerr.Wrap(err, "failed to save reminder", goerr.V("reminderID", reminderID))
+	}
+
+	return reminder, nil
+}
@@ -0,0 +1,143 @@
+package usecase_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces/mocks"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+	"github.com/slack-go/slack"
+)
+
+func TestReminderScan(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemory()
+
+	var mu sync.Mutex
+	posted := map[string]int{}
+	mockSlack := &mocks.SlackClientMock{
+		PostMessageFunc: func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			posted[channelID]++
+			return channelID, "1234.5678", nil
+		},
+	}
+	countPosts := func() map[string]int {
+		mu.Lock()
+		defer mu.Unlock()
+		result := posted
+		posted = map[string]int{}
+		return result
+	}
+
+	cfg := &model.RemindersConfig{
+		// Keep the cross-replica deduplication window negligible so scans at
+		// simulated times are not suppressed by wall clock TTLs
+		Interval:               time.Nanosecond,
+		StatusUpdate:           4 * time.Hour,
+		StatusUpdateBySeverity: map[string]time.Duration{"critical": time.Hour},
+		NoLead:                 30 * time.Minute,
+		StaleTask:              48 * time.Hour,
+		Monitoring:             72 * time.Hour,
+	}
+	appConfig := testConfig()
+	appConfig.Reminders = cfg
+	uc := usecase.NewReminder(repo, slackSvc.NewUIService(mockSlack, appConfig), appConfig)
+
+	now := time.Now()
+	put := func(incident *model.Incident) {
+		gt.NoError(t, repo.PutIncident(ctx, incident)).Required()
+	}
+
+	// Handling for 2h with a lead: only the critical override is exceeded
+	put(&model.Incident{ID: 1, ChannelID: "C-CRITICAL", Status: types.IncidentStatusHandling, SeverityID: "critical", Lead: "U-LEAD", CreatedAt: now.Add(-2 * time.Hour)})
+	put(&model.Incident{ID: 2, ChannelID: "C-LOW", Status: types.IncidentStatusHandling, SeverityID: "low", Lead: "U-LEAD", CreatedAt: now.Add(-2 * time.Hour)})
+	// No lead for an hour
+	put(&model.Incident{ID: 3, ChannelID: "C-NOLEAD", Status: types.IncidentStatusTriage, SeverityID: "low", CreatedBy: "U-CREATOR", CreatedAt: now.Add(-time.Hour)})
+	// Monitoring for 4 days
+	put(&model.Incident{ID: 4, ChannelID: "C-MONITOR", Status: types.IncidentStatusMonitoring, Lead: "U-LEAD", CreatedAt: now.Add(-10 * 24 * time.Hour)})
+	gt.NoError(t, repo.AddStatusHistory(ctx, &model.StatusHistory{
+		ID: types.NewStatusHistoryID(), IncidentID: 4, Status: types.IncidentStatusMonitoring,
+		ChangedBy: "U-LEAD", ChangedAt: now.Add(-4 * 24 * time.Hour),
+	}))
+	// Closed and test incidents are never reminded
+	put(&model.Incident{ID: 5, ChannelID: "C-CLOSED", Status: types.IncidentStatusClosed, CreatedAt: now.Add(-30 * 24 * time.Hour)})
+	put(&model.Incident{ID: 6, ChannelID: "C-TEST", Status: types.IncidentStatusTriage, IsTest: true, CreatedAt: now.Add(-30 * 24 * time.Hour)})
+
+	// Tasks of a recently updated incident
+	put(&model.Incident{ID: 7, ChannelID: "C-TASKS", Status: types.IncidentStatusHandling, Lead: "U-LEAD", CreatedAt: now.Add(-time.Minute)})
+	for _, task := range []*model.Task{
+		{ID: "t-follow-up", Title: "Rotate keys", Status: model.TaskStatusFollowUp},
+		{ID: "t-assigned", Title: "Review logs", Status: model.TaskStatusTodo, AssigneeID: "U-ANALYST"},
+		{ID: "t-unassigned", Title: "Write report", Status: model.TaskStatusTodo},
+		{ID: "t-completed", Title: "Block IP", Status: model.TaskStatusCompleted, AssigneeID: "U-ANALYST"},
+		{ID: "t-recent", Title: "Notify users", Status: model.TaskStatusFollowUp, UpdatedAt: now.Add(-time.Hour)},
+	} {
+		task.IncidentID = 7
+		task.CreatedBy = "U-LEAD"
+		if task.UpdatedAt.IsZero() {
+			task.UpdatedAt = now.Add(-3 * 24 * time.Hour)
+		}
+		gt.NoError(t, repo.CreateTask(ctx, task)).Required()
+	}
+
+	t.Run("posts due reminders", func(t *testing.T) {
+		gt.NoError(t, uc.Scan(ctx, now))
+		gt.Equal(t, countPosts(), map[string]int{
+			"C-CRITICAL": 1,
+			"C-NOLEAD":   1,
+			"C-MONITOR":  1,
+			"C-TASKS":    2,
+		})
+	})
+
+	t.Run("does not repeat reminders within the threshold", func(t *testing.T) {
+		gt.NoError(t, uc.Scan(ctx, now.Add(10*time.Minute)))
+		gt.Equal(t, len(countPosts()), 0)
+	})
+
+	t.Run("repeats reminders after the threshold", func(t *testing.T) {
+		gt.NoError(t, uc.Scan(ctx, now.Add(61*time.Minute)))
+		got := countPosts()
+		gt.Equal(t, got["C-CRITICAL"], 1)
+		gt.Equal(t, got["C-NOLEAD"], 1)
+		gt.Equal(t, got["C-MONITOR"], 0)
+	})
+
+	t.Run("snoozed reminders are not posted", func(t *testing.T) {
+		reminder, err := uc.Snooze(ctx, model.ReminderKey(1, model.ReminderKindStatusUpdate, ""), 24*time.Hour, "U-LEAD")
+		gt.NoError(t, err).Required()
+		gt.Equal(t, reminder.SnoozedBy, types.SlackUserID("U-LEAD"))
+		gt.True(t, reminder.SnoozedUntil.After(now.Add(23*time.Hour)))
+
+		gt.NoError(t, uc.Scan(ctx, now.Add(2*time.Hour)))
+		got := countPosts()
+		gt.Equal(t, got["C-CRITICAL"], 0)
+		gt.Equal(t, got["C-NOLEAD"], 1)
+	})
+
+	t.Run("status change resets the status update reminder", func(t *testing.T) {
+		gt.NoError(t, repo.AddStatusHistory(ctx, &model.StatusHistory{
+			ID: types.NewStatusHistoryID(), IncidentID: 2, Status: types.IncidentStatusHandling,
+			ChangedBy: "U-LEAD", ChangedAt: now.Add(3 * time.Hour),
+		}))
+		gt.NoError(t, uc.Scan(ctx, now.Add(5*time.Hour)))
+		gt.Equal(t, countPosts()["C-LOW"], 0)
+
+		gt.NoError(t, uc.Scan(ctx, now.Add(7*time.Hour)))
+		gt.Equal(t, countPosts()["C-LOW"], 1)
+	})
+
+	t.Run("snoozing an unknown reminder fails", func(t *testing.T) {
+		_, err := uc.Snooze(ctx, "999:no_lead", time.Hour, "U-LEAD")
+		gt.Error(t, err)
+	})
+}
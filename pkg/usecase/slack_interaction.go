@@ -14,7 +14,6 @@ import (
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
 	slackblocks "github.com/secmon-lab/lycaon/pkg/service/slack"
-	"github.com/secmon-lab/lycaon/pkg/utils/async"
 	"github.com/slack-go/slack"
 )
 
@@ -27,11 +26,38 @@ type SlackInteraction struct {
 	slackClient interfaces.SlackClient
 	slackSvc    *slackblocks.UIService
 	severities  *model.SeveritiesConfig
+	authzUC     interfaces.Authorization
+	reminderUC  interfaces.Reminder
+	updateUC    interfaces.StakeholderUpdate
+}
+
+// SlackInteractionOption configures SlackInteraction
+type SlackInteractionOption func(*SlackInteraction)
+
+// WithAuthorization enforces roles on interactions. Without it every Slack user may run every action.
+func WithAuthorization(authzUC interfaces.Authorization) SlackInteractionOption {
+	return func(s *SlackInteraction) {
+		s.authzUC = authzUC
+	}
+}
+
+// WithReminders enables the snooze buttons of reminders
+func WithReminders(reminderUC interfaces.Reminder) SlackInteractionOption {
+	return func(s *SlackInteraction) {
+		s.reminderUC = reminderUC
+	}
+}
+
+// WithStakeholderUpdates enables the buttons and modal for posting stakeholder updates
+func WithStakeholderUpdates(updateUC interfaces.StakeholderUpdate) SlackInteractionOption {
+	return func(s *SlackInteraction) {
+		s.updateUC = updateUC
+	}
 }
 
 // NewSlackInteraction creates a new SlackInteraction instance
-func NewSlackInteraction(incidentUC interfaces.Incident, taskUC interfaces.Task, statusUC interfaces.StatusUseCase, authUC interfaces.Auth, slackClient interfaces.SlackClient, slackService *slackblocks.UIService, severities *model.SeveritiesConfig) *SlackInteraction {
-	return &SlackInteraction{
+func NewSlackInteraction(incidentUC interfaces.Incident, taskUC interfaces.Task, statusUC interfaces.StatusUseCase, authUC interfaces.Auth, slackClient interfaces.SlackClient, slackService *slackblocks.UIService, severities *model.SeveritiesConfig, opts ...SlackInteractionOption) *SlackInteraction {
+	s := &SlackInteraction{
 		incidentUC:  incidentUC,
 		taskUC:      taskUC,
 		statusUC:    statusUC,
@@ -40,6 +66,10 @@ func NewSlackInteraction(incidentUC interfaces.Incident, taskUC interfaces.Task,
 		slackSvc:    slackService,
 		severities:  severities,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // HandleBlockActions handles block action interactions (buttons)
@@ -85,6 +115,10 @@ func (s *SlackInteraction) handleBlockActions(ctx context.Context, interaction *
 			"type", string(action.Type),
 		)
 
+		if err := s.authorizeBlockAction(ctx, interaction, action); err != nil {
+			return s.handleAuthorizationError(ctx, interaction, interaction.Channel.ID, err)
+		}
+
 		// Handle specific actions based on ActionID
 		switch action.ActionID {
 		case "create_incident":
@@ -99,6 +133,9 @@ func (s *SlackInteraction) handleBlockActions(ctx context.Context, interaction *
 		case "edit_incident_status":
 			return s.handleEditIncidentStatusAction(ctx, interaction, action)
 
+		case slackblocks.StakeholderUpdateActionID:
+			return s.handleStakeholderUpdateAction(ctx, interaction, action)
+
 		case "acknowledge":
 			ctxlog.From(ctx).Info("Acknowledge action triggered")
 			// TODO: Implement acknowledge logic
@@ -108,6 +145,14 @@ func (s *SlackInteraction) handleBlockActions(ctx context.Context, interaction *
 			// TODO: Implement resolve logic
 
 		default:
+			if strings.HasPrefix(action.ActionID, slackblocks.AccessRequestActionPrefix) {
+				return s.handleAccessRequestAction(ctx, interaction, action)
+			}
+
+			if strings.HasPrefix(action.ActionID, slackblocks.ReminderSnoozeActionPrefix) {
+				return s.handleReminderSnoozeAction(ctx, interaction, action)
+			}
+
 			// Check if it's a task action
 			if strings.HasPrefix(action.ActionID, "task_") {
 				return s.handleTaskAction(ctx, interaction, action)
@@ -132,21 +177,14 @@ func (s *SlackInteraction) handleCreateIncidentAction(ctx context.Context, inter
 
 	requestID := action.Value
 
-	// Send immediate acknowledgment to Slack
-	ctxlog.From(ctx).Info("Acknowledging incident creation request")
-
-	// Process incident creation asynchronously with preserved context
-	backgroundCtx := async.NewBackgroundContext(ctx)
-	async.Dispatch(backgroundCtx, func(asyncCtx context.Context) error {
-		// Call the single usecase method that handles everything including error messaging
-		s.incidentUC.HandleCreateIncidentActionAsync(
-			asyncCtx,
-			requestID,
-			interaction.User.ID,
-			interaction.Channel.ID,
-		)
-		return nil
-	})
+	// Call the single usecase method that handles everything including error messaging.
+	// The interaction is already processed by a background job worker.
+	s.incidentUC.HandleCreateIncidentAction(
+		ctx,
+		requestID,
+		interaction.User.ID,
+		interaction.Channel.ID,
+	)
 
 	return nil
 }
@@ -190,6 +228,9 @@ func (s *SlackInteraction) handleShortcut(ctx context.Context, interaction *slac
 	// Handle specific shortcuts based on CallbackID
 	switch interaction.CallbackID {
 	case "create_incident_shortcut":
+		if err := s.authorizeIncidentCreation(ctx, interaction); err != nil {
+			return s.handleAuthorizationError(ctx, interaction, interaction.Channel.ID, err)
+		}
 		ctxlog.From(ctx).Info("Create incident shortcut triggered")
 		// TODO: Open incident creation modal
 
@@ -209,6 +250,10 @@ func (s *SlackInteraction) handleViewSubmission(ctx context.Context, interaction
 		"callbackID", interaction.View.CallbackID,
 	)
 
+	if channelID, err := s.authorizeViewSubmission(ctx, interaction); err != nil {
+		return s.handleAuthorizationError(ctx, interaction, channelID, err)
+	}
+
 	// Handle specific view submissions based on CallbackID
 	switch interaction.View.CallbackID {
 	case "incident_creation_modal", "incident_edit_modal":
@@ -220,6 +265,9 @@ func (s *SlackInteraction) handleViewSubmission(ctx context.Context, interaction
 	case "edit_incident_details_modal":
 		return s.handleEditIncidentDetailsModalSubmission(ctx, interaction)
 
+	case slackblocks.StakeholderUpdateCallbackID:
+		return s.handleStakeholderUpdateSubmission(ctx, interaction)
+
 	default:
 		// Check if it's a task edit modal submission
 		if strings.HasPrefix(interaction.View.CallbackID, "task_edit_submit:") {
@@ -333,38 +381,32 @@ func (s *SlackInteraction) handleIncidentModalSubmission(ctx context.Context, in
 		"isTest", isTestValue,
 	)
 
-	// Process incident creation asynchronously
-	backgroundCtx := async.NewBackgroundContext(ctx)
-	async.Dispatch(backgroundCtx, func(asyncCtx context.Context) error {
-		// Call the incident creation with the edited details
-		incident, err := s.incidentUC.HandleCreateIncidentWithDetailsAndAssets(
-			asyncCtx,
-			requestID,
-			titleValue,
-			descriptionValue,
-			categoryValue,
-			severityValue,
-			assetIDs,
-			privateValue,
-			isTestValue,
-			interaction.User.ID,
+	// Call the incident creation with the edited details
+	// (the interaction is already processed by a background job worker)
+	incident, err := s.incidentUC.HandleCreateIncidentWithDetailsAndAssets(
+		ctx,
+		requestID,
+		titleValue,
+		descriptionValue,
+		categoryValue,
+		severityValue,
+		assetIDs,
+		privateValue,
+		isTestValue,
+		interaction.User.ID,
+	)
+	if err != nil {
+		return goerr.Wrap(err, "failed to create incident from modal",
+			goerr.V("user", interaction.User.ID),
+			goerr.V("requestID", requestID),
 		)
-		if err != nil {
-			ctxlog.From(asyncCtx).Error("Failed to create incident from modal",
-				"error", err,
-				"user", interaction.User.ID,
-				"requestID", requestID,
-			)
-			return goerr.Wrap(err, "failed to create incident from modal")
-		}
+	}
 
-		ctxlog.From(asyncCtx).Info("Incident created successfully from modal",
-			"incidentID", incident.ID,
-			"channelName", incident.ChannelName,
-			"createdBy", interaction.User.ID,
-		)
-		return nil
-	})
+	ctxlog.From(ctx).Info("Incident created successfully from modal",
+		"incidentID", incident.ID,
+		"channelName", incident.ChannelName,
+		"createdBy", interaction.User.ID,
+	)
 
 	return nil
 }
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	slackblocks "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
+	"github.com/slack-go/slack"
+)
+
+// handleAccessRequestAction approves or denies a request to access a private incident
+func (s *SlackInteraction) handleAccessRequestAction(ctx context.Context, interaction *slack.InteractionCallback, action *slack.BlockAction) error {
+	decision, err := slackblocks.ParseAccessRequestAction(action.ActionID, action.Value)
+	if err != nil {
+		return goerr.Wrap(err, "failed to parse access request action")
+	}
+	decidedBy := types.SlackUserID(interaction.User.ID)
+
+	// Only the approver named in the request may decide it
+	approverID := decision.ApproverID
+	if approverID == "" {
+		incident, err := s.incidentUC.GetIncident(ctx, int(decision.IncidentID))
+		if err != nil {
+			return goerr.Wrap(err, "failed to get incident", goerr.V("incidentID", decision.IncidentID))
+		}
+		approverID = incident.AccessApprover()
+	}
+	if decidedBy != approverID {
+		return s.handleAuthorizationError(ctx, interaction, interaction.Channel.ID,
+			goerr.Wrap(model.ErrPermissionDenied, "only the approver named in the request can decide it",
+				goerr.V("incidentID", decision.IncidentID),
+				goerr.V("approverID", approverID),
+				goerr.V("decidedBy", decidedBy)))
+	}
+
+	ctxlog.From(ctx).Info("Access request decided",
+		"incidentID", decision.IncidentID,
+		"requesterID", decision.RequesterID,
+		"decidedBy", decidedBy,
+		"approved", decision.Approved,
+	)
+
+	var grant *model.AccessGrant
+	if decision.Approved {
+		grant, err = s.incidentUC.GrantIncidentAccess(ctx, decision.IncidentID, interfaces.GrantIncidentAccessRequest{
+			UserID:    decision.RequesterID,
+			Reason:    "Access request approved in Slack",
+			TTL:       decision.TTL,
+			GrantedBy: decidedBy,
+		})
+		if err != nil {
+			return goerr.Wrap(err, "failed to grant requested access",
+				goerr.V("incidentID", decision.IncidentID),
+				goerr.V("requesterID", decision.RequesterID))
+		}
+	}
+
+	if err := s.slackSvc.UpdateAccessRequestMessage(ctx, types.ChannelID(interaction.Channel.ID), interaction.Message.Timestamp, decision.RequesterID, decidedBy, grant); err != nil {
+		apperr.Handle(ctx, goerr.Wrap(err, "failed to update access request message"))
+	}
+
+	// Tell the requester the outcome in a direct message
+	text := fmt.Sprintf("🚫 Your request to access incident #%d was denied by <@%s>", decision.IncidentID, decidedBy)
+	if grant != nil {
+		text = fmt.Sprintf("✅ Your request to access incident #%d was approved by <@%s>", decision.IncidentID, decidedBy)
+	}
+	if _, _, err := s.slackClient.PostMessage(ctx, decision.RequesterID.String(), slack.MsgOptionText(text, false)); err != nil {
+		apperr.Handle(ctx, goerr.Wrap(err, "failed to notify access requester",
+			goerr.V("requesterID", decision.RequesterID)))
+	}
+
+	return nil
+}
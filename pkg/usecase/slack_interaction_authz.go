@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	slackblocks "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/slack-go/slack"
+)
+
+// authorizeBlockAction checks the role of the user clicking a button
+func (s *SlackInteraction) authorizeBlockAction(ctx context.Context, interaction *slack.InteractionCallback, action *slack.BlockAction) error {
+	if s.authzUC == nil {
+		return nil
+	}
+	userID := types.SlackUserID(interaction.User.ID)
+
+	switch {
+	case action.ActionID == "create_incident", action.ActionID == "edit_incident":
+		return s.authzUC.AuthorizeIncidentCreation(ctx, userID)
+
+	case action.ActionID == "edit_incident_details", action.ActionID == "edit_incident_status",
+		action.ActionID == slackblocks.StakeholderUpdateActionID:
+		return s.authorizeIncidentUpdateByID(ctx, userID, action.Value)
+
+	case strings.HasPrefix(action.ActionID, slackblocks.AccessRequestActionPrefix):
+		// Value format: {incidentID}:{requesterID}
+		incidentID, _, _ := strings.Cut(action.Value, ":")
+		return s.authorizeIncidentUpdateByID(ctx, userID, incidentID)
+
+	case strings.HasPrefix(action.ActionID, slackblocks.ReminderSnoozeActionPrefix):
+		// Value format: {incidentID}:{kind}[:{taskID}]
+		incidentID, _, _ := strings.Cut(action.Value, ":")
+		return s.authorizeIncidentUpdateByID(ctx, userID, incidentID)
+
+	case strings.HasPrefix(action.ActionID, "task_"), strings.HasPrefix(action.ActionID, "task:"):
+		incident, err := s.incidentUC.GetIncidentByChannelID(ctx, types.ChannelID(interaction.Channel.ID))
+		if err != nil {
+			return goerr.Wrap(err, "failed to get incident by channel", goerr.V("channelID", interaction.Channel.ID))
+		}
+		return s.authzUC.AuthorizeIncidentUpdate(ctx, userID, incident)
+	}
+
+	return nil
+}
+
+// authorizeViewSubmission checks the role of the user submitting a modal. It
+// returns the channel the modal was opened from, if known, for error reporting.
+func (s *SlackInteraction) authorizeViewSubmission(ctx context.Context, interaction *slack.InteractionCallback) (string, error) {
+	if s.authzUC == nil {
+		return "", nil
+	}
+	userID := types.SlackUserID(interaction.User.ID)
+	callbackID := interaction.View.CallbackID
+
+	switch {
+	case callbackID == "incident_creation_modal", callbackID == "incident_edit_modal":
+		return "", s.authzUC.AuthorizeIncidentCreation(ctx, userID)
+
+	case callbackID == "status_change_modal", callbackID == "edit_incident_details_modal":
+		// Both modals carry base64 encoded JSON with incident and channel IDs
+		var metadata EditIncidentDetailsPrivateMetadata
+		raw, err := base64.StdEncoding.DecodeString(interaction.View.PrivateMetadata)
+		if err != nil {
+			return "", goerr.Wrap(err, "failed to decode private metadata")
+		}
+		if err := json.Unmarshal(raw, &metadata); err != nil {
+			return "", goerr.Wrap(err, "failed to unmarshal private metadata")
+		}
+		return metadata.ChannelID, s.authorizeIncidentUpdateByID(ctx, userID, metadata.IncidentID)
+
+	case callbackID == slackblocks.StakeholderUpdateCallbackID:
+		// Private metadata is the incident ID
+		return "", s.authorizeIncidentUpdateByID(ctx, userID, interaction.View.PrivateMetadata)
+
+	case strings.HasPrefix(callbackID, "task_edit_submit:"):
+		// Format: task_edit_submit:{incidentID}:{taskID}
+		parts := strings.Split(strings.TrimPrefix(callbackID, "task_edit_submit:"), ":")
+		return "", s.authorizeIncidentUpdateByID(ctx, userID, parts[0])
+	}
+
+	return "", nil
+}
+
+// authorizeIncidentCreation checks the role of the user running an incident creation shortcut
+func (s *SlackInteraction) authorizeIncidentCreation(ctx context.Context, interaction *slack.InteractionCallback) error {
+	if s.authzUC == nil {
+		return nil
+	}
+	return s.authzUC.AuthorizeIncidentCreation(ctx, types.SlackUserID(interaction.User.ID))
+}
+
+func (s *SlackInteraction) authorizeIncidentUpdateByID(ctx context.Context, userID types.SlackUserID, incidentIDStr string) error {
+	incidentID, err := strconv.Atoi(incidentIDStr)
+	if err != nil {
+		return goerr.Wrap(err, "invalid incident ID", goerr.V("incidentID", incidentIDStr))
+	}
+
+	incident, err := s.incidentUC.GetIncident(ctx, incidentID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to get incident", goerr.V("incidentID", incidentID))
+	}
+
+	return s.authzUC.AuthorizeIncidentUpdate(ctx, userID, incident)
+}
+
+// handleAuthorizationError tells the user that they lack permission. A denial
+// is not retried, so it returns nil; any other error is returned as is.
+func (s *SlackInteraction) handleAuthorizationError(ctx context.Context, interaction *slack.InteractionCallback, channelID string, err error) error {
+	if !errors.Is(err, model.ErrPermissionDenied) {
+		return goerr.Wrap(err, "failed to authorize interaction")
+	}
+
+	ctxlog.From(ctx).Info("Slack interaction denied",
+		"user", interaction.User.ID,
+		"type", interaction.Type,
+		"error", err,
+	)
+
+	text := "You do not have permission to perform this action. Ask an incident lead or an admin for access."
+	var opts []slack.MsgOption
+	if channelID != "" {
+		opts = append(opts, slack.MsgOptionPostEphemeral(interaction.User.ID))
+	} else {
+		// Modals may lack a channel, so fall back to a direct message
+		channelID = interaction.User.ID
+	}
+	opts = append(opts, slack.MsgOptionText(text, false))
+
+	if _, _, err := s.slackClient.PostMessage(ctx, channelID, opts...); err != nil {
+		ctxlog.From(ctx).Warn("Failed to notify user of denied interaction", "error", err, "user", interaction.User.ID)
+	}
+	return nil
+}
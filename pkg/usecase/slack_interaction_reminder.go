@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	slackblocks "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
+	"github.com/slack-go/slack"
+)
+
+// handleReminderSnoozeAction snoozes a reminder and replaces it with a note of who snoozed it
+func (s *SlackInteraction) handleReminderSnoozeAction(ctx context.Context, interaction *slack.InteractionCallback, action *slack.BlockAction) error {
+	if s.reminderUC == nil {
+		ctxlog.From(ctx).Warn("Reminder snooze clicked but reminders are not configured", "actionID", action.ActionID)
+		return nil
+	}
+
+	snooze, err := slackblocks.ParseReminderSnoozeAction(action.ActionID, action.Value)
+	if err != nil {
+		return goerr.Wrap(err, "failed to parse reminder snooze action")
+	}
+	userID := types.SlackUserID(interaction.User.ID)
+
+	reminder, err := s.reminderUC.Snooze(ctx, snooze.ReminderID, snooze.Duration, userID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to snooze reminder", goerr.V("reminderID", snooze.ReminderID))
+	}
+
+	ctxlog.From(ctx).Info("Reminder snoozed",
+		"reminderID", reminder.ID,
+		"incidentID", snooze.IncidentID,
+		"snoozedBy", userID,
+		"until", reminder.SnoozedUntil,
+	)
+
+	if err := s.slackSvc.UpdateReminderMessage(ctx, types.ChannelID(interaction.Channel.ID), interaction.Message.Timestamp, userID, reminder.SnoozedUntil); err != nil {
+		apperr.Handle(ctx, goerr.Wrap(err, "failed to update reminder message"))
+	}
+
+	return nil
+}
@@ -1,14 +1,24 @@
 package usecase_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/m-mizutani/goerr/v2"
 	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces/mocks"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	slackblocks "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+	"github.com/slack-go/slack"
 )
 
 // TestCallbackIDParsing tests the new callback ID parsing logic
@@ -162,3 +172,174 @@ func TestCallbackIDCompatibility(t *testing.T) {
 		})
 	}
 }
+
+func TestSlackInteractionAuthorization(t *testing.T) {
+	ctx := context.Background()
+
+	incident := &model.Incident{ID: 7, ChannelID: "C-INC-7", CreatedBy: "U-LEAD"}
+	payload, err := json.Marshal(slack.InteractionCallback{
+		Type:    slack.InteractionTypeBlockActions,
+		User:    slack.User{ID: "U-VIEWER"},
+		Channel: slack.Channel{GroupConversation: slack.GroupConversation{Conversation: slack.Conversation{ID: "C-INC-7"}}},
+		ActionCallback: slack.ActionCallbacks{
+			BlockActions: []*slack.BlockAction{
+				{ActionID: "edit_incident_status", Value: "7"},
+			},
+		},
+	})
+	gt.NoError(t, err).Required()
+
+	newInteraction := func(authzErr error) (*usecase.SlackInteraction, *mocks.StatusUseCaseMock, *mocks.SlackClientMock) {
+		incidentMock := &mocks.IncidentMock{
+			GetIncidentFunc: func(ctx context.Context, id int) (*model.Incident, error) {
+				gt.Equal(t, 7, id)
+				return incident, nil
+			},
+		}
+		statusMock := &mocks.StatusUseCaseMock{
+			HandleEditStatusActionFunc: func(ctx context.Context, incidentIDStr string, userID types.SlackUserID, triggerID, channelID, messageTS string) error {
+				return nil
+			},
+		}
+		slackMock := &mocks.SlackClientMock{
+			PostMessageFunc: func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+				return channelID, "1234.5678", nil
+			},
+		}
+		authzMock := &mocks.AuthorizationMock{
+			AuthorizeIncidentUpdateFunc: func(ctx context.Context, userID types.SlackUserID, inc *model.Incident) error {
+				gt.Equal(t, types.SlackUserID("U-VIEWER"), userID)
+				gt.Equal(t, incident, inc)
+				return authzErr
+			},
+		}
+		uc := usecase.NewSlackInteraction(incidentMock, &mocks.TaskMock{}, statusMock, &mocks.AuthMock{}, slackMock, nil, nil, usecase.WithAuthorization(authzMock))
+		return uc, statusMock, slackMock
+	}
+
+	t.Run("denied action notifies user and is not retried", func(t *testing.T) {
+		uc, statusMock, slackMock := newInteraction(goerr.Wrap(model.ErrPermissionDenied, "viewer"))
+
+		err := uc.HandleBlockActions(ctx, &interfaces.SlackInteractionData{RawPayload: payload})
+		gt.NoError(t, err)
+		gt.A(t, statusMock.HandleEditStatusActionCalls()).Length(0)
+		gt.A(t, slackMock.PostMessageCalls()).Length(1)
+		gt.Equal(t, "C-INC-7", slackMock.PostMessageCalls()[0].ChannelID)
+	})
+
+	t.Run("allowed action runs", func(t *testing.T) {
+		uc, statusMock, slackMock := newInteraction(nil)
+
+		err := uc.HandleBlockActions(ctx, &interfaces.SlackInteractionData{RawPayload: payload})
+		gt.NoError(t, err)
+		gt.A(t, statusMock.HandleEditStatusActionCalls()).Length(1)
+		gt.A(t, slackMock.PostMessageCalls()).Length(0)
+	})
+}
+
+func TestSlackInteractionAccessRequest(t *testing.T) {
+	ctx := context.Background()
+	incident := &model.Incident{ID: 7, ChannelID: "C-INC-7", CreatedBy: "U-LEAD", Private: true}
+
+	newPayloadFrom := func(userID, actionID, value string) []byte {
+		payload, err := json.Marshal(slack.InteractionCallback{
+			Type:    slack.InteractionTypeBlockActions,
+			User:    slack.User{ID: userID},
+			Channel: slack.Channel{GroupConversation: slack.GroupConversation{Conversation: slack.Conversation{ID: "C-INC-7"}}},
+			Message: slack.Message{Msg: slack.Msg{Timestamp: "1111.2222"}},
+			ActionCallback: slack.ActionCallbacks{
+				BlockActions: []*slack.BlockAction{
+					{ActionID: actionID, Value: value},
+				},
+			},
+		})
+		gt.NoError(t, err).Required()
+		return payload
+	}
+	newPayload := func(actionID string) []byte {
+		return newPayloadFrom("U-LEAD", actionID, "7:U-REQUESTER:U-LEAD")
+	}
+
+	newInteraction := func() (*usecase.SlackInteraction, *mocks.IncidentMock, *mocks.SlackClientMock, *mocks.AuthorizationMock) {
+		incidentMock := &mocks.IncidentMock{
+			GetIncidentFunc: func(ctx context.Context, id int) (*model.Incident, error) {
+				return incident, nil
+			},
+			GrantIncidentAccessFunc: func(ctx context.Context, incidentID types.IncidentID, req interfaces.GrantIncidentAccessRequest) (*model.AccessGrant, error) {
+				return model.NewAccessGrant(req.UserID, req.GroupID, req.Reason, req.TTL, req.GrantedBy)
+			},
+		}
+		slackMock := &mocks.SlackClientMock{
+			PostMessageFunc: func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+				return channelID, "1234.5678", nil
+			},
+			UpdateMessageFunc: func(ctx context.Context, channelID, timestamp string, options ...slack.MsgOption) (string, string, string, error) {
+				return channelID, timestamp, "", nil
+			},
+		}
+		authzMock := &mocks.AuthorizationMock{
+			AuthorizeIncidentUpdateFunc: func(ctx context.Context, userID types.SlackUserID, inc *model.Incident) error {
+				return nil
+			},
+		}
+		uiService := slackblocks.NewUIService(slackMock, &model.Config{})
+		uc := usecase.NewSlackInteraction(incidentMock, &mocks.TaskMock{}, &mocks.StatusUseCaseMock{}, &mocks.AuthMock{}, slackMock, uiService, nil, usecase.WithAuthorization(authzMock))
+		return uc, incidentMock, slackMock, authzMock
+	}
+
+	t.Run("approval grants time-boxed access and notifies the requester", func(t *testing.T) {
+		uc, incidentMock, slackMock, authzMock := newInteraction()
+
+		err := uc.HandleBlockActions(ctx, &interfaces.SlackInteractionData{RawPayload: newPayload("access_request:approve:24")})
+		gt.NoError(t, err)
+
+		gt.A(t, authzMock.AuthorizeIncidentUpdateCalls()).Length(1)
+		gt.A(t, incidentMock.GrantIncidentAccessCalls()).Length(1).At(0, func(t testing.TB, v struct {
+			Ctx        context.Context
+			IncidentID types.IncidentID
+			Req        interfaces.GrantIncidentAccessRequest
+		}) {
+			gt.Equal(t, v.IncidentID, types.IncidentID(7))
+			gt.Equal(t, v.Req.UserID, types.SlackUserID("U-REQUESTER"))
+			gt.Equal(t, v.Req.GrantedBy, types.SlackUserID("U-LEAD"))
+			gt.Equal(t, v.Req.TTL, 24*time.Hour)
+		})
+		gt.A(t, slackMock.UpdateMessageCalls()).Length(1)
+		gt.A(t, slackMock.PostMessageCalls()).Length(1)
+		gt.Equal(t, slackMock.PostMessageCalls()[0].ChannelID, "U-REQUESTER")
+	})
+
+	t.Run("denial does not grant access", func(t *testing.T) {
+		uc, incidentMock, slackMock, _ := newInteraction()
+
+		err := uc.HandleBlockActions(ctx, &interfaces.SlackInteractionData{RawPayload: newPayload("access_request:deny")})
+		gt.NoError(t, err)
+		gt.A(t, incidentMock.GrantIncidentAccessCalls()).Length(0)
+		gt.A(t, slackMock.PostMessageCalls()).Length(1)
+	})
+
+	t.Run("only the named approver can approve", func(t *testing.T) {
+		uc, incidentMock, slackMock, _ := newInteraction()
+
+		err := uc.HandleBlockActions(ctx, &interfaces.SlackInteractionData{RawPayload: newPayloadFrom("U-PARTICIPANT", "access_request:approve:24", "7:U-REQUESTER:U-LEAD")})
+		gt.NoError(t, err)
+		gt.A(t, incidentMock.GrantIncidentAccessCalls()).Length(0)
+		gt.A(t, slackMock.UpdateMessageCalls()).Length(0)
+
+		// The participant is told that they cannot decide the request
+		gt.A(t, slackMock.PostMessageCalls()).Length(1)
+		gt.Equal(t, slackMock.PostMessageCalls()[0].ChannelID, "C-INC-7")
+	})
+
+	t.Run("requests without a named approver are decided by the lead or creator", func(t *testing.T) {
+		uc, incidentMock, _, _ := newInteraction()
+
+		err := uc.HandleBlockActions(ctx, &interfaces.SlackInteractionData{RawPayload: newPayloadFrom("U-PARTICIPANT", "access_request:approve:24", "7:U-REQUESTER")})
+		gt.NoError(t, err)
+		gt.A(t, incidentMock.GrantIncidentAccessCalls()).Length(0)
+
+		err = uc.HandleBlockActions(ctx, &interfaces.SlackInteractionData{RawPayload: newPayloadFrom("U-LEAD", "access_request:approve:24", "7:U-REQUESTER")})
+		gt.NoError(t, err)
+		gt.A(t, incidentMock.GrantIncidentAccessCalls()).Length(1)
+	})
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	slackblocks "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/slack-go/slack"
+)
+
+// handleStakeholderUpdateAction opens the stakeholder update modal
+func (s *SlackInteraction) handleStakeholderUpdateAction(ctx context.Context, interaction *slack.InteractionCallback, action *slack.BlockAction) error {
+	if s.updateUC == nil {
+		ctxlog.From(ctx).Warn("Stakeholder update clicked but updates are not enabled", "actionID", action.ActionID)
+		return nil
+	}
+
+	incidentID, err := strconv.Atoi(action.Value)
+	if err != nil {
+		return goerr.Wrap(err, "invalid incident ID in stakeholder update action", goerr.V("value", action.Value))
+	}
+
+	if err := s.updateUC.OpenStakeholderUpdateModal(ctx, types.IncidentID(incidentID), interaction.TriggerID); err != nil {
+		return goerr.Wrap(err, "failed to open stakeholder update modal", goerr.V("incidentID", incidentID))
+	}
+	return nil
+}
+
+// handleStakeholderUpdateSubmission posts the update written in the stakeholder update modal
+func (s *SlackInteraction) handleStakeholderUpdateSubmission(ctx context.Context, interaction *slack.InteractionCallback) error {
+	if s.updateUC == nil {
+		return nil
+	}
+
+	incidentID, err := strconv.Atoi(interaction.View.PrivateMetadata)
+	if err != nil {
+		return goerr.Wrap(err, "invalid incident ID in stakeholder update modal",
+			goerr.V("privateMetadata", interaction.View.PrivateMetadata))
+	}
+
+	text := slackblocks.StakeholderUpdateText(interaction.View)
+	if _, err := s.updateUC.PostStakeholderUpdate(ctx, types.IncidentID(incidentID), text, types.SlackUserID(interaction.User.ID)); err != nil {
+		return goerr.Wrap(err, "failed to post stakeholder update", goerr.V("incidentID", incidentID))
+	}
+	return nil
+}
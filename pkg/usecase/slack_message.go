@@ -13,6 +13,7 @@ import (
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
 	llmSvc "github.com/secmon-lab/lycaon/pkg/service/llm"
+	"github.com/secmon-lab/lycaon/pkg/service/pubsub"
 	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
 	"github.com/slack-go/slack"
 	"github.com/slack-go/slack/slackevents"
@@ -36,6 +37,17 @@ type SlackMessage struct {
 	messageHistory *slackSvc.MessageHistoryService
 	llmService     *llmSvc.LLMService
 	modelConfig    *model.Config
+	events         *pubsub.Broker
+}
+
+// SlackMessageOption configures SlackMessage
+type SlackMessageOption func(*SlackMessage)
+
+// WithMessageEvents sets the broker that saved messages are published to
+func WithMessageEvents(events *pubsub.Broker) SlackMessageOption {
+	return func(s *SlackMessage) {
+		s.events = events
+	}
 }
 
 // NewSlackMessage creates a new SlackMessage use case
@@ -47,6 +59,7 @@ func NewSlackMessage(
 	slackClient interfaces.SlackClient,
 	slackService *slackSvc.UIService,
 	modelConfig *model.Config,
+	opts ...SlackMessageOption,
 ) (*SlackMessage, error) {
 	// Validate required parameters
 	if repo == nil {
@@ -74,6 +87,9 @@ func NewSlackMessage(
 		llmService:     llmSvc.NewLLMService(gollemClient),
 		modelConfig:    modelConfig,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
 
 	// Get bot user ID from Slack API
 	authResp, err := slackClient.AuthTestContext(ctx)
@@ -102,6 +118,7 @@ func (s *SlackMessage) ProcessMessage(ctx context.Context, event *slackevents.Me
 	if err := s.repo.SaveMessage(ctx, message); err != nil {
 		return goerr.Wrap(err, "failed to save message")
 	}
+	s.events.PublishMessage(ctx, message)
 
 	ctxlog.From(ctx).Info("Message processed",
 		"messageID", message.ID,
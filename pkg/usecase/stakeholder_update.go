@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/service/audit"
+	"github.com/secmon-lab/lycaon/pkg/service/pubsub"
+	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
+)
+
+// StakeholderUpdate posts updates written for people outside the incident response
+type StakeholderUpdate struct {
+	repo     interfaces.Repository
+	slackSvc *slackSvc.UIService
+	config   *model.Config
+	audit    *audit.Recorder
+	events   *pubsub.Broker
+}
+
+// StakeholderUpdateOption configures StakeholderUpdate
+type StakeholderUpdateOption func(*StakeholderUpdate)
+
+// WithStakeholderUpdateEvents sets the broker that posted updates are published to
+func WithStakeholderUpdateEvents(events *pubsub.Broker) StakeholderUpdateOption {
+	return func(uc *StakeholderUpdate) {
+		uc.events = events
+	}
+}
+
+// NewStakeholderUpdate creates a new StakeholderUpdate instance
+func NewStakeholderUpdate(repo interfaces.Repository, slackSvc *slackSvc.UIService, config *model.Config, opts ...StakeholderUpdateOption) *StakeholderUpdate {
+	uc := &StakeholderUpdate{
+		repo:     repo,
+		slackSvc: slackSvc,
+		config:   config,
+		audit:    audit.New(repo),
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+	return uc
+}
+
+// OpenStakeholderUpdateModal opens the modal for writing an update, prefilled with the previous one
+func (uc *StakeholderUpdate) OpenStakeholderUpdateModal(ctx context.Context, incidentID types.IncidentID, triggerID string) error {
+	incident, err := uc.repo.GetIncident(ctx, incidentID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to get incident", goerr.V("incidentID", incidentID))
+	}
+
+	updates, err := uc.repo.ListStakeholderUpdates(ctx, incidentID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to list stakeholder updates", goerr.V("incidentID", incidentID))
+	}
+	var previous *model.StakeholderUpdate
+	if len(updates) > 0 {
+		previous = updates[len(updates)-1]
+	}
+
+	return uc.slackSvc.OpenStakeholderUpdateModal(ctx, triggerID, incident, previous)
+}
+
+// PostStakeholderUpdate records an update and posts it in the incident channel.
+// Updates of public incidents are also cross-posted to the channel the incident
+// was declared in and, except for test incidents, to the announcement channels.
+func (uc *StakeholderUpdate) PostStakeholderUpdate(ctx context.Context, incidentID types.IncidentID, text string, userID types.SlackUserID) (*model.StakeholderUpdate, error) {
+	incident, err := uc.repo.GetIncident(ctx, incidentID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get incident", goerr.V("incidentID", incidentID))
+	}
+
+	update, err := model.NewStakeholderUpdate(incident, text, userID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "invalid stakeholder update", goerr.V("incidentID", incidentID))
+	}
+	if err := uc.repo.AddStakeholderUpdate(ctx, update); err != nil {
+		return nil, goerr.Wrap(err, "failed to save stakeholder update", goerr.V("incidentID", incidentID))
+	}
+
+	uc.audit.Record(ctx, types.AuditActionIncidentStakeholderUpdate, audit.IncidentTarget(incidentID), userID, nil, update)
+	uc.events.PublishStakeholderUpdate(ctx, update)
+
+	// The update is recorded, so failing to post it to a channel is not fatal
+	for _, target := range uc.updateChannels(incident) {
+		if err := uc.slackSvc.PostStakeholderUpdate(ctx, target, incident, update, target != incident.ChannelID); err != nil {
+			apperr.Handle(ctx, goerr.Wrap(err, "failed to post stakeholder update",
+				goerr.V("incidentID", incidentID),
+				goerr.V("channelID", target)))
+		}
+	}
+
+	ctxlog.From(ctx).Info("Stakeholder update posted",
+		"incidentID", incidentID,
+		"postedBy", userID,
+	)
+	return update, nil
+}
+
+// ListStakeholderUpdates lists the updates of an incident, oldest first
+func (uc *StakeholderUpdate) ListStakeholderUpdates(ctx context.Context, incidentID types.IncidentID) ([]*model.StakeholderUpdate, error) {
+	updates, err := uc.repo.ListStakeholderUpdates(ctx, incidentID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to list stakeholder updates", goerr.V("incidentID", incidentID))
+	}
+	return updates, nil
+}
+
+// updateChannels returns the channels an update of incident is posted to, the
+// incident channel first
+func (uc *StakeholderUpdate) updateChannels(incident *model.Incident) []types.ChannelID {
+	var channels []types.ChannelID
+	add := func(ch types.ChannelID) {
+		if ch != "" && !slices.Contains(channels, ch) {
+			channels = append(channels, ch)
+		}
+	}
+
+	add(incident.ChannelID)
+	if incident.Private {
+		return channels
+	}
+	add(incident.OriginChannelID)
+	if !incident.IsTest && uc.config != nil && uc.config.StakeholderUpdates != nil {
+		for _, ch := range uc.config.StakeholderUpdates.AnnouncementChannels {
+			add(ch)
+		}
+	}
+	return channels
+}
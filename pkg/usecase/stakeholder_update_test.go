@@ -0,0 +1,167 @@
+package usecase_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
+	"github.com/secmon-lab/lycaon/pkg/domain/interfaces/mocks"
+	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/repository"
+	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/usecase"
+	"github.com/slack-go/slack"
+)
+
+func TestStakeholderUpdatePost(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (interfaces.Repository, *usecase.StakeholderUpdate, func() []string) {
+		repo := repository.NewMemory()
+
+		var mu sync.Mutex
+		var channels []string
+		mockSlack := &mocks.SlackClientMock{
+			PostMessageFunc: func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				channels = append(channels, channelID)
+				return channelID, "1234.5678", nil
+			},
+		}
+
+		appConfig := testConfig()
+		appConfig.StakeholderUpdates = &model.StakeholderUpdatesConfig{
+			AnnouncementChannels: []types.ChannelID{"C-ANNOUNCE", "C-ORIGIN"},
+		}
+		uc := usecase.NewStakeholderUpdate(repo, slackSvc.NewUIService(mockSlack, appConfig), appConfig)
+
+		return repo, uc, func() []string {
+			mu.Lock()
+			defer mu.Unlock()
+			return slices.Clone(channels)
+		}
+	}
+
+	t.Run("records the update and cross-posts it", func(t *testing.T) {
+		repo, uc, posted := setup(t)
+		gt.NoError(t, repo.PutIncident(ctx, &model.Incident{
+			ID: 1, ChannelID: "C-INCIDENT", OriginChannelID: "C-ORIGIN", Status: types.IncidentStatusHandling,
+		})).Required()
+
+		update, err := uc.PostStakeholderUpdate(ctx, 1, "  Login is degraded, fix in progress  ", "U-LEAD")
+		gt.NoError(t, err).Required()
+		gt.Equal(t, update.Text, "Login is degraded, fix in progress")
+		gt.Equal(t, update.Status, types.IncidentStatusHandling)
+		gt.Equal(t, update.PostedBy, types.SlackUserID("U-LEAD"))
+
+		// The origin channel is also an announcement channel but is posted to once
+		gt.A(t, posted()).Equal([]string{"C-INCIDENT", "C-ORIGIN", "C-ANNOUNCE"})
+
+		updates, err := uc.ListStakeholderUpdates(ctx, 1)
+		gt.NoError(t, err)
+		gt.A(t, updates).Length(1)
+		gt.Equal(t, updates[0].ID, update.ID)
+	})
+
+	t.Run("private incidents are only posted in the incident channel", func(t *testing.T) {
+		repo, uc, posted := setup(t)
+		gt.NoError(t, repo.PutIncident(ctx, &model.Incident{
+			ID: 1, ChannelID: "C-INCIDENT", OriginChannelID: "C-ORIGIN", Private: true,
+		})).Required()
+
+		_, err := uc.PostStakeholderUpdate(ctx, 1, "Contained", "U-LEAD")
+		gt.NoError(t, err)
+		gt.A(t, posted()).Equal([]string{"C-INCIDENT"})
+	})
+
+	t.Run("test incidents are not posted in announcement channels", func(t *testing.T) {
+		repo, uc, posted := setup(t)
+		gt.NoError(t, repo.PutIncident(ctx, &model.Incident{
+			ID: 1, ChannelID: "C-INCIDENT", OriginChannelID: "C-DECLARED", IsTest: true,
+		})).Required()
+
+		_, err := uc.PostStakeholderUpdate(ctx, 1, "Drill update", "U-LEAD")
+		gt.NoError(t, err)
+		gt.A(t, posted()).Equal([]string{"C-INCIDENT", "C-DECLARED"})
+	})
+
+	t.Run("invalid updates are rejected", func(t *testing.T) {
+		repo, uc, posted := setup(t)
+		gt.NoError(t, repo.PutIncident(ctx, &model.Incident{ID: 1, ChannelID: "C-INCIDENT"})).Required()
+
+		_, err := uc.PostStakeholderUpdate(ctx, 1, "   ", "U-LEAD")
+		gt.Error(t, err)
+		_, err = uc.PostStakeholderUpdate(ctx, 1, strings.Repeat("a", 3001), "U-LEAD")
+		gt.Error(t, err)
+		_, err = uc.PostStakeholderUpdate(ctx, 999, "Unknown incident", "U-LEAD")
+		gt.Error(t, err)
+
+		gt.A(t, posted()).Length(0)
+		updates, err := uc.ListStakeholderUpdates(ctx, 1)
+		gt.NoError(t, err)
+		gt.A(t, updates).Length(0)
+	})
+}
+
+func TestReminderStakeholderUpdatePrompt(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemory()
+
+	var mu sync.Mutex
+	posted := 0
+	mockSlack := &mocks.SlackClientMock{
+		PostMessageFunc: func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			posted++
+			return channelID, "1234.5678", nil
+		},
+	}
+	countPosts := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		result := posted
+		posted = 0
+		return result
+	}
+
+	appConfig := testConfig()
+	// Only stakeholder update prompts are enabled. The negligible scan interval
+	// keeps scans at simulated times from being deduplicated by wall clock TTLs.
+	appConfig.Reminders = &model.RemindersConfig{Interval: time.Nanosecond}
+	appConfig.StakeholderUpdates = &model.StakeholderUpdatesConfig{Interval: time.Hour}
+	uc := usecase.NewReminder(repo, slackSvc.NewUIService(mockSlack, appConfig), appConfig)
+
+	now := time.Now()
+	gt.NoError(t, repo.PutIncident(ctx, &model.Incident{
+		ID: 1, ChannelID: "C-INCIDENT", Status: types.IncidentStatusHandling, Lead: "U-LEAD", CreatedAt: now.Add(-2 * time.Hour),
+	})).Required()
+	gt.NoError(t, repo.PutIncident(ctx, &model.Incident{
+		ID: 2, ChannelID: "C-TRIAGE", Status: types.IncidentStatusTriage, Lead: "U-LEAD", CreatedAt: now.Add(-2 * time.Hour),
+	})).Required()
+
+	t.Run("prompts incidents in handling without a recent update", func(t *testing.T) {
+		gt.NoError(t, uc.Scan(ctx, now))
+		gt.Equal(t, countPosts(), 1)
+	})
+
+	t.Run("a posted update resets the prompt", func(t *testing.T) {
+		update, err := model.NewStakeholderUpdate(&model.Incident{ID: 1}, "Mitigated", "U-LEAD")
+		gt.NoError(t, err).Required()
+		update.PostedAt = now.Add(2 * time.Hour)
+		gt.NoError(t, repo.AddStakeholderUpdate(ctx, update)).Required()
+
+		gt.NoError(t, uc.Scan(ctx, now.Add(150*time.Minute)))
+		gt.Equal(t, countPosts(), 0)
+
+		gt.NoError(t, uc.Scan(ctx, now.Add(3*time.Hour)))
+		gt.Equal(t, countPosts(), 1)
+	})
+}
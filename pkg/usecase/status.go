@@ -4,13 +4,19 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/m-mizutani/goerr/v2"
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/service/audit"
+	"github.com/secmon-lab/lycaon/pkg/service/pubsub"
 	slackSvc "github.com/secmon-lab/lycaon/pkg/service/slack"
+	"github.com/secmon-lab/lycaon/pkg/utils/apperr"
 )
 
 // StatusUseCase provides status management functionality
@@ -18,19 +24,43 @@ type StatusUseCase struct {
 	repo     interfaces.Repository
 	slackSvc *slackSvc.UIService
 	config   *model.Config
+	audit    *audit.Recorder
+	events   *pubsub.Broker
+	notifier *changeNotifier
+}
+
+// StatusOption configures StatusUseCase
+type StatusOption func(*StatusUseCase)
+
+// WithStatusEvents sets the broker that status changes are published to
+func WithStatusEvents(events *pubsub.Broker) StatusOption {
+	return func(uc *StatusUseCase) {
+		uc.events = events
+	}
 }
 
 // NewStatusUseCase creates a new StatusUseCase instance
-func NewStatusUseCase(repo interfaces.Repository, slackSvc *slackSvc.UIService, config *model.Config) *StatusUseCase {
-	return &StatusUseCase{
+func NewStatusUseCase(repo interfaces.Repository, slackSvc *slackSvc.UIService, config *model.Config, opts ...StatusOption) *StatusUseCase {
+	uc := &StatusUseCase{
 		repo:     repo,
 		slackSvc: slackSvc,
 		config:   config,
+		audit:    audit.New(repo),
+		notifier: &changeNotifier{slackSvc: slackSvc, config: config},
+	}
+	for _, opt := range opts {
+		opt(uc)
 	}
+	return uc
 }
 
 // UpdateStatus updates the incident status and records the change in history
 func (uc *StatusUseCase) UpdateStatus(ctx context.Context, incidentID types.IncidentID, incidentStatus types.IncidentStatus, userID types.SlackUserID, note string) error {
+	return uc.updateStatus(ctx, incidentID, incidentStatus, userID, note, "")
+}
+
+// updateStatus implements UpdateStatus. A non-empty resolution is saved with a closure.
+func (uc *StatusUseCase) updateStatus(ctx context.Context, incidentID types.IncidentID, incidentStatus types.IncidentStatus, userID types.SlackUserID, note, resolution string) error {
 	// Validate input
 	if err := incidentID.Validate(); err != nil {
 		return goerr.Wrap(err, "invalid incident ID")
@@ -57,25 +87,212 @@ func (uc *StatusUseCase) UpdateStatus(ctx context.Context, incidentID types.Inci
 			goerr.V("newStatus", incidentStatus))
 	}
 
+	// Leaving closed reopens the incident, which needs to be explained
+	reopening := incident.Status == types.IncidentStatusClosed
+	if reopening && strings.TrimSpace(note) == "" {
+		return goerr.Wrap(model.ErrReopenReasonRequired, "failed to reopen incident",
+			goerr.V("incidentID", incidentID))
+	}
+
 	// Create status history entry
 	statusHistory, err := model.NewStatusHistory(incidentID, incidentStatus, userID, note)
 	if err != nil {
 		return goerr.Wrap(err, "failed to create status history")
 	}
+	statusHistory.Reopened = reopening
 
 	// Add status history to repository
 	if err := uc.repo.AddStatusHistory(ctx, statusHistory); err != nil {
 		return goerr.Wrap(err, "failed to add status history")
 	}
 
-	// Update incident status
-	if err := uc.repo.UpdateIncidentStatus(ctx, incidentID, incidentStatus); err != nil {
+	// Closures and reopens save the status together with the closure fields
+	if incidentStatus == types.IncidentStatusClosed || reopening {
+		if err := uc.trackClosure(ctx, incidentID, incidentStatus, resolution); err != nil {
+			return err
+		}
+	} else if err := uc.repo.UpdateIncidentStatus(ctx, incidentID, incidentStatus); err != nil {
 		return goerr.Wrap(err, "failed to update incident status")
 	}
 
+	uc.audit.Record(ctx, types.AuditActionIncidentStatusChange, audit.IncidentTarget(incidentID), userID,
+		statusAuditFields{Status: incident.Status},
+		statusAuditFields{Status: incidentStatus, Note: note},
+	)
+
+	before := *incident
+	incident.Status = incidentStatus
+	uc.events.PublishStatus(ctx, incident, statusHistory)
+	uc.notifier.notify(ctx, &before, incident, userID)
+
+	return nil
+}
+
+// trackClosure saves the new status of a closed or reopened incident. Closing keeps
+// when the incident was first and last closed, so its channel can be archived after
+// a grace period, and the resolution if given. Reopening counts the reopen, brings
+// back an archived channel and resumes reminders that were snoozed before closure.
+func (uc *StatusUseCase) trackClosure(ctx context.Context, incidentID types.IncidentID, incidentStatus types.IncidentStatus, resolution string) error {
+	// Only the closure fields are written, so concurrent edits of other fields are kept
+	var incident model.Incident
+	err := uc.repo.UpdateIncidentAtomic(ctx, incidentID, func(current *model.Incident) error {
+		current.Status = incidentStatus
+		if incidentStatus == types.IncidentStatusClosed {
+			if resolution != "" {
+				current.Resolution = resolution
+			}
+			current.ClosedAt = time.Now()
+			if current.FirstClosedAt.IsZero() {
+				current.FirstClosedAt = current.ClosedAt
+			}
+		} else {
+			current.ClosedAt = time.Time{}
+			current.ReopenCount++
+		}
+		incident = *current
+		return nil
+	})
+	if err != nil {
+		return goerr.Wrap(err, "failed to save incident closure")
+	}
+	if incidentStatus == types.IncidentStatusClosed {
+		return nil
+	}
+
+	if !incident.IsTest {
+		incidentReopens.Inc(incident.SeverityID.String())
+	}
+
+	if incident.ChannelArchived && uc.slackSvc != nil {
+		if err := uc.slackSvc.UnarchiveChannel(ctx, incident.ChannelID); err != nil {
+			apperr.Handle(ctx, goerr.Wrap(err, "failed to unarchive reopened incident channel",
+				goerr.V("incidentID", incidentID)))
+		} else if err := uc.repo.UpdateIncidentAtomic(ctx, incidentID, func(current *model.Incident) error {
+			current.ChannelArchived = false
+			return nil
+		}); err != nil {
+			return goerr.Wrap(err, "failed to save unarchived incident channel")
+		}
+	}
+	if err := uc.resumeReminders(ctx, &incident); err != nil {
+		apperr.Handle(ctx, err)
+	}
+	return nil
+}
+
+// CloseIncident closes the incident with a summary of its resolution, which is
+// kept on the incident and noted in the status history
+func (uc *StatusUseCase) CloseIncident(ctx context.Context, incidentID types.IncidentID, userID types.SlackUserID, resolution string) (*model.Incident, error) {
+	resolution = strings.TrimSpace(resolution)
+	if resolution == "" {
+		return nil, goerr.New("resolution summary is required to close an incident")
+	}
+
+	if err := uc.updateStatus(ctx, incidentID, types.IncidentStatusClosed, userID, resolution, resolution); err != nil {
+		return nil, goerr.Wrap(err, "failed to close incident")
+	}
+
+	incident, err := uc.repo.GetIncident(ctx, incidentID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get incident")
+	}
+	uc.events.PublishIncident(ctx, "", incident, userID.String())
+
+	// Let the channel know, as the incident may have been closed outside of Slack
+	if incident.ChannelID != "" {
+		if err := uc.PostStatusMessage(ctx, incident.ChannelID, incidentID); err != nil {
+			apperr.Handle(ctx, goerr.Wrap(err, "failed to post status message of closed incident",
+				goerr.V("incidentID", incidentID)))
+		}
+	}
+
+	return incident, nil
+}
+
+// ReopenIncident brings a closed incident back to handling. The reason is
+// required and recorded in the status history entry of the reopen.
+func (uc *StatusUseCase) ReopenIncident(ctx context.Context, incidentID types.IncidentID, userID types.SlackUserID, reason string) (*model.Incident, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, goerr.Wrap(model.ErrReopenReasonRequired, "failed to reopen incident",
+			goerr.V("incidentID", incidentID))
+	}
+
+	incident, err := uc.repo.GetIncident(ctx, incidentID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get incident")
+	}
+	if incident.Status != types.IncidentStatusClosed {
+		return nil, goerr.New("only closed incidents can be reopened",
+			goerr.V("incidentID", incidentID),
+			goerr.V("status", incident.Status))
+	}
+
+	if err := uc.UpdateStatus(ctx, incidentID, types.IncidentStatusHandling, userID, reason); err != nil {
+		return nil, goerr.Wrap(err, "failed to reopen incident")
+	}
+
+	incident, err = uc.repo.GetIncident(ctx, incidentID)
+	if err != nil {
+		return nil, goerr.Wrap(err, "failed to get incident")
+	}
+
+	// Let the channel know, as the incident may have been reopened outside of Slack
+	if incident.ChannelID != "" {
+		if err := uc.PostStatusMessage(ctx, incident.ChannelID, incidentID); err != nil {
+			apperr.Handle(ctx, goerr.Wrap(err, "failed to post status message of reopened incident",
+				goerr.V("incidentID", incidentID)))
+		}
+	}
+
+	return incident, nil
+}
+
+// resumeReminders lifts snoozes of the reminders of a reopened incident, which
+// were set while it was still open and would otherwise silence it
+func (uc *StatusUseCase) resumeReminders(ctx context.Context, incident *model.Incident) error {
+	keys := []string{
+		model.ReminderKey(incident.ID, model.ReminderKindStatusUpdate, ""),
+		model.ReminderKey(incident.ID, model.ReminderKindNoLead, ""),
+		model.ReminderKey(incident.ID, model.ReminderKindMonitoring, ""),
+		model.ReminderKey(incident.ID, model.ReminderKindStakeholderUpdate, ""),
+	}
+	tasks, err := uc.repo.ListTasksByIncident(ctx, incident.ID)
+	if err != nil {
+		return goerr.Wrap(err, "failed to list tasks of reopened incident", goerr.V("incidentID", incident.ID))
+	}
+	for _, task := range tasks {
+		keys = append(keys, model.ReminderKey(incident.ID, model.ReminderKindStaleTask, task.ID))
+	}
+
+	now := time.Now()
+	for _, key := range keys {
+		reminder, err := uc.repo.GetReminder(ctx, key)
+		if errors.Is(err, model.ErrReminderNotFound) {
+			continue
+		} else if err != nil {
+			return goerr.Wrap(err, "failed to get reminder", goerr.V("reminderID", key))
+		}
+		if !now.Before(reminder.SnoozedUntil) {
+			continue
+		}
+
+		reminder.SnoozedUntil = time.Time{}
+		reminder.SnoozedBy = ""
+		if err := uc.repo.PutReminder(ctx, reminder); err != nil {
+			return goerr.Wrap(err, "failed to resume reminder", goerr.V("reminderID", key))
+		}
+	}
+
 	return nil
 }
 
+// statusAuditFields is the audited part of a status change
+type statusAuditFields struct {
+	Status types.IncidentStatus
+	Note   string
+}
+
 // GetStatusHistory retrieves status history for an incident with user information
 func (uc *StatusUseCase) GetStatusHistory(ctx context.Context, incidentID types.IncidentID) ([]*model.StatusHistoryWithUser, error) {
 	if err := incidentID.Validate(); err != nil {
@@ -125,21 +342,13 @@ func (uc *StatusUseCase) PostStatusMessage(ctx context.Context, channelID types.
 		return goerr.Wrap(err, "failed to get incident")
 	}
 
-	// Get lead user information
-	var leadName string
-	if incident.Lead != "" {
-		leadUser, err := uc.repo.GetUserBySlackID(ctx, incident.Lead)
-		if err == nil && leadUser != nil {
-			leadName = leadUser.Name
-		} else {
-			leadName = string(incident.Lead)
-		}
-	} else {
-		leadName = "Not assigned"
+	relations, err := loadIncidentRelations(ctx, uc.repo, incident)
+	if err != nil {
+		return err
 	}
 
 	// Post status message using slack service
-	return uc.slackSvc.PostStatusMessage(ctx, channelID, incident, leadName)
+	return uc.slackSvc.PostStatusMessage(ctx, channelID, incident, incidentLeadName(ctx, uc.repo, incident), relations)
 }
 
 // HandleEditStatusAction handles Slack edit status action by opening a status selection modal
@@ -196,21 +405,13 @@ func (uc *StatusUseCase) UpdateOriginalStatusMessage(ctx context.Context, channe
 			goerr.V("messageTS", messageTS))
 	}
 
-	// Get lead user information
-	var leadName string
-	if incident.Lead != "" {
-		leadUser, err := uc.repo.GetUserBySlackID(ctx, incident.Lead)
-		if err == nil && leadUser != nil {
-			leadName = leadUser.Name
-		} else {
-			leadName = string(incident.Lead)
-		}
-	} else {
-		leadName = "Not assigned"
+	relations, err := loadIncidentRelations(ctx, uc.repo, incident)
+	if err != nil {
+		return err
 	}
 
 	// Update status message using slack service
-	return uc.slackSvc.UpdateStatusMessage(ctx, channelID, messageTS, incident, leadName)
+	return uc.slackSvc.UpdateStatusMessage(ctx, channelID, messageTS, incident, incidentLeadName(ctx, uc.repo, incident), relations)
 }
 
 // HandleStatusChangeModalSubmission handles status change modal submission processing
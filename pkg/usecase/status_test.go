@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -79,6 +80,35 @@ func TestStatusUseCase_UpdateStatus(t *testing.T) {
 	gt.Equal(t, latestHistory.Note, "Moving to monitoring phase")
 }
 
+func TestStatusUseCase_UpdateStatus_Audit(t *testing.T) {
+	repo := repository.NewMemory()
+	slackService := slackSvc.NewUIService(&mocks.SlackClientMock{}, testConfig())
+	statusUC := usecase.NewStatusUseCase(repo, slackService, testConfig())
+
+	incidentID := types.IncidentID(time.Now().UnixNano())
+	incident, err := model.NewIncident("inc", incidentID, "Test Incident", "", "test_category", "", nil,
+		"C123456", "test-channel", "T123456", "U123456", false)
+	gt.NoError(t, err).Required()
+	gt.NoError(t, repo.PutIncident(context.Background(), incident))
+
+	ctx := model.WithAuditActor(context.Background(), model.AuditActor{
+		ID:        "U789012",
+		Source:    types.AuditSourceSlack,
+		RequestID: "Ev123",
+	})
+	gt.NoError(t, statusUC.UpdateStatus(ctx, incidentID, types.IncidentStatusMonitoring, "U789012", "Mitigated"))
+
+	entries, err := repo.ListAuditEntries(ctx, model.AuditFilter{IncidentID: incidentID})
+	gt.NoError(t, err)
+	gt.A(t, entries).Length(1).At(0, func(t testing.TB, v *model.AuditEntry) {
+		gt.Equal(t, v.Action, types.AuditActionIncidentStatusChange)
+		gt.Equal(t, v.ActorID, "U789012")
+		gt.Equal(t, v.Source, types.AuditSourceSlack)
+		gt.Equal(t, v.RequestID, "Ev123")
+		gt.A(t, v.Changes).Length(2)
+	})
+}
+
 func TestStatusUseCase_UpdateStatus_SameStatus(t *testing.T) {
 	ctx := context.Background()
 	repo := repository.NewMemory()
@@ -518,3 +548,128 @@ func TestStatusUseCase_HandleStatusChangeModalSubmission_WithoutMessage(t *testi
 	// Verify UpdateMessage was NOT called (no message to update)
 	gt.Equal(t, len(mockSlack.UpdateMessageCalls()), 0)
 }
+
+func TestStatusUseCase_CloseIncident(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemory()
+	var posted []string
+	mockSlack := &mocks.SlackClientMock{
+		PostMessageFunc: func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+			posted = append(posted, channelID)
+			return channelID, "1234567890.123456", nil
+		},
+	}
+	slackService := slackSvc.NewUIService(mockSlack, testConfig())
+	statusUC := usecase.NewStatusUseCase(repo, slackService, testConfig())
+
+	incidentID := types.IncidentID(time.Now().UnixNano())
+	incident, err := model.NewIncident("inc", incidentID, "Test Incident", "", "test_category", "", nil,
+		"C123456", "test-channel", "T123456", "U123456", false)
+	gt.NoError(t, err).Required()
+	incident.ChannelID = "C-INCIDENT"
+	gt.NoError(t, repo.PutIncident(ctx, incident))
+
+	t.Run("resolution is required", func(t *testing.T) {
+		_, err := statusUC.CloseIncident(ctx, incidentID, "U789012", "  ")
+		gt.Error(t, err)
+
+		got, err := repo.GetIncident(ctx, incidentID)
+		gt.NoError(t, err).Required()
+		gt.NotEqual(t, got.Status, types.IncidentStatusClosed)
+	})
+
+	t.Run("closes with resolution", func(t *testing.T) {
+		closed, err := statusUC.CloseIncident(ctx, incidentID, "U789012", " Rolled back the release ")
+		gt.NoError(t, err).Required()
+		gt.Equal(t, closed.Status, types.IncidentStatusClosed)
+		gt.Equal(t, closed.Resolution, "Rolled back the release")
+
+		got, err := repo.GetIncident(ctx, incidentID)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, got.Resolution, "Rolled back the release")
+
+		histories, err := repo.GetStatusHistories(ctx, incidentID)
+		gt.NoError(t, err).Required()
+		gt.A(t, histories).Longer(0)
+		gt.Equal(t, histories[len(histories)-1].Note, "Rolled back the release")
+		gt.A(t, posted).Has("C-INCIDENT")
+	})
+}
+
+func TestStatusUseCase_ReopenIncident(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemory()
+	mockSlack := &mocks.SlackClientMock{
+		PostMessageFunc: func(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+			return channelID, "1234567890.123456", nil
+		},
+	}
+	slackService := slackSvc.NewUIService(mockSlack, testConfig())
+	statusUC := usecase.NewStatusUseCase(repo, slackService, testConfig())
+
+	incidentID := types.IncidentID(time.Now().UnixNano())
+	incident, err := model.NewIncident("inc", incidentID, "Test Incident", "", "test_category", "", nil,
+		"C123456", "test-channel", "T123456", "U123456", false)
+	gt.NoError(t, err).Required()
+	incident.ChannelID = "C-INCIDENT"
+	gt.NoError(t, repo.PutIncident(ctx, incident))
+
+	t.Run("open incidents cannot be reopened", func(t *testing.T) {
+		_, err := statusUC.ReopenIncident(ctx, incidentID, "U789012", "Regressed")
+		gt.Error(t, err)
+	})
+
+	_, err = statusUC.CloseIncident(ctx, incidentID, "U789012", "Rolled back the release")
+	gt.NoError(t, err).Required()
+	closed, err := repo.GetIncident(ctx, incidentID)
+	gt.NoError(t, err).Required()
+	gt.False(t, closed.FirstClosedAt.IsZero())
+
+	// A reminder snoozed while the incident was open
+	snoozed := model.NewReminder(incidentID, model.ReminderKindStatusUpdate, "")
+	snoozed.SnoozedUntil = time.Now().Add(24 * time.Hour)
+	snoozed.SnoozedBy = "U789012"
+	gt.NoError(t, repo.PutReminder(ctx, snoozed))
+
+	t.Run("reason is required", func(t *testing.T) {
+		_, err := statusUC.ReopenIncident(ctx, incidentID, "U789012", " ")
+		gt.True(t, errors.Is(err, model.ErrReopenReasonRequired))
+
+		// Leaving closed through a plain status change needs a reason as well
+		err = statusUC.UpdateStatus(ctx, incidentID, types.IncidentStatusTriage, "U789012", "")
+		gt.True(t, errors.Is(err, model.ErrReopenReasonRequired))
+
+		got, err := repo.GetIncident(ctx, incidentID)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, got.Status, types.IncidentStatusClosed)
+	})
+
+	t.Run("reopens into handling", func(t *testing.T) {
+		reopened, err := statusUC.ReopenIncident(ctx, incidentID, "U789012", "Errors are back")
+		gt.NoError(t, err).Required()
+		gt.Equal(t, reopened.Status, types.IncidentStatusHandling)
+		gt.Equal(t, reopened.ReopenCount, 1)
+		gt.True(t, reopened.ClosedAt.IsZero())
+		gt.Equal(t, reopened.FirstClosedAt, closed.FirstClosedAt)
+
+		histories, err := repo.GetStatusHistories(ctx, incidentID)
+		gt.NoError(t, err).Required()
+		last := histories[len(histories)-1]
+		gt.True(t, last.Reopened)
+		gt.Equal(t, last.Note, "Errors are back")
+
+		reminder, err := repo.GetReminder(ctx, snoozed.ID)
+		gt.NoError(t, err).Required()
+		gt.True(t, reminder.SnoozedUntil.IsZero())
+	})
+
+	t.Run("first closure is kept when closing again", func(t *testing.T) {
+		_, err := statusUC.CloseIncident(ctx, incidentID, "U789012", "Fixed for good")
+		gt.NoError(t, err).Required()
+
+		got, err := repo.GetIncident(ctx, incidentID)
+		gt.NoError(t, err).Required()
+		gt.Equal(t, got.FirstClosedAt, closed.FirstClosedAt)
+		gt.True(t, got.ClosedAt.After(got.FirstClosedAt))
+	})
+}
@@ -7,20 +7,39 @@ import (
 	"github.com/secmon-lab/lycaon/pkg/domain/interfaces"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
 	"github.com/secmon-lab/lycaon/pkg/domain/types"
+	"github.com/secmon-lab/lycaon/pkg/service/audit"
+	"github.com/secmon-lab/lycaon/pkg/service/pubsub"
 )
 
 // TaskUseCase implements the Task interface
 type TaskUseCase struct {
 	repo      interfaces.Repository
 	slackRepo interfaces.SlackClient
+	audit     *audit.Recorder
+	events    *pubsub.Broker
+}
+
+// TaskOption configures TaskUseCase
+type TaskOption func(*TaskUseCase)
+
+// WithTaskEvents sets the broker that task changes are published to
+func WithTaskEvents(events *pubsub.Broker) TaskOption {
+	return func(u *TaskUseCase) {
+		u.events = events
+	}
 }
 
 // NewTaskUseCase creates a new TaskUseCase instance
-func NewTaskUseCase(repo interfaces.Repository, slackRepo interfaces.SlackClient) interfaces.Task {
-	return &TaskUseCase{
+func NewTaskUseCase(repo interfaces.Repository, slackRepo interfaces.SlackClient, opts ...TaskOption) interfaces.Task {
+	u := &TaskUseCase{
 		repo:      repo,
 		slackRepo: slackRepo,
+		audit:     audit.New(repo),
+	}
+	for _, opt := range opts {
+		opt(u)
 	}
+	return u
 }
 
 // CreateTask creates a new task for an incident
@@ -50,6 +69,8 @@ func (u *TaskUseCase) CreateTask(ctx context.Context, incidentID types.IncidentI
 			goerr.V("taskID", task.ID),
 			goerr.V("incidentID", incidentID))
 	}
+	u.audit.Record(ctx, types.AuditActionTaskCreate, audit.TaskTarget(task), userID, nil, task)
+	u.events.PublishTask(ctx, types.TimelineEventTaskCreated, task, userID.String())
 
 	return task, nil
 }
@@ -80,6 +101,7 @@ func (u *TaskUseCase) UpdateTask(ctx context.Context, taskID types.TaskID, updat
 		return nil, goerr.Wrap(err, "failed to get task",
 			goerr.V("taskID", taskID))
 	}
+	before := *task
 
 	// Apply updates
 	if updates.Title != nil {
@@ -118,6 +140,8 @@ func (u *TaskUseCase) UpdateTask(ctx context.Context, taskID types.TaskID, updat
 		return nil, goerr.Wrap(err, "failed to save updated task",
 			goerr.V("taskID", taskID))
 	}
+	u.audit.Record(ctx, types.AuditActionTaskUpdate, audit.TaskTarget(task), "", &before, task)
+	u.events.PublishTask(ctx, types.TimelineEventTaskUpdated, task, "")
 
 	return task, nil
 }
@@ -131,6 +155,7 @@ func (u *TaskUseCase) UpdateTaskByIncident(ctx context.Context, incidentID types
 			goerr.V("incidentID", incidentID),
 			goerr.V("taskID", taskID))
 	}
+	before := *task
 
 	// Apply updates
 	if updates.Title != nil {
@@ -172,6 +197,8 @@ func (u *TaskUseCase) UpdateTaskByIncident(ctx context.Context, incidentID types
 			goerr.V("incidentID", incidentID),
 			goerr.V("taskID", taskID))
 	}
+	u.audit.Record(ctx, types.AuditActionTaskUpdate, audit.TaskTarget(task), "", &before, task)
+	u.events.PublishTask(ctx, types.TimelineEventTaskUpdated, task, "")
 
 	return task, nil
 }
@@ -184,6 +211,7 @@ func (u *TaskUseCase) CompleteTask(ctx context.Context, taskID types.TaskID) (*m
 		return nil, goerr.Wrap(err, "failed to get task",
 			goerr.V("taskID", taskID))
 	}
+	before := *task
 
 	// Mark as completed
 	if err := task.Complete(); err != nil {
@@ -196,6 +224,8 @@ func (u *TaskUseCase) CompleteTask(ctx context.Context, taskID types.TaskID) (*m
 		return nil, goerr.Wrap(err, "failed to save completed task",
 			goerr.V("taskID", taskID))
 	}
+	u.audit.Record(ctx, types.AuditActionTaskUpdate, audit.TaskTarget(task), "", &before, task)
+	u.events.PublishTask(ctx, types.TimelineEventTaskUpdated, task, "")
 
 	return task, nil
 }
@@ -209,6 +239,7 @@ func (u *TaskUseCase) CompleteTaskByIncident(ctx context.Context, incidentID typ
 			goerr.V("incidentID", incidentID),
 			goerr.V("taskID", taskID))
 	}
+	before := *task
 
 	// Mark as completed
 	if err := task.Complete(); err != nil {
@@ -223,6 +254,8 @@ func (u *TaskUseCase) CompleteTaskByIncident(ctx context.Context, incidentID typ
 			goerr.V("incidentID", incidentID),
 			goerr.V("taskID", taskID))
 	}
+	u.audit.Record(ctx, types.AuditActionTaskUpdate, audit.TaskTarget(task), "", &before, task)
+	u.events.PublishTask(ctx, types.TimelineEventTaskUpdated, task, "")
 
 	return task, nil
 }
@@ -235,6 +268,7 @@ func (u *TaskUseCase) UncompleteTask(ctx context.Context, taskID types.TaskID) (
 		return nil, goerr.Wrap(err, "failed to get task",
 			goerr.V("taskID", taskID))
 	}
+	before := *task
 
 	// Mark as incomplete
 	if err := task.Uncomplete(); err != nil {
@@ -247,6 +281,8 @@ func (u *TaskUseCase) UncompleteTask(ctx context.Context, taskID types.TaskID) (
 		return nil, goerr.Wrap(err, "failed to save uncompleted task",
 			goerr.V("taskID", taskID))
 	}
+	u.audit.Record(ctx, types.AuditActionTaskUpdate, audit.TaskTarget(task), "", &before, task)
+	u.events.PublishTask(ctx, types.TimelineEventTaskUpdated, task, "")
 
 	return task, nil
 }
@@ -260,6 +296,7 @@ func (u *TaskUseCase) UncompleteTaskByIncident(ctx context.Context, incidentID t
 			goerr.V("incidentID", incidentID),
 			goerr.V("taskID", taskID))
 	}
+	before := *task
 
 	// Mark as incomplete
 	if err := task.Uncomplete(); err != nil {
@@ -274,6 +311,8 @@ func (u *TaskUseCase) UncompleteTaskByIncident(ctx context.Context, incidentID t
 			goerr.V("incidentID", incidentID),
 			goerr.V("taskID", taskID))
 	}
+	u.audit.Record(ctx, types.AuditActionTaskUpdate, audit.TaskTarget(task), "", &before, task)
+	u.events.PublishTask(ctx, types.TimelineEventTaskUpdated, task, "")
 
 	return task, nil
 }
@@ -310,6 +349,7 @@ func (u *TaskUseCase) UpdateTaskStatusByIncident(ctx context.Context, incidentID
 			goerr.V("incidentID", incidentID),
 			goerr.V("taskID", taskID))
 	}
+	before := *task
 
 	// Update status
 	if err := task.UpdateStatus(status); err != nil {
@@ -326,6 +366,8 @@ func (u *TaskUseCase) UpdateTaskStatusByIncident(ctx context.Context, incidentID
 			goerr.V("taskID", taskID),
 			goerr.V("status", status))
 	}
+	u.audit.Record(ctx, types.AuditActionTaskUpdate, audit.TaskTarget(task), "", &before, task)
+	u.events.PublishTask(ctx, types.TimelineEventTaskUpdated, task, "")
 
 	return task, nil
 }
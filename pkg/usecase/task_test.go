@@ -25,6 +25,9 @@ func TestTaskUseCase_CreateTask(t *testing.T) {
 			CreateTaskFunc: func(ctx context.Context, task *model.Task) error {
 				return nil
 			},
+			PutAuditEntryFunc: func(ctx context.Context, entry *model.AuditEntry) error {
+				return nil
+			},
 		}
 		slackRepo := &mocks.SlackClientMock{}
 
@@ -48,6 +51,14 @@ func TestTaskUseCase_CreateTask(t *testing.T) {
 		// Verify mock calls
 		gt.Equal(t, len(repo.GetIncidentCalls()), 1)
 		gt.Equal(t, len(repo.CreateTaskCalls()), 1)
+		gt.A(t, repo.PutAuditEntryCalls()).Length(1).At(0, func(t testing.TB, v struct {
+			Ctx   context.Context
+			Entry *model.AuditEntry
+		}) {
+			gt.Equal(t, v.Entry.Action, types.AuditActionTaskCreate)
+			gt.Equal(t, v.Entry.ActorID, "U123456")
+			gt.Equal(t, v.Entry.IncidentID, incidentID)
+		})
 	})
 
 	t.Run("fails when incident not found", func(t *testing.T) {
@@ -126,6 +137,9 @@ func TestTaskUseCase_UpdateTask(t *testing.T) {
 			UpdateTaskFunc: func(ctx context.Context, task *model.Task) error {
 				return nil
 			},
+			PutAuditEntryFunc: func(ctx context.Context, entry *model.AuditEntry) error {
+				return nil
+			},
 		}
 		slackRepo := &mocks.SlackClientMock{}
 
@@ -206,6 +220,9 @@ func TestTaskUseCase_CompleteTask(t *testing.T) {
 			UpdateTaskFunc: func(ctx context.Context, task *model.Task) error {
 				return nil
 			},
+			PutAuditEntryFunc: func(ctx context.Context, entry *model.AuditEntry) error {
+				return nil
+			},
 		}
 		slackRepo := &mocks.SlackClientMock{}
 
@@ -7,6 +7,7 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/m-mizutani/ctxlog"
 	"github.com/secmon-lab/lycaon/pkg/domain/model"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Dispatch executes a handler function asynchronously with panic recovery
@@ -81,5 +82,10 @@ func NewBackgroundContext(ctx context.Context) context.Context {
 		newCtx = WithTraceID(newCtx, traceID)
 	}
 
+	// Spans started by the async operation continue the trace of the caller
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		newCtx = trace.ContextWithSpanContext(newCtx, sc)
+	}
+
 	return newCtx
 }
@@ -0,0 +1,411 @@
+// Package metrics exposes counters, histograms and gauges in the Prometheus
+// text exposition format. Instruments created with the package level
+// constructors are registered to Default, which is served on /metrics.
+package metrics
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"runtime"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/m-mizutani/ctxlog"
+	"github.com/m-mizutani/goerr/v2"
+)
+
+// contentType is the content type of the text exposition format
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// DefaultBuckets are latency buckets in seconds suitable for HTTP and API calls
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// Collector is a metric family that can be written in the exposition format
+type Collector interface {
+	name() string
+	write(ctx context.Context, w *bufio.Writer) error
+}
+
+// Registry holds the collectors exposed together
+type Registry struct {
+	mu         sync.RWMutex
+	collectors map[string]Collector
+}
+
+// NewRegistry creates an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{collectors: make(map[string]Collector)}
+}
+
+// Default is the registry of the package level constructors. It also exposes
+// metrics of the Go runtime.
+var Default = newDefaultRegistry()
+
+func newDefaultRegistry() *Registry {
+	r := NewRegistry()
+	r.mustRegister(NewGaugeFunc("go_goroutines", "Number of goroutines that currently exist.", nil,
+		func(context.Context) ([]Sample, error) {
+			return []Sample{{Value: float64(runtime.NumGoroutine())}}, nil
+		}))
+	r.mustRegister(NewGaugeFunc("go_memstats_heap_alloc_bytes", "Number of heap bytes allocated and still in use.", nil,
+		func(context.Context) ([]Sample, error) {
+			var m runtime.MemStats
+			runtime.ReadMemStats(&m)
+			return []Sample{{Value: float64(m.HeapAlloc)}}, nil
+		}))
+	return r
+}
+
+// Register adds collectors to the registry. Names must be unique.
+func (r *Registry) Register(collectors ...Collector) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, c := range collectors {
+		if _, ok := r.collectors[c.name()]; ok {
+			return goerr.New("metric is already registered", goerr.V("name", c.name()))
+		}
+	}
+	for _, c := range collectors {
+		r.collectors[c.name()] = c
+	}
+	return nil
+}
+
+// mustRegister registers collectors created by the package, whose names are fixed
+func (r *Registry) mustRegister(c Collector) {
+	if err := r.Register(c); err != nil {
+		panic(err)
+	}
+}
+
+// WriteTo writes all metrics ordered by name. A collector failing to collect
+// is skipped so that the other metrics are still exposed.
+func (r *Registry) WriteTo(ctx context.Context, w io.Writer) error {
+	r.mu.RLock()
+	collectors := make([]Collector, 0, len(r.collectors))
+	for _, c := range r.collectors {
+		collectors = append(collectors, c)
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(collectors, func(i, j int) bool {
+		return collectors[i].name() < collectors[j].name()
+	})
+
+	bw := bufio.NewWriter(w)
+	for _, c := range collectors {
+		if err := c.write(ctx, bw); err != nil {
+			ctxlog.From(ctx).Warn("Failed to collect metric", "name", c.name(), "error", err)
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return goerr.Wrap(err, "failed to write metrics")
+	}
+	return nil
+}
+
+// Handler serves the metrics of the registry
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		if err := r.WriteTo(req.Context(), w); err != nil {
+			ctxlog.From(req.Context()).Error("Failed to write metrics", "error", err)
+		}
+	})
+}
+
+// desc is the name, help and label names shared by all instruments
+type desc struct {
+	metricName string
+	help       string
+	labels     []string
+}
+
+func (d *desc) name() string {
+	return d.metricName
+}
+
+func (d *desc) writeHeader(w *bufio.Writer, typ string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", d.metricName, escapeHelp(d.help), d.metricName, typ)
+}
+
+// key joins label values into a map key
+func (d *desc) key(values []string) string {
+	if len(values) != len(d.labels) {
+		panic(fmt.Sprintf("metric %s expects %d label values, got %d", d.metricName, len(d.labels), len(values)))
+	}
+	return strings.Join(values, "\xff")
+}
+
+// CounterVec is a set of counters partitioned by label values
+type CounterVec struct {
+	desc
+	mu     sync.Mutex
+	values map[string]*counterValue
+}
+
+type counterValue struct {
+	labels []string
+	value  float64
+}
+
+// NewCounterVec creates a counter vector registered to the registry
+func (r *Registry) NewCounterVec(name, help string, labels ...string) *CounterVec {
+	c := &CounterVec{
+		desc:   desc{metricName: name, help: help, labels: labels},
+		values: make(map[string]*counterValue),
+	}
+	r.mustRegister(c)
+	return c
+}
+
+// NewCounterVec creates a counter vector registered to Default
+func NewCounterVec(name, help string, labels ...string) *CounterVec {
+	return Default.NewCounterVec(name, help, labels...)
+}
+
+// Inc increments the counter of the label values by one
+func (c *CounterVec) Inc(values ...string) {
+	c.Add(1, values...)
+}
+
+// Add increases the counter of the label values by v, which must not be negative
+func (c *CounterVec) Add(v float64, values ...string) {
+	if v < 0 {
+		return
+	}
+	key := c.key(values)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cv, ok := c.values[key]
+	if !ok {
+		cv = &counterValue{labels: slices.Clone(values)}
+		c.values[key] = cv
+	}
+	cv.value += v
+}
+
+// Value returns the current value of the counter of the label values
+func (c *CounterVec) Value(values ...string) float64 {
+	key := c.key(values)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cv, ok := c.values[key]; ok {
+		return cv.value
+	}
+	return 0
+}
+
+func (c *CounterVec) write(_ context.Context, w *bufio.Writer) error {
+	c.mu.Lock()
+	samples := make([]Sample, 0, len(c.values))
+	for _, cv := range c.values {
+		samples = append(samples, Sample{LabelValues: cv.labels, Value: cv.value})
+	}
+	c.mu.Unlock()
+
+	c.writeHeader(w, "counter")
+	writeSamples(w, c.metricName, c.labels, samples)
+	return nil
+}
+
+// HistogramVec is a set of histograms partitioned by label values
+type HistogramVec struct {
+	desc
+	buckets []float64
+	mu      sync.Mutex
+	values  map[string]*histogramValue
+}
+
+type histogramValue struct {
+	labels []string
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// NewHistogramVec creates a histogram vector registered to the registry.
+// buckets are upper bounds in increasing order; the +Inf bucket is implicit.
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	h := &HistogramVec{
+		desc:    desc{metricName: name, help: help, labels: labels},
+		buckets: slices.Sorted(slices.Values(buckets)),
+		values:  make(map[string]*histogramValue),
+	}
+	r.mustRegister(h)
+	return h
+}
+
+// NewHistogramVec creates a histogram vector registered to Default
+func NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	return Default.NewHistogramVec(name, help, buckets, labels...)
+}
+
+// Observe adds v to the histogram of the label values
+func (h *HistogramVec) Observe(v float64, values ...string) {
+	key := h.key(values)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	hv, ok := h.values[key]
+	if !ok {
+		hv = &histogramValue{labels: slices.Clone(values), counts: make([]uint64, len(h.buckets))}
+		h.values[key] = hv
+	}
+	for i, upper := range h.buckets {
+		if v <= upper {
+			hv.counts[i]++
+		}
+	}
+	hv.count++
+	hv.sum += v
+}
+
+// Count returns the number of observations of the label values
+func (h *HistogramVec) Count(values ...string) uint64 {
+	key := h.key(values)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if hv, ok := h.values[key]; ok {
+		return hv.count
+	}
+	return 0
+}
+
+func (h *HistogramVec) write(_ context.Context, w *bufio.Writer) error {
+	h.mu.Lock()
+	snapshot := make([]histogramValue, 0, len(h.values))
+	for _, hv := range h.values {
+		snapshot = append(snapshot, histogramValue{
+			labels: hv.labels,
+			counts: slices.Clone(hv.counts),
+			count:  hv.count,
+			sum:    hv.sum,
+		})
+	}
+	h.mu.Unlock()
+
+	slices.SortFunc(snapshot, func(a, b histogramValue) int {
+		return slices.Compare(a.labels, b.labels)
+	})
+
+	h.writeHeader(w, "histogram")
+	bucketLabels := append(slices.Clone(h.labels), "le")
+	for _, hv := range snapshot {
+		for i, upper := range h.buckets {
+			writeSample(w, h.metricName+"_bucket", bucketLabels, append(slices.Clone(hv.labels), formatFloat(upper)), float64(hv.counts[i]))
+		}
+		writeSample(w, h.metricName+"_bucket", bucketLabels, append(slices.Clone(hv.labels), "+Inf"), float64(hv.count))
+		writeSample(w, h.metricName+"_sum", h.labels, hv.labels, hv.sum)
+		writeSample(w, h.metricName+"_count", h.labels, hv.labels, float64(hv.count))
+	}
+	return nil
+}
+
+// Sample is a value of a metric with its label values
+type Sample struct {
+	LabelValues []string
+	Value       float64
+}
+
+// CollectFunc returns the current samples of a metric
+type CollectFunc func(ctx context.Context) ([]Sample, error)
+
+// funcCollector exposes samples computed at scrape time
+type funcCollector struct {
+	desc
+	typ     string
+	collect CollectFunc
+}
+
+// NewGaugeFunc creates a gauge whose samples are collected at scrape time
+func NewGaugeFunc(name, help string, labels []string, collect CollectFunc) Collector {
+	return &funcCollector{desc: desc{metricName: name, help: help, labels: labels}, typ: "gauge", collect: collect}
+}
+
+// NewCounterFunc creates a counter whose samples are collected at scrape time,
+// e.g. from counters maintained by another component
+func NewCounterFunc(name, help string, labels []string, collect CollectFunc) Collector {
+	return &funcCollector{desc: desc{metricName: name, help: help, labels: labels}, typ: "counter", collect: collect}
+}
+
+func (f *funcCollector) write(ctx context.Context, w *bufio.Writer) error {
+	samples, err := f.collect(ctx)
+	if err != nil {
+		return goerr.Wrap(err, "failed to collect samples", goerr.V("name", f.metricName))
+	}
+	for _, s := range samples {
+		if len(s.LabelValues) != len(f.labels) {
+			return goerr.New("sample has wrong number of label values",
+				goerr.V("name", f.metricName), goerr.V("labels", s.LabelValues))
+		}
+	}
+
+	f.writeHeader(w, f.typ)
+	writeSamples(w, f.metricName, f.labels, samples)
+	return nil
+}
+
+func writeSamples(w *bufio.Writer, name string, labels []string, samples []Sample) {
+	samples = slices.Clone(samples) // Collectors may return shared, cached samples
+	slices.SortFunc(samples, func(a, b Sample) int {
+		return slices.Compare(a.LabelValues, b.LabelValues)
+	})
+	for _, s := range samples {
+		writeSample(w, name, labels, s.LabelValues, s.Value)
+	}
+}
+
+func writeSample(w *bufio.Writer, name string, labels, values []string, v float64) {
+	w.WriteString(name)
+	if len(labels) > 0 {
+		w.WriteByte('{')
+		for i, label := range labels {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			fmt.Fprintf(w, "%s=\"%s\"", label, escapeLabelValue(values[i]))
+		}
+		w.WriteByte('}')
+	}
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(v))
+	w.WriteByte('\n')
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string {
+	return helpEscaper.Replace(s)
+}
+
+func escapeLabelValue(s string) string {
+	return labelEscaper.Replace(s)
+}
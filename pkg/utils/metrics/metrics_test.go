@@ -0,0 +1,107 @@
+package metrics_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/utils/metrics"
+)
+
+func scrape(t *testing.T, reg *metrics.Registry) string {
+	t.Helper()
+	var buf bytes.Buffer
+	gt.NoError(t, reg.WriteTo(context.Background(), &buf)).Required()
+	return buf.String()
+}
+
+func TestRegistry(t *testing.T) {
+	t.Run("counters are written with escaped labels", func(t *testing.T) {
+		reg := metrics.NewRegistry()
+		c := reg.NewCounterVec("test_calls_total", "Calls by method.", "method")
+		c.Inc("chat.postMessage")
+		c.Add(2, "chat.postMessage")
+		c.Inc(`a"b\c`)
+		c.Add(-1, "chat.postMessage") // Counters never decrease
+
+		gt.Equal(t, 3.0, c.Value("chat.postMessage"))
+		gt.Equal(t, `# HELP test_calls_total Calls by method.
+# TYPE test_calls_total counter
+test_calls_total{method="a\"b\\c"} 1
+test_calls_total{method="chat.postMessage"} 3
+`, scrape(t, reg))
+	})
+
+	t.Run("histograms are cumulative", func(t *testing.T) {
+		reg := metrics.NewRegistry()
+		h := reg.NewHistogramVec("test_duration_seconds", "Durations.", []float64{1, 0.1}, "route")
+		h.Observe(0.05, "/health")
+		h.Observe(0.5, "/health")
+		h.Observe(5, "/health")
+
+		gt.Equal(t, uint64(3), h.Count("/health"))
+		gt.Equal(t, `# HELP test_duration_seconds Durations.
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{route="/health",le="0.1"} 1
+test_duration_seconds_bucket{route="/health",le="1"} 2
+test_duration_seconds_bucket{route="/health",le="+Inf"} 3
+test_duration_seconds_sum{route="/health"} 5.55
+test_duration_seconds_count{route="/health"} 3
+`, scrape(t, reg))
+	})
+
+	t.Run("gauge functions are collected at scrape time", func(t *testing.T) {
+		reg := metrics.NewRegistry()
+		depth := 1.0
+		gt.NoError(t, reg.Register(metrics.NewGaugeFunc("test_depth", "Depth.", []string{"status"},
+			func(context.Context) ([]metrics.Sample, error) {
+				return []metrics.Sample{{LabelValues: []string{"pending"}, Value: depth}}, nil
+			}))).Required()
+
+		gt.S(t, scrape(t, reg)).Contains(`test_depth{status="pending"} 1`)
+		depth = 4
+		gt.S(t, scrape(t, reg)).Contains(`test_depth{status="pending"} 4`)
+	})
+
+	t.Run("failing collectors are skipped", func(t *testing.T) {
+		reg := metrics.NewRegistry()
+		gt.NoError(t, reg.Register(
+			metrics.NewGaugeFunc("test_broken", "Broken.", nil, func(context.Context) ([]metrics.Sample, error) {
+				return nil, errors.New("unavailable")
+			}),
+			metrics.NewCounterFunc("test_ok_total", "OK.", nil, func(context.Context) ([]metrics.Sample, error) {
+				return []metrics.Sample{{Value: 7}}, nil
+			}),
+		)).Required()
+
+		out := scrape(t, reg)
+		gt.S(t, out).NotContains("test_broken")
+		gt.S(t, out).Contains("# TYPE test_ok_total counter\ntest_ok_total 7\n")
+	})
+
+	t.Run("names must be unique", func(t *testing.T) {
+		reg := metrics.NewRegistry()
+		gauge := func() metrics.Collector {
+			return metrics.NewGaugeFunc("test_dup", "Dup.", nil, func(context.Context) ([]metrics.Sample, error) {
+				return nil, nil
+			})
+		}
+		gt.NoError(t, reg.Register(gauge()))
+		gt.Error(t, reg.Register(gauge()))
+	})
+
+	t.Run("handler sets the exposition content type", func(t *testing.T) {
+		reg := metrics.NewRegistry()
+		reg.NewCounterVec("test_requests_total", "Requests.").Inc()
+
+		w := httptest.NewRecorder()
+		reg.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		gt.Equal(t, http.StatusOK, w.Code)
+		gt.S(t, w.Header().Get("Content-Type")).HasPrefix("text/plain; version=0.0.4")
+		gt.S(t, w.Body.String()).Contains("test_requests_total 1\n")
+	})
+}
@@ -0,0 +1,65 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+	"github.com/secmon-lab/lycaon/pkg/utils/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	return recorder
+}
+
+func TestStartAndEnd(t *testing.T) {
+	recorder := setupRecorder(t)
+	ctx := context.Background()
+
+	ctx, parent := tracing.Start(ctx, "parent")
+	_, child := tracing.Start(ctx, "child")
+	tracing.End(child, errors.New("boom"))
+	tracing.End(parent, nil)
+
+	spans := recorder.Ended()
+	gt.A(t, spans).Length(2).Required()
+	gt.Equal(t, "child", spans[0].Name())
+	gt.Equal(t, codes.Error, spans[0].Status().Code)
+	gt.Equal(t, "boom", spans[0].Status().Description)
+	gt.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	gt.Equal(t, codes.Unset, spans[1].Status().Code)
+	gt.Equal(t, tracing.TraceID(ctx), spans[1].SpanContext().TraceID().String())
+}
+
+func TestInjectAndExtract(t *testing.T) {
+	setupRecorder(t)
+
+	t.Run("trace continues from traceparent", func(t *testing.T) {
+		ctx, span := tracing.Start(context.Background(), "enqueue")
+		defer span.End()
+
+		traceparent := tracing.Inject(ctx)
+		gt.S(t, traceparent).HasPrefix("00-" + span.SpanContext().TraceID().String())
+
+		resumed, child := tracing.Start(tracing.Extract(context.Background(), traceparent), "run")
+		defer child.End()
+		gt.Equal(t, span.SpanContext().TraceID().String(), tracing.TraceID(resumed))
+	})
+
+	t.Run("without span", func(t *testing.T) {
+		ctx := context.Background()
+		gt.Equal(t, "", tracing.Inject(ctx))
+		gt.Equal(t, "", tracing.TraceID(tracing.Extract(ctx, "")))
+		gt.Equal(t, "", tracing.TraceID(tracing.Extract(ctx, "malformed")))
+	})
+}